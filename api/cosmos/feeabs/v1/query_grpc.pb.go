@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: cosmos/feeabs/v1/query.proto
+
+package feeabsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	Query_Params_FullMethodName        = "/cosmos.feeabs.v1.Query/Params"
+	Query_FeeDenom_FullMethodName      = "/cosmos.feeabs.v1.Query/FeeDenom"
+	Query_EquivalentFee_FullMethodName = "/cosmos.feeabs.v1.Query/EquivalentFee"
+	Query_Reserve_FullMethodName       = "/cosmos.feeabs.v1.Query/Reserve"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query defines the gRPC querier service.
+type QueryClient interface {
+	// Params returns the total set of fee abstraction parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeeDenom returns the conversion rate of an accepted fee denom.
+	FeeDenom(ctx context.Context, in *QueryFeeDenomRequest, opts ...grpc.CallOption) (*QueryFeeDenomResponse, error)
+	// EquivalentFee returns the base denom equivalent of a fee.
+	EquivalentFee(ctx context.Context, in *QueryEquivalentFeeRequest, opts ...grpc.CallOption) (*QueryEquivalentFeeResponse, error)
+	// Reserve returns the base denom reserve the equivalent of the converted
+	// fees is paid from.
+	Reserve(ctx context.Context, in *QueryReserveRequest, opts ...grpc.CallOption) (*QueryReserveResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeDenom(ctx context.Context, in *QueryFeeDenomRequest, opts ...grpc.CallOption) (*QueryFeeDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeeDenomResponse)
+	err := c.cc.Invoke(ctx, Query_FeeDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EquivalentFee(ctx context.Context, in *QueryEquivalentFeeRequest, opts ...grpc.CallOption) (*QueryEquivalentFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEquivalentFeeResponse)
+	err := c.cc.Invoke(ctx, Query_EquivalentFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reserve(ctx context.Context, in *QueryReserveRequest, opts ...grpc.CallOption) (*QueryReserveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryReserveResponse)
+	err := c.cc.Invoke(ctx, Query_Reserve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+//
+// Query defines the gRPC querier service.
+type QueryServer interface {
+	// Params returns the total set of fee abstraction parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeeDenom returns the conversion rate of an accepted fee denom.
+	FeeDenom(context.Context, *QueryFeeDenomRequest) (*QueryFeeDenomResponse, error)
+	// EquivalentFee returns the base denom equivalent of a fee.
+	EquivalentFee(context.Context, *QueryEquivalentFeeRequest) (*QueryEquivalentFeeResponse, error)
+	// Reserve returns the base denom reserve the equivalent of the converted
+	// fees is paid from.
+	Reserve(context.Context, *QueryReserveRequest) (*QueryReserveResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) FeeDenom(context.Context, *QueryFeeDenomRequest) (*QueryFeeDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDenom not implemented")
+}
+func (UnimplementedQueryServer) EquivalentFee(context.Context, *QueryEquivalentFeeRequest) (*QueryEquivalentFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquivalentFee not implemented")
+}
+func (UnimplementedQueryServer) Reserve(context.Context, *QueryReserveRequest) (*QueryReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserve not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDenom(ctx, req.(*QueryFeeDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EquivalentFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEquivalentFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EquivalentFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EquivalentFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EquivalentFee(ctx, req.(*QueryEquivalentFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Reserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reserve(ctx, req.(*QueryReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.feeabs.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FeeDenom",
+			Handler:    _Query_FeeDenom_Handler,
+		},
+		{
+			MethodName: "EquivalentFee",
+			Handler:    _Query_EquivalentFee_Handler,
+		},
+		{
+			MethodName: "Reserve",
+			Handler:    _Query_Reserve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/feeabs/v1/query.proto",
+}
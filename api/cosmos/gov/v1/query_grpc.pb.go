@@ -21,14 +21,19 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Query_Proposal_FullMethodName    = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName   = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName        = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName       = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName      = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName     = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName    = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Proposal_FullMethodName            = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName           = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName               = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName              = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
+	Query_GovDelegation_FullMethodName       = "/cosmos.gov.v1.Query/GovDelegation"
+	Query_Representative_FullMethodName      = "/cosmos.gov.v1.Query/Representative"
+	Query_Representatives_FullMethodName     = "/cosmos.gov.v1.Query/Representatives"
+	Query_SimulateProposal_FullMethodName    = "/cosmos.gov.v1.Query/SimulateProposal"
+	Query_OptimisticProposals_FullMethodName = "/cosmos.gov.v1.Query/OptimisticProposals"
 )
 
 // QueryClient is the client API for Query service.
@@ -53,6 +58,17 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// GovDelegation queries the governance vote delegation of a delegator.
+	GovDelegation(ctx context.Context, in *QueryGovDelegationRequest, opts ...grpc.CallOption) (*QueryGovDelegationResponse, error)
+	// Representative queries a representative and its voting power.
+	Representative(ctx context.Context, in *QueryRepresentativeRequest, opts ...grpc.CallOption) (*QueryRepresentativeResponse, error)
+	// Representatives queries all representatives and their voting power.
+	Representatives(ctx context.Context, in *QueryRepresentativesRequest, opts ...grpc.CallOption) (*QueryRepresentativesResponse, error)
+	// SimulateProposal executes the messages of a proposal as the gov module account against
+	// the state at the current height, without committing any state change.
+	SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error)
+	// OptimisticProposals queries the proposals on the optimistic track.
+	OptimisticProposals(ctx context.Context, in *QueryOptimisticProposalsRequest, opts ...grpc.CallOption) (*QueryOptimisticProposalsResponse, error)
 }
 
 type queryClient struct {
@@ -143,6 +159,56 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) GovDelegation(ctx context.Context, in *QueryGovDelegationRequest, opts ...grpc.CallOption) (*QueryGovDelegationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_GovDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Representative(ctx context.Context, in *QueryRepresentativeRequest, opts ...grpc.CallOption) (*QueryRepresentativeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRepresentativeResponse)
+	err := c.cc.Invoke(ctx, Query_Representative_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Representatives(ctx context.Context, in *QueryRepresentativesRequest, opts ...grpc.CallOption) (*QueryRepresentativesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRepresentativesResponse)
+	err := c.cc.Invoke(ctx, Query_Representatives_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateProposal(ctx context.Context, in *QuerySimulateProposalRequest, opts ...grpc.CallOption) (*QuerySimulateProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySimulateProposalResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OptimisticProposals(ctx context.Context, in *QueryOptimisticProposalsRequest, opts ...grpc.CallOption) (*QueryOptimisticProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOptimisticProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_OptimisticProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -165,6 +231,17 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// GovDelegation queries the governance vote delegation of a delegator.
+	GovDelegation(context.Context, *QueryGovDelegationRequest) (*QueryGovDelegationResponse, error)
+	// Representative queries a representative and its voting power.
+	Representative(context.Context, *QueryRepresentativeRequest) (*QueryRepresentativeResponse, error)
+	// Representatives queries all representatives and their voting power.
+	Representatives(context.Context, *QueryRepresentativesRequest) (*QueryRepresentativesResponse, error)
+	// SimulateProposal executes the messages of a proposal as the gov module account against
+	// the state at the current height, without committing any state change.
+	SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error)
+	// OptimisticProposals queries the proposals on the optimistic track.
+	OptimisticProposals(context.Context, *QueryOptimisticProposalsRequest) (*QueryOptimisticProposalsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -196,6 +273,21 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) GovDelegation(context.Context, *QueryGovDelegationRequest) (*QueryGovDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovDelegation not implemented")
+}
+func (UnimplementedQueryServer) Representative(context.Context, *QueryRepresentativeRequest) (*QueryRepresentativeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Representative not implemented")
+}
+func (UnimplementedQueryServer) Representatives(context.Context, *QueryRepresentativesRequest) (*QueryRepresentativesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Representatives not implemented")
+}
+func (UnimplementedQueryServer) SimulateProposal(context.Context, *QuerySimulateProposalRequest) (*QuerySimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
+func (UnimplementedQueryServer) OptimisticProposals(context.Context, *QueryOptimisticProposalsRequest) (*QueryOptimisticProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimisticProposals not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -353,6 +445,96 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GovDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovDelegation(ctx, req.(*QueryGovDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Representative_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRepresentativeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Representative(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Representative_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Representative(ctx, req.(*QueryRepresentativeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Representatives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRepresentativesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Representatives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Representatives_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Representatives(ctx, req.(*QueryRepresentativesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposal(ctx, req.(*QuerySimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OptimisticProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOptimisticProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OptimisticProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OptimisticProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OptimisticProposals(ctx, req.(*QueryOptimisticProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -392,6 +574,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "GovDelegation",
+			Handler:    _Query_GovDelegation_Handler,
+		},
+		{
+			MethodName: "Representative",
+			Handler:    _Query_Representative_Handler,
+		},
+		{
+			MethodName: "Representatives",
+			Handler:    _Query_Representatives_Handler,
+		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _Query_SimulateProposal_Handler,
+		},
+		{
+			MethodName: "OptimisticProposals",
+			Handler:    _Query_OptimisticProposals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -21,12 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Msg_SubmitProposal_FullMethodName    = "/cosmos.gov.v1.Msg/SubmitProposal"
-	Msg_ExecLegacyContent_FullMethodName = "/cosmos.gov.v1.Msg/ExecLegacyContent"
-	Msg_Vote_FullMethodName              = "/cosmos.gov.v1.Msg/Vote"
-	Msg_VoteWeighted_FullMethodName      = "/cosmos.gov.v1.Msg/VoteWeighted"
-	Msg_Deposit_FullMethodName           = "/cosmos.gov.v1.Msg/Deposit"
-	Msg_UpdateParams_FullMethodName      = "/cosmos.gov.v1.Msg/UpdateParams"
+	Msg_SubmitProposal_FullMethodName               = "/cosmos.gov.v1.Msg/SubmitProposal"
+	Msg_ExecLegacyContent_FullMethodName            = "/cosmos.gov.v1.Msg/ExecLegacyContent"
+	Msg_Vote_FullMethodName                         = "/cosmos.gov.v1.Msg/Vote"
+	Msg_VoteWeighted_FullMethodName                 = "/cosmos.gov.v1.Msg/VoteWeighted"
+	Msg_Deposit_FullMethodName                      = "/cosmos.gov.v1.Msg/Deposit"
+	Msg_UpdateParams_FullMethodName                 = "/cosmos.gov.v1.Msg/UpdateParams"
+	Msg_DelegateGovVote_FullMethodName              = "/cosmos.gov.v1.Msg/DelegateGovVote"
+	Msg_UndelegateGovVote_FullMethodName            = "/cosmos.gov.v1.Msg/UndelegateGovVote"
+	Msg_CancelProposal_FullMethodName               = "/cosmos.gov.v1.Msg/CancelProposal"
+	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
 )
 
 // MsgClient is the client API for Msg service.
@@ -51,6 +55,18 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// DelegateGovVote defines a method to delegate the governance voting power
+	// of an account to a representative.
+	DelegateGovVote(ctx context.Context, in *MsgDelegateGovVote, opts ...grpc.CallOption) (*MsgDelegateGovVoteResponse, error)
+	// UndelegateGovVote defines a method to remove the governance vote
+	// delegation of an account.
+	UndelegateGovVote(ctx context.Context, in *MsgUndelegateGovVote, opts ...grpc.CallOption) (*MsgUndelegateGovVoteResponse, error)
+	// CancelProposal defines a method to cancel a proposal in its deposit or
+	// voting period by its proposer.
+	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// SubmitMultipleChoiceProposal defines a method to create a new multiple
+	// choice proposal, which has no messages to execute.
+	SubmitMultipleChoiceProposal(ctx context.Context, in *MsgSubmitMultipleChoiceProposal, opts ...grpc.CallOption) (*MsgSubmitMultipleChoiceProposalResponse, error)
 }
 
 type msgClient struct {
@@ -121,6 +137,46 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) DelegateGovVote(ctx context.Context, in *MsgDelegateGovVote, opts ...grpc.CallOption) (*MsgDelegateGovVoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDelegateGovVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateGovVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateGovVote(ctx context.Context, in *MsgUndelegateGovVote, opts ...grpc.CallOption) (*MsgUndelegateGovVoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUndelegateGovVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateGovVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitMultipleChoiceProposal(ctx context.Context, in *MsgSubmitMultipleChoiceProposal, opts ...grpc.CallOption) (*MsgSubmitMultipleChoiceProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSubmitMultipleChoiceProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitMultipleChoiceProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -143,6 +199,18 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// DelegateGovVote defines a method to delegate the governance voting power
+	// of an account to a representative.
+	DelegateGovVote(context.Context, *MsgDelegateGovVote) (*MsgDelegateGovVoteResponse, error)
+	// UndelegateGovVote defines a method to remove the governance vote
+	// delegation of an account.
+	UndelegateGovVote(context.Context, *MsgUndelegateGovVote) (*MsgUndelegateGovVoteResponse, error)
+	// CancelProposal defines a method to cancel a proposal in its deposit or
+	// voting period by its proposer.
+	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// SubmitMultipleChoiceProposal defines a method to create a new multiple
+	// choice proposal, which has no messages to execute.
+	SubmitMultipleChoiceProposal(context.Context, *MsgSubmitMultipleChoiceProposal) (*MsgSubmitMultipleChoiceProposalResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -168,6 +236,18 @@ func (UnimplementedMsgServer) Deposit(context.Context, *MsgDeposit) (*MsgDeposit
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) DelegateGovVote(context.Context, *MsgDelegateGovVote) (*MsgDelegateGovVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateGovVote not implemented")
+}
+func (UnimplementedMsgServer) UndelegateGovVote(context.Context, *MsgUndelegateGovVote) (*MsgUndelegateGovVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateGovVote not implemented")
+}
+func (UnimplementedMsgServer) CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
+}
+func (UnimplementedMsgServer) SubmitMultipleChoiceProposal(context.Context, *MsgSubmitMultipleChoiceProposal) (*MsgSubmitMultipleChoiceProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMultipleChoiceProposal not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -289,6 +369,78 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateGovVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateGovVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateGovVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateGovVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateGovVote(ctx, req.(*MsgDelegateGovVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateGovVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateGovVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateGovVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateGovVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateGovVote(ctx, req.(*MsgUndelegateGovVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposal(ctx, req.(*MsgCancelProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitMultipleChoiceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitMultipleChoiceProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitMultipleChoiceProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitMultipleChoiceProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitMultipleChoiceProposal(ctx, req.(*MsgSubmitMultipleChoiceProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -320,6 +472,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "DelegateGovVote",
+			Handler:    _Msg_DelegateGovVote_Handler,
+		},
+		{
+			MethodName: "UndelegateGovVote",
+			Handler:    _Msg_UndelegateGovVote_Handler,
+		},
+		{
+			MethodName: "CancelProposal",
+			Handler:    _Msg_CancelProposal_Handler,
+		},
+		{
+			MethodName: "SubmitMultipleChoiceProposal",
+			Handler:    _Msg_SubmitMultipleChoiceProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
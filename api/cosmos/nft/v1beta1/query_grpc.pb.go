@@ -19,13 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Query_Balance_FullMethodName = "/cosmos.nft.v1beta1.Query/Balance"
-	Query_Owner_FullMethodName   = "/cosmos.nft.v1beta1.Query/Owner"
-	Query_Supply_FullMethodName  = "/cosmos.nft.v1beta1.Query/Supply"
-	Query_NFTs_FullMethodName    = "/cosmos.nft.v1beta1.Query/NFTs"
-	Query_NFT_FullMethodName     = "/cosmos.nft.v1beta1.Query/NFT"
-	Query_Class_FullMethodName   = "/cosmos.nft.v1beta1.Query/Class"
-	Query_Classes_FullMethodName = "/cosmos.nft.v1beta1.Query/Classes"
+	Query_Balance_FullMethodName     = "/cosmos.nft.v1beta1.Query/Balance"
+	Query_Owner_FullMethodName       = "/cosmos.nft.v1beta1.Query/Owner"
+	Query_Supply_FullMethodName      = "/cosmos.nft.v1beta1.Query/Supply"
+	Query_NFTs_FullMethodName        = "/cosmos.nft.v1beta1.Query/NFTs"
+	Query_NFT_FullMethodName         = "/cosmos.nft.v1beta1.Query/NFT"
+	Query_Class_FullMethodName       = "/cosmos.nft.v1beta1.Query/Class"
+	Query_Classes_FullMethodName     = "/cosmos.nft.v1beta1.Query/Classes"
+	Query_RoyaltyInfo_FullMethodName = "/cosmos.nft.v1beta1.Query/RoyaltyInfo"
 )
 
 // QueryClient is the client API for Query service.
@@ -49,6 +50,9 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// RoyaltyInfo queries the royalty receiver and amount owed for a sale of an NFT of the given class at the given
+	// price, same as royaltyInfo in ERC2981
+	RoyaltyInfo(ctx context.Context, in *QueryRoyaltyInfoRequest, opts ...grpc.CallOption) (*QueryRoyaltyInfoResponse, error)
 }
 
 type queryClient struct {
@@ -129,6 +133,16 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) RoyaltyInfo(ctx context.Context, in *QueryRoyaltyInfoRequest, opts ...grpc.CallOption) (*QueryRoyaltyInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRoyaltyInfoResponse)
+	err := c.cc.Invoke(ctx, Query_RoyaltyInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -150,6 +164,9 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// RoyaltyInfo queries the royalty receiver and amount owed for a sale of an NFT of the given class at the given
+	// price, same as royaltyInfo in ERC2981
+	RoyaltyInfo(context.Context, *QueryRoyaltyInfoRequest) (*QueryRoyaltyInfoResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -178,6 +195,9 @@ func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*Que
 func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (UnimplementedQueryServer) RoyaltyInfo(context.Context, *QueryRoyaltyInfoRequest) (*QueryRoyaltyInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoyaltyInfo not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -317,6 +337,24 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RoyaltyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoyaltyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RoyaltyInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoyaltyInfo(ctx, req.(*QueryRoyaltyInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -352,6 +390,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "RoyaltyInfo",
+			Handler:    _Query_RoyaltyInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
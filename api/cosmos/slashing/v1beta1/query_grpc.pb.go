@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName           = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName      = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName     = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_DowntimeOffenses_FullMethodName = "/cosmos.slashing.v1beta1.Query/DowntimeOffenses"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +37,8 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// DowntimeOffenses queries the downtime offense history of given cons address
+	DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +79,16 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDowntimeOffensesResponse)
+	err := c.cc.Invoke(ctx, Query_DowntimeOffenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -88,6 +101,8 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// DowntimeOffenses queries the downtime offense history of given cons address
+	DowntimeOffenses(context.Context, *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -104,6 +119,9 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) DowntimeOffenses(context.Context, *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeOffenses not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -171,6 +189,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DowntimeOffenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeOffensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeOffenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DowntimeOffenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeOffenses(ctx, req.(*QueryDowntimeOffensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -190,6 +226,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "DowntimeOffenses",
+			Handler:    _Query_DowntimeOffenses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -19,20 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Query_Validators_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Validators"
-	Query_Validator_FullMethodName                     = "/cosmos.staking.v1beta1.Query/Validator"
-	Query_ValidatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
-	Query_ValidatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
-	Query_Delegation_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Delegation"
-	Query_UnbondingDelegation_FullMethodName           = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
-	Query_DelegatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
-	Query_DelegatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
-	Query_Redelegations_FullMethodName                 = "/cosmos.staking.v1beta1.Query/Redelegations"
-	Query_DelegatorValidators_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorValidator_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
-	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
-	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
-	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_Validators_FullMethodName                       = "/cosmos.staking.v1beta1.Query/Validators"
+	Query_Validator_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Validator"
+	Query_ValidatorDelegations_FullMethodName             = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
+	Query_ValidatorUnbondingDelegations_FullMethodName    = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
+	Query_Delegation_FullMethodName                       = "/cosmos.staking.v1beta1.Query/Delegation"
+	Query_UnbondingDelegation_FullMethodName              = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
+	Query_DelegatorDelegations_FullMethodName             = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+	Query_DelegatorUnbondingDelegations_FullMethodName    = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
+	Query_Redelegations_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Redelegations"
+	Query_DelegatorValidators_FullMethodName              = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorValidator_FullMethodName               = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
+	Query_HistoricalInfo_FullMethodName                   = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
+	Query_Pool_FullMethodName                             = "/cosmos.staking.v1beta1.Query/Pool"
+	Query_Params_FullMethodName                           = "/cosmos.staking.v1beta1.Query/Params"
+	Query_ValidatorPendingCommissionChange_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorPendingCommissionChange"
+	Query_PendingCommissionChanges_FullMethodName         = "/cosmos.staking.v1beta1.Query/PendingCommissionChanges"
+	Query_TokenizeShareRecordById_FullMethodName          = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordById"
+	Query_TokenizeShareRecordByDenom_FullMethodName       = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordByDenom"
+	Query_TokenizeShareRecordsOwned_FullMethodName        = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordsOwned"
+	Query_AllTokenizeShareRecords_FullMethodName          = "/cosmos.staking.v1beta1.Query/AllTokenizeShareRecords"
+	Query_TotalLiquidStaked_FullMethodName                = "/cosmos.staking.v1beta1.Query/TotalLiquidStaked"
+	Query_ValidatorLiquidShares_FullMethodName            = "/cosmos.staking.v1beta1.Query/ValidatorLiquidShares"
 )
 
 // QueryClient is the client API for Query service.
@@ -94,6 +102,32 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorPendingCommissionChange queries the pending commission change of
+	// a validator.
+	ValidatorPendingCommissionChange(ctx context.Context, in *QueryValidatorPendingCommissionChangeRequest, opts ...grpc.CallOption) (*QueryValidatorPendingCommissionChangeResponse, error)
+	// PendingCommissionChanges queries the pending commission changes of all
+	// validators.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	PendingCommissionChanges(ctx context.Context, in *QueryPendingCommissionChangesRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangesResponse, error)
+	// TokenizeShareRecordById queries the tokenize share record by its id.
+	TokenizeShareRecordById(ctx context.Context, in *QueryTokenizeShareRecordByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIdResponse, error)
+	// TokenizeShareRecordByDenom queries the tokenize share record by the denom
+	// of its share tokens.
+	TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByDenomResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an
+	// account.
+	TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// AllTokenizeShareRecords queries all the tokenize share records.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	AllTokenizeShareRecords(ctx context.Context, in *QueryAllTokenizeShareRecordsRequest, opts ...grpc.CallOption) (*QueryAllTokenizeShareRecordsResponse, error)
+	// TotalLiquidStaked queries the total amount of liquid staked tokens.
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
+	// ValidatorLiquidShares queries the liquid shares of a validator.
+	ValidatorLiquidShares(ctx context.Context, in *QueryValidatorLiquidSharesRequest, opts ...grpc.CallOption) (*QueryValidatorLiquidSharesResponse, error)
 }
 
 type queryClient struct {
@@ -244,6 +278,86 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidatorPendingCommissionChange(ctx context.Context, in *QueryValidatorPendingCommissionChangeRequest, opts ...grpc.CallOption) (*QueryValidatorPendingCommissionChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorPendingCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorPendingCommissionChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingCommissionChanges(ctx context.Context, in *QueryPendingCommissionChangesRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingCommissionChangesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingCommissionChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordById(ctx context.Context, in *QueryTokenizeShareRecordByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenizeShareRecordByIdResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenizeShareRecordByDenomResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordByDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenizeShareRecordsOwnedResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordsOwned_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllTokenizeShareRecords(ctx context.Context, in *QueryAllTokenizeShareRecordsRequest, opts ...grpc.CallOption) (*QueryAllTokenizeShareRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllTokenizeShareRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_AllTokenizeShareRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, Query_TotalLiquidStaked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorLiquidShares(ctx context.Context, in *QueryValidatorLiquidSharesRequest, opts ...grpc.CallOption) (*QueryValidatorLiquidSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorLiquidSharesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorLiquidShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -303,6 +417,32 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorPendingCommissionChange queries the pending commission change of
+	// a validator.
+	ValidatorPendingCommissionChange(context.Context, *QueryValidatorPendingCommissionChangeRequest) (*QueryValidatorPendingCommissionChangeResponse, error)
+	// PendingCommissionChanges queries the pending commission changes of all
+	// validators.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	PendingCommissionChanges(context.Context, *QueryPendingCommissionChangesRequest) (*QueryPendingCommissionChangesResponse, error)
+	// TokenizeShareRecordById queries the tokenize share record by its id.
+	TokenizeShareRecordById(context.Context, *QueryTokenizeShareRecordByIdRequest) (*QueryTokenizeShareRecordByIdResponse, error)
+	// TokenizeShareRecordByDenom queries the tokenize share record by the denom
+	// of its share tokens.
+	TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordByDenomResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an
+	// account.
+	TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// AllTokenizeShareRecords queries all the tokenize share records.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	AllTokenizeShareRecords(context.Context, *QueryAllTokenizeShareRecordsRequest) (*QueryAllTokenizeShareRecordsResponse, error)
+	// TotalLiquidStaked queries the total amount of liquid staked tokens.
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
+	// ValidatorLiquidShares queries the liquid shares of a validator.
+	ValidatorLiquidShares(context.Context, *QueryValidatorLiquidSharesRequest) (*QueryValidatorLiquidSharesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -352,6 +492,30 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) ValidatorPendingCommissionChange(context.Context, *QueryValidatorPendingCommissionChangeRequest) (*QueryValidatorPendingCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPendingCommissionChange not implemented")
+}
+func (UnimplementedQueryServer) PendingCommissionChanges(context.Context, *QueryPendingCommissionChangesRequest) (*QueryPendingCommissionChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCommissionChanges not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordById(context.Context, *QueryTokenizeShareRecordByIdRequest) (*QueryTokenizeShareRecordByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordById not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordByDenom not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordsOwned not implemented")
+}
+func (UnimplementedQueryServer) AllTokenizeShareRecords(context.Context, *QueryAllTokenizeShareRecordsRequest) (*QueryAllTokenizeShareRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTokenizeShareRecords not implemented")
+}
+func (UnimplementedQueryServer) TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
+func (UnimplementedQueryServer) ValidatorLiquidShares(context.Context, *QueryValidatorLiquidSharesRequest) (*QueryValidatorLiquidSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorLiquidShares not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -617,6 +781,150 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorPendingCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPendingCommissionChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPendingCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorPendingCommissionChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPendingCommissionChange(ctx, req.(*QueryValidatorPendingCommissionChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingCommissionChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCommissionChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCommissionChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingCommissionChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCommissionChanges(ctx, req.(*QueryPendingCommissionChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordById(ctx, req.(*QueryTokenizeShareRecordByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordByDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, req.(*QueryTokenizeShareRecordByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordsOwned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsOwnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordsOwned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, req.(*QueryTokenizeShareRecordsOwnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllTokenizeShareRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTokenizeShareRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllTokenizeShareRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllTokenizeShareRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTokenizeShareRecords(ctx, req.(*QueryAllTokenizeShareRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalLiquidStaked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorLiquidShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorLiquidSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorLiquidShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorLiquidShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorLiquidShares(ctx, req.(*QueryValidatorLiquidSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -680,6 +988,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidatorPendingCommissionChange",
+			Handler:    _Query_ValidatorPendingCommissionChange_Handler,
+		},
+		{
+			MethodName: "PendingCommissionChanges",
+			Handler:    _Query_PendingCommissionChanges_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordById",
+			Handler:    _Query_TokenizeShareRecordById_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordByDenom",
+			Handler:    _Query_TokenizeShareRecordByDenom_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordsOwned",
+			Handler:    _Query_TokenizeShareRecordsOwned_Handler,
+		},
+		{
+			MethodName: "AllTokenizeShareRecords",
+			Handler:    _Query_AllTokenizeShareRecords_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
+		{
+			MethodName: "ValidatorLiquidShares",
+			Handler:    _Query_ValidatorLiquidShares_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"context"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -35,6 +37,9 @@ const (
 // InitChain implements the ABCI interface. It runs the initialization logic
 // directly on the CommitMultiStore.
 func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitChain) {
+	_, span := app.startABCISpan("InitChain", AttributeKeyHeight.Int64(req.InitialHeight))
+	defer span.End()
+
 	if req.ChainId != app.chainID {
 		panic(fmt.Sprintf("invalid chain-id on InitChain; expected: %s, got: %s", app.chainID, req.ChainId))
 	}
@@ -121,6 +126,9 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 
 // Info implements the ABCI interface.
 func (app *BaseApp) Info(req abci.RequestInfo) abci.ResponseInfo {
+	_, span := app.startABCISpan("Info")
+	defer span.End()
+
 	lastCommitID := app.cms.LastCommitID()
 
 	return abci.ResponseInfo{
@@ -152,6 +160,9 @@ func (app *BaseApp) FilterPeerByID(info string) abci.ResponseQuery {
 
 // BeginBlock implements the ABCI application interface.
 func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeginBlock) {
+	_, span := app.startABCISpan("BeginBlock", AttributeKeyHeight.Int64(req.Header.Height))
+	defer span.End()
+
 	if req.Header.ChainID != app.chainID {
 		panic(fmt.Sprintf("invalid chain-id on BeginBlock; expected: %s, got: %s", app.chainID, req.Header.ChainID))
 	}
@@ -192,6 +203,10 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 			WithHeaderHash(req.Hash)
 	}
 
+	if app.resourceSummaryEvent {
+		app.blockResourceUsage = newBlockResourceUsage()
+	}
+
 	if app.beginBlocker != nil {
 		res = app.beginBlocker(app.deliverState.ctx, req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
@@ -215,6 +230,9 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 // EndBlock implements the ABCI interface.
 func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBlock) {
+	_, span := app.startABCISpan("EndBlock", AttributeKeyHeight.Int64(req.Height))
+	defer span.End()
+
 	if app.deliverState.ms.TracingEnabled() {
 		app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
 	}
@@ -224,6 +242,11 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 
+	if app.blockResourceUsage != nil {
+		res.Events = append(res.Events, sdk.MarkEventsToIndex(app.blockResourceUsage.events(), app.indexEvents)...)
+		app.blockResourceUsage = nil
+	}
+
 	if cp := app.GetConsensusParams(app.deliverState.ctx); cp != nil {
 		res.ConsensusParamUpdates = cp
 	}
@@ -252,6 +275,9 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 // Ref: https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-060-abci-1.0.md
 // Ref: https://github.com/tendermint/tendermint/blob/main/spec/abci/abci%2B%2B_basic_concepts.md
 func (app *BaseApp) PrepareProposal(req abci.RequestPrepareProposal) (resp abci.ResponsePrepareProposal) {
+	_, span := app.startABCISpan("PrepareProposal", AttributeKeyHeight.Int64(req.Height))
+	defer span.End()
+
 	if app.prepareProposal == nil {
 		panic("PrepareProposal method not set")
 	}
@@ -309,6 +335,9 @@ func (app *BaseApp) PrepareProposal(req abci.RequestPrepareProposal) (resp abci.
 // Ref: https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-060-abci-1.0.md
 // Ref: https://github.com/tendermint/tendermint/blob/main/spec/abci/abci%2B%2B_basic_concepts.md
 func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) (resp abci.ResponseProcessProposal) {
+	_, span := app.startABCISpan("ProcessProposal", AttributeKeyHeight.Int64(req.Height))
+	defer span.End()
+
 	if app.processProposal == nil {
 		panic("app.ProcessProposal is not set")
 	}
@@ -371,7 +400,9 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	gInfo, result, anteEvents, priority, err := app.runTx(mode, req.Tx)
+	goCtx, span := app.startABCISpan("CheckTx")
+	gInfo, result, anteEvents, priority, err := app.runTx(goCtx, mode, req.Tx)
+	endSpan(span, err)
 	if err != nil {
 		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace)
 	}
@@ -410,7 +441,9 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	goCtx, span := app.startABCISpan("DeliverTx", AttributeKeyHeight.Int64(app.deliverState.ctx.BlockHeight()))
+	gInfo, result, anteEvents, _, err := app.runTx(goCtx, runTxModeDeliver, req.Tx)
+	endSpan(span, err)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -434,6 +467,9 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 // height.
 func (app *BaseApp) Commit() abci.ResponseCommit {
 	header := app.deliverState.ctx.BlockHeader()
+	_, span := app.startABCISpan("Commit", AttributeKeyHeight.Int64(header.Height))
+	defer span.End()
+
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
 	rms, ok := app.cms.(*rootmulti.Store)
@@ -535,6 +571,12 @@ func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	telemetry.IncrCounter(1, "query", req.Path)
 	defer telemetry.MeasureSince(time.Now(), req.Path)
 
+	goCtx, span := app.startABCISpan("Query",
+		AttributeKeyQueryPath.String(req.Path),
+		AttributeKeyHeight.Int64(req.Height),
+	)
+	defer span.End()
+
 	if req.Path == "/cosmos.tx.v1beta1.Service/BroadcastTx" {
 		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't route a broadcast tx message"), app.trace)
 	}
@@ -542,7 +584,7 @@ func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	// handle gRPC routes first rather than calling splitPath because '/' characters
 	// are used as part of gRPC paths
 	if grpcHandler := app.grpcQueryRouter.Route(req.Path); grpcHandler != nil {
-		return app.handleQueryGRPC(grpcHandler, req)
+		return app.handleQueryGRPC(goCtx, grpcHandler, req)
 	}
 
 	path := SplitABCIQueryPath(req.Path)
@@ -689,11 +731,12 @@ func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.
 	}
 }
 
-func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
+func (app *BaseApp) handleQueryGRPC(goCtx context.Context, handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
 	ctx, err := app.CreateQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err, app.trace)
 	}
+	ctx = ctx.WithContext(trace.ContextWithSpan(ctx.Context(), trace.SpanFromContext(goCtx)))
 
 	res, err := handler(ctx, req)
 	if err != nil {
@@ -0,0 +1,223 @@
+package baseapp
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"golang.org/x/exp/maps"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Resource usage summary event types and attribute keys, emitted by EndBlock
+// when enabled with SetResourceSummaryEvent.
+const (
+	EventTypeMsgResourceUsage   = "msg_resource_usage"
+	EventTypeStoreResourceUsage = "store_resource_usage"
+
+	AttributeKeyUsageMsgTypeURL = "msg_type_url"
+	AttributeKeyUsageStoreKey   = "store_key"
+	AttributeKeyUsageCount      = "count"
+	AttributeKeyUsageGasUsed    = "gas_used"
+	AttributeKeyUsageReads      = "reads"
+	AttributeKeyUsageWrites     = "writes"
+	AttributeKeyUsageDuration   = "duration"
+)
+
+// blockResourceUsage aggregates the resources consumed by the messages of a
+// block, per message type URL and per store key.
+type blockResourceUsage struct {
+	msgs   map[string]*telemetry.ResourceUsage
+	stores map[string]*telemetry.ResourceUsage
+}
+
+func newBlockResourceUsage() *blockResourceUsage {
+	return &blockResourceUsage{
+		msgs:   make(map[string]*telemetry.ResourceUsage),
+		stores: make(map[string]*telemetry.ResourceUsage),
+	}
+}
+
+func addResourceUsage(usages map[string]*telemetry.ResourceUsage, key string, usage telemetry.ResourceUsage) {
+	total, ok := usages[key]
+	if !ok {
+		total = &telemetry.ResourceUsage{}
+		usages[key] = total
+	}
+	total.Add(usage)
+}
+
+// events returns the summary events of the block, sorted by message type URL
+// and store key.
+func (u *blockResourceUsage) events() []abci.Event {
+	events := make([]abci.Event, 0, len(u.msgs)+len(u.stores))
+	events = appendResourceUsageEvents(events, EventTypeMsgResourceUsage, AttributeKeyUsageMsgTypeURL, u.msgs)
+	return appendResourceUsageEvents(events, EventTypeStoreResourceUsage, AttributeKeyUsageStoreKey, u.stores)
+}
+
+func appendResourceUsageEvents(events []abci.Event, eventType, attrKey string, usages map[string]*telemetry.ResourceUsage) []abci.Event {
+	keys := maps.Keys(usages)
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		usage := usages[key]
+		events = append(events, abci.Event(sdk.NewEvent(eventType,
+			sdk.NewAttribute(attrKey, key),
+			sdk.NewAttribute(AttributeKeyUsageCount, strconv.FormatUint(usage.Count, 10)),
+			sdk.NewAttribute(AttributeKeyUsageGasUsed, strconv.FormatUint(usage.GasUsed, 10)),
+			sdk.NewAttribute(AttributeKeyUsageReads, strconv.FormatUint(usage.Reads, 10)),
+			sdk.NewAttribute(AttributeKeyUsageWrites, strconv.FormatUint(usage.Writes, 10)),
+			sdk.NewAttribute(AttributeKeyUsageDuration, usage.Duration.String()),
+		)))
+	}
+
+	return events
+}
+
+// resourceAccountingEnabled returns true if the resources consumed by the
+// messages are accounted in the given mode.
+func (app *BaseApp) resourceAccountingEnabled(mode runTxMode) bool {
+	return mode == runTxModeDeliver && (app.resourceAccounting || app.resourceSummaryEvent)
+}
+
+// recordResourceUsage records the resources consumed by a message, measured by
+// meter, in the metrics and the block summary.
+func (app *BaseApp) recordResourceUsage(msgTypeURL string, meter *resourceMeter) {
+	usage := meter.usage()
+
+	if app.resourceAccounting {
+		telemetry.ObserveMsgResourceUsage(msgTypeURL, usage)
+		for key, storeUsage := range meter.stores {
+			telemetry.ObserveStoreResourceUsage(key, *storeUsage)
+		}
+	}
+
+	if app.resourceSummaryEvent && app.blockResourceUsage != nil {
+		addResourceUsage(app.blockResourceUsage.msgs, msgTypeURL, usage)
+		for key, storeUsage := range meter.stores {
+			addResourceUsage(app.blockResourceUsage.stores, key, *storeUsage)
+		}
+	}
+}
+
+var _ storetypes.StoreGasMeter = (*resourceMeter)(nil)
+
+// resourceMeter is a GasMeter measuring the resources consumed by a message,
+// in total and per store key.
+type resourceMeter struct {
+	storetypes.GasMeter
+
+	start    time.Time
+	gasStart storetypes.Gas
+	stores   map[string]*telemetry.ResourceUsage
+}
+
+func newResourceMeter(gm storetypes.GasMeter) *resourceMeter {
+	return &resourceMeter{
+		GasMeter: gm,
+		start:    time.Now(),
+		gasStart: gm.GasConsumed(),
+		stores:   make(map[string]*telemetry.ResourceUsage),
+	}
+}
+
+// WrapKVStore implements the StoreGasMeter interface.
+func (m *resourceMeter) WrapKVStore(key storetypes.StoreKey, store storetypes.KVStore) storetypes.KVStore {
+	if parent, ok := m.GasMeter.(storetypes.StoreGasMeter); ok {
+		store = parent.WrapKVStore(key, store)
+	}
+
+	usage, ok := m.stores[key.Name()]
+	if !ok {
+		usage = &telemetry.ResourceUsage{Count: 1}
+		m.stores[key.Name()] = usage
+	}
+
+	return &meteredKVStore{KVStore: store, meter: m.GasMeter, usage: usage}
+}
+
+// usage returns the resources consumed since the creation of the meter.
+func (m *resourceMeter) usage() telemetry.ResourceUsage {
+	usage := telemetry.ResourceUsage{
+		Count:    1,
+		GasUsed:  m.GasConsumed() - m.gasStart,
+		Duration: time.Since(m.start),
+	}
+	for _, storeUsage := range m.stores {
+		usage.Reads += storeUsage.Reads
+		usage.Writes += storeUsage.Writes
+	}
+	return usage
+}
+
+// meteredKVStore is a KVStore measuring the gas, accesses and wall time of the
+// operations made on the gas KVStore it wraps.
+type meteredKVStore struct {
+	storetypes.KVStore
+
+	meter storetypes.GasMeter
+	usage *telemetry.ResourceUsage
+}
+
+func (s *meteredKVStore) measure(start time.Time, gasStart storetypes.Gas, write bool) {
+	if write {
+		s.usage.Writes++
+	} else {
+		s.usage.Reads++
+	}
+	s.usage.GasUsed += s.meter.GasConsumed() - gasStart
+	s.usage.Duration += time.Since(start)
+}
+
+// Get implements the KVStore interface.
+func (s *meteredKVStore) Get(key []byte) []byte {
+	defer s.measure(time.Now(), s.meter.GasConsumed(), false)
+	return s.KVStore.Get(key)
+}
+
+// Has implements the KVStore interface.
+func (s *meteredKVStore) Has(key []byte) bool {
+	defer s.measure(time.Now(), s.meter.GasConsumed(), false)
+	return s.KVStore.Has(key)
+}
+
+// Set implements the KVStore interface.
+func (s *meteredKVStore) Set(key, value []byte) {
+	defer s.measure(time.Now(), s.meter.GasConsumed(), true)
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements the KVStore interface.
+func (s *meteredKVStore) Delete(key []byte) {
+	defer s.measure(time.Now(), s.meter.GasConsumed(), true)
+	s.KVStore.Delete(key)
+}
+
+// Iterator implements the KVStore interface.
+func (s *meteredKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	defer s.measure(time.Now(), s.meter.GasConsumed(), false)
+	return &meteredIterator{Iterator: s.KVStore.Iterator(start, end), store: s}
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *meteredKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	defer s.measure(time.Now(), s.meter.GasConsumed(), false)
+	return &meteredIterator{Iterator: s.KVStore.ReverseIterator(start, end), store: s}
+}
+
+// meteredIterator is an Iterator accounting each step as a store read.
+type meteredIterator struct {
+	storetypes.Iterator
+
+	store *meteredKVStore
+}
+
+// Next implements the Iterator interface.
+func (it *meteredIterator) Next() {
+	defer it.store.measure(time.Now(), it.store.meter.GasConsumed(), false)
+	it.Iterator.Next()
+}
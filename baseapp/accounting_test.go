@@ -0,0 +1,92 @@
+package baseapp_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestResourceSummaryEvent(t *testing.T) {
+	metrics, err := telemetry.New(telemetry.Config{
+		Enabled:                 true,
+		ServiceName:             "test",
+		PrometheusRetentionTime: 60,
+	})
+	require.NoError(t, err)
+
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key"))) }
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetResourceAccounting(true), baseapp.SetResourceSummaryEvent(true))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	var gasUsed uint64
+	for i := int64(0); i < 2; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, 2*i, 2*i+1))
+		require.NoError(t, err)
+		res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), res.Log)
+		gasUsed += uint64(res.GasUsed)
+	}
+
+	res := suite.baseApp.EndBlock(abci.RequestEndBlock{Height: 1})
+	suite.baseApp.Commit()
+
+	summaries := map[string]map[string]string{}
+	for _, event := range res.Events {
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		summaries[event.Type] = attrs
+	}
+
+	msgUsage := summaries[baseapp.EventTypeMsgResourceUsage]
+	require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), msgUsage[baseapp.AttributeKeyUsageMsgTypeURL])
+	require.Equal(t, "4", msgUsage[baseapp.AttributeKeyUsageCount])
+	// every msg reads and writes its counter once
+	require.Equal(t, "4", msgUsage[baseapp.AttributeKeyUsageReads])
+	require.Equal(t, "4", msgUsage[baseapp.AttributeKeyUsageWrites])
+
+	msgGasUsed, err := strconv.ParseUint(msgUsage[baseapp.AttributeKeyUsageGasUsed], 10, 64)
+	require.NoError(t, err)
+	require.Positive(t, msgGasUsed)
+	require.Less(t, msgGasUsed, gasUsed)
+
+	storeUsage := summaries[baseapp.EventTypeStoreResourceUsage]
+	require.Equal(t, capKey1.Name(), storeUsage[baseapp.AttributeKeyUsageStoreKey])
+	require.Equal(t, "4", storeUsage[baseapp.AttributeKeyUsageReads])
+	require.Equal(t, "4", storeUsage[baseapp.AttributeKeyUsageWrites])
+
+	storeGasUsed, err := strconv.ParseUint(storeUsage[baseapp.AttributeKeyUsageGasUsed], 10, 64)
+	require.NoError(t, err)
+	require.Positive(t, storeGasUsed)
+	require.LessOrEqual(t, storeGasUsed, msgGasUsed)
+
+	// the summary is reset at every block
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	res = suite.baseApp.EndBlock(abci.RequestEndBlock{Height: 2})
+	require.Empty(t, res.Events)
+
+	// the per msg type and per store key histograms are exposed with the other metrics
+	gr, err := metrics.Gather(telemetry.FormatPrometheus)
+	require.NoError(t, err)
+	msgTypeURL := sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})
+	require.Contains(t, string(gr.Metrics), fmt.Sprintf(`cosmos_msg_gas_used_sum{msg_type_url="%s"} %d`, msgTypeURL, msgGasUsed))
+	require.Contains(t, string(gr.Metrics), fmt.Sprintf(`cosmos_msg_reads_count{msg_type_url="%s"} 4`, msgTypeURL))
+	require.Contains(t, string(gr.Metrics), fmt.Sprintf(`cosmos_store_gas_used_sum{store_key="%s"} %d`, capKey1.Name(), storeGasUsed))
+	require.Contains(t, string(gr.Metrics), fmt.Sprintf(`cosmos_store_writes_count{store_key="%s"} 4`, capKey1.Name()))
+}
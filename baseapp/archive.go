@@ -0,0 +1,160 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// ArchiveRoute routes the gRPC queries at the heights from StartHeight to
+// EndHeight, both inclusive, that are pruned from the local state to the
+// archive node served by Conn. A zero EndHeight means no upper bound.
+type ArchiveRoute struct {
+	StartHeight int64
+	EndHeight   int64
+	Conn        gogogrpc.ClientConn
+}
+
+// Contains returns true if the route serves the queries at height.
+func (r ArchiveRoute) Contains(height int64) bool {
+	return height >= r.StartHeight && (r.EndHeight == 0 || height <= r.EndHeight)
+}
+
+// ValidateBasic checks that the route height range is valid.
+func (r ArchiveRoute) ValidateBasic() error {
+	if r.StartHeight <= 0 {
+		return fmt.Errorf("archive route start height must be positive, got %d", r.StartHeight)
+	}
+	if r.EndHeight != 0 && r.EndHeight < r.StartHeight {
+		return fmt.Errorf("archive route end height %d is lower than start height %d", r.EndHeight, r.StartHeight)
+	}
+	if r.Conn == nil {
+		return fmt.Errorf("archive route of heights %d to %d has no connection", r.StartHeight, r.EndHeight)
+	}
+	return nil
+}
+
+// archiveConn returns the connection to the archive node serving the queries at
+// height, or nil if height is not a past height served by an archive route.
+// The first matching route is selected.
+func (app *BaseApp) archiveConn(height int64) gogogrpc.ClientConn {
+	if height <= 0 || height >= app.LastBlockHeight() {
+		return nil
+	}
+
+	for _, route := range app.archiveRoutes {
+		if route.Contains(height) {
+			return route.Conn
+		}
+	}
+
+	return nil
+}
+
+// queryArchive forwards the proto encoded request of a gRPC query of method at
+// height to the archive node served by conn, and returns the proto encoded
+// response.
+func (app *BaseApp) queryArchive(ctx context.Context, conn gogogrpc.ClientConn, method string, height int64, reqBz []byte) ([]byte, error) {
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10)))
+
+	app.logger.Debug("routing query to archive node", "method", method, "height", height)
+
+	res := &archiveMessage{}
+	if err := conn.Invoke(ctx, method, &archiveMessage{bz: reqBz}, res, grpc.ForceCodec(app.grpcQueryRouter.cdc)); err != nil {
+		return nil, err
+	}
+
+	return res.bz, nil
+}
+
+// archiveMessage is a proto message holding the encoded request or response of
+// a gRPC query forwarded to an archive node, so that queries can be forwarded
+// without knowing their types.
+type archiveMessage struct {
+	bz []byte
+}
+
+func (m *archiveMessage) Reset()         { m.bz = nil }
+func (m *archiveMessage) String() string { return fmt.Sprintf("%X", m.bz) }
+func (*archiveMessage) ProtoMessage()    {}
+
+func (m *archiveMessage) Marshal() ([]byte, error) { return m.bz, nil }
+
+func (m *archiveMessage) MarshalTo(data []byte) (int, error) { return copy(data, m.bz), nil }
+
+func (m *archiveMessage) MarshalToSizedBuffer(data []byte) (int, error) {
+	return copy(data[len(data)-len(m.bz):], m.bz), nil
+}
+
+func (m *archiveMessage) Size() int { return len(m.bz) }
+
+func (m *archiveMessage) Unmarshal(data []byte) error {
+	m.bz = append([]byte(nil), data...)
+	return nil
+}
+
+var _ gogogrpc.ClientConn = (*LocalArchive)(nil)
+
+// LocalArchive is a gRPC client connection serving the queries routed to an
+// archive node from the state of a local BaseApp, at the height given in the
+// outgoing x-cosmos-block-height header. It stands in for a remote archive node,
+// e.g. in tests.
+type LocalArchive struct {
+	app *BaseApp
+}
+
+// NewLocalArchive returns a LocalArchive serving queries from app.
+func NewLocalArchive(app *BaseApp) *LocalArchive {
+	return &LocalArchive{app: app}
+}
+
+// Invoke implements the gRPC ClientConn.Invoke method.
+func (a *LocalArchive) Invoke(ctx context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	handler := a.app.grpcQueryRouter.Route(method)
+	if handler == nil {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+
+	var height int64
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
+			var err error
+			height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
+			}
+		}
+	}
+
+	sdkCtx, err := a.app.CreateQueryContext(height, false)
+	if err != nil {
+		return err
+	}
+
+	reqBz, err := a.app.grpcQueryRouter.cdc.Marshal(args)
+	if err != nil {
+		return err
+	}
+
+	res, err := handler(sdkCtx.WithContext(ctx), abci.RequestQuery{Data: reqBz, Path: method, Height: height})
+	if err != nil {
+		return err
+	}
+
+	return a.app.grpcQueryRouter.cdc.Unmarshal(res.Value, reply)
+}
+
+// NewStream implements the gRPC ClientConn.NewStream method.
+func (a *LocalArchive) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("not supported")
+}
@@ -0,0 +1,111 @@
+package baseapp_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+func newArchiveTestSuite(t *testing.T, blocks int64, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+	suite := NewBaseAppSuite(t, opts...)
+	testdata.RegisterQueryServer(suite.baseApp.GRPCQueryRouter(), testdata.QueryImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	for height := int64(1); height <= blocks; height++ {
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		suite.baseApp.EndBlock(abci.RequestEndBlock{Height: height})
+		suite.baseApp.Commit()
+	}
+
+	return suite
+}
+
+func TestArchiveRoutes_ABCIQuery(t *testing.T) {
+	archive := newArchiveTestSuite(t, 12, baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)))
+	suite := newArchiveTestSuite(t, 12,
+		baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)),
+		baseapp.SetArchiveRoutes([]baseapp.ArchiveRoute{
+			{StartHeight: 1, Conn: baseapp.NewLocalArchive(archive.baseApp)},
+		}),
+	)
+
+	reqBz, err := archive.cdc.Marshal(&testdata.EchoRequest{Message: "hello"})
+	require.NoError(t, err)
+
+	// the ABCI queries are served under the consensus lock, they are never
+	// forwarded to the archive nodes
+	res := suite.baseApp.Query(abci.RequestQuery{Path: "/testpb.Query/Echo", Data: reqBz, Height: 2})
+	require.False(t, res.IsOK())
+
+	res = suite.baseApp.Query(abci.RequestQuery{Path: "/testpb.Query/Echo", Data: reqBz, Height: 12})
+	require.True(t, res.IsOK(), res.Log)
+}
+
+func TestArchiveRoutes_GRPCServer(t *testing.T) {
+	archive := newArchiveTestSuite(t, 12, baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)))
+	suite := newArchiveTestSuite(t, 12,
+		baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)),
+		baseapp.SetArchiveRoutes([]baseapp.ArchiveRoute{
+			{StartHeight: 2, EndHeight: 3, Conn: baseapp.NewLocalArchive(archive.baseApp)},
+		}),
+	)
+
+	grpcCodec := codec.NewProtoCodec(suite.cdc.InterfaceRegistry()).GRPCCodec()
+	grpcSrv := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+	suite.baseApp.RegisterGRPCServer(grpcSrv)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go grpcSrv.Serve(listener) //nolint:errcheck
+	defer grpcSrv.Stop()
+
+	conn, err := grpc.Dial(
+		listener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := testdata.NewQueryClient(conn)
+	for _, height := range []string{"2", "12"} {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, height)
+
+		var header metadata.MD
+		res, err := client.Echo(ctx, &testdata.EchoRequest{Message: "hello"}, grpc.Header(&header))
+		require.NoError(t, err)
+		require.Equal(t, "hello", res.Message)
+		require.Equal(t, []string{height}, header.Get(grpctypes.GRPCBlockHeightHeader))
+	}
+
+	// the pruned heights out of the route are not forwarded
+	ctx := metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, "1")
+	_, err = client.Echo(ctx, &testdata.EchoRequest{Message: "hello"})
+	require.Error(t, err)
+}
+
+func TestArchiveRoute_ValidateBasic(t *testing.T) {
+	conn := baseapp.NewLocalArchive(nil)
+
+	require.NoError(t, baseapp.ArchiveRoute{StartHeight: 1, Conn: conn}.ValidateBasic())
+	require.NoError(t, baseapp.ArchiveRoute{StartHeight: 1, EndHeight: 1, Conn: conn}.ValidateBasic())
+	require.Error(t, baseapp.ArchiveRoute{StartHeight: 0, Conn: conn}.ValidateBasic())
+	require.Error(t, baseapp.ArchiveRoute{StartHeight: 2, EndHeight: 1, Conn: conn}.ValidateBasic())
+	require.Error(t, baseapp.ArchiveRoute{StartHeight: 1}.ValidateBasic())
+}
@@ -1,8 +1,10 @@
 package baseapp
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 
@@ -12,12 +14,14 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/maps"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
@@ -59,6 +63,7 @@ type BaseApp struct { //nolint: maligned
 	interfaceRegistry codectypes.InterfaceRegistry
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
 	txEncoder         sdk.TxEncoder // marshal sdk.Tx into []byte
+	tracer            trace.Tracer  // tracer of the ABCI calls and transactions
 
 	mempool         mempool.Mempool            // application side mempool
 	anteHandler     sdk.AnteHandler            // ante handler for fee and auth
@@ -143,6 +148,22 @@ type BaseApp struct { //nolint: maligned
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
 
+	// resourceAccounting enables the Prometheus histograms of the gas, store
+	// accesses and wall time consumed per message type URL and store key.
+	resourceAccounting bool
+
+	// resourceSummaryEvent enables the block summary events of the resources
+	// consumed per message type URL and store key, emitted by EndBlock.
+	resourceSummaryEvent bool
+
+	// blockResourceUsage aggregates the resources consumed by the messages of
+	// the current block, set on BeginBlock if resourceSummaryEvent is enabled.
+	blockResourceUsage *blockResourceUsage
+
+	// archiveRoutes routes the gRPC queries at heights pruned from the local
+	// state to archive nodes.
+	archiveRoutes []ArchiveRoute
+
 	chainID string
 }
 
@@ -163,6 +184,7 @@ func NewBaseApp(
 		grpcQueryRouter:  NewGRPCQueryRouter(),
 		msgServiceRouter: NewMsgServiceRouter(),
 		txDecoder:        txDecoder,
+		tracer:           telemetry.Tracer(),
 		fauxMerkleMode:   false,
 	}
 
@@ -625,14 +647,28 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // if all messages get executed successfully and the execution mode is DeliverTx.
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
-// and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+// and execute successfully. An error is returned otherwise. The span of the
+// transaction is started as a child of the span carried by goCtx, if any.
+func (app *BaseApp) runTx(goCtx context.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
+	goCtx, span := app.tracer.Start(goCtx, "runTx", trace.WithAttributes(
+		AttributeKeyTxMode.String(runTxModeNames[mode]),
+		AttributeKeyTxHash.String(fmt.Sprintf("%X", tmhash.Sum(txBytes))),
+	))
+	defer func() {
+		span.SetAttributes(
+			AttributeKeyGasWanted.Int64(int64(gInfo.GasWanted)),
+			AttributeKeyGasUsed.Int64(int64(gInfo.GasUsed)),
+		)
+		endSpan(span, err)
+	}()
+
 	ctx := app.getContextForTx(mode, txBytes)
+	ctx = ctx.WithContext(goCtx)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -808,8 +844,18 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
 		}
 
+		msgCtx := ctx
+		var meter *resourceMeter
+		if app.resourceAccountingEnabled(mode) {
+			meter = newResourceMeter(ctx.GasMeter())
+			msgCtx = ctx.WithGasMeter(meter)
+		}
+
 		// ADR 031 request type routing
-		msgResult, err := handler(ctx, msg)
+		msgResult, err := handler(msgCtx, msg)
+		if meter != nil {
+			app.recordResourceUsage(sdk.MsgTypeURL(msg), meter)
+		}
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -890,7 +936,7 @@ func (app *BaseApp) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
 		return nil, err
 	}
 
-	_, _, _, _, err = app.runTx(runTxPrepareProposal, bz) //nolint:dogsled
+	_, _, _, _, err = app.runTx(context.Background(), runTxPrepareProposal, bz) //nolint:dogsled
 	if err != nil {
 		return nil, err
 	}
@@ -909,7 +955,7 @@ func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
 		return nil, err
 	}
 
-	_, _, _, _, err = app.runTx(runTxProcessProposal, txBz) //nolint:dogsled
+	_, _, _, _, err = app.runTx(context.Background(), runTxProcessProposal, txBz) //nolint:dogsled
 	if err != nil {
 		return nil, err
 	}
@@ -919,6 +965,14 @@ func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
 
 // Close is called in start cmd to gracefully cleanup resources.
 func (app *BaseApp) Close() error {
+	for _, route := range app.archiveRoutes {
+		if closer, ok := route.Conn.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
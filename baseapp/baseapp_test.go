@@ -613,6 +613,51 @@ func TestABCI_CreateQueryContext(t *testing.T) {
 	}
 }
 
+func TestNewHistoricalContext(t *testing.T) {
+	key := []byte("height")
+	beginBlockerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set(key, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+			return abci.ResponseBeginBlock{}
+		})
+	}
+
+	suite := NewBaseAppSuite(t, beginBlockerOpt, baseapp.SetChainID("test-chain"))
+	suite.baseApp.InitChain(abci.RequestInitChain{ChainId: "test-chain", ConsensusParams: &tmproto.ConsensusParams{}})
+
+	_, err := suite.baseApp.NewHistoricalContext(1)
+	require.Error(t, err)
+
+	start := time.Unix(1000, 0).UTC()
+	for height := int64(1); height <= 3; height++ {
+		header := tmproto.Header{ChainID: "test-chain", Height: height, Time: start.Add(time.Duration(height) * time.Second)}
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		suite.baseApp.EndBlock(abci.RequestEndBlock{})
+		suite.baseApp.Commit()
+	}
+
+	for height := int64(1); height <= 3; height++ {
+		ctx, err := suite.baseApp.NewHistoricalContext(height)
+		require.NoError(t, err)
+		require.Equal(t, "test-chain", ctx.ChainID())
+		require.Equal(t, height, ctx.BlockHeight())
+		require.Equal(t, start.Add(time.Duration(height)*time.Second), ctx.BlockTime())
+		require.Equal(t, uint64(height), sdk.BigEndianToUint64(ctx.KVStore(capKey1).Get(key)))
+
+		// writes are discarded
+		ctx.KVStore(capKey1).Set(key, []byte("overwritten"))
+	}
+
+	ctx, err := suite.baseApp.NewHistoricalContext(2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), sdk.BigEndianToUint64(ctx.KVStore(capKey1).Get(key)))
+
+	_, err = suite.baseApp.NewHistoricalContext(0)
+	require.Error(t, err)
+	_, err = suite.baseApp.NewHistoricalContext(4)
+	require.Error(t, err)
+}
+
 func TestSetMinGasPrices(t *testing.T) {
 	minGasPrices := sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5000)}
 	suite := NewBaseAppSuite(t, baseapp.SetMinGasPrices(minGasPrices.String()))
@@ -5,12 +5,14 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/reflection"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,6 +21,8 @@ type GRPCQueryRouter struct {
 	routes      map[string]GRPCQueryHandler
 	cdc         encoding.Codec
 	serviceData []serviceData
+	tracer      trace.Tracer
+	tracing     bool
 }
 
 // serviceData represents a gRPC service, along with its handler.
@@ -33,6 +37,7 @@ var _ gogogrpc.Server = &GRPCQueryRouter{}
 func NewGRPCQueryRouter() *GRPCQueryRouter {
 	return &GRPCQueryRouter{
 		routes: map[string]GRPCQueryHandler{},
+		tracer: telemetry.Tracer(),
 	}
 }
 
@@ -50,6 +55,13 @@ func (qrt *GRPCQueryRouter) Route(path string) GRPCQueryHandler {
 	return handler
 }
 
+// tracingEnabled returns true if the query spans are recorded, i.e. if a
+// TracerProvider was set with BaseApp.SetTracerProvider or registered by
+// telemetry.EnableTracing.
+func (qrt *GRPCQueryRouter) tracingEnabled() bool {
+	return qrt.tracing || telemetry.IsTracingEnabled()
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service/
 //
@@ -76,7 +88,17 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 			)
 		}
 
-		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (_ abci.ResponseQuery, err error) {
+			// the context is left untouched unless tracing is enabled
+			if qrt.tracingEnabled() {
+				goCtx, span := qrt.tracer.Start(ctx.Context(), "query "+fqName, trace.WithAttributes(
+					AttributeKeyQueryPath.String(fqName),
+					AttributeKeyHeight.Int64(ctx.BlockHeight()),
+				))
+				defer func() { endSpan(span, err) }()
+				ctx = ctx.WithContext(goCtx)
+			}
+
 			// call the method handler from the service description with the handler object,
 			// a wrapped sdk.Context with proto-unmarshaled data from the ABCI request data
 			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(i interface{}) error {
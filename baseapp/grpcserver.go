@@ -7,6 +7,7 @@ import (
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -24,7 +25,12 @@ func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRou
 func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 	// Define an interceptor for all gRPC queries: this interceptor will create
 	// a new sdk.Context, and pass it into the query handler.
-	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	interceptor := func(grpcCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		grpcCtx, span := app.grpcQueryRouter.tracer.Start(grpcCtx, "query "+info.FullMethod, trace.WithAttributes(
+			AttributeKeyQueryPath.String(info.FullMethod),
+		))
+		defer func() { endSpan(span, err) }()
+
 		// If there's some metadata in the context, retrieve it.
 		md, ok := metadata.FromIncomingContext(grpcCtx)
 		if !ok {
@@ -49,6 +55,11 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 		// actually support proofs with gRPC right now.
 		sdkCtx, err := app.CreateQueryContext(height, false)
 		if err != nil {
+			// Forward the queries at heights pruned from the local state to the
+			// archive node serving them, if any.
+			if conn := app.archiveConn(height); conn != nil {
+				return app.handleArchiveQuery(grpcCtx, conn, info.FullMethod, height, req)
+			}
 			return nil, err
 		}
 
@@ -57,6 +68,9 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
 		}
 
+		span.SetAttributes(AttributeKeyHeight.Int64(sdkCtx.BlockHeight()))
+		sdkCtx = sdkCtx.WithContext(trace.ContextWithSpan(sdkCtx.Context(), span))
+
 		// Attach the sdk.Context into the gRPC's context.Context.
 		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
 
@@ -98,3 +112,24 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 		server.RegisterService(newDesc, data.handler)
 	}
 }
+
+// handleArchiveQuery forwards a gRPC query of method at height to the archive
+// node served by conn.
+func (app *BaseApp) handleArchiveQuery(grpcCtx context.Context, conn gogogrpc.ClientConn, method string, height int64, req interface{}) (interface{}, error) {
+	reqBz, err := app.grpcQueryRouter.cdc.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resBz, err := app.queryArchive(grpcCtx, conn, method, height, reqBz)
+	if err != nil {
+		return nil, err
+	}
+
+	md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	if err = grpc.SetHeader(grpcCtx, md); err != nil {
+		app.logger.Error("failed to set gRPC header", "err", err)
+	}
+
+	return &archiveMessage{bz: resBz}, nil
+}
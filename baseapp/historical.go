@@ -0,0 +1,49 @@
+package baseapp
+
+import (
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHistoricalContext returns a context reading the state committed at height,
+// which must be retained by the pruning strategy. It lets off-chain jobs run
+// keeper methods in-process against historical state, e.g. after loading the
+// application from a copy of its database with LoadLatestVersion.
+//
+// The context is read-only: it is backed by a branch of an immutable view of the
+// state, which is never written back, so writes done through the context are
+// discarded. Its block header holds the chain ID, the height and, if available,
+// the block time of height, and its gas meter is infinite.
+func (app *BaseApp) NewHistoricalContext(height int64) (sdk.Context, error) {
+	if height <= 0 {
+		return sdk.Context{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "height must be positive, got %d", height)
+	}
+
+	// use custom query multistore if provided
+	qms := app.qms
+	if qms == nil {
+		qms = app.cms.(sdk.MultiStore)
+	}
+
+	lastBlockHeight := qms.LatestVersion()
+	if height > lastBlockHeight {
+		return sdk.Context{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "height %d is in the future; latest height is %d", height, lastBlockHeight)
+	}
+
+	cacheMS, err := qms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "failed to load state at height %d; %s (latest height: %d)", height, err, lastBlockHeight)
+	}
+
+	header := tmproto.Header{ChainID: app.chainID, Height: height}
+	if rms, ok := app.cms.(*rootmulti.Store); ok {
+		if cInfo, err := rms.GetCommitInfo(height); cInfo != nil && err == nil {
+			header.Time = cInfo.Timestamp
+		}
+	}
+
+	return sdk.NewContext(cacheMS, header, false, app.logger), nil
+}
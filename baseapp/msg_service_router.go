@@ -6,9 +6,11 @@ import (
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -18,6 +20,8 @@ type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
 	circuitBreaker    CircuitBreaker
+	tracer            trace.Tracer
+	tracing           bool
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -26,6 +30,7 @@ var _ gogogrpc.Server = &MsgServiceRouter{}
 func NewMsgServiceRouter() *MsgServiceRouter {
 	return &MsgServiceRouter{
 		routes: map[string]MsgServiceHandler{},
+		tracer: telemetry.Tracer(),
 	}
 }
 
@@ -111,7 +116,24 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			)
 		}
 
-		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (_ *sdk.Result, err error) {
+			// the context is left untouched unless tracing is enabled
+			if msr.tracing || telemetry.IsTracingEnabled() {
+				goCtx, span := msr.tracer.Start(ctx.Context(), "msg "+requestTypeName, trace.WithAttributes(
+					AttributeKeyMsgTypeURL.String(requestTypeName),
+				))
+				defer func() { endSpan(span, err) }()
+
+				// count the store accesses of the msg only when the span is sampled
+				if span.IsRecording() {
+					meter := newStoreAccessMeter(ctx.GasMeter())
+					ctx = ctx.WithGasMeter(meter)
+					defer func() { span.SetAttributes(meter.attributes()...) }()
+				}
+
+				ctx = ctx.WithContext(goCtx)
+			}
+
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
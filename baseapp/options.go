@@ -11,6 +11,7 @@ import (
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
@@ -23,6 +24,40 @@ func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetPruningOverrides sets pruning options on the IAVL stores with the given key
+// names, overriding the pruning options of the multistore for them. It panics if
+// overrides are given and the multistore is not a rootmulti.Store.
+func SetPruningOverrides(overrides map[string]pruningtypes.PruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if len(overrides) == 0 {
+			return
+		}
+
+		rms, ok := bapp.cms.(*rootmulti.Store)
+		if !ok {
+			panic(fmt.Sprintf("pruning overrides are not supported by multistore %T", bapp.cms))
+		}
+		rms.SetPruningOverrides(overrides)
+	}
+}
+
+// SetStoreBackends sets the backends of the IAVL stores with the given key names,
+// loading their CommitKVStore in place of an IAVL store. It panics if backends
+// are given and the multistore is not a rootmulti.Store.
+func SetStoreBackends(backends map[string]rootmulti.StoreBackend) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if len(backends) == 0 {
+			return
+		}
+
+		rms, ok := bapp.cms.(*rootmulti.Store)
+		if !ok {
+			panic(fmt.Sprintf("store backends are not supported by multistore %T", bapp.cms))
+		}
+		rms.SetStoreBackends(backends)
+	}
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -75,6 +110,32 @@ func SetIAVLLazyLoading(lazyLoading bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetLazyLoading(lazyLoading) }
 }
 
+// SetResourceAccounting enables/disables the Prometheus histograms of the gas,
+// store reads and writes and wall time consumed per message type URL and store
+// key in DeliverTx.
+func SetResourceAccounting(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.resourceAccounting = enabled }
+}
+
+// SetResourceSummaryEvent enables/disables the EndBlock summary events of the
+// resources consumed by the messages of the block per message type URL and
+// store key.
+func SetResourceSummaryEvent(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.resourceSummaryEvent = enabled }
+}
+
+// SetArchiveRoutes sets the routes of the gRPC queries at heights pruned from
+// the local state to archive nodes. It panics if a route is invalid.
+func SetArchiveRoutes(routes []ArchiveRoute) func(*BaseApp) {
+	for _, route := range routes {
+		if err := route.ValidateBasic(); err != nil {
+			panic(err)
+		}
+	}
+
+	return func(app *BaseApp) { app.archiveRoutes = routes }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
@@ -0,0 +1,110 @@
+package baseapp
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Span attribute keys set by BaseApp.
+const (
+	AttributeKeyHeight      = attribute.Key("cosmos.block.height")
+	AttributeKeyTxMode      = attribute.Key("cosmos.tx.mode")
+	AttributeKeyTxHash      = attribute.Key("cosmos.tx.hash")
+	AttributeKeyGasWanted   = attribute.Key("cosmos.tx.gas_wanted")
+	AttributeKeyGasUsed     = attribute.Key("cosmos.tx.gas_used")
+	AttributeKeyMsgTypeURL  = attribute.Key("cosmos.msg.type_url")
+	AttributeKeyStoreReads  = attribute.Key("cosmos.store.reads")
+	AttributeKeyStoreWrites = attribute.Key("cosmos.store.writes")
+	AttributeKeyQueryPath   = attribute.Key("cosmos.query.path")
+)
+
+var runTxModeNames = map[runTxMode]string{
+	runTxModeCheck:       "check",
+	runTxModeReCheck:     "recheck",
+	runTxModeSimulate:    "simulate",
+	runTxModeDeliver:     "deliver",
+	runTxPrepareProposal: "prepare_proposal",
+	runTxProcessProposal: "process_proposal",
+}
+
+// SetTracerProvider sets the OpenTelemetry TracerProvider used to create the
+// spans of the ABCI calls, transactions, messages and gRPC queries handled by
+// the BaseApp and its routers. By default the globally registered provider is
+// used, see telemetry.EnableTracing.
+func SetTracerProvider(tp trace.TracerProvider) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetTracerProvider(tp) }
+}
+
+// SetTracerProvider sets the OpenTelemetry TracerProvider of the BaseApp and its routers.
+func (app *BaseApp) SetTracerProvider(tp trace.TracerProvider) {
+	if app.sealed {
+		panic("SetTracerProvider() on sealed BaseApp")
+	}
+
+	tracer := tp.Tracer(telemetry.TracerName)
+	app.tracer = tracer
+	app.grpcQueryRouter.tracer = tracer
+	app.grpcQueryRouter.tracing = true
+	app.msgServiceRouter.tracer = tracer
+	app.msgServiceRouter.tracing = true
+}
+
+// endSpan records err on span, if any, and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+}
+
+// storeAccessMeter is a GasMeter counting the store reads and writes charged to
+// it, relying on the gas descriptors used by the gas KVStore.
+type storeAccessMeter struct {
+	storetypes.GasMeter
+
+	reads  int64
+	writes int64
+}
+
+func newStoreAccessMeter(gm storetypes.GasMeter) *storeAccessMeter {
+	return &storeAccessMeter{GasMeter: gm}
+}
+
+// ConsumeGas implements the GasMeter interface.
+func (m *storeAccessMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	switch descriptor {
+	case storetypes.GasReadCostFlatDesc, storetypes.GasHasDesc, storetypes.GasIterNextCostFlatDesc:
+		m.reads++
+	case storetypes.GasWriteCostFlatDesc, storetypes.GasDeleteDesc:
+		m.writes++
+	}
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// WrapKVStore implements the StoreGasMeter interface.
+func (m *storeAccessMeter) WrapKVStore(key storetypes.StoreKey, store storetypes.KVStore) storetypes.KVStore {
+	if parent, ok := m.GasMeter.(storetypes.StoreGasMeter); ok {
+		return parent.WrapKVStore(key, store)
+	}
+	return store
+}
+
+// attributes returns the store access counts as span attributes.
+func (m *storeAccessMeter) attributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		AttributeKeyStoreReads.Int64(m.reads),
+		AttributeKeyStoreWrites.Int64(m.writes),
+	}
+}
+
+// startABCISpan starts the root span of an ABCI call.
+func (app *BaseApp) startABCISpan(name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return app.tracer.Start(context.Background(), "abci."+name, trace.WithAttributes(attrs...))
+}
@@ -0,0 +1,103 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTracing_DeliverTx(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key"))) }
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetTracerProvider(tp))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+
+	suite.baseApp.EndBlock(abci.RequestEndBlock{Height: 1})
+	suite.baseApp.Commit()
+
+	spans := map[string]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	for _, name := range []string{"abci.InitChain", "abci.BeginBlock", "abci.DeliverTx", "abci.EndBlock", "abci.Commit", "runTx"} {
+		require.Contains(t, spans, name)
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})
+	msgSpan, ok := spans["msg "+msgTypeURL]
+	require.True(t, ok)
+
+	// the msg span is a child of the tx span, itself a child of the DeliverTx span
+	runTxSpan, deliverTxSpan := spans["runTx"], spans["abci.DeliverTx"]
+	require.Equal(t, runTxSpan.SpanContext.SpanID(), msgSpan.Parent.SpanID())
+	require.Equal(t, deliverTxSpan.SpanContext.SpanID(), runTxSpan.Parent.SpanID())
+	require.Equal(t, deliverTxSpan.SpanContext.TraceID(), msgSpan.SpanContext.TraceID())
+
+	attrs := attributeMap(runTxSpan.Attributes)
+	require.Equal(t, "deliver", attrs[baseapp.AttributeKeyTxMode].AsString())
+	require.Equal(t, int64(res.GasUsed), attrs[baseapp.AttributeKeyGasUsed].AsInt64())
+
+	attrs = attributeMap(msgSpan.Attributes)
+	require.Equal(t, msgTypeURL, attrs[baseapp.AttributeKeyMsgTypeURL].AsString())
+	require.Positive(t, attrs[baseapp.AttributeKeyStoreReads].AsInt64())
+	require.Positive(t, attrs[baseapp.AttributeKeyStoreWrites].AsInt64())
+}
+
+func TestTracing_FailedTx(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key"))) }
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetTracerProvider(tp))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	tx = setFailOnAnte(t, suite.txConfig, tx, true)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK())
+
+	for _, span := range exporter.GetSpans() {
+		if span.Name == "runTx" || span.Name == "abci.DeliverTx" {
+			require.Equal(t, "Error", span.Status.Code.String())
+			require.Equal(t, res.Log, span.Status.Description)
+		}
+	}
+}
+
+func attributeMap(attrs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value, len(attrs))
+	for _, attr := range attrs {
+		m[attr.Key] = attr.Value
+	}
+	return m
+}
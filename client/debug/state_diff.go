@@ -0,0 +1,279 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagStores = "stores"
+
+// StateDiff holds the changes of the application state between two heights.
+type StateDiff struct {
+	FromHeight int64       `json:"from_height"`
+	ToHeight   int64       `json:"to_height"`
+	Stores     []StoreDiff `json:"stores"`
+}
+
+// StoreDiff holds the keys of a store added, removed and modified between two
+// heights.
+type StoreDiff struct {
+	Name     string   `json:"name"`
+	Added    []KVDiff `json:"added,omitempty"`
+	Removed  []KVDiff `json:"removed,omitempty"`
+	Modified []KVDiff `json:"modified,omitempty"`
+}
+
+// KVDiff holds the values of a key at both heights, and their human-readable
+// representation if a decoder is registered for the store.
+type KVDiff struct {
+	Key       tmbytes.HexBytes `json:"key"`
+	FromValue tmbytes.HexBytes `json:"from_value,omitempty"`
+	ToValue   tmbytes.HexBytes `json:"to_value,omitempty"`
+	Decoded   string           `json:"decoded,omitempty"`
+}
+
+// StateDiffCmd creates a command reporting the keys added, removed and modified
+// in each store of the application state between two heights.
+func StateDiffCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff [from-height] [to-height]",
+		Short: "Report the changes of the application state between two heights",
+		Long: fmt.Sprintf(`Report the keys added, removed and modified in each store of the application
+state between two heights, as JSON. Both heights must be retained by the node.
+The values are decoded with the store decoders of the app simulation manager, if any.
+The node must not be running.
+
+Example:
+$ %s debug state-diff 100 101
+$ %s debug state-diff 100 200 --stores bank,staking
+			`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height: %w", err)
+			}
+			toHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height: %w", err)
+			}
+
+			storeNames, err := cmd.Flags().GetStringSlice(flagStores)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(serverCtx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(log.NewNopLogger(), db, nil, serverCtx.Viper)
+			rootMultiStore, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("currently only support the state diff of rootmulti.Store type")
+			}
+
+			var decoders sdk.StoreDecoderRegistry
+			if simApp, ok := app.(interface {
+				SimulationManager() *module.SimulationManager
+			}); ok && simApp.SimulationManager() != nil {
+				decoders = simApp.SimulationManager().StoreDecoders
+			}
+
+			diff, err := DiffStateVersions(rootMultiStore, fromHeight, toHeight, decoders, storeNames)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(diff, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return err
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().StringSlice(flagStores, nil, "Only report the changes of the given stores")
+
+	return cmd
+}
+
+// DiffStateVersions reports the keys added, removed and modified in the IAVL
+// stores of rs between the versions fromHeight and toHeight, reading both
+// versions read-only. Only the stores named in storeNames are compared, unless
+// it is empty. The changes of a store are decoded with its decoder in decoders,
+// if any.
+func DiffStateVersions(rs *rootmulti.Store, fromHeight, toHeight int64, decoders sdk.StoreDecoderRegistry, storeNames []string) (StateDiff, error) {
+	diff := StateDiff{FromHeight: fromHeight, ToHeight: toHeight, Stores: []StoreDiff{}}
+
+	fromInfo, err := rs.GetCommitInfo(fromHeight)
+	if err != nil {
+		return diff, fmt.Errorf("failed to load height %d: %w", fromHeight, err)
+	}
+	toInfo, err := rs.GetCommitInfo(toHeight)
+	if err != nil {
+		return diff, fmt.Errorf("failed to load height %d: %w", toHeight, err)
+	}
+
+	keysByName := rs.StoreKeysByName()
+	names := append([]string(nil), storeNames...)
+	if len(names) == 0 {
+		for name := range keysByName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		key, ok := keysByName[name]
+		if !ok {
+			return diff, fmt.Errorf("unknown store %s", name)
+		}
+
+		store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			// only the IAVL stores are versioned
+			continue
+		}
+
+		from, err := immutableStore(store, name, fromInfo)
+		if err != nil {
+			return diff, err
+		}
+		to, err := immutableStore(store, name, toInfo)
+		if err != nil {
+			return diff, err
+		}
+
+		storeDiff := StoreDiff{Name: name}
+		diffKVStores(from, to, func(key, fromValue, toValue []byte) {
+			change := KVDiff{Key: key, FromValue: fromValue, ToValue: toValue}
+			if decoder, ok := decoders[name]; ok {
+				change.Decoded = decodeKVPair(decoder, kv.Pair{Key: key, Value: fromValue}, kv.Pair{Key: key, Value: toValue})
+			}
+
+			switch {
+			case fromValue == nil:
+				storeDiff.Added = append(storeDiff.Added, change)
+			case toValue == nil:
+				storeDiff.Removed = append(storeDiff.Removed, change)
+			default:
+				storeDiff.Modified = append(storeDiff.Modified, change)
+			}
+		})
+
+		if len(storeDiff.Added)+len(storeDiff.Removed)+len(storeDiff.Modified) > 0 {
+			diff.Stores = append(diff.Stores, storeDiff)
+		}
+	}
+
+	return diff, nil
+}
+
+// immutableStore returns the version of store committed in commitInfo, or nil
+// if the store did not exist at that version.
+func immutableStore(store *iavl.Store, name string, commitInfo *storetypes.CommitInfo) (storetypes.KVStore, error) {
+	for _, storeInfo := range commitInfo.StoreInfos {
+		if storeInfo.Name != name {
+			continue
+		}
+
+		immutable, err := store.GetImmutable(commitInfo.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load height %d of store %s: %w", commitInfo.Version, name, err)
+		}
+		return immutable, nil
+	}
+
+	return nil, nil
+}
+
+// diffKVStores calls fn with the values from a and b of the keys added, removed
+// or modified from a to b, in ascending key order. The value of a missing key
+// is nil. A nil store is considered empty.
+func diffKVStores(a, b storetypes.KVStore, fn func(key, valueA, valueB []byte)) {
+	iterA, iterB := storeIterator(a), storeIterator(b)
+	defer iterA.Close()
+	defer iterB.Close()
+
+	for iterA.Valid() || iterB.Valid() {
+		var cmp int
+		switch {
+		case !iterB.Valid():
+			cmp = -1
+		case !iterA.Valid():
+			cmp = 1
+		default:
+			cmp = bytes.Compare(iterA.Key(), iterB.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			fn(copyBytes(iterA.Key()), copyBytes(iterA.Value()), nil)
+			iterA.Next()
+		case cmp > 0:
+			fn(copyBytes(iterB.Key()), nil, copyBytes(iterB.Value()))
+			iterB.Next()
+		default:
+			if !bytes.Equal(iterA.Value(), iterB.Value()) {
+				fn(copyBytes(iterA.Key()), copyBytes(iterA.Value()), copyBytes(iterB.Value()))
+			}
+			iterA.Next()
+			iterB.Next()
+		}
+	}
+}
+
+// storeIterator returns an iterator over the whole store, or an empty iterator
+// if the store is nil.
+func storeIterator(store storetypes.KVStore) storetypes.Iterator {
+	if store == nil {
+		store = dbadapter.Store{DB: dbm.NewMemDB()}
+	}
+	return store.Iterator(nil, nil)
+}
+
+// copyBytes returns a non-nil copy of bz, as iterators may reuse their buffers.
+func copyBytes(bz []byte) []byte {
+	return append([]byte{}, bz...)
+}
+
+// decodeKVPair returns the human-readable representation of a change with the
+// store decoder, or an empty string if the decoder does not support the key,
+// as decoders panic on unknown keys.
+func decodeKVPair(decoder func(kvA, kvB kv.Pair) string, kvA, kvB kv.Pair) (decoded string) {
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = ""
+		}
+	}()
+
+	return decoder(kvA, kvB)
+}
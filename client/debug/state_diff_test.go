@@ -0,0 +1,77 @@
+package debug_test
+
+import (
+	"fmt"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestDiffStateVersions(t *testing.T) {
+	keyA, keyB := storetypes.NewKVStoreKey("a"), storetypes.NewKVStoreKey("b")
+
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	rs.MountStoreWithDB(keyA, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(keyB, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(storetypes.NewTransientStoreKey("transient"), storetypes.StoreTypeTransient, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	storeA, storeB := rs.GetKVStore(keyA), rs.GetKVStore(keyB)
+	storeA.Set([]byte("removed"), []byte("1"))
+	storeA.Set([]byte("modified"), []byte("1"))
+	storeA.Set([]byte("unchanged"), []byte("1"))
+	storeB.Set([]byte("unchanged"), []byte("1"))
+	rs.Commit()
+
+	storeA.Delete([]byte("removed"))
+	storeA.Set([]byte("modified"), []byte("2"))
+	storeA.Set([]byte("added"), []byte("2"))
+	rs.Commit()
+
+	decoders := sdk.StoreDecoderRegistry{
+		"a": func(kvA, kvB kv.Pair) string {
+			if string(kvA.Key) == "added" {
+				panic("unknown key")
+			}
+			return fmt.Sprintf("%s: %s -> %s", kvA.Key, kvA.Value, kvB.Value)
+		},
+	}
+
+	diff, err := debug.DiffStateVersions(rs, 1, 2, decoders, nil)
+	require.NoError(t, err)
+	require.Equal(t, debug.StateDiff{
+		FromHeight: 1,
+		ToHeight:   2,
+		Stores: []debug.StoreDiff{{
+			Name:     "a",
+			Added:    []debug.KVDiff{{Key: []byte("added"), ToValue: []byte("2")}},
+			Removed:  []debug.KVDiff{{Key: []byte("removed"), FromValue: []byte("1"), Decoded: "removed: 1 -> "}},
+			Modified: []debug.KVDiff{{Key: []byte("modified"), FromValue: []byte("1"), ToValue: []byte("2"), Decoded: "modified: 1 -> 2"}},
+		}},
+	}, diff)
+
+	// the reverse diff swaps the added and removed keys
+	diff, err = debug.DiffStateVersions(rs, 2, 1, nil, []string{"a"})
+	require.NoError(t, err)
+	require.Len(t, diff.Stores, 1)
+	require.Equal(t, []debug.KVDiff{{Key: []byte("removed"), ToValue: []byte("1")}}, diff.Stores[0].Added)
+	require.Equal(t, []debug.KVDiff{{Key: []byte("added"), FromValue: []byte("2")}}, diff.Stores[0].Removed)
+
+	diff, err = debug.DiffStateVersions(rs, 1, 2, nil, []string{"b"})
+	require.NoError(t, err)
+	require.Empty(t, diff.Stores)
+
+	_, err = debug.DiffStateVersions(rs, 1, 2, nil, []string{"unknown"})
+	require.Error(t, err)
+
+	_, err = debug.DiffStateVersions(rs, 1, 3, nil, nil)
+	require.Error(t, err)
+}
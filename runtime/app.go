@@ -51,6 +51,9 @@ type App struct {
 	// initChainer is the init chainer function defined by the app config.
 	// this is only required if the chain wants to add special InitChainer logic.
 	initChainer sdk.InitChainer
+	// genesisStreamFile is the JSONL genesis stream the default init chainer
+	// initializes the modules from, if set.
+	genesisStreamFile string
 }
 
 // RegisterModules registers the provided modules with the module manager and
@@ -146,6 +149,10 @@ func (a *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Respons
 
 // InitChainer initializes the chain.
 func (a *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+	if a.genesisStreamFile != "" {
+		return a.ModuleManager.InitGenesisFromStreamFile(ctx, a.cdc, a.genesisStreamFile)
+	}
+
 	var genesisState map[string]json.RawMessage
 	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
@@ -217,6 +224,13 @@ func (a *App) SetInitChainer(initChainer sdk.InitChainer) {
 	a.BaseApp.SetInitChainer(initChainer)
 }
 
+// SetGenesisStreamFile sets the JSONL genesis stream file the default init
+// chainer initializes the modules from, in place of the app state of the
+// InitChain request.
+func (a *App) SetGenesisStreamFile(path string) {
+	a.genesisStreamFile = path
+}
+
 // UnsafeFindStoreKey fetches a registered StoreKey from the App in linear time.
 //
 // NOTE: This should only be used in testing.
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// archiveConnectTimeout is the timeout of the connection attempts to an archive
+// endpoint, so that the queries routed to an unreachable archive node fail fast.
+const archiveConnectTimeout = 5 * time.Second
+
+// GetArchiveRoutesFromFlags parses the archive endpoints of the
+// grpc.archive-endpoints app config and returns the routes of the gRPC queries
+// at pruned heights to them. The connections to the endpoints are established
+// lazily, on the first query routed to them.
+func GetArchiveRoutesFromFlags(appOpts types.AppOptions) ([]baseapp.ArchiveRoute, error) {
+	endpointsOpt := appOpts.Get(FlagGRPCArchiveEndpoints)
+	if endpointsOpt == nil {
+		return nil, nil
+	}
+
+	entries, err := cast.ToSliceE(endpointsOpt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive endpoints: %w", err)
+	}
+
+	routes := make([]baseapp.ArchiveRoute, 0, len(entries))
+	for _, entry := range entries {
+		fields, err := cast.ToStringMapE(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid archive endpoint: %w", err)
+		}
+
+		address := cast.ToString(fields["address"])
+		if address == "" {
+			return nil, fmt.Errorf("archive endpoint without address")
+		}
+
+		conn, err := grpc.Dial(
+			address,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithConnectParams(grpc.ConnectParams{
+				Backoff:           backoff.DefaultConfig,
+				MinConnectTimeout: archiveConnectTimeout,
+			}),
+			// the responses of the archive node are forwarded as is, allow the
+			// same size as the responses of the local gRPC server
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(config.DefaultGRPCMaxSendMsgSize)),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial archive endpoint %s: %w", address, err)
+		}
+
+		route := baseapp.ArchiveRoute{
+			StartHeight: cast.ToInt64(fields["start-height"]),
+			EndHeight:   cast.ToInt64(fields["end-height"]),
+			Conn:        conn,
+		}
+		if err := route.ValidateBasic(); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("archive endpoint %s: %w", address, err)
+		}
+		routes = append(routes, route)
+	}
+
+	return routes, nil
+}
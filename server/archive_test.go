@@ -0,0 +1,53 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetArchiveRoutesFromFlags(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+[grpc]
+enable = true
+
+[[grpc.archive-endpoints]]
+address = "localhost:9190"
+start-height = 1
+end-height = 1000
+
+[[grpc.archive-endpoints]]
+address = "localhost:9290"
+start-height = 1001
+`)))
+
+	routes, err := GetArchiveRoutesFromFlags(v)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+	require.Equal(t, int64(1), routes[0].StartHeight)
+	require.Equal(t, int64(1000), routes[0].EndHeight)
+	require.Equal(t, int64(1001), routes[1].StartHeight)
+	require.Equal(t, int64(0), routes[1].EndHeight)
+	for _, route := range routes {
+		require.NotNil(t, route.Conn)
+	}
+
+	routes, err = GetArchiveRoutesFromFlags(viper.New())
+	require.NoError(t, err)
+	require.Empty(t, routes)
+
+	v = viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+[[grpc.archive-endpoints]]
+address = "localhost:9190"
+start-height = 10
+end-height = 5
+`)))
+	_, err = GetArchiveRoutesFromFlags(v)
+	require.ErrorContains(t, err, "archive endpoint localhost:9190")
+}
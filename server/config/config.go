@@ -93,6 +93,14 @@ type BaseConfig struct {
 	// IAVLLazyLoading enable/disable the lazy loading of iavl store.
 	IAVLLazyLoading bool `mapstructure:"iavl-lazy-loading"`
 
+	// ResourceAccounting enables the Prometheus histograms of the gas, store reads
+	// and writes and wall time consumed per message type URL and store key.
+	ResourceAccounting bool `mapstructure:"resource-accounting"`
+
+	// ResourceSummaryEvent enables the EndBlock summary events of the resources
+	// consumed by the messages of the block per message type URL and store key.
+	ResourceSummaryEvent bool `mapstructure:"resource-summary-event"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the Tendermint config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -176,6 +184,19 @@ type GRPCConfig struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+
+	// ArchiveEndpoints defines the archive nodes the gRPC queries at heights
+	// pruned from the local state are forwarded to, by height range.
+	ArchiveEndpoints []GRPCArchiveEndpoint `mapstructure:"archive-endpoints"`
+}
+
+// GRPCArchiveEndpoint defines the gRPC endpoint of an archive node serving the
+// queries at the heights from StartHeight to EndHeight. A zero EndHeight means
+// no upper bound.
+type GRPCArchiveEndpoint struct {
+	Address     string `mapstructure:"address"`
+	StartHeight int64  `mapstructure:"start-height"`
+	EndHeight   int64  `mapstructure:"end-height"`
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -216,6 +237,23 @@ type (
 	// storage related operations.
 	StoreConfig struct {
 		Streamers []string `mapstructure:"streamers"`
+
+		// PruningOverrides defines the pruning options of the stores overriding
+		// the pruning options of the application.
+		PruningOverrides []StorePruningConfig `mapstructure:"pruning-overrides"`
+
+		// Backends defines the backends of the stores committing their state in
+		// place of IAVL, by store key name.
+		Backends map[string]string `mapstructure:"backends"`
+	}
+
+	// StorePruningConfig defines the pruning options of a store, overriding the
+	// pruning options of the application for it.
+	StorePruningConfig struct {
+		StoreKey          string `mapstructure:"store-key"`
+		Pruning           string `mapstructure:"pruning"`
+		PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+		PruningInterval   string `mapstructure:"pruning-interval"`
 	}
 
 	// StreamersConfig defines concrete state streaming configuration options. These
@@ -292,10 +330,17 @@ func DefaultConfig() *Config {
 			IAVLDisableFastNode: false,
 			IAVLLazyLoading:     false,
 			AppDBBackend:        "",
+
+			ResourceAccounting:   false,
+			ResourceSummaryEvent: false,
 		},
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:           false,
+			GlobalLabels:      [][]string{},
+			TracingEnabled:    false,
+			OTLPEndpoint:      telemetry.DefaultOTLPEndpoint,
+			OTLPInsecure:      false,
+			TracingSampleRate: telemetry.DefaultTracingSampleRate,
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -369,6 +414,9 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if c.Telemetry.TracingEnabled && (c.Telemetry.TracingSampleRate < 0 || c.Telemetry.TracingSampleRate > 1) {
+		return sdkerrors.ErrAppConfig.Wrapf("tracing sample rate must be between 0 and 1, got %v", c.Telemetry.TracingSampleRate)
+	}
 
 	return nil
 }
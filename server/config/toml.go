@@ -81,6 +81,16 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # Default is false.
 iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
 
+# ResourceAccounting enables the Prometheus histograms of the gas, store reads and
+# writes and wall time consumed per message type URL and store key in DeliverTx.
+# Requires telemetry with a Prometheus retention time to be exported.
+resource-accounting = {{ .BaseConfig.ResourceAccounting }}
+
+# ResourceSummaryEvent enables the EndBlock summary events of the resources consumed
+# by the messages of the block per message type URL and store key.
+# Note, the events are node local and should not be relied upon by clients.
+resource-summary-event = {{ .BaseConfig.ResourceSummaryEvent }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # The fallback is the db_backend value set in Tendermint's config.toml.
@@ -121,6 +131,20 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+# TracingEnabled enables OpenTelemetry tracing of ABCI calls, transactions,
+# messages and gRPC queries. Spans are exported through OTLP/gRPC.
+tracing-enabled = {{ .Telemetry.TracingEnabled }}
+
+# OTLPEndpoint defines the address of the OTLP/gRPC collector spans are exported to.
+otlp-endpoint = "{{ .Telemetry.OTLPEndpoint }}"
+
+# OTLPInsecure disables TLS on the connection to the OTLP collector.
+otlp-insecure = {{ .Telemetry.OTLPInsecure }}
+
+# TracingSampleRate defines the fraction of traces sampled, between 0 and 1.
+# Zero samples at the default rate of 1, use tracing-enabled to disable tracing.
+tracing-sample-rate = {{ .Telemetry.TracingSampleRate }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -207,6 +231,26 @@ max-recv-msg-size = "{{ .GRPC.MaxRecvMsgSize }}"
 # The default value is math.MaxInt32.
 max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
 
+# Archive endpoints define the gRPC endpoints of archive nodes serving the queries at
+# the heights from start-height to end-height (0 for no upper bound). The gRPC queries,
+# including the ones of the gRPC gateway, at heights pruned from the local state are
+# forwarded to the first archive endpoint whose height range contains them. The ABCI
+# queries are never forwarded.
+#
+# Example:
+#
+# [[grpc.archive-endpoints]]
+# address = "archive-1.example.com:9090"
+# start-height = 1
+# end-height = 1000000
+{{- range .GRPC.ArchiveEndpoints }}
+
+[[grpc.archive-endpoints]]
+address = "{{ .Address }}"
+start-height = {{ .StartHeight }}
+end-height = {{ .EndHeight }}
+{{- end }}
+
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
@@ -261,6 +305,51 @@ stop-node-on-error = "{{ .Streamers.File.StopNodeOnError }}"
 # fsync specifies if call fsync after writing the files.
 fsync = "{{ .Streamers.File.Fsync }}"
 
+# Pruning overrides set the pruning options of individual IAVL stores, overriding the base
+# configuration pruning options for them, e.g. to keep the full history of some stores only.
+# A store with an override is pruned every pruning-interval heights and whenever the
+# other stores are pruned. Snapshot heights are kept until their snapshot completes.
+# Queries at a height pruned from some stores only fail when accessing these stores.
+#
+# Example:
+#
+# [[store.pruning-overrides]]
+# store-key = "bank"
+# pruning = "nothing"
+#
+# [[store.pruning-overrides]]
+# store-key = "params"
+# pruning = "custom"
+# pruning-keep-recent = "100"
+# pruning-interval = "10"
+{{- range .Store.PruningOverrides }}
+
+[[store.pruning-overrides]]
+store-key = "{{ .StoreKey }}"
+pruning = "{{ .Pruning }}"
+pruning-keep-recent = "{{ .PruningKeepRecent }}"
+pruning-interval = "{{ .PruningInterval }}"
+{{- end }}
+
+# Backends set the backends of individual IAVL stores, committing their state in place of IAVL.
+# The "flat" backend keeps the latest state as flat key/value pairs in the database and commits
+# to it with a merkle tree stored next to it. Its stores keep no history: the queries at past
+# heights fail on them, as if the heights were pruned. They do not support state sync snapshots,
+# so state-sync.snapshot-interval must be 0. The backend of a store can only be selected before
+# the store is first committed, i.e. for new chains or stores.
+#
+# Example:
+#
+# [store.backends]
+# bank = "flat"
+{{- if .Store.Backends }}
+
+[store.backends]
+{{- range $storeKey, $backend := .Store.Backends }}
+{{ $storeKey }} = "{{ $backend }}"
+{{- end }}
+{{- end }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -3,16 +3,21 @@ package server
 // DONTCOVER
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 
 	tmjson "github.com/cometbft/cometbft/libs/json"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 const (
@@ -21,6 +26,13 @@ const (
 	FlagJailAllowedAddrs = "jail-allowed-addrs"
 	FlagModulesToExport  = "modules-to-export"
 	FlagOutputDocument   = "output-document"
+	FlagExportFormat     = "format"
+
+	// ExportFormatJSON exports the state as a genesis JSON document.
+	ExportFormatJSON = "json"
+	// ExportFormatJSONL streams the state as a JSONL genesis stream, writing
+	// the genesis state of each module on its own line as soon as exported.
+	ExportFormatJSONL = "jsonl"
 )
 
 // ExportCmd dumps app state to JSON.
@@ -39,6 +51,11 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return err
 			}
 
+			format, _ := cmd.Flags().GetString(FlagExportFormat)
+			if format != ExportFormatJSON && format != ExportFormatJSONL {
+				return fmt.Errorf("unknown export format %s, expected %s or %s", format, ExportFormatJSON, ExportFormatJSONL)
+			}
+
 			db, err := openDB(config.RootDir, GetAppDBBackend(serverCtx.Viper))
 			if err != nil {
 				return err
@@ -80,7 +97,6 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return err
 			}
 
-			doc.AppState = exported.AppState
 			doc.Validators = exported.Validators
 			doc.InitialHeight = exported.Height
 			doc.ConsensusParams = &tmtypes.ConsensusParams{
@@ -98,6 +114,20 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				},
 			}
 
+			cmd.SetOut(cmd.OutOrStdout())
+			cmd.SetErr(cmd.OutOrStderr())
+
+			if format == ExportFormatJSONL {
+				return writeGenesisStream(cmd.OutOrStdout(), outputDocument, doc, exported)
+			}
+
+			doc.AppState = exported.AppState
+			if doc.AppState == nil && exported.StreamAppState != nil {
+				if doc.AppState, err = collectAppState(exported); err != nil {
+					return err
+				}
+			}
+
 			// NOTE: Tendermint uses a custom JSON decoder for GenesisDoc
 			// (except for stuff inside AppState). Inside AppState, we're free
 			// to encode as protobuf or amino.
@@ -106,8 +136,6 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return err
 			}
 
-			cmd.SetOut(cmd.OutOrStdout())
-			cmd.SetErr(cmd.OutOrStderr())
 			out := sdk.MustSortJSON(encoded)
 
 			if outputDocument == "" {
@@ -133,6 +161,72 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 	cmd.Flags().StringSlice(FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
 	cmd.Flags().StringSlice(FlagModulesToExport, []string{}, "Comma-separated list of modules to export. If empty, will export all modules")
 	cmd.Flags().String(FlagOutputDocument, "", "Exported state is written to the given file instead of STDOUT")
+	cmd.Flags().String(FlagExportFormat, ExportFormatJSON, "Export format: json (genesis document) or jsonl (genesis stream, written module by module)")
 
 	return cmd
 }
+
+// writeGenesisStream writes the exported state as a JSONL genesis stream to the
+// outputDocument file, or to out if no file is given.
+func writeGenesisStream(out io.Writer, outputDocument string, doc *tmtypes.GenesisDoc, exported types.ExportedApp) error {
+	if outputDocument != "" {
+		file, err := os.Create(outputDocument)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	sw, err := module.NewGenesisStreamWriter(out, doc)
+	if err != nil {
+		return err
+	}
+
+	if exported.StreamAppState != nil {
+		err = exported.StreamAppState(sw.WriteModuleGenesis)
+	} else {
+		// the app exporter does not support streaming, stream the modules of
+		// the exported app state instead
+		err = streamAppState(exported.AppState, sw.WriteModuleGenesis)
+	}
+	if err != nil {
+		return err
+	}
+
+	return sw.Flush()
+}
+
+// streamAppState passes the genesis state of each module of appState to fn, in
+// the order of the module names.
+func streamAppState(appState json.RawMessage, fn func(moduleName string, genesis json.RawMessage) error) error {
+	var genesisState map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &genesisState); err != nil {
+		return err
+	}
+
+	moduleNames := maps.Keys(genesisState)
+	sort.Strings(moduleNames)
+	for _, moduleName := range moduleNames {
+		if err := fn(moduleName, genesisState[moduleName]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectAppState collects the genesis states of the modules streamed by an
+// app exporter into an app state.
+func collectAppState(exported types.ExportedApp) (json.RawMessage, error) {
+	genesisState := make(map[string]json.RawMessage)
+	err := exported.StreamAppState(func(moduleName string, genesis json.RawMessage) error {
+		genesisState[moduleName] = genesis
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(genesisState, "", "  ")
+}
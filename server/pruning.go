@@ -14,7 +14,54 @@ import (
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
 // returned, otherwise, it is assumed custom pruning options are provided.
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
-	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
+	return parsePruningOptions(
+		appOpts.Get(FlagPruning),
+		appOpts.Get(FlagPruningKeepRecent),
+		appOpts.Get(FlagPruningInterval),
+	)
+}
+
+// GetPruningOverridesFromFlags parses the per store pruning overrides of the
+// store.pruning-overrides app config and returns their PruningOptions by store
+// key name.
+func GetPruningOverridesFromFlags(appOpts types.AppOptions) (map[string]pruningtypes.PruningOptions, error) {
+	overridesOpt := appOpts.Get(FlagPruningOverrides)
+	if overridesOpt == nil {
+		return nil, nil
+	}
+
+	entries, err := cast.ToSliceE(overridesOpt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pruning overrides: %w", err)
+	}
+
+	overrides := make(map[string]pruningtypes.PruningOptions, len(entries))
+	for _, entry := range entries {
+		fields, err := cast.ToStringMapE(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning override: %w", err)
+		}
+
+		storeKey := cast.ToString(fields["store-key"])
+		if storeKey == "" {
+			return nil, fmt.Errorf("pruning override without store-key")
+		}
+		if _, ok := overrides[storeKey]; ok {
+			return nil, fmt.Errorf("duplicate pruning override for store %s", storeKey)
+		}
+
+		opts, err := parsePruningOptions(fields[FlagPruning], fields[FlagPruningKeepRecent], fields[FlagPruningInterval])
+		if err != nil {
+			return nil, fmt.Errorf("pruning override for store %s: %w", storeKey, err)
+		}
+		overrides[storeKey] = opts
+	}
+
+	return overrides, nil
+}
+
+func parsePruningOptions(strategyOpt, keepRecentOpt, intervalOpt interface{}) (pruningtypes.PruningOptions, error) {
+	strategy := strings.ToLower(cast.ToString(strategyOpt))
 
 	switch strategy {
 	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
@@ -22,8 +69,8 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 
 	case pruningtypes.PruningOptionCustom:
 		opts := pruningtypes.NewCustomPruningOptions(
-			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
-			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+			cast.ToUint64(keepRecentOpt),
+			cast.ToUint64(intervalOpt),
 		)
 
 		if err := opts.Validate(); err != nil {
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -66,3 +67,45 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPruningOverridesFromFlags(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+[store]
+streamers = []
+
+[[store.pruning-overrides]]
+store-key = "bank"
+pruning = "nothing"
+
+[[store.pruning-overrides]]
+store-key = "params"
+pruning = "custom"
+pruning-keep-recent = "100"
+pruning-interval = "10"
+`)))
+
+	overrides, err := GetPruningOverridesFromFlags(v)
+	require.NoError(t, err)
+	require.Equal(t, map[string]pruningtypes.PruningOptions{
+		"bank":   pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+		"params": pruningtypes.NewCustomPruningOptions(100, 10),
+	}, overrides)
+
+	overrides, err = GetPruningOverridesFromFlags(viper.New())
+	require.NoError(t, err)
+	require.Empty(t, overrides)
+
+	v = viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+[[store.pruning-overrides]]
+store-key = "params"
+pruning = "custom"
+pruning-keep-recent = "100"
+pruning-interval = "1"
+`)))
+	_, err = GetPruningOverridesFromFlags(v)
+	require.ErrorContains(t, err, "pruning override for store params")
+}
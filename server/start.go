@@ -3,6 +3,7 @@ package server
 // DONTCOVER
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -53,15 +54,20 @@ const (
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
+	FlagGenesisStream      = "genesis-stream"
 
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
+	FlagPruningOverrides    = "store.pruning-overrides"
+	FlagStoreBackends       = "store.backends"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
+	FlagResourceAccounting  = "resource-accounting"
+	FlagResourceSummary     = "resource-summary-event"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -84,6 +90,8 @@ const (
 	flagGRPCWebEnable  = "grpc-web.enable"
 	flagGRPCWebAddress = "grpc-web.address"
 
+	FlagGRPCArchiveEndpoints = "grpc.archive-endpoints"
+
 	// mempool flags
 	FlagMempoolMaxTxs = "mempool.max-txs"
 )
@@ -179,6 +187,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().String(FlagGenesisStream, "", "Initialize the chain from the given JSONL genesis stream instead of the app state of the genesis file")
 
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
 	cmd.Flags().Bool(FlagAPISwagger, false, "Define if swagger documentation should automatically be registered (Note: the API must also be enabled)")
@@ -255,6 +264,12 @@ func startStandAlone(ctx *Context, clientCtx client.Context, appCreator types.Ap
 		return err
 	}
 
+	stopTracing, err := startTracing(ctx, config)
+	if err != nil {
+		return err
+	}
+	defer stopTracing()
+
 	cfg := ctx.Config
 
 	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
@@ -406,6 +421,12 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		return err
 	}
 
+	stopTracing, err := startTracing(ctx, config)
+	if err != nil {
+		return err
+	}
+	defer stopTracing()
+
 	apiSrv, err := startAPIserver(config, genDocProvider, clientCtx, home, ctx, app, metrics)
 	if err != nil {
 		return err
@@ -590,6 +611,21 @@ func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
 	return telemetry.New(cfg.Telemetry)
 }
 
+// startTracing registers the OTLP tracer provider configured in the [telemetry]
+// section. The returned function flushes the pending spans on shutdown.
+func startTracing(ctx *Context, cfg serverconfig.Config) (func(), error) {
+	shutdown, err := telemetry.EnableTracing(context.Background(), cfg.Telemetry)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		if err := shutdown(context.Background()); err != nil {
+			ctx.Logger.Error("failed to shutdown tracer provider", "err", err)
+		}
+	}, nil
+}
+
 // wrapCPUProfile runs callback in a goroutine, then wait for quit signals.
 func wrapCPUProfile(ctx *Context, callback func() error) error {
 	if cpuProfile := ctx.Viper.GetString(flagCPUProfile); cpuProfile != "" {
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/flat"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+const (
+	// StoreBackendIAVL is the default backend of the stores.
+	StoreBackendIAVL = "iavl"
+	// StoreBackendFlat is the backend of the stores keeping their latest state
+	// as flat key/value pairs, see flat.Store.
+	StoreBackendFlat = "flat"
+)
+
+// GetStoreBackendsFromFlags parses the per store backends of the store.backends
+// app config and returns the StoreBackend of the stores not using the default
+// IAVL backend, by store key name. As the flat backend does not support state
+// sync snapshots, it cannot be used with a non-zero state-sync.snapshot-interval.
+func GetStoreBackendsFromFlags(appOpts types.AppOptions) (map[string]rootmulti.StoreBackend, error) {
+	backendsOpt := appOpts.Get(FlagStoreBackends)
+	if backendsOpt == nil {
+		return nil, nil
+	}
+
+	names, err := cast.ToStringMapStringE(backendsOpt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid store backends: %w", err)
+	}
+
+	backends := make(map[string]rootmulti.StoreBackend, len(names))
+	for storeKey, name := range names {
+		switch name {
+		case StoreBackendIAVL:
+		case StoreBackendFlat:
+			if cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)) > 0 {
+				return nil, fmt.Errorf("backend %s of store %s does not support state sync snapshots; set %s to 0", name, storeKey, FlagStateSyncSnapshotInterval)
+			}
+			backends[storeKey] = flat.LoadStore
+		default:
+			return nil, fmt.Errorf("unknown backend %s for store %s", name, storeKey)
+		}
+	}
+
+	return backends, nil
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func TestGetStoreBackendsFromFlags(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+[store]
+streamers = []
+
+[store.backends]
+bank = "flat"
+params = "iavl"
+`)))
+
+	backends, err := GetStoreBackendsFromFlags(v)
+	require.NoError(t, err)
+	require.Len(t, backends, 1)
+	require.NotNil(t, backends["bank"])
+
+	backends, err = GetStoreBackendsFromFlags(viper.New())
+	require.NoError(t, err)
+	require.Empty(t, backends)
+
+	v = viper.New()
+	v.Set(FlagStoreBackends, map[string]interface{}{"bank": "smt"})
+	_, err = GetStoreBackendsFromFlags(v)
+	require.ErrorContains(t, err, "unknown backend smt for store bank")
+
+	// the flat backend does not support state sync snapshots
+	v = viper.New()
+	v.Set(FlagStoreBackends, map[string]interface{}{"bank": "flat"})
+	v.Set(FlagStateSyncSnapshotInterval, 1000)
+	_, err = GetStoreBackendsFromFlags(v)
+	require.ErrorContains(t, err, "backend flat of store bank does not support state sync snapshots")
+}
+
+func TestStoreBackendsConfigTemplate(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Store.Backends = map[string]string{"bank": StoreBackendFlat}
+
+	configPath := filepath.Join(t.TempDir(), "app.toml")
+	config.WriteConfigFile(configPath, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed, err := config.ParseConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg.Store.Backends, parsed.Store.Backends)
+
+	backends, err := GetStoreBackendsFromFlags(v)
+	require.NoError(t, err)
+	require.Len(t, backends, 1)
+	require.NotNil(t, backends["bank"])
+}
@@ -80,6 +80,10 @@ type (
 	ExportedApp struct {
 		// AppState is the application state as JSON.
 		AppState json.RawMessage
+		// StreamAppState, if set, passes the genesis state of each exported
+		// module to fn in turn, in place of AppState. It allows exporting app
+		// states too large to be held in memory as a whole.
+		StreamAppState func(fn func(moduleName string, genesis json.RawMessage) error) error
 		// Validators is the exported validator set.
 		Validators []tmtypes.GenesisValidator
 		// Height is the app's latest block height.
@@ -458,6 +458,21 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
+	pruningOverrides, err := GetPruningOverridesFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	archiveRoutes, err := GetArchiveRoutesFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	storeBackends, err := GetStoreBackendsFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
 	if chainID == "" {
@@ -483,6 +498,9 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 
 	return []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
+		baseapp.SetPruningOverrides(pruningOverrides),
+		baseapp.SetArchiveRoutes(archiveRoutes),
+		baseapp.SetStoreBackends(storeBackends),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(FlagHaltTime))),
@@ -499,6 +517,8 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 			),
 		),
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
+		baseapp.SetResourceAccounting(cast.ToBool(appOpts.Get(FlagResourceAccounting))),
+		baseapp.SetResourceSummaryEvent(cast.ToBool(appOpts.Get(FlagResourceSummary))),
 		baseapp.SetChainID(chainID),
 	}
 }
@@ -0,0 +1,67 @@
+package simapp
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	feeabsante "github.com/cosmos/cosmos-sdk/x/feeabs/ante"
+	feemarketante "github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+)
+
+// HandlerOptions are the options required for constructing the SimApp
+// AnteHandler.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	FeeAbsKeeper    feeabsante.FeeAbsKeeper
+	FeeMarketKeeper feemarketante.FeeMarketKeeper
+}
+
+// NewAnteHandler returns the default SDK AnteHandler, with fees payable in the
+// denoms of the x/feeabs conversion table and charged the x/feemarket base fee.
+// The fee checker of the DeductFeeDecorator prices the fee in the base denom,
+// the ConvertFeeDecorator converts the deducted fee into the base denom, and the
+// BaseFeeDecorator checks the base denom equivalent of the fee against the base
+// fee, burning it from the converted fee if enabled.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	if options.FeeAbsKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "feeabs keeper is required for ante builder")
+	}
+
+	if options.FeeMarketKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "feemarket keeper is required for ante builder")
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, feeabsante.NewTxFeeChecker(options.FeeAbsKeeper)),
+		feeabsante.NewConvertFeeDecorator(options.FeeAbsKeeper),                          // ConvertFeeDecorator must be called right after DeductFeeDecorator
+		feemarketante.NewBaseFeeDecorator(options.FeeMarketKeeper, options.FeeAbsKeeper), // BaseFeeDecorator must be called after ConvertFeeDecorator
+		ante.NewSetPubKeyDecorator(options.AccountKeeper),                                // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
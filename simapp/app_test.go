@@ -4,16 +4,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,7 +31,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
@@ -72,6 +78,51 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestSimAppStreamExportAndImport(t *testing.T) {
+	db := dbm.NewMemDB()
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:  logger,
+		DB:      db,
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+	app.Commit()
+
+	// Making a new app object with the db, so that initchain hasn't been called
+	app2 := NewSimApp(logger, db, nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	exported, err := app2.StreamAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+	require.Nil(t, exported.AppState)
+
+	path := filepath.Join(t.TempDir(), "genesis.jsonl")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	sw, err := module.NewGenesisStreamWriter(file, &tmtypes.GenesisDoc{ChainID: "stream-chain"})
+	require.NoError(t, err)
+	require.NoError(t, exported.StreamAppState(sw.WriteModuleGenesis))
+	require.NoError(t, sw.Flush())
+	require.NoError(t, file.Close())
+
+	// initialize a new chain from the genesis stream
+	app3 := NewSimApp(logger, dbm.NewMemDB(), nil, true, simtestutil.AppOptionsMap{
+		flags.FlagHome:           t.TempDir(),
+		server.FlagGenesisStream: path,
+	}, baseapp.SetChainID("stream-chain"))
+	app3.InitChain(abci.RequestInitChain{
+		ChainId:         "stream-chain",
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+	})
+	app3.Commit()
+
+	expected, err := app2.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+	actual, err := app3.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected.AppState), string(actual.AppState))
+	require.Equal(t, expected.Validators, actual.Validators)
+}
+
 func TestRunMigrations(t *testing.T) {
 	db := dbm.NewMemDB()
 	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
@@ -189,6 +240,8 @@ func TestRunMigrations(t *testing.T) {
 					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
 					"vesting":      vesting.AppModule{}.ConsensusVersion(),
 					"feegrant":     feegrantmodule.AppModule{}.ConsensusVersion(),
+					"feeabs":       feeabs.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
 					"evidence":     evidence.AppModule{}.ConsensusVersion(),
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
@@ -10,6 +10,7 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/spf13/cast"
 
 	"cosmossdk.io/depinject"
 
@@ -27,6 +28,7 @@ import (
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config" // import for side-effects
@@ -46,8 +48,12 @@ import (
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	feeabskeeper "github.com/cosmos/cosmos-sdk/x/feeabs/keeper"
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -105,6 +111,8 @@ var (
 		vesting.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
 		consensus.AppModuleBasic{},
+		feeabs.AppModuleBasic{},
+		feemarket.AppModuleBasic{},
 	)
 )
 
@@ -141,6 +149,8 @@ type SimApp struct {
 	GroupKeeper           groupkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
+	FeeAbsKeeper          feeabskeeper.Keeper
+	FeeMarketKeeper       feemarketkeeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -197,6 +207,14 @@ func NewSimApp(
 				// For providing a custom inflation function for x/mint add here your
 				// custom function that implements the minttypes.InflationCalculationFn
 				// interface.
+
+				//
+				// FEEGRANT
+				//
+
+				// For resolving the target addresses of the messages sponsored by a
+				// feegrant ScopedAllowance.
+				NewAddressExtractors(),
 			),
 		)
 	)
@@ -224,6 +242,8 @@ func NewSimApp(
 		&app.GroupKeeper,
 		&app.NFTKeeper,
 		&app.ConsensusParamsKeeper,
+		&app.FeeAbsKeeper,
+		&app.FeeMarketKeeper,
 	); err != nil {
 		panic(err)
 	}
@@ -256,6 +276,11 @@ func NewSimApp(
 
 	app.App = appBuilder.Build(logger, db, traceStore, baseAppOptions...)
 
+	// initialize the chain from a JSONL genesis stream if provided
+	if genesisStreamFile := cast.ToString(appOpts.Get(server.FlagGenesisStream)); genesisStreamFile != "" {
+		app.SetGenesisStreamFile(genesisStreamFile)
+	}
+
 	// load state streaming if enabled
 	if _, _, err := streaming.LoadStreamingServices(app.App.BaseApp, appOpts, app.appCodec, logger, app.kvStoreKeys()); err != nil {
 		logger.Error("failed to load state streaming", "err", err)
@@ -283,6 +308,25 @@ func NewSimApp(
 
 	app.sm.RegisterStoreDecoders()
 
+	// replace the default ante handler set by the tx module so that fees can be
+	// paid in the denoms of the x/feeabs conversion table and are charged the
+	// x/feemarket base fee
+	anteHandler, err := NewAnteHandler(HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			SignModeHandler: app.txConfig.SignModeHandler(),
+			FeegrantKeeper:  app.FeeGrantKeeper,
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+		FeeAbsKeeper:    app.FeeAbsKeeper,
+		FeeMarketKeeper: app.FeeMarketKeeper,
+	})
+	if err != nil {
+		panic(err)
+	}
+	app.SetAnteHandler(anteHandler)
+
 	// A custom InitChainer can be set if extra pre-init-genesis logic is required.
 	// By default, when using app wiring enabled module, this is not required.
 	// For instance, the upgrade module will set automatically the module version map in its init genesis thanks to app wiring.
@@ -17,16 +17,7 @@ import (
 // ExportAppStateAndValidators exports the state of the application for a genesis
 // file.
 func (app *SimApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string) (servertypes.ExportedApp, error) {
-	// as if they could withdraw from the start of the next block
-	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
-
-	// We export at last height + 1, because that's the height at which
-	// Tendermint will start InitChain.
-	height := app.LastBlockHeight() + 1
-	if forZeroHeight {
-		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
-	}
+	ctx, height := app.exportContext(forZeroHeight, jailAllowedAddrs)
 
 	genState := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
 	appState, err := json.MarshalIndent(genState, "", "  ")
@@ -43,6 +34,40 @@ func (app *SimApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAd
 	}, err
 }
 
+// StreamAppStateAndValidators exports the state of the application for a JSONL
+// genesis stream. The genesis state of each module is only exported when the
+// app state is streamed, instead of building the whole app state in memory.
+func (app *SimApp) StreamAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string) (servertypes.ExportedApp, error) {
+	ctx, height := app.exportContext(forZeroHeight, jailAllowedAddrs)
+
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	return servertypes.ExportedApp{
+		StreamAppState: func(fn func(moduleName string, genesis json.RawMessage) error) error {
+			return app.ModuleManager.ExportGenesisStream(ctx, app.appCodec, modulesToExport, fn)
+		},
+		Validators:      validators,
+		Height:          height,
+		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
+	}, err
+}
+
+// exportContext returns the context and height of an export of the state of the
+// application, prepared for a fresh start at zero height if forZeroHeight.
+func (app *SimApp) exportContext(forZeroHeight bool, jailAllowedAddrs []string) (sdk.Context, int64) {
+	// as if they could withdraw from the start of the next block
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	// We export at last height + 1, because that's the height at which
+	// Tendermint will start InitChain.
+	height := app.LastBlockHeight() + 1
+	if forZeroHeight {
+		height = 0
+		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+	}
+
+	return ctx, height
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
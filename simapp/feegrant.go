@@ -0,0 +1,34 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// NewAddressExtractors returns the address extractors used by the feegrant
+// ScopedAllowance to resolve the target addresses of the SimApp messages.
+// Messages without an extractor are never sponsored by a ScopedAllowance.
+func NewAddressExtractors() feegrant.AddressExtractors {
+	extractors := feegrant.NewAddressExtractors()
+
+	for typeURL, extractor := range map[string]feegrant.AddressExtractor{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}): func(msg sdk.Msg) ([]string, error) {
+			return []string{msg.(*banktypes.MsgSend).ToAddress}, nil
+		},
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): func(msg sdk.Msg) ([]string, error) {
+			outputs := msg.(*banktypes.MsgMultiSend).Outputs
+			addrs := make([]string, 0, len(outputs))
+			for _, output := range outputs {
+				addrs = append(addrs, output.Address)
+			}
+			return addrs, nil
+		},
+	} {
+		if err := extractors.Register(typeURL, extractor); err != nil {
+			panic(err)
+		}
+	}
+
+	return extractors
+}
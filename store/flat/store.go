@@ -0,0 +1,530 @@
+package flat
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	ics23 "github.com/confio/ics23/go"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	_ types.KVStore                 = (*Store)(nil)
+	_ types.CommitStore             = (*Store)(nil)
+	_ types.CommitKVStore           = (*Store)(nil)
+	_ types.Queryable               = (*Store)(nil)
+	_ types.StoreWithInitialVersion = (*Store)(nil)
+)
+
+var (
+	dataPrefix        = []byte{'d'} // key/value pairs of the state
+	treePrefix        = []byte{'t'} // nodes of the merkle tree by hash
+	undoPrefix        = []byte{'u'} // previous values of the database keys written by the last commit
+	latestCommitKey   = []byte("m/latest")
+	previousCommitKey = []byte("m/previous")
+)
+
+// undo log entries of keys which were respectively absent or set before the
+// last commit
+const (
+	undoDelete byte = iota
+	undoSet
+)
+
+// Store is a CommitKVStore keeping the latest version of its state as flat
+// key/value pairs in the database, and committing to it with a merkle tree
+// persisted next to it, see tree. Writes to the database are only done on
+// commit, along with an undo log of the commit so that the store can be rolled
+// back by one version.
+//
+// Unlike IAVL stores, only the latest version is retained: queries at past
+// heights are not supported, and neither are state sync snapshots. The proofs
+// of its queries are ICS23 proofs of the simple merkle tree spec, as for the
+// commit info of the multi store.
+type Store struct {
+	mtx sync.RWMutex
+
+	db             dbm.DB
+	logger         log.Logger
+	state          *committedState
+	cache          *cachekv.Store
+	tree           *tree
+	lastCommitID   types.CommitID
+	prevCommitID   types.CommitID
+	initialVersion int64
+	pruning        pruningtypes.PruningOptions
+}
+
+// LoadStore returns a Store from the given database, at the version of id. The
+// store may be one version ahead of id if a commit was interrupted, in which
+// case it is rolled back to id. It matches the rootmulti.StoreBackend type.
+func LoadStore(db dbm.DB, logger log.Logger, key types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitKVStore, error) {
+	st := &Store{
+		db:             db,
+		logger:         logger,
+		state:          &committedState{Store: dbadapter.Store{DB: dbm.NewPrefixDB(db, dataPrefix)}, db: db},
+		initialVersion: int64(initialVersion),
+		pruning:        pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
+	}
+	st.cache = cachekv.NewStore(st.state)
+
+	var err error
+	if st.lastCommitID, err = loadCommitID(db, latestCommitKey); err != nil {
+		return nil, err
+	}
+	if st.prevCommitID, err = loadCommitID(db, previousCommitKey); err != nil {
+		return nil, err
+	}
+
+	if st.lastCommitID.Version > id.Version && st.prevCommitID.Version == id.Version {
+		logger.Info("rolling back interrupted commit", "key", key.Name(), "version", st.lastCommitID.Version)
+		if err := st.rollback(); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case st.lastCommitID.Version == 0 && id.Version > 0:
+		return nil, fmt.Errorf("store %s has no state at version %d; the backend of a store cannot be changed once committed", key.Name(), id.Version)
+	case st.lastCommitID.Version != id.Version:
+		return nil, fmt.Errorf("version of store %s mismatch; expected %d got %d", key.Name(), id.Version, st.lastCommitID.Version)
+	}
+
+	st.tree = newTree(db, st.lastCommitID.Hash)
+	if id.Version > 0 && !bytes.Equal(st.lastCommitID.Hash, id.Hash) {
+		return nil, fmt.Errorf("state of store %s does not match its commit hash at version %d", key.Name(), id.Version)
+	}
+	if st.tree.root != nil {
+		if err := st.tree.load(st.tree.root); err != nil {
+			return nil, fmt.Errorf("merkle tree of store %s: %w", key.Name(), err)
+		}
+	}
+
+	return st, nil
+}
+
+func loadCommitID(db dbm.DB, key []byte) (types.CommitID, error) {
+	var id types.CommitID
+
+	bz, err := db.Get(key)
+	if err != nil || bz == nil {
+		return id, err
+	}
+
+	err = id.Unmarshal(bz)
+	return id, err
+}
+
+func setCommitID(batch dbm.Batch, key []byte, id types.CommitID) error {
+	bz, err := id.Marshal()
+	if err != nil {
+		return err
+	}
+	return batch.Set(key, bz)
+}
+
+// Commit implements Committer. It writes the changes of the state and of its
+// merkle tree to the database in a single batch, along with their undo log.
+func (st *Store) Commit() types.CommitID {
+	defer telemetry.MeasureSince(time.Now(), "store", "flat", "commit")
+
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
+	version := st.lastCommitID.Version + 1
+	if st.lastCommitID.Version == 0 && st.initialVersion > 1 {
+		version = st.initialVersion
+	}
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	// replace the undo log of the previous commit
+	iter, err := dbm.IteratePrefix(st.db, undoPrefix)
+	if err != nil {
+		panic(err)
+	}
+	for ; iter.Valid(); iter.Next() {
+		if err := batch.Delete(iter.Key()); err != nil {
+			panic(err)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		panic(err)
+	}
+
+	st.state.batch, st.state.tree = batch, st.tree
+	st.cache.Write()
+	hash, err := st.tree.save(st.state.write)
+	st.state.batch, st.state.tree = nil, nil
+	if err != nil {
+		panic(err)
+	}
+
+	commitID := types.CommitID{Version: version, Hash: hash}
+	if err := setCommitID(batch, latestCommitKey, commitID); err != nil {
+		panic(err)
+	}
+	if err := setCommitID(batch, previousCommitKey, st.lastCommitID); err != nil {
+		panic(err)
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		panic(err)
+	}
+
+	st.prevCommitID, st.lastCommitID = st.lastCommitID, commitID
+	return commitID
+}
+
+// LastCommitID implements Committer.
+func (st *Store) LastCommitID() types.CommitID {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	return st.lastCommitID
+}
+
+// SetPruning implements Committer. The store only retains its latest version,
+// regardless of the pruning options.
+func (st *Store) SetPruning(opts pruningtypes.PruningOptions) {
+	st.pruning = opts
+}
+
+// GetPruning implements Committer.
+func (st *Store) GetPruning() pruningtypes.PruningOptions {
+	return st.pruning
+}
+
+// SetInitialVersion sets the version of the first commit of the store.
+func (st *Store) SetInitialVersion(version int64) {
+	st.initialVersion = version
+}
+
+// LoadVersionForOverwriting rolls the store back to targetVersion, discarding
+// the uncommitted changes. Only the previous version can be rolled back to. It
+// returns the version of the store.
+func (st *Store) LoadVersionForOverwriting(targetVersion int64) (int64, error) {
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
+	switch targetVersion {
+	case st.lastCommitID.Version:
+	case st.prevCommitID.Version:
+		if err := st.rollback(); err != nil {
+			return st.lastCommitID.Version, err
+		}
+	default:
+		return st.lastCommitID.Version, fmt.Errorf("cannot roll back to version %d, only the previous version %d is retained", targetVersion, st.prevCommitID.Version)
+	}
+
+	st.cache = cachekv.NewStore(st.state)
+	st.tree = newTree(st.db, st.lastCommitID.Hash)
+	return st.lastCommitID.Version, nil
+}
+
+// rollback reverts the last commit with its undo log.
+func (st *Store) rollback() error {
+	if ok, err := st.db.Has(previousCommitKey); err != nil || !ok {
+		return fmt.Errorf("no undo log to roll back version %d: %v", st.lastCommitID.Version, err)
+	}
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	iter, err := dbm.IteratePrefix(st.db, undoPrefix)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, entry := iter.Key()[len(undoPrefix):], iter.Value()
+		if entry[0] == undoSet {
+			err = batch.Set(key, entry[1:])
+		} else {
+			err = batch.Delete(key)
+		}
+		if err != nil {
+			return err
+		}
+		if err := batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+
+	if err := setCommitID(batch, latestCommitKey, st.prevCommitID); err != nil {
+		return err
+	}
+	if err := batch.Delete(previousCommitKey); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	st.lastCommitID, st.prevCommitID = st.prevCommitID, types.CommitID{}
+	return nil
+}
+
+// GetStoreType implements Store.
+func (st *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeFlat
+}
+
+// CacheWrap implements Store.
+func (st *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
+
+// Get implements types.KVStore.
+func (st *Store) Get(key []byte) []byte {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	return st.cache.Get(key)
+}
+
+// Has implements types.KVStore.
+func (st *Store) Has(key []byte) bool {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	return st.cache.Has(key)
+}
+
+// Set implements types.KVStore.
+func (st *Store) Set(key, value []byte) {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	st.cache.Set(key, value)
+}
+
+// Delete implements types.KVStore.
+func (st *Store) Delete(key []byte) {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	st.cache.Delete(key)
+}
+
+// Iterator implements types.KVStore.
+func (st *Store) Iterator(start, end []byte) types.Iterator {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	return st.cache.Iterator(start, end)
+}
+
+// ReverseIterator implements types.KVStore.
+func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	return st.cache.ReverseIterator(start, end)
+}
+
+// Query implements ABCI interface, allows queries of the committed state at the
+// latest version, which is queried if the height is 0.
+func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	defer telemetry.MeasureSince(time.Now(), "store", "flat", "query")
+
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"), false)
+	}
+
+	st.mtx.RLock()
+	defer st.mtx.RUnlock()
+
+	res.Height = req.Height
+	if res.Height == 0 {
+		res.Height = st.lastCommitID.Version
+	}
+	if res.Height != st.lastCommitID.Version {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "store only retains its latest version %d, got %d", st.lastCommitID.Version, res.Height), false)
+	}
+
+	switch req.Path {
+	case "/key": // get by key
+		key := req.Data // data holds the key bytes
+
+		res.Key = key
+		res.Value = st.state.Get(key)
+
+		if !req.Prove {
+			break
+		}
+
+		proof, err := st.commitmentProof(key, res.Value)
+		if err != nil {
+			return sdkerrors.QueryResult(err, false)
+		}
+		if proof != nil {
+			res.ProofOps = &tmcrypto.ProofOps{
+				Ops: []tmcrypto.ProofOp{types.NewSimpleMerkleCommitmentOp(key, proof).ProofOp()},
+			}
+		}
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		subspace := req.Data
+		res.Key = subspace
+
+		iterator := types.KVStorePrefixIterator(st.state, subspace)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+
+		_ = iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path), false)
+	}
+
+	return res
+}
+
+// commitmentProof returns the ICS23 proof of the existence of key with value in
+// the committed state, or of its absence if value is nil. It returns nil if the
+// state is empty, as the absence of a key cannot be proven then.
+func (st *Store) commitmentProof(key, value []byte) (*ics23.CommitmentProof, error) {
+	if st.tree.root == nil {
+		return nil, nil
+	}
+
+	if value != nil {
+		exist, err := st.existenceProof(key, value)
+		if err != nil {
+			return nil, err
+		}
+		return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: exist}}, nil
+	}
+
+	// prove the existence of the neighbors of the key
+	nonexist := &ics23.NonExistenceProof{Key: key}
+	var err error
+	if nonexist.Left, err = st.neighborProof(st.state.ReverseIterator(nil, key)); err != nil {
+		return nil, err
+	}
+	if nonexist.Right, err = st.neighborProof(st.state.Iterator(key, nil)); err != nil {
+		return nil, err
+	}
+
+	return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonexist}}, nil
+}
+
+// neighborProof returns the ICS23 existence proof of the first key/value pair
+// of iter, if any, and closes it.
+func (st *Store) neighborProof(iter types.Iterator) (*ics23.ExistenceProof, error) {
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, iter.Error()
+	}
+	return st.existenceProof(iter.Key(), iter.Value())
+}
+
+// existenceProof returns the ICS23 existence proof of key with value in the
+// committed state.
+func (st *Store) existenceProof(key, value []byte) (*ics23.ExistenceProof, error) {
+	proof, err := st.tree.existenceProof(st.lastCommitID.Hash, key, value)
+	if err == nil && proof == nil {
+		err = fmt.Errorf("key %X of the state is missing from the merkle tree", key)
+	}
+	return proof, err
+}
+
+// committedState is the committed state of the store in the database. Its
+// writes, only done when the cache of the store is written on commit, go to the
+// batch of the commit along with their undo log, and update the merkle tree.
+type committedState struct {
+	dbadapter.Store
+
+	db    dbm.DB
+	batch dbm.Batch
+	tree  *tree
+}
+
+// Set implements types.KVStore.
+func (s *committedState) Set(key, value []byte) {
+	if err := s.write(prefixed(dataPrefix, key), value); err != nil {
+		panic(err)
+	}
+	if err := s.tree.set(key, value); err != nil {
+		panic(err)
+	}
+}
+
+// Delete implements types.KVStore.
+func (s *committedState) Delete(key []byte) {
+	if err := s.write(prefixed(dataPrefix, key), nil); err != nil {
+		panic(err)
+	}
+	if err := s.tree.remove(key); err != nil {
+		panic(err)
+	}
+}
+
+// write writes value to the database key, deleting it if value is nil, along
+// with the undo log entry of its previous value.
+func (s *committedState) write(key, value []byte) error {
+	if s.batch == nil {
+		panic("flat store state written outside of a commit")
+	}
+
+	prev, err := s.db.Get(key)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(prev, value) && (prev == nil) == (value == nil) {
+		return nil
+	}
+
+	undo := []byte{undoDelete}
+	if prev != nil {
+		undo = append([]byte{undoSet}, prev...)
+	}
+	if err := s.batch.Set(prefixed(undoPrefix, key), undo); err != nil {
+		return err
+	}
+
+	if value == nil {
+		return s.batch.Delete(key)
+	}
+	return s.batch.Set(key, value)
+}
+
+func prefixed(prefix, key []byte) []byte {
+	return append(append(make([]byte, 0, len(prefix)+len(key)), prefix...), key...)
+}
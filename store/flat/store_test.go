@@ -0,0 +1,185 @@
+package flat
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var testKey = types.NewKVStoreKey("test")
+
+func newStore(t *testing.T, db dbm.DB, id types.CommitID) *Store {
+	store, err := LoadStore(db, log.NewNopLogger(), testKey, id, 0)
+	require.NoError(t, err)
+	return store.(*Store)
+}
+
+// stateHash returns the commit hash of state, written to a new store in reverse
+// order of keys.
+func stateHash(t *testing.T, state map[string][]byte) []byte {
+	keys := make([]string, 0, len(state))
+	for key := range state {
+		keys = append(keys, key)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+
+	store := newStore(t, dbm.NewMemDB(), types.CommitID{})
+	for _, key := range keys {
+		store.Set([]byte(key), state[key])
+		store.Commit()
+	}
+	return store.LastCommitID().Hash
+}
+
+func TestStore_CommitAndLoad(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db, types.CommitID{})
+
+	state := map[string][]byte{}
+	for i := 0; i < 20; i++ {
+		key, value := fmt.Sprintf("key%02d", i), []byte(fmt.Sprintf("value%d", i))
+		store.Set([]byte(key), value)
+		state[key] = value
+	}
+	require.Equal(t, []byte("value1"), store.Get([]byte("key01")))
+
+	// writes are only persisted on commit
+	bz, err := db.Get(prefixed(dataPrefix, []byte("key01")))
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	cid1 := store.Commit()
+	require.Equal(t, int64(1), cid1.Version)
+	require.Equal(t, stateHash(t, state), cid1.Hash)
+
+	store.Delete([]byte("key01"))
+	store.Set([]byte("key02"), []byte("updated"))
+	store.Set([]byte("key20"), []byte("added"))
+	delete(state, "key01")
+	state["key02"], state["key20"] = []byte("updated"), []byte("added")
+
+	cid2 := store.Commit()
+	require.Equal(t, int64(2), cid2.Version)
+	require.Equal(t, stateHash(t, state), cid2.Hash)
+
+	iter := store.Iterator([]byte("key00"), []byte("key03"))
+	var keys []string
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"key00", "key02"}, keys)
+
+	// reload the store
+	store = newStore(t, db, cid2)
+	require.Equal(t, cid2, store.LastCommitID())
+	require.Nil(t, store.Get([]byte("key01")))
+	require.Equal(t, []byte("updated"), store.Get([]byte("key02")))
+
+	// the state of another version cannot be loaded
+	_, err = LoadStore(db, log.NewNopLogger(), testKey, types.CommitID{Version: 3}, 0)
+	require.Error(t, err)
+	_, err = LoadStore(db, log.NewNopLogger(), testKey, types.CommitID{Version: 2, Hash: cid1.Hash}, 0)
+	require.Error(t, err)
+	_, err = LoadStore(dbm.NewMemDB(), log.NewNopLogger(), testKey, cid2, 0)
+	require.Error(t, err)
+}
+
+func TestStore_Rollback(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db, types.CommitID{})
+
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("1"))
+	cid1 := store.Commit()
+
+	store.Set([]byte("a"), []byte("2"))
+	store.Delete([]byte("b"))
+	store.Set([]byte("c"), []byte("2"))
+	cid2 := store.Commit()
+
+	// loading the previous version rolls back an interrupted commit
+	store = newStore(t, db, cid1)
+	require.Equal(t, cid1, store.LastCommitID())
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Equal(t, []byte("1"), store.Get([]byte("b")))
+	require.Nil(t, store.Get([]byte("c")))
+
+	// only a single version can be rolled back
+	_, err := LoadStore(db, log.NewNopLogger(), testKey, types.CommitID{}, 0)
+	require.Error(t, err)
+
+	store.Set([]byte("a"), []byte("2"))
+	store.Delete([]byte("b"))
+	store.Set([]byte("c"), []byte("2"))
+	require.Equal(t, cid2, store.Commit())
+
+	version, err := store.LoadVersionForOverwriting(cid1.Version)
+	require.NoError(t, err)
+	require.Equal(t, cid1.Version, version)
+	require.Equal(t, cid1, store.LastCommitID())
+	require.Equal(t, []byte("1"), store.Get([]byte("b")))
+
+	_, err = store.LoadVersionForOverwriting(0)
+	require.Error(t, err)
+}
+
+func TestStore_InitialVersion(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), types.CommitID{})
+	store.SetInitialVersion(5)
+
+	store.Set([]byte("a"), []byte("1"))
+	require.Equal(t, int64(5), store.Commit().Version)
+	require.Equal(t, int64(6), store.Commit().Version)
+}
+
+func TestStore_Query(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), types.CommitID{})
+
+	// the absence of a key cannot be proven in an empty state
+	res := store.Query(abci.RequestQuery{Path: "/key", Data: []byte("a"), Prove: true})
+	require.True(t, res.IsOK(), res.Log)
+	require.Nil(t, res.ProofOps)
+
+	for _, key := range []string{"b", "d", "f", "h", "j"} {
+		store.Set([]byte(key), []byte("value-"+key))
+	}
+	cid := store.Commit()
+
+	// uncommitted writes are not queried
+	store.Set([]byte("c"), []byte("uncommitted"))
+
+	for _, key := range []string{"a", "b", "c", "f", "j", "k"} {
+		res := store.Query(abci.RequestQuery{Path: "/key", Data: []byte(key), Prove: true})
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, cid.Version, res.Height)
+		require.Len(t, res.ProofOps.Ops, 1)
+
+		op, err := types.CommitmentOpDecoder(res.ProofOps.Ops[0])
+		require.NoError(t, err)
+
+		if res.Value == nil {
+			root, err := op.Run(nil)
+			require.NoError(t, err, key)
+			require.Equal(t, [][]byte{cid.Hash}, root)
+		} else {
+			require.Equal(t, []byte("value-"+key), res.Value)
+			root, err := op.Run([][]byte{res.Value})
+			require.NoError(t, err, key)
+			require.Equal(t, [][]byte{cid.Hash}, root)
+
+			_, err = op.Run([][]byte{[]byte("other")})
+			require.Error(t, err)
+		}
+	}
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("b"), Height: cid.Version + 1})
+	require.False(t, res.IsOK())
+}
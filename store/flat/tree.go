@@ -0,0 +1,384 @@
+package flat
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+
+	dbm "github.com/cometbft/cometbft-db"
+	ics23 "github.com/confio/ics23/go"
+
+	sdkmaps "github.com/cosmos/cosmos-sdk/store/internal/maps"
+)
+
+// node record types
+const (
+	leafNode byte = iota
+	innerNode
+)
+
+// tree is a merkle tree committing to the key/value pairs of a store, persisted
+// in the database. It is a crit-bit tree: each inner node branches on the first
+// bit where the keys of its subtree differ, the keys of its left subtree having
+// a 0 bit there. Its shape only depends on the keys of the store, not on the
+// order of their writes, and its leaves are sorted by key.
+//
+// The leaves and inner nodes are hashed as the ones of the simple merkle tree of
+// the multi store commit info, so that its proofs are ICS23 proofs of the same
+// spec. The nodes are stored by hash, and loaded on demand: only the nodes
+// changed since the last save are held in memory.
+type tree struct {
+	db     dbm.DB
+	root   *node             // nil if the tree is empty
+	writes map[string][]byte // node records written since the last save, nil if deleted
+}
+
+// node is an inner node or a leaf of the tree.
+type node struct {
+	hash     []byte   // nil if the node changed since it was saved
+	leaf     bool     // whether the node is a leaf
+	key      []byte   // key of a leaf
+	bit      int      // crit bit of an inner node
+	children [2]*node // children of an inner node, by crit bit
+	loaded   bool     // false if only the hash of the node is known
+	saved    bool     // whether the node is stored in the database
+}
+
+// newTree returns the tree stored in db of root hash, which is the hash of the
+// empty tree if it has no leaf.
+func newTree(db dbm.DB, rootHash []byte) *tree {
+	t := &tree{db: db, writes: make(map[string][]byte)}
+	if len(rootHash) > 0 && !bytes.Equal(rootHash, emptyHash()) {
+		t.root = &node{hash: rootHash, saved: true}
+	}
+	return t
+}
+
+// set sets the leaf of key to value.
+func (t *tree) set(key, value []byte) error {
+	leaf := &node{hash: leafHash(key, value), leaf: true, key: key, loaded: true}
+	if t.root == nil {
+		t.root = leaf
+		return nil
+	}
+
+	// the leaf branches off at the first bit where key differs from the key of
+	// the closest leaf, unless it replaces it
+	closest := t.root
+	for {
+		if err := t.load(closest); err != nil {
+			return err
+		}
+		if closest.leaf {
+			break
+		}
+		closest = closest.children[keyBit(key, closest.bit)]
+	}
+
+	bit := math.MaxInt
+	if !bytes.Equal(closest.key, key) {
+		bit = critBit(key, closest.key)
+	}
+
+	root, err := t.insert(t.root, leaf, bit)
+	if err != nil {
+		return err
+	}
+	t.root = root
+	return nil
+}
+
+// insert inserts leaf in the subtree of n, branching off at bit, and returns the
+// new root of the subtree.
+func (t *tree) insert(n, leaf *node, bit int) (*node, error) {
+	if err := t.load(n); err != nil {
+		return nil, err
+	}
+
+	if n.leaf && bytes.Equal(n.key, leaf.key) {
+		t.orphan(n)
+		return leaf, nil
+	}
+
+	if n.leaf || n.bit > bit {
+		inner := &node{bit: bit, loaded: true}
+		side := keyBit(leaf.key, bit)
+		inner.children[side], inner.children[1-side] = leaf, n
+		return inner, nil
+	}
+
+	side := keyBit(leaf.key, n.bit)
+	child, err := t.insert(n.children[side], leaf, bit)
+	if err != nil {
+		return nil, err
+	}
+
+	t.orphan(n)
+	n.children[side] = child
+	return n, nil
+}
+
+// remove removes the leaf of key, if any.
+func (t *tree) remove(key []byte) error {
+	if t.root == nil {
+		return nil
+	}
+
+	root, _, err := t.delete(t.root, key)
+	if err != nil {
+		return err
+	}
+	t.root = root
+	return nil
+}
+
+// delete deletes the leaf of key from the subtree of n, and returns the new root
+// of the subtree and whether the leaf was found.
+func (t *tree) delete(n *node, key []byte) (*node, bool, error) {
+	if err := t.load(n); err != nil {
+		return nil, false, err
+	}
+
+	if n.leaf {
+		if !bytes.Equal(n.key, key) {
+			return n, false, nil
+		}
+		t.orphan(n)
+		return nil, true, nil
+	}
+
+	side := keyBit(key, n.bit)
+	child, found, err := t.delete(n.children[side], key)
+	if err != nil || !found {
+		return n, found, err
+	}
+
+	t.orphan(n)
+	if child == nil {
+		// the sibling of the deleted leaf replaces their parent
+		return n.children[1-side], true, nil
+	}
+	n.children[side] = child
+	return n, true, nil
+}
+
+// orphan marks n as changed, deleting its record if it was saved.
+func (t *tree) orphan(n *node) {
+	if n.saved {
+		t.writes[string(nodeKey(n.hash))] = nil
+	}
+	n.saved = false
+	if !n.leaf {
+		n.hash = nil
+	}
+}
+
+// load loads n from the database if only its hash is known.
+func (t *tree) load(n *node) error {
+	if n.loaded {
+		return nil
+	}
+
+	loaded, err := t.readNode(n.hash)
+	if err != nil {
+		return err
+	}
+	*n = *loaded
+	return nil
+}
+
+// readNode reads the saved node of hash from the database. Its children are
+// not loaded.
+func (t *tree) readNode(hash []byte) (*node, error) {
+	bz, err := t.db.Get(nodeKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("missing tree node %X", hash)
+	}
+
+	n := &node{hash: hash, loaded: true, saved: true}
+	switch bz[0] {
+	case leafNode:
+		n.leaf, n.key = true, bz[1:]
+
+	case innerNode:
+		bit, l := binary.Uvarint(bz[1:])
+		if l <= 0 || len(bz) != 1+l+2*sha256.Size {
+			return nil, fmt.Errorf("invalid tree node %X", hash)
+		}
+		n.bit = int(bit)
+		for i := range n.children {
+			start := 1 + l + i*sha256.Size
+			n.children[i] = &node{hash: bz[start : start+sha256.Size], saved: true}
+		}
+
+	default:
+		return nil, fmt.Errorf("invalid tree node %X", hash)
+	}
+
+	return n, nil
+}
+
+// save hashes the nodes changed since the last save and writes their records,
+// along with the deletions of the records of the nodes they replaced, with
+// write. The nodes are then unloaded, and the root hash of the tree returned.
+func (t *tree) save(write func(key, value []byte) error) ([]byte, error) {
+	hash := emptyHash()
+	if t.root != nil {
+		hash = t.saveNode(t.root)
+	}
+
+	keys := make([]string, 0, len(t.writes))
+	for key := range t.writes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := write([]byte(key), t.writes[key]); err != nil {
+			return nil, err
+		}
+	}
+
+	t.writes = make(map[string][]byte)
+	if t.root != nil {
+		t.root = &node{hash: hash, saved: true}
+	}
+	return hash, nil
+}
+
+func (t *tree) saveNode(n *node) []byte {
+	if n.saved {
+		return n.hash
+	}
+
+	var record []byte
+	if n.leaf {
+		record = append([]byte{leafNode}, n.key...)
+	} else {
+		left, right := t.saveNode(n.children[0]), t.saveNode(n.children[1])
+		n.hash = innerHash(left, right)
+
+		record = binary.AppendUvarint([]byte{innerNode}, uint64(n.bit))
+		record = append(append(record, left...), right...)
+	}
+
+	t.writes[string(nodeKey(n.hash))] = record
+	n.saved = true
+	return n.hash
+}
+
+// existenceProof returns the ICS23 proof of the existence of key with value in
+// the saved tree of root hash, or nil if key has no leaf.
+func (t *tree) existenceProof(rootHash, key, value []byte) (*ics23.ExistenceProof, error) {
+	var path []*ics23.InnerOp
+
+	hash := rootHash
+	for {
+		n, err := t.readNode(hash)
+		if err != nil {
+			return nil, err
+		}
+		if n.leaf {
+			if !bytes.Equal(n.key, key) {
+				return nil, nil
+			}
+			break
+		}
+
+		// combine with: 0x01 || lefthash || righthash
+		side := keyBit(key, n.bit)
+		inner := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if side == 0 {
+			inner.Prefix = []byte{1}
+			inner.Suffix = n.children[1].hash
+		} else {
+			inner.Prefix = append([]byte{1}, n.children[0].hash...)
+		}
+		path = append(path, inner)
+		hash = n.children[side].hash
+	}
+
+	// the inner ops are ordered from the leaf to the root
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   key,
+		Value: value,
+		Leaf: &ics23.LeafOp{
+			Hash:         ics23.HashOp_SHA256,
+			PrehashKey:   ics23.HashOp_NO_HASH,
+			PrehashValue: ics23.HashOp_SHA256,
+			Length:       ics23.LengthOp_VAR_PROTO,
+			Prefix:       []byte{leafNode},
+		},
+		Path: path,
+	}, nil
+}
+
+// keyBit returns the bit of key at index i. Each byte of a key is preceded by a
+// 1 bit, and the key is followed by 0 bits, so that the keys are ordered by bits
+// as they are by bytes, a key being lower than the keys it prefixes.
+func keyBit(key []byte, i int) int {
+	pos, off := i/9, i%9
+	switch {
+	case pos >= len(key):
+		return 0
+	case off == 0:
+		return 1
+	default:
+		return int(key[pos]>>(8-off)) & 1
+	}
+}
+
+// critBit returns the index of the first bit where the distinct keys a and b
+// differ.
+func critBit(a, b []byte) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return 9*i + 1 + bits.LeadingZeros8(a[i]^b[i])
+		}
+	}
+
+	if len(a) < len(b) {
+		return 9 * len(a)
+	}
+	return 9 * len(b)
+}
+
+func nodeKey(hash []byte) []byte {
+	return prefixed(treePrefix, hash)
+}
+
+// leafHash returns the hash of the leaf of a key/value pair, as hashed by the
+// simple merkle tree of the multi store commit info.
+func leafHash(key, value []byte) []byte {
+	valueHash := sha256.Sum256(value)
+	bz := sdkmaps.NewKVPair(key, valueHash[:]).Bytes()
+
+	h := sha256.New()
+	h.Write([]byte{leafNode})
+	h.Write(bz)
+	return h.Sum(nil)
+}
+
+func innerHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{innerNode})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+func emptyHash() []byte {
+	h := sha256.Sum256(nil)
+	return h[:]
+}
@@ -0,0 +1,100 @@
+package flat
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestCritBit(t *testing.T) {
+	keys := [][]byte{{}, {0}, {0, 0}, {0, 1}, {1}, {0x7f}, {0x80}, {0x80, 0}, {0xff}, {0xff, 0xff}}
+	for _, a := range keys {
+		for _, b := range keys {
+			if bytes.Equal(a, b) {
+				continue
+			}
+
+			// the keys share the bits before their crit bit, and are ordered by it
+			bit := critBit(a, b)
+			require.Equal(t, bit, critBit(b, a))
+			for i := 0; i < bit; i++ {
+				require.Equal(t, keyBit(a, i), keyBit(b, i), "%X %X bit %d", a, b, i)
+			}
+			require.Equal(t, bytes.Compare(a, b) < 0, keyBit(a, bit) < keyBit(b, bit), "%X %X", a, b)
+		}
+	}
+}
+
+func TestTree_RandomWrites(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	db := dbm.NewMemDB()
+	store := newStore(t, db, types.CommitID{})
+
+	state := map[string][]byte{}
+	for version := 1; version <= 50; version++ {
+		prevState := make(map[string][]byte, len(state))
+		for key, value := range state {
+			prevState[key] = value
+		}
+
+		for i := 0; i < 20; i++ {
+			key := fmt.Sprintf("%X", r.Intn(64))
+			if r.Intn(3) == 0 {
+				store.Delete([]byte(key))
+				delete(state, key)
+			} else {
+				value := []byte(fmt.Sprintf("%d", r.Intn(4)))
+				store.Set([]byte(key), value)
+				state[key] = value
+			}
+		}
+		cid := store.Commit()
+
+		// the tree only depends on the state, and keeps no replaced node
+		require.Equal(t, stateHash(t, state), cid.Hash, version)
+		require.Equal(t, treeNodes(len(state)), countNodes(t, db), version)
+
+		if version%10 == 0 {
+			_, err := store.LoadVersionForOverwriting(cid.Version - 1)
+			require.NoError(t, err)
+			require.Equal(t, stateHash(t, prevState), store.LastCommitID().Hash, version)
+			require.Equal(t, treeNodes(len(prevState)), countNodes(t, db), version)
+
+			state = prevState
+			store = newStore(t, db, store.LastCommitID())
+		}
+	}
+
+	// all the nodes are removed with the state
+	for key := range state {
+		store.Delete([]byte(key))
+	}
+	require.Equal(t, emptyHash(), store.Commit().Hash)
+	require.Equal(t, 0, countNodes(t, db))
+}
+
+// treeNodes returns the number of nodes of a tree of n leaves.
+func treeNodes(n int) int {
+	if n == 0 {
+		return 0
+	}
+	return 2*n - 1
+}
+
+func countNodes(t *testing.T, db dbm.DB) int {
+	iter, err := dbm.IteratePrefix(db, treePrefix)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
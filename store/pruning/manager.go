@@ -177,6 +177,11 @@ func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// GetSnapshotInterval returns the interval at which the snapshots are taken.
+func (m *Manager) GetSnapshotInterval() uint64 {
+	return m.snapshotInterval
+}
+
 // ShouldPruneAtHeight return true if the given height should be pruned, false otherwise
 func (m *Manager) ShouldPruneAtHeight(height int64) bool {
 	return m.opts.Interval > 0 && m.opts.GetPruningStrategy() != types.PruningNothing && height%int64(m.opts.Interval) == 0
@@ -0,0 +1,54 @@
+package rootmulti
+
+import (
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// StoreBackend loads the CommitKVStore of a store mounted with StoreTypeIAVL in
+// place of an IAVL store, from the database of the store at the version of id.
+// A non-zero initialVersion is the version of the first commit of a store added
+// by a store upgrade.
+//
+// The stores of a store backend only serve their latest version: the versions
+// of CacheMultiStoreWithVersion they do not retain are handled as pruned. To
+// support queries with proofs, they must implement Queryable and return ICS23
+// proofs decoded by DefaultProofRuntime. Their state is not part of the state
+// sync snapshots.
+type StoreBackend func(db dbm.DB, logger log.Logger, key types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitKVStore, error)
+
+// SetStoreBackends sets the backends of the IAVL stores with the given key
+// names, loading their CommitKVStore in place of an IAVL store. As the state of
+// a store is only readable by the backend which committed it, the backend of a
+// store cannot be changed once the store is committed.
+func (rs *Store) SetStoreBackends(backends map[string]StoreBackend) {
+	rs.storeBackends = backends
+}
+
+// validateStoreBackends checks that the store backends apply to mounted IAVL
+// stores.
+func (rs *Store) validateStoreBackends() error {
+	for name, backend := range rs.storeBackends {
+		key, ok := rs.keysByName[name]
+		if !ok {
+			return fmt.Errorf("store backend for unknown store %s", name)
+		}
+		if typ := rs.storesParams[key].typ; typ != types.StoreTypeIAVL {
+			return fmt.Errorf("store backend for store %s of type %s, expected %s", name, typ, types.StoreTypeIAVL)
+		}
+		if backend == nil {
+			return fmt.Errorf("nil store backend for store %s", name)
+		}
+	}
+	return nil
+}
+
+// versionOverwriter is implemented by the stores of store backends supporting
+// RollbackToVersion.
+type versionOverwriter interface {
+	LoadVersionForOverwriting(targetVersion int64) (int64, error)
+}
@@ -0,0 +1,85 @@
+package rootmulti
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/flat"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+)
+
+func newMultiStoreWithBackends(db dbm.DB) *Store {
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStoreBackends(map[string]StoreBackend{
+		testStoreKey2.Name(): flat.LoadStore,
+	})
+	return ms
+}
+
+func TestMultiStore_StoreBackends(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithBackends(db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	require.IsType(t, &iavl.Store{}, ms.GetCommitKVStore(testStoreKey1))
+	require.IsType(t, &flat.Store{}, ms.GetCommitKVStore(testStoreKey2))
+
+	for i := byte(1); i <= 3; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte{i})
+		ms.GetKVStore(testStoreKey2).Set([]byte("key"), []byte{i})
+		ms.GetKVStore(testStoreKey2).Set([]byte{'k', i}, []byte{i})
+		ms.Commit()
+	}
+	cid := ms.LastCommitID()
+
+	// the flat store only serves its latest version
+	cms, err := ms.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	require.Equal(t, []byte{3}, cms.GetKVStore(testStoreKey2).Get([]byte("key")))
+
+	cms, err = ms.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, cms.GetKVStore(testStoreKey1).Get([]byte("key")))
+	require.Panics(t, func() { cms.GetKVStore(testStoreKey2).Get([]byte("key")) })
+
+	// the proofs of the flat store are verified by the default proof runtime
+	prt := DefaultProofRuntime()
+	res := ms.Query(abci.RequestQuery{Path: "/store2/key", Data: []byte("key"), Prove: true})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte{3}, res.Value)
+	require.NoError(t, prt.VerifyValue(res.ProofOps, cid.Hash, "/store2/key", []byte{3}))
+	require.Error(t, prt.VerifyValue(res.ProofOps, cid.Hash, "/store2/key", []byte{2}))
+
+	res = ms.Query(abci.RequestQuery{Path: "/store2/key", Data: []byte("k0"), Prove: true})
+	require.True(t, res.IsOK(), res.Log)
+	require.Nil(t, res.Value)
+	require.NoError(t, prt.VerifyAbsence(res.ProofOps, cid.Hash, "/store2/k0"))
+
+	res = ms.Query(abci.RequestQuery{Path: "/store2/key", Data: []byte("key"), Height: 2})
+	require.False(t, res.IsOK())
+
+	// reload the multi store
+	ms = newMultiStoreWithBackends(db)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, cid, ms.LastCommitID())
+	require.Equal(t, []byte{3}, ms.GetKVStore(testStoreKey2).Get([]byte("key")))
+
+	// roll back the last version
+	require.NoError(t, ms.RollbackToVersion(2))
+	require.Equal(t, int64(2), ms.LastCommitID().Version)
+	require.Equal(t, []byte{2}, ms.GetKVStore(testStoreKey1).Get([]byte("key")))
+	require.Equal(t, []byte{2}, ms.GetKVStore(testStoreKey2).Get([]byte("key")))
+	require.Nil(t, ms.GetKVStore(testStoreKey2).Get([]byte{'k', 3}))
+
+	// the backend of a committed store cannot be changed
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.Error(t, ms.LoadLatestVersion())
+
+	ms = newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStoreBackends(map[string]StoreBackend{"unknown": flat.LoadStore})
+	require.Error(t, ms.LoadLatestVersion())
+}
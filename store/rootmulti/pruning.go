@@ -0,0 +1,166 @@
+package rootmulti
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	iavltree "github.com/cosmos/iavl"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// SetPruningOverrides sets the pruning options of the IAVL stores with the given
+// key names, overriding the pruning options of the multi store for them.
+//
+// A store with a pruning override is pruned every Interval heights and whenever
+// the multi store is pruned, keeping its KeepRecent latest versions. Heights at
+// which state sync snapshots are taken are kept until their snapshot completes.
+// As the stores of a multi store may then retain different versions, the stores
+// pruned at the version of CacheMultiStoreWithVersion are replaced by stores
+// failing all accesses, so that the stores still retaining it can be queried.
+func (rs *Store) SetPruningOverrides(overrides map[string]pruningtypes.PruningOptions) {
+	rs.pruningOverrides = overrides
+}
+
+// GetPruningOverrides returns the pruning options of the stores overriding the
+// pruning options of the multi store, by store key name.
+func (rs *Store) GetPruningOverrides() map[string]pruningtypes.PruningOptions {
+	return rs.pruningOverrides
+}
+
+// validatePruningOverrides checks that the pruning overrides apply to mounted
+// IAVL stores.
+func (rs *Store) validatePruningOverrides() error {
+	for name := range rs.pruningOverrides {
+		key, ok := rs.keysByName[name]
+		if !ok {
+			return fmt.Errorf("pruning override for unknown store %s", name)
+		}
+		if typ := rs.storesParams[key].typ; typ != types.StoreTypeIAVL {
+			return fmt.Errorf("pruning override for store %s of type %s, expected %s", name, typ, types.StoreTypeIAVL)
+		}
+	}
+	return nil
+}
+
+// pruneOverriddenStores prunes the stores with a pruning override whose pruning
+// interval is reached at version.
+func (rs *Store) pruneOverriddenStores(version int64) error {
+	for name, opts := range rs.pruningOverrides {
+		if opts.Interval == 0 || version%int64(opts.Interval) != 0 {
+			continue
+		}
+		if err := rs.pruneOverriddenStore(rs.keysByName[name], opts, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneOverriddenStore deletes the versions of a store with a pruning override
+// older than its KeepRecent versions before latestVersion, except the snapshot
+// heights whose snapshot is not completed yet.
+func (rs *Store) pruneOverriddenStore(key types.StoreKey, opts pruningtypes.PruningOptions, latestVersion int64) error {
+	if opts.GetPruningStrategy() == pruningtypes.PruningNothing {
+		return nil
+	}
+
+	store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+	if !ok {
+		return nil
+	}
+
+	snapshotInterval := int64(rs.pruningManager.GetSnapshotInterval())
+	lastSnapshotHeight := atomic.LoadInt64(&rs.lastSnapshotHeight)
+
+	var pruningHeights []int64
+	for _, v := range store.GetAllVersions() {
+		version := int64(v)
+		if version >= latestVersion-int64(opts.KeepRecent) {
+			continue
+		}
+		if snapshotInterval > 0 && version%snapshotInterval == 0 && version > lastSnapshotHeight {
+			continue
+		}
+		pruningHeights = append(pruningHeights, version)
+	}
+
+	if len(pruningHeights) == 0 {
+		return nil
+	}
+
+	rs.logger.Debug("pruning store with pruning override", "key", key.Name(), "heights", pruningHeights)
+
+	err := store.DeleteVersions(pruningHeights...)
+	if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+		return err
+	}
+	return nil
+}
+
+var _ types.KVStore = prunedStore{}
+
+// prunedStore stands for a store whose version was pruned while other stores of
+// the multi store retain it, due to pruning overrides. Any access panics.
+type prunedStore struct {
+	name    string
+	version int64
+}
+
+func (s prunedStore) fail() {
+	panic(fmt.Errorf("version %d of store %s has been pruned", s.version, s.name))
+}
+
+// GetStoreType implements the Store interface.
+func (s prunedStore) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (s prunedStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s prunedStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// Get implements the KVStore interface.
+func (s prunedStore) Get(_ []byte) []byte {
+	s.fail()
+	return nil
+}
+
+// Has implements the KVStore interface.
+func (s prunedStore) Has(_ []byte) bool {
+	s.fail()
+	return false
+}
+
+// Set implements the KVStore interface.
+func (s prunedStore) Set(_, _ []byte) {
+	s.fail()
+}
+
+// Delete implements the KVStore interface.
+func (s prunedStore) Delete(_ []byte) {
+	s.fail()
+}
+
+// Iterator implements the KVStore interface.
+func (s prunedStore) Iterator(_, _ []byte) types.Iterator {
+	s.fail()
+	return nil
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s prunedStore) ReverseIterator(_, _ []byte) types.Iterator {
+	s.fail()
+	return nil
+}
@@ -0,0 +1,87 @@
+package rootmulti
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func storeVersions(ms *Store, key types.StoreKey) []int {
+	return ms.GetCommitKVStore(key).(*iavl.Store).GetAllVersions()
+}
+
+func TestMultiStore_PruningOverrides(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetPruningOverrides(map[string]pruningtypes.PruningOptions{
+		testStoreKey2.Name(): pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+		testStoreKey3.Name(): pruningtypes.NewCustomPruningOptions(1, 5),
+	})
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < 10; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte{byte(i)})
+		ms.GetKVStore(testStoreKey2).Set([]byte("key"), []byte{byte(i)})
+		ms.Commit()
+	}
+
+	require.Equal(t, []int{8, 9, 10}, storeVersions(ms, testStoreKey1))
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, storeVersions(ms, testStoreKey2))
+	require.Equal(t, []int{9, 10}, storeVersions(ms, testStoreKey3))
+
+	// the stores retaining a version can be queried at it, the others fail
+	cms, err := ms.CacheMultiStoreWithVersion(5)
+	require.NoError(t, err)
+	require.Equal(t, []byte{4}, cms.GetKVStore(testStoreKey2).Get([]byte("key")))
+	require.PanicsWithError(t, "version 5 of store store1 has been pruned", func() {
+		cms.GetKVStore(testStoreKey1).Get([]byte("key"))
+	})
+
+	// the stores with a pruning override are also pruned by PruneStores
+	ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte{10})
+	ms.Commit()
+	require.NoError(t, ms.PruneStores(false, []int64{8}))
+	require.Equal(t, []int{9, 10, 11}, storeVersions(ms, testStoreKey1))
+	require.Equal(t, []int{10, 11}, storeVersions(ms, testStoreKey3))
+}
+
+func TestMultiStore_PruningOverridesSnapshotHeights(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetSnapshotInterval(4)
+	ms.SetPruningOverrides(map[string]pruningtypes.PruningOptions{
+		testStoreKey1.Name(): pruningtypes.NewCustomPruningOptions(0, 1),
+	})
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < 9; i++ {
+		ms.Commit()
+	}
+
+	// the snapshot heights are kept until their snapshot completes
+	require.Equal(t, []int{4, 8, 9}, storeVersions(ms, testStoreKey1))
+
+	ms.PruneSnapshotHeight(8)
+	ms.Commit()
+	require.Equal(t, []int{10}, storeVersions(ms, testStoreKey1))
+}
+
+func TestMultiStore_PruningOverridesValidation(t *testing.T) {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetPruningOverrides(map[string]pruningtypes.PruningOptions{
+		"unknown": pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	})
+	require.ErrorContains(t, ms.LoadLatestVersion(), "pruning override for unknown store unknown")
+
+	ms = newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+	ms.SetPruningOverrides(map[string]pruningtypes.PruningOptions{
+		"transient": pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+	})
+	require.ErrorContains(t, ms.LoadLatestVersion(), "pruning override for store transient of type")
+}
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -59,6 +60,9 @@ type Store struct {
 	logger              log.Logger
 	lastCommitInfo      *types.CommitInfo
 	pruningManager      *pruning.Manager
+	pruningOverrides    map[string]pruningtypes.PruningOptions
+	storeBackends       map[string]StoreBackend
+	lastSnapshotHeight  int64 // accessed atomically
 	iavlCacheSize       int
 	iavlDisableFastNode bool
 	storesParams        map[types.StoreKey]storeParams
@@ -202,6 +206,13 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	infos := make(map[string]types.StoreInfo)
 
 	rs.logger.Debug("loadVersion", "ver", ver)
+
+	if err := rs.validatePruningOverrides(); err != nil {
+		return err
+	}
+	if err := rs.validateStoreBackends(); err != nil {
+		return err
+	}
 	cInfo := &types.CommitInfo{}
 
 	// load old data if we are not version 0
@@ -339,6 +350,7 @@ func moveKVStoreData(oldDB types.KVStore, newDB types.KVStore) error {
 // If other strategy, this height is persisted until it is
 // less than <current height> - KeepRecent and <current height> % Interval == 0
 func (rs *Store) PruneSnapshotHeight(height int64) {
+	atomic.StoreInt64(&rs.lastSnapshotHeight, height)
 	rs.pruningManager.HandleHeightSnapshot(height)
 }
 
@@ -503,6 +515,12 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	var commitInfo *types.CommitInfo
 	storeInfos := map[string]bool{}
+	// retained is set if any IAVL store retains the version, prunedErr to the
+	// error of loading a store that was pruned at that version.
+	var (
+		retained  bool
+		prunedErr error
+	)
 	for key, store := range rs.stores {
 		var cacheStore types.KVStore
 		switch store.GetStoreType() {
@@ -533,14 +551,30 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 				}
 
 				// If the store existed at this version, it means there's actually an error
-				// getting the root store at this version.
+				// getting the root store at this version, unless it was pruned while
+				// other stores retain it due to pruning overrides.
 				if storeInfos[key.Name()] {
-					return nil, err
+					if len(rs.pruningOverrides) == 0 || store.(*iavl.Store).VersionExists(version) {
+						return nil, err
+					}
+					cacheStore, prunedErr = prunedStore{name: key.Name(), version: version}, err
 				}
+			} else {
+				retained = true
 			}
 
 		default:
 			cacheStore = store
+
+			// the stores of store backends only retain their latest version
+			if _, ok := rs.storeBackends[key.Name()]; ok {
+				if last := store.LastCommitID().Version; last != version {
+					cacheStore = prunedStore{name: key.Name(), version: version}
+					prunedErr = fmt.Errorf("version %d of store %s is not retained, its latest version is %d", version, key.Name(), last)
+				} else {
+					retained = true
+				}
+			}
 		}
 
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
@@ -552,6 +586,10 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 		cachedStores[key] = cacheStore
 	}
 
+	if prunedErr != nil && !retained {
+		return nil, prunedErr
+	}
+
 	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), nil
 }
 
@@ -596,7 +634,7 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 func (rs *Store) handlePruning(version int64) error {
 	rs.pruningManager.HandleHeight(version - 1) // we should never prune the current version.
 	if !rs.pruningManager.ShouldPruneAtHeight(version) {
-		return nil
+		return rs.pruneOverriddenStores(version)
 	}
 	rs.logger.Info("prune start", "height", version)
 	defer rs.logger.Info("prune end", "height", version)
@@ -606,6 +644,8 @@ func (rs *Store) handlePruning(version int64) error {
 // PruneStores prunes the specific heights of the multi store.
 // If clearPruningManager is true, the pruning manager will return the pruning heights,
 // and they are appended to the pruningHeights to be pruned.
+// The stores with a pruning override are not pruned at these heights, but according
+// to their own pruning options relative to the latest version, see SetPruningOverrides.
 func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (err error) {
 	if clearPruningManager {
 		heights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
@@ -620,7 +660,7 @@ func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (
 		pruningHeights = append(pruningHeights, heights...)
 	}
 
-	if len(pruningHeights) == 0 {
+	if len(pruningHeights) == 0 && len(rs.pruningOverrides) == 0 {
 		rs.logger.Debug("no heights need to be pruned")
 		return nil
 	}
@@ -636,6 +676,17 @@ func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (
 			continue
 		}
 
+		if opts, ok := rs.pruningOverrides[key.Name()]; ok {
+			if err := rs.pruneOverriddenStore(key, opts, rs.lastCommitInfo.GetVersion()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if len(pruningHeights) == 0 {
+			continue
+		}
+
 		store = rs.GetCommitKVStore(key)
 
 		err := store.(*iavl.Store).DeleteVersions(pruningHeights...)
@@ -721,14 +772,14 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 func (rs *Store) SetInitialVersion(version int64) error {
 	rs.initialVersion = version
 
-	// Loop through all the stores, if it's an IAVL store, then set initial
-	// version on it.
+	// Loop through all the stores, if it's an IAVL store or a store of a store
+	// backend supporting it, then set initial version on it.
 	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-			store.(types.StoreWithInitialVersion).SetInitialVersion(version)
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying store.
+		store = rs.GetCommitKVStore(key)
+		if store, ok := store.(types.StoreWithInitialVersion); ok && rs.storesParams[key].typ == types.StoreTypeIAVL {
+			store.SetInitialVersion(version)
 		}
 	}
 
@@ -949,7 +1000,9 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		var store types.CommitKVStore
 		var err error
 
-		if params.initialVersion == 0 {
+		if backend, ok := rs.storeBackends[key.Name()]; ok {
+			store, err = backend(db, rs.logger, key, id, params.initialVersion)
+		} else if params.initialVersion == 0 {
 			store, err = iavl.LoadStore(db, rs.logger, key, id, rs.lazyLoading, rs.iavlCacheSize, rs.iavlDisableFastNode)
 		} else {
 			store, err = iavl.LoadStoreWithInitialVersion(db, rs.logger, key, id, rs.lazyLoading, params.initialVersion, rs.iavlCacheSize, rs.iavlDisableFastNode)
@@ -1031,6 +1084,14 @@ func (rs *Store) RollbackToVersion(target int64) error {
 			if err != nil {
 				return err
 			}
+		} else if _, ok := rs.storeBackends[key.Name()]; ok {
+			overwriter, ok := rs.GetCommitKVStore(key).(versionOverwriter)
+			if !ok {
+				return fmt.Errorf("store %s of type %s does not support rollback", key.Name(), store.GetStoreType())
+			}
+			if _, err := overwriter.LoadVersionForOverwriting(target); err != nil {
+				return err
+			}
 		}
 	}
 
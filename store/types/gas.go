@@ -50,6 +50,15 @@ type GasMeter interface {
 	String() string
 }
 
+// StoreGasMeter is a GasMeter wrapping the gas KVStores charging it, e.g. to
+// attribute the consumed gas and store accesses to their store key.
+type StoreGasMeter interface {
+	GasMeter
+
+	// WrapKVStore wraps the gas KVStore of the given key, charging this meter.
+	WrapKVStore(key StoreKey, store KVStore) KVStore
+}
+
 type basicGasMeter struct {
 	limit    Gas
 	consumed Gas
@@ -310,6 +310,7 @@ const (
 	StoreTypeMemory
 	StoreTypeSMT
 	StoreTypePersistent
+	StoreTypeFlat
 )
 
 func (st StoreType) String() string {
@@ -334,6 +335,9 @@ func (st StoreType) String() string {
 
 	case StoreTypePersistent:
 		return "StoreTypePersistent"
+
+	case StoreTypeFlat:
+		return "StoreTypeFlat"
 	}
 
 	return "unknown store type"
@@ -0,0 +1,104 @@
+package telemetry
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Resource accounting metric label names.
+const (
+	MetricLabelNameMsgTypeURL = "msg_type_url"
+	MetricLabelNameStoreKey   = "store_key"
+)
+
+// ResourceUsage defines the gas, KV store reads and writes and wall time
+// consumed by messages or by the accesses to a store key.
+type ResourceUsage struct {
+	Count    uint64
+	GasUsed  uint64
+	Reads    uint64
+	Writes   uint64
+	Duration time.Duration
+}
+
+// Add adds the usage of other to u.
+func (u *ResourceUsage) Add(other ResourceUsage) {
+	u.Count += other.Count
+	u.GasUsed += other.GasUsed
+	u.Reads += other.Reads
+	u.Writes += other.Writes
+	u.Duration += other.Duration
+}
+
+// resourceHistograms holds the Prometheus histograms of the resources consumed
+// per value of a label.
+type resourceHistograms struct {
+	gasUsed  *prometheus.HistogramVec
+	reads    *prometheus.HistogramVec
+	writes   *prometheus.HistogramVec
+	duration *prometheus.HistogramVec
+}
+
+func newResourceHistograms(subsystem, label, help string) *resourceHistograms {
+	newHistogramVec := func(name, what string, buckets []float64) *prometheus.HistogramVec {
+		return prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "cosmos",
+			Subsystem: subsystem,
+			Name:      name,
+			Help:      what + " " + help,
+			Buckets:   buckets,
+		}, []string{label})
+	}
+
+	return &resourceHistograms{
+		gasUsed:  newHistogramVec("gas_used", "Gas consumed", prometheus.ExponentialBuckets(1000, 2, 16)),
+		reads:    newHistogramVec("reads", "KV store reads", prometheus.ExponentialBuckets(1, 2, 12)),
+		writes:   newHistogramVec("writes", "KV store writes", prometheus.ExponentialBuckets(1, 2, 12)),
+		duration: newHistogramVec("duration_seconds", "Wall time", prometheus.ExponentialBuckets(0.0001, 4, 10)),
+	}
+}
+
+// register registers the histograms, ignoring the ones already registered.
+func (h *resourceHistograms) register(reg prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{h.gasUsed, h.reads, h.writes, h.duration} {
+		if err := reg.Register(c); err != nil && !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *resourceHistograms) observe(labelValue string, usage ResourceUsage) {
+	h.gasUsed.WithLabelValues(labelValue).Observe(float64(usage.GasUsed))
+	h.reads.WithLabelValues(labelValue).Observe(float64(usage.Reads))
+	h.writes.WithLabelValues(labelValue).Observe(float64(usage.Writes))
+	h.duration.WithLabelValues(labelValue).Observe(usage.Duration.Seconds())
+}
+
+var (
+	msgResourceHistograms   = newResourceHistograms("msg", MetricLabelNameMsgTypeURL, "by the execution of a message")
+	storeResourceHistograms = newResourceHistograms("store", MetricLabelNameStoreKey, "by the accesses of a message to a store key")
+)
+
+// registerResourceHistograms registers the resource accounting histograms with
+// the default Prometheus registerer.
+func registerResourceHistograms() error {
+	if err := msgResourceHistograms.register(prometheus.DefaultRegisterer); err != nil {
+		return err
+	}
+	return storeResourceHistograms.register(prometheus.DefaultRegisterer)
+}
+
+// ObserveMsgResourceUsage records the resources consumed by the execution of a
+// message with the given type URL.
+func ObserveMsgResourceUsage(msgTypeURL string, usage ResourceUsage) {
+	msgResourceHistograms.observe(msgTypeURL, usage)
+}
+
+// ObserveStoreResourceUsage records the resources consumed by the accesses of a
+// message to the store with the given key name.
+func ObserveStoreResourceUsage(storeKey string, usage ResourceUsage) {
+	storeResourceHistograms.observe(storeKey, usage)
+}
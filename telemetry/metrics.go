@@ -52,6 +52,22 @@ type Config struct {
 	// Example:
 	// [["chain_id", "cosmoshub-1"]]
 	GlobalLabels [][]string `mapstructure:"global-labels"`
+
+	// TracingEnabled enables OpenTelemetry tracing of ABCI calls, transactions,
+	// messages and gRPC queries. Spans are exported through OTLP/gRPC.
+	TracingEnabled bool `mapstructure:"tracing-enabled"`
+
+	// OTLPEndpoint defines the address of the OTLP/gRPC collector spans are
+	// exported to.
+	OTLPEndpoint string `mapstructure:"otlp-endpoint"`
+
+	// OTLPInsecure disables TLS on the connection to the OTLP collector.
+	OTLPInsecure bool `mapstructure:"otlp-insecure"`
+
+	// TracingSampleRate defines the fraction of traces sampled, between 0 and 1.
+	// Zero, e.g. when the key is missing from app.toml, samples at
+	// DefaultTracingSampleRate. Tracing is disabled with TracingEnabled instead.
+	TracingSampleRate float64 `mapstructure:"tracing-sample-rate"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -111,6 +127,10 @@ func New(cfg Config) (_ *Metrics, rerr error) {
 			return nil, err
 		}
 
+		if err := registerResourceHistograms(); err != nil {
+			return nil, err
+		}
+
 		fanout = append(fanout, promSink)
 	}
 
@@ -1,12 +1,14 @@
 package telemetry
 
 import (
+	"bytes"
 	"encoding/json"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/armon/go-metrics"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/expfmt"
 	"github.com/stretchr/testify/require"
 )
@@ -74,3 +76,29 @@ func emitMetrics() {
 		}
 	}
 }
+
+func TestMetrics_ResourceHistograms(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	require.NoError(t, msgResourceHistograms.register(reg))
+	require.NoError(t, storeResourceHistograms.register(reg))
+
+	// registering the histograms again is a no-op
+	require.NoError(t, msgResourceHistograms.register(reg))
+
+	ObserveMsgResourceUsage("/test.MsgTest", ResourceUsage{Count: 1, GasUsed: 3000, Reads: 2, Writes: 1, Duration: time.Millisecond})
+	ObserveStoreResourceUsage("test", ResourceUsage{Count: 1, GasUsed: 2500, Reads: 2, Writes: 1, Duration: time.Millisecond})
+
+	mfs, err := reg.Gather()
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	for _, mf := range mfs {
+		_, err := expfmt.MetricFamilyToText(buf, mf)
+		require.NoError(t, err)
+	}
+
+	require.Contains(t, buf.String(), `cosmos_msg_gas_used_sum{msg_type_url="/test.MsgTest"} 3000`)
+	require.Contains(t, buf.String(), `cosmos_msg_writes_count{msg_type_url="/test.MsgTest"} 1`)
+	require.Contains(t, buf.String(), `cosmos_store_gas_used_sum{store_key="test"} 2500`)
+	require.Contains(t, buf.String(), `cosmos_store_reads_sum{store_key="test"} 2`)
+}
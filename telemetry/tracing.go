@@ -0,0 +1,98 @@
+package telemetry
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName defines the instrumentation name of the spans emitted by the SDK.
+const TracerName = "github.com/cosmos/cosmos-sdk"
+
+// DefaultOTLPEndpoint defines the default address of the OTLP/gRPC collector.
+const DefaultOTLPEndpoint = "localhost:4317"
+
+// DefaultTracingSampleRate defines the fraction of traces sampled when no
+// sample rate is configured.
+const DefaultTracingSampleRate = 1.0
+
+var tracingEnabled atomic.Bool
+
+// Tracer returns the SDK tracer of the globally registered TracerProvider. Until
+// tracing is enabled, the global provider is a no-op and spans are discarded at
+// almost no cost.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// NewTracerProvider creates a TracerProvider exporting spans in batches to the
+// OTLP/gRPC collector configured in cfg. It returns nil if tracing is disabled.
+func NewTracerProvider(ctx context.Context, cfg Config) (*sdktrace.TracerProvider, error) {
+	if !cfg.TracingEnabled {
+		return nil, nil
+	}
+
+	endpoint := cfg.OTLPEndpoint
+	if endpoint == "" {
+		endpoint = DefaultOTLPEndpoint
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if cfg.OTLPInsecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(tracingSampleRate(cfg)))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName))),
+	), nil
+}
+
+// EnableTracing creates a TracerProvider from cfg and registers it as the global
+// provider used by Tracer. The returned function flushes and stops the provider,
+// it is a no-op if tracing is disabled.
+func EnableTracing(ctx context.Context, cfg Config) (shutdown func(context.Context) error, err error) {
+	tp, err := NewTracerProvider(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if tp == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	otel.SetTracerProvider(tp)
+	tracingEnabled.Store(true)
+
+	return func(ctx context.Context) error {
+		tracingEnabled.Store(false)
+		return tp.Shutdown(ctx)
+	}, nil
+}
+
+// IsTracingEnabled returns true if a TracerProvider has been registered by
+// EnableTracing and not shut down yet.
+func IsTracingEnabled() bool {
+	return tracingEnabled.Load()
+}
+
+// tracingSampleRate returns the sample rate configured in cfg, defaulting to
+// DefaultTracingSampleRate if it is unset.
+func tracingSampleRate(cfg Config) float64 {
+	if cfg.TracingSampleRate == 0 {
+		return DefaultTracingSampleRate
+	}
+	return cfg.TracingSampleRate
+}
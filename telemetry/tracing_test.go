@@ -0,0 +1,33 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableTracing_Disabled(t *testing.T) {
+	shutdown, err := EnableTracing(context.Background(), Config{})
+	require.NoError(t, err)
+	require.False(t, IsTracingEnabled())
+	require.NoError(t, shutdown(context.Background()))
+}
+
+func TestEnableTracing(t *testing.T) {
+	shutdown, err := EnableTracing(context.Background(), Config{
+		TracingEnabled: true,
+		OTLPEndpoint:   "localhost:0",
+		OTLPInsecure:   true,
+	})
+	require.NoError(t, err)
+	require.True(t, IsTracingEnabled())
+
+	require.NoError(t, shutdown(context.Background()))
+	require.False(t, IsTracingEnabled())
+}
+
+func TestTracingSampleRate(t *testing.T) {
+	require.Equal(t, DefaultTracingSampleRate, tracingSampleRate(Config{}))
+	require.Equal(t, 0.25, tracingSampleRate(Config{TracingSampleRate: 0.25}))
+}
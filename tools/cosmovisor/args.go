@@ -27,6 +27,11 @@ const (
 	EnvDataBackupPath       = "DAEMON_DATA_BACKUP_DIR"
 	EnvInterval             = "DAEMON_POLL_INTERVAL"
 	EnvPreupgradeMaxRetries = "DAEMON_PREUPGRADE_MAX_RETRIES"
+	EnvPreupgradeScript     = "DAEMON_PREUPGRADE_SCRIPT"
+	EnvVerifyChecksum       = "DAEMON_VERIFY_BINARY_CHECKSUM"
+	EnvUpgradeTimeout       = "DAEMON_UPGRADE_TIMEOUT"
+	EnvRPCAddress           = "DAEMON_RPC_ADDRESS"
+	EnvShutdownGrace        = "DAEMON_SHUTDOWN_GRACE"
 )
 
 const (
@@ -39,6 +44,14 @@ const (
 // must be the same as x/upgrade/types.UpgradeInfoFilename
 const defaultFilename = "upgrade-info.json"
 
+// pendingUpgradeFilename is the file recording the upgrade monitored for a rollback
+const pendingUpgradeFilename = "cosmovisor-pending-upgrade.json"
+
+const defaultRPCAddress = "http://localhost:26657"
+
+// defaultShutdownGrace is the time the app is given to stop after SIGTERM if ShutdownGrace isn't set.
+const defaultShutdownGrace = 30 * time.Second
+
 // Config is the information passed in to control the daemon
 type Config struct {
 	Home                  string
@@ -50,6 +63,11 @@ type Config struct {
 	UnsafeSkipBackup      bool
 	DataBackupPath        string
 	PreupgradeMaxRetries  int
+	PreupgradeScript      string
+	VerifyBinaryChecksum  bool
+	UpgradeTimeout        time.Duration
+	RPCAddress            string
+	ShutdownGrace         time.Duration
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -86,6 +104,21 @@ func (cfg *Config) UpgradeInfoFilePath() string {
 	return filepath.Join(cfg.Home, "data", defaultFilename)
 }
 
+// PendingUpgradeFilePath is the path of the file recording the upgrade monitored for a rollback, next
+// to the upgrade-info file.
+func (cfg *Config) PendingUpgradeFilePath() string {
+	return filepath.Join(cfg.Home, "data", pendingUpgradeFilename)
+}
+
+// PreupgradeScriptPath is the path to the custom pre-upgrade script, or an empty
+// string if none is configured. A relative path is resolved against Root.
+func (cfg *Config) PreupgradeScriptPath() string {
+	if cfg.PreupgradeScript == "" || filepath.IsAbs(cfg.PreupgradeScript) {
+		return cfg.PreupgradeScript
+	}
+	return filepath.Join(cfg.Root(), cfg.PreupgradeScript)
+}
+
 // SymLinkToGenesis creates a symbolic link from "./current" to the genesis directory.
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -105,6 +138,14 @@ func (cfg *Config) WaitRestartDelay() {
 	}
 }
 
+// ShutdownGracePeriod returns the time the app is given to stop after SIGTERM before it is killed.
+func (cfg *Config) ShutdownGracePeriod() time.Duration {
+	if cfg.ShutdownGrace > 0 {
+		return cfg.ShutdownGrace
+	}
+	return defaultShutdownGrace
+}
+
 // CurrentBin is the path to the currently selected binary (genesis if no link is set)
 // This will resolve the symlink to the underlying directory to make it easier to debug
 func (cfg *Config) CurrentBin() (string, error) {
@@ -138,14 +179,19 @@ func (cfg *Config) CurrentBin() (string, error) {
 func GetConfigFromEnv() (*Config, error) {
 	var errs []error
 	cfg := &Config{
-		Home:           os.Getenv(EnvHome),
-		Name:           os.Getenv(EnvName),
-		DataBackupPath: os.Getenv(EnvDataBackupPath),
+		Home:             os.Getenv(EnvHome),
+		Name:             os.Getenv(EnvName),
+		DataBackupPath:   os.Getenv(EnvDataBackupPath),
+		PreupgradeScript: os.Getenv(EnvPreupgradeScript),
+		RPCAddress:       os.Getenv(EnvRPCAddress),
 	}
 
 	if cfg.DataBackupPath == "" {
 		cfg.DataBackupPath = cfg.Home
 	}
+	if cfg.RPCAddress == "" {
+		cfg.RPCAddress = defaultRPCAddress
+	}
 
 	var err error
 	if cfg.AllowDownloadBinaries, err = booleanOption(EnvDownloadBin, false); err != nil {
@@ -157,6 +203,9 @@ func GetConfigFromEnv() (*Config, error) {
 	if cfg.UnsafeSkipBackup, err = booleanOption(EnvSkipBackup, false); err != nil {
 		errs = append(errs, err)
 	}
+	if cfg.VerifyBinaryChecksum, err = booleanOption(EnvVerifyChecksum, false); err != nil {
+		errs = append(errs, err)
+	}
 
 	interval := os.Getenv(EnvInterval)
 	if interval != "" {
@@ -181,6 +230,26 @@ func GetConfigFromEnv() (*Config, error) {
 		}
 	}
 
+	upgradeTimeout := os.Getenv(EnvUpgradeTimeout)
+	if upgradeTimeout != "" {
+		val, err := parseEnvDuration(upgradeTimeout)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid: %s: %w", EnvUpgradeTimeout, err))
+		} else {
+			cfg.UpgradeTimeout = val
+		}
+	}
+
+	shutdownGrace := os.Getenv(EnvShutdownGrace)
+	if shutdownGrace != "" {
+		val, err := parseEnvDuration(shutdownGrace)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid: %s: %w", EnvShutdownGrace, err))
+		} else {
+			cfg.ShutdownGrace = val
+		}
+	}
+
 	envPreupgradeMaxRetriesVal := os.Getenv(EnvPreupgradeMaxRetries)
 	if cfg.PreupgradeMaxRetries, err = strconv.Atoi(envPreupgradeMaxRetriesVal); err != nil && envPreupgradeMaxRetriesVal != "" {
 		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvPreupgradeMaxRetries, err))
@@ -247,8 +316,26 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	// the upgraded binary is only monitored by a running cosmovisor
+	if cfg.UpgradeTimeout > 0 && !cfg.RestartAfterUpgrade {
+		errs = append(errs, fmt.Errorf("%s requires %s to be true", EnvUpgradeTimeout, EnvRestartUpgrade))
+	}
+
+	if cfg.PreupgradeScript != "" {
+		switch info, err := os.Stat(cfg.PreupgradeScriptPath()); {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("cannot stat %s: %w", EnvPreupgradeScript, err))
+		case !info.Mode().IsRegular():
+			errs = append(errs, fmt.Errorf("%s must be a regular file", cfg.PreupgradeScriptPath()))
+		}
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup == true {
+		// the rollback restores the data backup
+		if cfg.UpgradeTimeout > 0 {
+			errs = append(errs, fmt.Errorf("%s requires a data backup, %s must not be set", EnvUpgradeTimeout, EnvSkipBackup))
+		}
 		return errs
 	}
 
@@ -366,6 +453,11 @@ func (cfg Config) DetailString() string {
 		{EnvSkipBackup, fmt.Sprintf("%t", cfg.UnsafeSkipBackup)},
 		{EnvDataBackupPath, cfg.DataBackupPath},
 		{EnvPreupgradeMaxRetries, fmt.Sprintf("%d", cfg.PreupgradeMaxRetries)},
+		{EnvPreupgradeScript, cfg.PreupgradeScript},
+		{EnvVerifyChecksum, fmt.Sprintf("%t", cfg.VerifyBinaryChecksum)},
+		{EnvUpgradeTimeout, fmt.Sprintf("%s", cfg.UpgradeTimeout)},
+		{EnvRPCAddress, cfg.RPCAddress},
+		{EnvShutdownGrace, fmt.Sprintf("%s", cfg.ShutdownGracePeriod())},
 	}
 
 	derivedEntries := []struct{ name, value string }{
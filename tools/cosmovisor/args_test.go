@@ -377,6 +377,7 @@ func (s *argsTestSuite) TestGetConfigFromEnv() {
 			UnsafeSkipBackup:      skipBackup,
 			DataBackupPath:        dataBackupPath,
 			PreupgradeMaxRetries:  preupgradeMaxRetries,
+			RPCAddress:            defaultRPCAddress,
 		}
 	}
 
@@ -605,6 +606,82 @@ func (s *argsTestSuite) TestGetConfigFromEnv() {
 	}
 }
 
+func (s *argsTestSuite) TestGetConfigFromEnvUpgradeOptions() {
+	initialEnv := s.clearEnv()
+	defer s.setEnv(nil, initialEnv)
+
+	absPath, err := filepath.Abs(filepath.Join("testdata", "rollback"))
+	s.Require().NoError(err)
+	s.setEnv(s.T(), &cosmovisorEnv{Home: absPath, Name: "dummyd"})
+
+	tests := []struct {
+		name             string
+		env              map[string]string
+		expectedErrCount int
+		check            func(t *testing.T, cfg *Config)
+	}{
+		{
+			name: "not set",
+			check: func(t *testing.T, cfg *Config) {
+				require.Empty(t, cfg.PreupgradeScriptPath())
+				require.False(t, cfg.VerifyBinaryChecksum)
+				require.Zero(t, cfg.UpgradeTimeout)
+				require.Equal(t, defaultRPCAddress, cfg.RPCAddress)
+				require.Zero(t, cfg.ShutdownGrace)
+				require.Equal(t, defaultShutdownGrace, cfg.ShutdownGracePeriod())
+			},
+		},
+		{
+			name: "all good",
+			env: map[string]string{
+				EnvPreupgradeScript: "scripts/pre-upgrade-retry.sh",
+				EnvVerifyChecksum:   "true",
+				EnvUpgradeTimeout:   "5m",
+				EnvRPCAddress:       "http://127.0.0.1:36657",
+				EnvShutdownGrace:    "10s",
+			},
+			check: func(t *testing.T, cfg *Config) {
+				require.Equal(t, filepath.Join(absPath, "cosmovisor", "scripts", "pre-upgrade-retry.sh"), cfg.PreupgradeScriptPath())
+				require.True(t, cfg.VerifyBinaryChecksum)
+				require.Equal(t, 5*time.Minute, cfg.UpgradeTimeout)
+				require.Equal(t, "http://127.0.0.1:36657", cfg.RPCAddress)
+				require.Equal(t, 10*time.Second, cfg.ShutdownGracePeriod())
+			},
+		},
+		{
+			name:             "all bad",
+			env:              map[string]string{EnvPreupgradeScript: "scripts/missing.sh", EnvVerifyChecksum: "bad", EnvUpgradeTimeout: "bad", EnvShutdownGrace: "bad"},
+			expectedErrCount: 4,
+		},
+		{
+			name:             "upgrade timeout without backup",
+			env:              map[string]string{EnvUpgradeTimeout: "5m", EnvSkipBackup: "true"},
+			expectedErrCount: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			for envVar, envVal := range tc.env {
+				t.Setenv(envVar, envVal)
+			}
+			cfg, err := GetConfigFromEnv()
+			if tc.expectedErrCount == 0 {
+				require.NoError(t, err)
+				tc.check(t, cfg)
+				return
+			}
+
+			require.Error(t, err)
+			errCount := 1
+			if multi, isMulti := err.(*errors.MultiError); isMulti {
+				errCount = multi.Len()
+			}
+			require.Equal(t, tc.expectedErrCount, errCount, "error count")
+		})
+	}
+}
+
 func (s *argsTestSuite) TestLogConfigOrError() {
 	cfg := &Config{
 		Home:                  "/no/place/like/it",
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/tools/cosmovisor"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	// FlagUpgradeHeight defines the height of a scheduled upgrade
+	FlagUpgradeHeight = "upgrade-height"
+	// FlagForce overwrites the binary of an existing upgrade
+	FlagForce = "force"
+)
+
+func init() {
+	addUpgradeCmd.Flags().Int64(FlagUpgradeHeight, 0, "Schedule the upgrade at the given height, setting the halt height of the node to the block before it")
+	addUpgradeCmd.Flags().Bool(FlagForce, false, "Overwrite the binary of an existing upgrade")
+	rootCmd.AddCommand(addUpgradeCmd)
+}
+
+var addUpgradeCmd = &cobra.Command{
+	Use:          "add-upgrade <upgrade-name> <path to executable>",
+	Short:        "Adds an upgrade binary to cosmovisor, optionally scheduling the upgrade at a given height.",
+	SilenceUsage: true,
+	Args:         cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := cmd.Context().Value(cosmovisor.LoggerKey).(*zerolog.Logger)
+
+		cfg, err := cosmovisor.GetConfigFromEnv()
+		if err != nil {
+			return err
+		}
+
+		height, err := cmd.Flags().GetInt64(FlagUpgradeHeight)
+		if err != nil {
+			return err
+		}
+
+		force, err := cmd.Flags().GetBool(FlagForce)
+		if err != nil {
+			return err
+		}
+
+		return AddUpgrade(logger, cfg, args[0], args[1], height, force)
+	},
+}
+
+// AddUpgrade copies the executable at pathToExe in the directory of the upgrade name.
+// If height is positive, the upgrade is also scheduled at height with cosmovisor.ScheduleUpgrade.
+func AddUpgrade(logger *zerolog.Logger, cfg *cosmovisor.Config, name, pathToExe string, height int64, force bool) error {
+	name = strings.ToLower(name)
+	if len(name) == 0 {
+		return errors.New("no <upgrade-name> provided")
+	}
+	if height < 0 {
+		return fmt.Errorf("invalid upgrade height %d", height)
+	}
+
+	switch exeInfo, err := os.Stat(pathToExe); {
+	case os.IsNotExist(err):
+		return fmt.Errorf("executable file not found: %w", err)
+	case err != nil:
+		return fmt.Errorf("could not stat executable: %w", err)
+	case exeInfo.IsDir():
+		return errors.New("invalid path to executable: must not be a directory")
+	}
+
+	upgradeBin := cfg.UpgradeBin(name)
+	if _, err := os.Stat(upgradeBin); err == nil && !force {
+		return fmt.Errorf("the binary of upgrade %q already exists at %q, use --%s to overwrite it", name, upgradeBin, FlagForce)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(upgradeBin), 0o755); err != nil {
+		return fmt.Errorf("error creating upgrade directory: %w", err)
+	}
+
+	logger.Info().Msgf("copying executable into place: %q", upgradeBin)
+	if err := copyFile(pathToExe, upgradeBin); err != nil {
+		return fmt.Errorf("error copying executable: %w", err)
+	}
+	if err := cosmovisor.MarkExecutable(upgradeBin); err != nil {
+		return err
+	}
+
+	if height == 0 {
+		logger.Info().Msgf("upgrade %q added", name)
+		return nil
+	}
+
+	if err := cosmovisor.ScheduleUpgrade(cfg, upgradetypes.Plan{Name: name, Height: height}); err != nil {
+		return err
+	}
+
+	logger.Info().Msgf("upgrade %q scheduled at height %d, the halt height of the node is set to %d", name, height, height-1)
+	return nil
+}
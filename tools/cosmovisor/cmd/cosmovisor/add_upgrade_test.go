@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+func TestAddUpgrade(t *testing.T) {
+	logger := cosmovisor.NewLogger()
+
+	home := t.TempDir()
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd"}
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+	appConfig := "# halt-height contains a non-zero block height\nhalt-height = 0\n\nhalt-time = 0\n"
+	require.NoError(t, os.WriteFile(cfg.AppConfigPath(), []byte(appConfig), 0o644))
+
+	exe := filepath.Join(t.TempDir(), "dummyd")
+	require.NoError(t, os.WriteFile(exe, []byte("#!/bin/sh\necho v2\n"), 0o644))
+
+	// invalid arguments
+	require.ErrorContains(t, AddUpgrade(logger, cfg, "chain2", filepath.Join(home, "missing"), 0, false), "executable file not found")
+	require.ErrorContains(t, AddUpgrade(logger, cfg, "chain2", home, 0, false), "must not be a directory")
+	require.ErrorContains(t, AddUpgrade(logger, cfg, "chain2", exe, -1, false), "invalid upgrade height")
+
+	// add the binary only
+	require.NoError(t, AddUpgrade(logger, cfg, "Chain2", exe, 0, false))
+	require.NoError(t, cosmovisor.EnsureBinary(cfg.UpgradeBin("chain2")))
+	require.NoFileExists(t, cfg.UpgradeInfoFilePath())
+	_, ok := cfg.ScheduledUpgrade("chain2")
+	require.False(t, ok)
+
+	// the binary is not overwritten without force
+	require.ErrorContains(t, AddUpgrade(logger, cfg, "chain2", exe, 100, false), "already exists")
+	require.NoFileExists(t, cfg.UpgradeInfoFilePath())
+
+	// schedule the upgrade
+	require.NoError(t, AddUpgrade(logger, cfg, "chain2", exe, 100, true))
+	plan, ok := cfg.ScheduledUpgrade("chain2")
+	require.True(t, ok)
+	require.Equal(t, "chain2", plan.Name)
+	require.Equal(t, int64(100), plan.Height)
+
+	bz, err := os.ReadFile(cfg.UpgradeInfoFilePath())
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"chain2","time":"0001-01-01T00:00:00Z","height":100}`, string(bz))
+
+	bz, err = os.ReadFile(cfg.AppConfigPath())
+	require.NoError(t, err)
+	require.Equal(t, "# halt-height contains a non-zero block height\nhalt-height = 99\n\nhalt-time = 0\n", string(bz))
+
+	// an upgrade cannot be scheduled before the planned one
+	require.ErrorContains(t, AddUpgrade(logger, cfg, "chain3", exe, 100, false), "already planned at height 100")
+	require.ErrorContains(t, AddUpgrade(logger, cfg, "chain4", exe, 1, false), "must be greater than 1")
+}
@@ -2,8 +2,10 @@ package cosmovisor
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -23,6 +25,18 @@ type Launcher struct {
 	logger *zerolog.Logger
 	cfg    *Config
 	fw     *fileWatcher
+
+	// upgrade done by the last run, rolled back if the upgraded binary fails
+	pending *pendingUpgrade
+}
+
+// pendingUpgrade is an upgrade whose binary didn't advance past the upgrade
+// height yet. It is persisted so that the upgrade is still rolled back if
+// cosmovisor restarts in the meantime.
+type pendingUpgrade struct {
+	Plan      upgradetypes.Plan `json:"plan"`
+	BackupDir string            `json:"backup_dir"` // data backup taken before the upgrade
+	PrevDir   string            `json:"prev_dir"`   // directory of the binary running before the upgrade
 }
 
 func NewLauncher(logger *zerolog.Logger, cfg *Config) (Launcher, error) {
@@ -30,14 +44,59 @@ func NewLauncher(logger *zerolog.Logger, cfg *Config) (Launcher, error) {
 	if err != nil {
 		return Launcher{}, err
 	}
+	fw.scheduledUpgrade, fw.rpcAddress = cfg.ScheduledUpgrade, cfg.RPCAddress
+	fw.haltHeight = func() (int64, error) { return getHaltHeight(cfg) }
+
+	var pending *pendingUpgrade
+	if cfg.UpgradeTimeout > 0 {
+		if pending, err = readPendingUpgrade(cfg); err != nil {
+			return Launcher{}, err
+		}
+	}
+
+	return Launcher{logger: logger, cfg: cfg, fw: fw, pending: pending}, nil
+}
+
+// readPendingUpgrade returns the upgrade recorded by writePendingUpgrade, or nil if there is none.
+func readPendingUpgrade(cfg *Config) (*pendingUpgrade, error) {
+	bz, err := os.ReadFile(cfg.PendingUpgradeFilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while reading %s: %w", pendingUpgradeFilename, err)
+	}
+
+	var pending pendingUpgrade
+	if err := json.Unmarshal(bz, &pending); err != nil {
+		return nil, fmt.Errorf("error while parsing %s: %w", pendingUpgradeFilename, err)
+	}
+
+	return &pending, nil
+}
+
+// writePendingUpgrade records the pending upgrade next to the upgrade-info file. The file is removed
+// once the upgrade is checked, or along with the data directory on rollback.
+func writePendingUpgrade(cfg *Config, pending *pendingUpgrade) error {
+	bz, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(cfg.PendingUpgradeFilePath(), bz, 0o600); err != nil {
+		return fmt.Errorf("error while writing %s: %w", pendingUpgradeFilename, err)
+	}
 
-	return Launcher{logger: logger, cfg: cfg, fw: fw}, nil
+	return nil
 }
 
 // Run launches the app in a subprocess and returns when the subprocess (app)
 // exits (either when it dies, or *after* a successful upgrade.) and upgrade finished.
 // Returns true if the upgrade request was detected and the upgrade process started.
-func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
+// If UpgradeTimeout is set, an error is returned after rolling back the upgrade
+// done by the previous run if its binary exits or does not advance past the
+// upgrade height within the timeout.
+func (l *Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 	bin, err := l.cfg.CurrentBin()
 	if err != nil {
 		return false, fmt.Errorf("error creating symlink to genesis: %w", err)
@@ -47,56 +106,108 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		return false, fmt.Errorf("current binary is invalid: %w", err)
 	}
 
-	l.logger.Info().Str("path", bin).Strs("args", args).Msg("running app")
-	cmd := exec.Command(bin, args...)
-	cmd.Stdout = stdout
-	cmd.Stderr = stderr
-	if err := cmd.Start(); err != nil {
-		return false, fmt.Errorf("launching process %s %s failed: %w", bin, strings.Join(args, " "), err)
-	}
-
-	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGQUIT, syscall.SIGTERM)
-	go func() {
-		sig := <-sigs
-		if err := cmd.Process.Signal(sig); err != nil {
-			l.logger.Fatal().Err(err).Str("bin", bin).Msg("terminated")
+	for {
+		needsUpdate, err := l.launch(bin, args, stdout, stderr)
+		if err != nil {
+			return false, err
+		}
+		if needsUpdate {
+			break
 		}
-	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
-		return false, err
+		// the app is restarted to apply the halt height of a scheduled upgrade
+		if !l.fw.needsRestart {
+			return false, nil
+		}
+		l.fw.needsRestart = false
 	}
 
 	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
 		l.cfg.WaitRestartDelay()
 
-		if err := l.doBackup(); err != nil {
+		backupDir, err := l.doBackup()
+		if err != nil {
 			return false, err
 		}
 
+		if err := l.doCustomPreUpgrade(); err != nil {
+			return false, err
+		}
+
+		prevDir, err := os.Readlink(filepath.Join(l.cfg.Root(), currentLink))
+		if err != nil {
+			return false, fmt.Errorf("error while reading current symlink: %w", err)
+		}
+
 		if err := UpgradeBinary(l.logger, l.cfg, l.fw.currentInfo); err != nil {
 			return false, err
 		}
 
+		if err := clearScheduledHaltHeight(l.cfg, l.fw.currentInfo); err != nil {
+			return false, err
+		}
+
 		if err = l.doPreUpgrade(); err != nil {
 			return false, err
 		}
 
+		if l.cfg.UpgradeTimeout > 0 {
+			l.pending = &pendingUpgrade{Plan: l.fw.currentInfo, BackupDir: backupDir, PrevDir: prevDir}
+			if err := writePendingUpgrade(l.cfg, l.pending); err != nil {
+				return false, err
+			}
+		}
+
 		return true, nil
 	}
 
 	return false, nil
 }
 
+// launch runs the app and waits for it to exit or for an upgrade, see WaitForUpgradeOrExit.
+func (l *Launcher) launch(bin string, args []string, stdout, stderr io.Writer) (bool, error) {
+	// the halt height only applies when the app starts, so the one it starts with is recorded
+	haltHeight, err := getHaltHeight(l.cfg)
+	if err != nil {
+		haltHeight = 0
+	}
+	l.fw.startHaltHeight = haltHeight
+
+	l.logger.Info().Str("path", bin).Strs("args", args).Msg("running app")
+	cmd := exec.Command(bin, args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Start(); err != nil {
+		return false, fmt.Errorf("launching process %s %s failed: %w", bin, strings.Join(args, " "), err)
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGQUIT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+	go func() {
+		sig := <-sigs
+		if err := cmd.Process.Signal(sig); err != nil {
+			l.logger.Fatal().Err(err).Str("bin", bin).Msg("terminated")
+		}
+	}()
+
+	return l.WaitForUpgradeOrExit(cmd)
+}
+
 // WaitForUpgradeOrExit checks upgrade plan file created by the app.
 // When it returns, the process (app) is finished.
 //
-// It returns (true, nil) if an upgrade should be initiated (and we killed the process)
+// It returns (true, nil) if an upgrade should be initiated (and we stopped the process)
 // It returns (false, err) if the process died by itself, or there was an issue reading the upgrade-info file.
 // It returns (false, nil) if the process exited normally without triggering an upgrade. This is very unlikely
 // to happened with "start" but may happened with short-lived commands like `gaiad export ...`
-func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
+//
+// If the process runs the binary of a pending upgrade, it also returns (false, err) after rolling back
+// the upgrade if the process dies or the node doesn't advance past the upgrade height within UpgradeTimeout.
+//
+// If the process must be restarted to apply the halt height of a scheduled upgrade, it returns (false, nil)
+// once the process is stopped.
+func (l *Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	currentUpgrade, err := l.cfg.UpgradeInfo()
 	if err != nil {
 		l.logger.Error().Err(err)
@@ -107,42 +218,192 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 		cmdDone <- cmd.Wait()
 	}()
 
+	upgradeNeeded := l.fw.MonitorUpdate(currentUpgrade)
+
+	var upgradeChecked <-chan error
+	if l.pending != nil {
+		stop := make(chan struct{})
+		defer close(stop)
+		upgradeChecked = l.checkPendingUpgrade(stop)
+	}
+
+	for {
+		select {
+		case <-upgradeNeeded:
+			if l.fw.needsRestart {
+				l.logger.Info().Str("upgrade", l.fw.restartedFor).Msg("daemon restarting to apply the halt height of the scheduled upgrade")
+				l.stop(cmd, cmdDone)
+				return false, nil
+			}
+			// upgrade - stop the process and restart
+			l.logger.Info().Msg("daemon shutting down in an attempt to restart")
+			l.stop(cmd, cmdDone)
+			return true, nil
+		case err := <-upgradeChecked:
+			if err == nil {
+				l.logger.Info().Str("upgrade", l.pending.Plan.Name).Msg("node advanced past the upgrade height")
+				l.pending, upgradeChecked = nil, nil
+				if err := os.Remove(l.cfg.PendingUpgradeFilePath()); err != nil && !os.IsNotExist(err) {
+					l.logger.Error().Err(err).Msg("error while removing " + pendingUpgradeFilename)
+				}
+				continue
+			}
+			l.logger.Error().Err(err).Msg("daemon shutting down in an attempt to roll back")
+			l.stop(cmd, cmdDone)
+			l.fw.Stop()
+			return false, l.doRollback(err)
+		case err := <-cmdDone:
+			l.fw.Stop()
+			// the app x/upgrade causes a panic and the app can die before the filwatcher finds the
+			// update, so we need to recheck update-info file. The app halted for a scheduled upgrade
+			// exits normally.
+			if l.fw.checkUpdateAfterExit(currentUpgrade, err) {
+				return true, nil
+			}
+			// no error -> command exits normally (eg. short command like `gaiad version`)
+			if err == nil {
+				return false, nil
+			}
+			if l.pending != nil {
+				return false, l.doRollback(fmt.Errorf("upgraded binary exited: %w", err))
+			}
+			return false, err
+		}
+	}
+}
+
+// stop stops the app gracefully with SIGTERM, so that it isn't interrupted in the middle of a block
+// commit, and kills it if it doesn't exit within the shutdown grace period. It returns once the app
+// exited, cmdDone receiving the result of cmd.Wait.
+func (l *Launcher) stop(cmd *exec.Cmd, cmdDone <-chan error) {
+	if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+		l.logger.Error().Err(err).Msg("failed to stop the app gracefully, killing it")
+		_ = cmd.Process.Kill()
+	}
+
+	grace := time.NewTimer(l.cfg.ShutdownGracePeriod())
+	defer grace.Stop()
+
 	select {
-	case <-l.fw.MonitorUpdate(currentUpgrade):
-		// upgrade - kill the process and restart
-		l.logger.Info().Msg("daemon shutting down in an attempt to restart")
+	case <-cmdDone:
+	case <-grace.C:
+		l.logger.Warn().Dur("grace", l.cfg.ShutdownGracePeriod()).Msg("app did not stop within the shutdown grace period, killing it")
 		_ = cmd.Process.Kill()
-	case err := <-cmdDone:
-		l.fw.Stop()
-		// no error -> command exits normally (eg. short command like `gaiad version`)
-		if err == nil {
-			return false, nil
-		}
-		// the app x/upgrade causes a panic and the app can die before the filwatcher finds the
-		// update, so we need to recheck update-info file.
-		if !l.fw.CheckUpdate(currentUpgrade) {
-			return false, err
+		<-cmdDone
+	}
+}
+
+// checkPendingUpgrade polls the height of the node until it advances past the height of the pending
+// upgrade. The returned channel receives nil once it does, or an error if it doesn't within UpgradeTimeout.
+func (l *Launcher) checkPendingUpgrade(stop <-chan struct{}) <-chan error {
+	res := make(chan error, 1)
+	plan := l.pending.Plan
+	timeout := time.NewTimer(l.cfg.UpgradeTimeout)
+	ticker := time.NewTicker(l.cfg.PollInterval)
+
+	go func() {
+		defer timeout.Stop()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-timeout.C:
+				res <- fmt.Errorf("node did not advance past upgrade height %d within %s", plan.Height, l.cfg.UpgradeTimeout)
+				return
+			case <-ticker.C:
+				// the node is not reachable until it starts, so errors are ignored until the timeout
+				if height, err := queryNodeHeight(l.cfg.RPCAddress); err == nil && height > plan.Height {
+					res <- nil
+					return
+				}
+			}
 		}
+	}()
+
+	return res
+}
+
+// queryNodeHeight returns the latest block height of the node from the status endpoint of its RPC server.
+func queryNodeHeight(rpcAddress string) (int64, error) {
+	// the address may be given as the rpc.laddr of the node config
+	rpcAddress = strings.Replace(rpcAddress, "tcp://", "http://", 1)
+
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Get(strings.TrimSuffix(rpcAddress, "/") + "/status")
+	if err != nil {
+		return 0, err
 	}
-	return true, nil
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status from %s: %s", rpcAddress, resp.Status)
+	}
+
+	var status struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
 }
 
-func (l Launcher) doBackup() error {
+// doRollback rolls back the pending upgrade, which failed with cause. It restores the data backup
+// taken before the upgrade and points the current symlink back to the binary running before it.
+// It returns an error wrapping cause, the upgrade being left to the operator.
+func (l *Launcher) doRollback(cause error) error {
+	pending := l.pending
+	l.pending = nil
+
+	l.logger.Error().Err(cause).Str("upgrade", pending.Plan.Name).Msg("upgrade failed, starting rollback")
+
+	// the pending upgrade file is removed with the data directory
+	dataDir := filepath.Join(l.cfg.Home, "data")
+	if err := os.RemoveAll(dataDir); err != nil {
+		return fmt.Errorf("error while removing data directory: %w", err)
+	}
+	if err := copy.Copy(pending.BackupDir, dataDir); err != nil {
+		return fmt.Errorf("error while restoring data backup %s: %w", pending.BackupDir, err)
+	}
+
+	link := filepath.Join(l.cfg.Root(), currentLink)
+	if err := os.Remove(link); err != nil {
+		return fmt.Errorf("error while removing current symlink: %w", err)
+	}
+	if err := os.Symlink(pending.PrevDir, link); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+	l.cfg.currentUpgrade = upgradetypes.Plan{}
+
+	l.logger.Info().Str("backup", pending.BackupDir).Str("binary dir", pending.PrevDir).Msg("rollback completed")
+
+	return fmt.Errorf("upgrade %q failed and was rolled back: %w", pending.Plan.Name, cause)
+}
+
+// doBackup takes a backup of the data directory, unless UnsafeSkipBackup is set, and returns its path.
+func (l Launcher) doBackup() (string, error) {
 	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
 	if !l.cfg.UnsafeSkipBackup {
 		// check if upgrade-info.json is not empty.
 		var uInfo upgradetypes.Plan
 		upgradeInfoFile, err := os.ReadFile(filepath.Join(l.cfg.Home, "data", "upgrade-info.json"))
 		if err != nil {
-			return fmt.Errorf("error while reading upgrade-info.json: %w", err)
+			return "", fmt.Errorf("error while reading upgrade-info.json: %w", err)
 		}
 
 		if err = json.Unmarshal(upgradeInfoFile, &uInfo); err != nil {
-			return err
+			return "", err
 		}
 
 		if uInfo.Name == "" {
-			return fmt.Errorf("upgrade-info.json is empty")
+			return "", fmt.Errorf("upgrade-info.json is empty")
 		}
 
 		// a destination directory, Format YYYY-MM-DD
@@ -154,15 +415,45 @@ func (l Launcher) doBackup() error {
 
 		// copy the $DAEMON_HOME/data to a backup dir
 		if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
+			return "", fmt.Errorf("error while taking data backup: %w", err)
 		}
 
 		// backup is done, lets check endtime to calculate total time taken for backup process
 		et := time.Now()
 		l.logger.Info().Str("backup saved at", dst).Time("backup completion time", et).TimeDiff("time taken to complete backup", et, st).Msg("backup completed")
+
+		return dst, nil
 	}
 
-	return nil
+	return "", nil
+}
+
+// doCustomPreUpgrade runs the custom pre-upgrade script configured by the operator, if any, with the
+// name and height of the upgrade as arguments. It runs before the binary is switched, and the upgrade
+// is aborted unless the script exits with code 0. Exit code 31 is retried up to PreupgradeMaxRetries
+// times, as for the pre-upgrade command of the application.
+func (l *Launcher) doCustomPreUpgrade() error {
+	script := l.cfg.PreupgradeScriptPath()
+	if script == "" {
+		return nil
+	}
+
+	upgrade := l.fw.currentInfo
+	for attempt := 1; ; attempt++ {
+		result, err := exec.Command(script, upgrade.Name, strconv.FormatInt(upgrade.Height, 10)).Output()
+		if err == nil {
+			l.logger.Info().Bytes("result", result).Msg("custom pre-upgrade script successful. continuing the upgrade.")
+			return nil
+		}
+
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 31 && attempt <= l.cfg.PreupgradeMaxRetries {
+			l.logger.Error().Err(err).Int("attempt", attempt).Msg("custom pre-upgrade script failed. retrying")
+			continue
+		}
+
+		return fmt.Errorf("custom pre-upgrade script %s failed: %w", script, err)
+	}
 }
 
 // doPreUpgrade runs the pre-upgrade command defined by the application and handles respective error codes.
@@ -5,6 +5,12 @@ package cosmovisor_test
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -164,6 +170,213 @@ func (s *processTestSuite) TestLaunchProcessWithDownloads() {
 	require.Equal(cfg.UpgradeBin("chain3"), currentBin)
 }
 
+// TestLaunchProcessWithPreupgradeScript runs the custom pre-upgrade scripts from testdata/rollback
+// and checks their exit codes are handled properly
+func (s *processTestSuite) TestLaunchProcessWithPreupgradeScript() {
+	cases := []struct {
+		script     string
+		maxRetries int
+		expectErr  bool
+	}{
+		{"scripts/pre-upgrade-retry.sh", 1, false},
+		{"scripts/pre-upgrade-retry.sh", 0, true},
+		{"scripts/pre-upgrade-fail.sh", 1, true},
+	}
+
+	for _, tc := range cases {
+		require := s.Require()
+		home := copyTestData(s.T(), "rollback")
+		cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, UnsafeSkipBackup: true, PreupgradeScript: tc.script, PreupgradeMaxRetries: tc.maxRetries}
+		logger := cosmovisor.NewLogger()
+
+		launcher, err := cosmovisor.NewLauncher(logger, cfg)
+		require.NoError(err)
+
+		doUpgrade, err := launcher.Run([]string{cfg.UpgradeInfoFilePath()}, NewBuffer(), NewBuffer())
+		currentBin, cerr := cfg.CurrentBin()
+		require.NoError(cerr)
+		if tc.expectErr {
+			require.Error(err, tc.script)
+			require.False(doUpgrade)
+			require.Equal(cfg.GenesisBin(), currentBin)
+		} else {
+			require.NoError(err, tc.script)
+			require.True(doUpgrade)
+			require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+		}
+	}
+}
+
+// TestLaunchProcessWithRollback upgrades with the binaries from testdata/rollback and checks the upgrade is
+// rolled back if the upgraded binary exits or the node doesn't advance past the upgrade height in time
+func (s *processTestSuite) TestLaunchProcessWithRollback() {
+	// the status endpoint of the node RPC server
+	var height atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"sync_info":{"latest_block_height":"%d"}}}`, height.Load())
+	}))
+	defer srv.Close()
+
+	cases := []struct {
+		mode      string
+		height    int64
+		expectErr string
+	}{
+		{"ok", 50, ""},
+		{"fail", 49, "upgraded binary exited"},
+		{"hang", 49, "did not advance past upgrade height 49"},
+	}
+
+	for _, tc := range cases {
+		require := s.Require()
+		home := copyTestData(s.T(), "rollback")
+		cfg := &cosmovisor.Config{
+			Home: home, Name: "dummyd", PollInterval: 20 * time.Millisecond, DataBackupPath: home,
+			UpgradeTimeout: 2 * time.Second, RPCAddress: srv.URL,
+		}
+		logger := cosmovisor.NewLogger()
+		upgradeFile := cfg.UpgradeInfoFilePath()
+		stateFile := filepath.Join(home, "data", "state")
+		height.Store(tc.height)
+
+		launcher, err := cosmovisor.NewLauncher(logger, cfg)
+		require.NoError(err)
+
+		doUpgrade, err := launcher.Run([]string{upgradeFile}, NewBuffer(), NewBuffer())
+		require.NoError(err)
+		require.True(doUpgrade)
+
+		// the pending upgrade is still checked after a restart of cosmovisor
+		require.FileExists(cfg.PendingUpgradeFilePath())
+		launcher, err = cosmovisor.NewLauncher(logger, cfg)
+		require.NoError(err)
+
+		start := time.Now()
+		doUpgrade, err = launcher.Run([]string{tc.mode, upgradeFile}, NewBuffer(), NewBuffer())
+		require.False(doUpgrade)
+		require.Less(time.Since(start), 5*time.Second, tc.mode)
+		require.NoFileExists(cfg.PendingUpgradeFilePath(), tc.mode)
+
+		currentBin, cerr := cfg.CurrentBin()
+		require.NoError(cerr)
+		state, serr := os.ReadFile(stateFile)
+		require.NoError(serr)
+
+		if tc.expectErr == "" {
+			require.NoError(err, tc.mode)
+			require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+			require.Equal("chain2\n", string(state))
+			continue
+		}
+
+		// the data backup and the genesis binary are restored
+		require.ErrorContains(err, tc.expectErr, tc.mode)
+		require.Equal(cfg.GenesisBin(), currentBin)
+		require.Equal("genesis\n", string(state))
+	}
+}
+
+// TestLaunchProcessWithScheduledUpgrade schedules an upgrade of the binaries from testdata/scheduled and checks
+// the upgrade is only done once the node stops at the halt height, the node being restarted to apply it if the
+// upgrade is scheduled while it runs
+func (s *processTestSuite) TestLaunchProcessWithScheduledUpgrade() {
+	// the status endpoint of the node RPC server
+	var height atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"sync_info":{"latest_block_height":"%d"}}}`, height.Load())
+	}))
+	defer srv.Close()
+
+	// an RPC server which is not reachable
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	plan := upgradetypes.Plan{Name: "chain2", Height: 50}
+
+	// the halt height can't be applied in time once the node is in the last two blocks before the upgrade
+	height.Store(48)
+	cfg := &cosmovisor.Config{Home: copyTestData(s.T(), "scheduled"), Name: "dummyd", RPCAddress: srv.URL}
+	s.Require().ErrorContains(cosmovisor.ScheduleUpgrade(cfg, plan), "too close to the upgrade height")
+
+	cases := []struct {
+		name          string
+		height        int64
+		reachedHeight int64
+		rpcDown       bool
+		scheduleLate  bool
+		expectUpgrade bool
+	}{
+		// the node is stopped by the halt height
+		{"halt height", 10, 48, false, false, true},
+		// the node is restarted to apply the halt height of an upgrade scheduled while it runs
+		{"restart", 10, 48, false, true, true},
+		// the node exits before the upgrade height
+		{"early exit", 10, 10, false, false, false},
+		// the node is assumed to be stopped by the halt height if its height is unknown
+		{"rpc down", 0, 0, true, false, true},
+	}
+
+	for _, tc := range cases {
+		require := s.Require()
+		home := copyTestData(s.T(), "scheduled")
+		rpcAddress := srv.URL
+		if tc.rpcDown {
+			rpcAddress = down.URL
+		}
+		cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20 * time.Millisecond, UnsafeSkipBackup: true, RPCAddress: rpcAddress}
+		logger := cosmovisor.NewLogger()
+		height.Store(tc.height)
+
+		scheduled := make(chan error, 1)
+		if tc.scheduleLate {
+			time.AfterFunc(200*time.Millisecond, func() { scheduled <- cosmovisor.ScheduleUpgrade(cfg, plan) })
+		} else {
+			scheduled <- cosmovisor.ScheduleUpgrade(cfg, plan)
+		}
+
+		launcher, err := cosmovisor.NewLauncher(logger, cfg)
+		require.NoError(err)
+
+		start := time.Now()
+		timer := time.AfterFunc(500*time.Millisecond, func() { height.Store(tc.reachedHeight) })
+		stdout := NewBuffer()
+		doUpgrade, err := launcher.Run([]string{"start"}, stdout, NewBuffer())
+		timer.Stop()
+		require.NoError(err)
+		require.NoError(<-scheduled)
+		require.Equal(tc.expectUpgrade, doUpgrade, tc.name)
+		require.Less(time.Since(start), 4*time.Second, tc.name)
+
+		launches := 1
+		if tc.scheduleLate {
+			launches = 2
+		}
+		require.Equal(strings.Repeat("Genesis start\n", launches), stdout.String(), tc.name)
+
+		currentBin, err := cfg.CurrentBin()
+		require.NoError(err)
+		appConfig, err := os.ReadFile(filepath.Join(home, "config", "app.toml"))
+		require.NoError(err)
+
+		if !tc.expectUpgrade {
+			require.Equal(cfg.GenesisBin(), currentBin)
+			require.Contains(string(appConfig), "\nhalt-height = 49\n")
+			continue
+		}
+
+		// the upgrade binary runs with the halt height reset
+		require.GreaterOrEqual(time.Since(start), 500*time.Millisecond, tc.name)
+		require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+		require.Contains(string(appConfig), "\nhalt-height = 0\n")
+
+		stdout = NewBuffer()
+		doUpgrade, err = launcher.Run([]string{"second", "run"}, stdout, NewBuffer())
+		require.NoError(err)
+		require.False(doUpgrade)
+		require.Equal("Chain 2 is live!\nArgs: second run\n", stdout.String())
+	}
+}
+
 // TestSkipUpgrade tests heights that are identified to be skipped and return if upgrade height matches the skip heights
 func TestSkipUpgrade(t *testing.T) {
 	cases := []struct {
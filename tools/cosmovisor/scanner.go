@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -27,6 +28,19 @@ type fileWatcher struct {
 	needsUpdate bool
 
 	initialized bool
+
+	// upgrades scheduled with ScheduleUpgrade are held until the node stops at the
+	// halt height of app.toml, the node being restarted once if it was started with
+	// another halt height. The height of the node reported by its RPC server tells
+	// whether it stopped at the halt height or before.
+	scheduledUpgrade func(name string) (upgradetypes.Plan, bool)
+	haltHeight       func() (int64, error)
+	startHaltHeight  int64 // halt height the node was started with
+	needsRestart     bool
+	restartedFor     string // name of the upgrade the node was last restarted for
+	rpcAddress       string
+	nodeHeight       atomic.Int64 // 0 if the RPC server of the node was not reached
+	rpcFailing       atomic.Bool
 }
 
 func newUpgradeFileWatcher(logger *zerolog.Logger, filename string, interval time.Duration) (*fileWatcher, error) {
@@ -94,6 +108,15 @@ func (fw *fileWatcher) MonitorUpdate(currentUpgrade upgradetypes.Plan) <-chan st
 // currentName is the name of currently running upgrade. The check is rejected if it finds
 // an upgrade with the same name.
 func (fw *fileWatcher) CheckUpdate(currentUpgrade upgradetypes.Plan) bool {
+	return fw.checkUpdate(currentUpgrade, false, nil)
+}
+
+// checkUpdateAfterExit checks if there is a new update request once the app exited with exitErr.
+func (fw *fileWatcher) checkUpdateAfterExit(currentUpgrade upgradetypes.Plan, exitErr error) bool {
+	return fw.checkUpdate(currentUpgrade, true, exitErr)
+}
+
+func (fw *fileWatcher) checkUpdate(currentUpgrade upgradetypes.Plan, exited bool, exitErr error) bool {
 	if fw.needsUpdate {
 		return true
 	}
@@ -114,6 +137,12 @@ func (fw *fileWatcher) CheckUpdate(currentUpgrade upgradetypes.Plan) bool {
 		return false
 	}
 
+	// a scheduled upgrade is checked again until the node stops at its halt height, the node
+	// being restarted if needed to apply it
+	if !strings.EqualFold(currentUpgrade.Name, info.Name) && fw.held(info, exited, exitErr) {
+		return !exited && fw.needsRestart
+	}
+
 	if !fw.initialized {
 		// daemon has restarted
 		fw.initialized = true
@@ -139,6 +168,61 @@ func (fw *fileWatcher) CheckUpdate(currentUpgrade upgradetypes.Plan) bool {
 	return false
 }
 
+// held returns true if info is an upgrade scheduled with ScheduleUpgrade whose height is not reached.
+// The node stops by itself at the halt height, once it committed the block before the upgrade height, so
+// the upgrade is held as long as the app runs. If the app was started with another halt height, needsRestart
+// is set so that it is restarted once with the halt height of the upgrade.
+// Once the app exited, the upgrade is due if the node was seen in the last two blocks before the upgrade
+// height. If the RPC server of the node was never reached, the upgrade is due if the app exited without
+// error with the halt height of the upgrade still set in app.toml.
+func (fw *fileWatcher) held(info upgradetypes.Plan, exited bool, exitErr error) bool {
+	if fw.scheduledUpgrade == nil {
+		return false
+	}
+	if scheduled, ok := fw.scheduledUpgrade(info.Name); !ok || scheduled.Height != info.Height {
+		return false
+	}
+
+	if exited {
+		if height := fw.nodeHeight.Load(); height > 0 || exitErr != nil || fw.haltHeight == nil {
+			return height < info.Height-2
+		}
+
+		haltHeight, err := fw.haltHeight()
+		if err != nil {
+			fw.logger.Error().Err(err).Str("upgrade", info.Name).Msg("failed to read the halt height of the scheduled upgrade")
+			return true
+		}
+		if haltHeight != info.Height-1 {
+			return true
+		}
+
+		fw.logger.Warn().Str("upgrade", info.Name).Int64("halt height", haltHeight).Msg("node height unknown, assuming the node stopped at the halt height")
+		return false
+	}
+
+	if fw.startHaltHeight != info.Height-1 && fw.restartedFor != info.Name {
+		fw.needsRestart, fw.restartedFor = true, info.Name
+		return true
+	}
+
+	height, err := queryNodeHeight(fw.rpcAddress)
+	if err != nil {
+		// the node is not reachable until it starts, so only the first failure is logged
+		if !fw.rpcFailing.Swap(true) {
+			fw.logger.Warn().Err(err).Str("upgrade", info.Name).Msg("failed to query the node height for the scheduled upgrade, retrying")
+		}
+		return true
+	}
+
+	if fw.rpcFailing.Swap(false) {
+		fw.logger.Info().Str("upgrade", info.Name).Int64("height", height).Msg("node height queried for the scheduled upgrade")
+	}
+	fw.nodeHeight.Store(height)
+
+	return true
+}
+
 func parseUpgradeInfoFile(filename string) (upgradetypes.Plan, error) {
 	var ui upgradetypes.Plan
 
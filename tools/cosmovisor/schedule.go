@@ -0,0 +1,136 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// scheduledUpgradeFileName is the file registering an upgrade scheduled with ScheduleUpgrade in its
+// upgrade directory.
+const scheduledUpgradeFileName = "scheduled-upgrade.json"
+
+// haltHeightRegexp matches the halt-height option of the app.toml file.
+var haltHeightRegexp = regexp.MustCompile(`(?m)^halt-height[ \t]*=[ \t]*(\d+)[ \t]*$`)
+
+// AppConfigPath is the path to the app.toml file of the node.
+func (cfg *Config) AppConfigPath() string {
+	return filepath.Join(cfg.Home, "config", "app.toml")
+}
+
+// ScheduledUpgrade returns the upgrade registered with ScheduleUpgrade under name, if any.
+func (cfg *Config) ScheduledUpgrade(name string) (upgradetypes.Plan, bool) {
+	plan, err := parseUpgradeInfoFile(filepath.Join(cfg.UpgradeDir(name), scheduledUpgradeFileName))
+	if err != nil {
+		return upgradetypes.Plan{}, false
+	}
+	return plan, true
+}
+
+// ScheduleUpgrade schedules an upgrade which doesn't go through x/upgrade, e.g. a coordinated hard fork.
+// The binary of the upgrade must be in place. The upgrade is registered in its upgrade directory and
+// written to the monitored upgrade-info.json file, and halt-height is set in app.toml to the block
+// before the upgrade height, so that the node stops once it committed that block and the upgrade binary
+// runs the block at the upgrade height as with x/upgrade. The new halt height only applies once the node
+// restarts, which Cosmovisor does when it detects the upgrade, so the upgrade is refused if the node is
+// already in the last two blocks before the upgrade height.
+func ScheduleUpgrade(cfg *Config, plan upgradetypes.Plan) error {
+	plan.Name = strings.ToLower(plan.Name)
+	if plan.Name == "" {
+		return errors.New("upgrade name must not be empty")
+	}
+	if plan.Height <= 1 {
+		return fmt.Errorf("upgrade height must be greater than 1, got %d", plan.Height)
+	}
+
+	if err := EnsureBinary(cfg.UpgradeBin(plan.Name)); err != nil {
+		return fmt.Errorf("binary of upgrade %q is invalid: %w", plan.Name, err)
+	}
+
+	// the monitored file is only checked for upgrades at increasing heights
+	if current, err := parseUpgradeInfoFile(cfg.UpgradeInfoFilePath()); err == nil && current.Height >= plan.Height {
+		return fmt.Errorf("upgrade %q is already planned at height %d, which is not before %d", current.Name, current.Height, plan.Height)
+	}
+
+	// the node must have a block to restart before it commits the block before the upgrade height
+	if height, err := queryNodeHeight(cfg.RPCAddress); err == nil && height >= plan.Height-2 {
+		return fmt.Errorf("node is at height %d, too close to the upgrade height %d to apply the halt height in time", height, plan.Height)
+	}
+
+	bz, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(cfg.UpgradeDir(plan.Name), scheduledUpgradeFileName), bz, 0o644); err != nil {
+		return fmt.Errorf("error while registering upgrade: %w", err)
+	}
+
+	if err := setHaltHeight(cfg, plan.Height-1); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(cfg.UpgradeInfoFilePath(), bz, 0o644); err != nil {
+		return fmt.Errorf("error while writing upgrade-info.json: %w", err)
+	}
+
+	return nil
+}
+
+// clearScheduledHaltHeight resets the halt height set by ScheduleUpgrade for plan once its binary is
+// in place, as it would halt the upgrade binary.
+func clearScheduledHaltHeight(cfg *Config, plan upgradetypes.Plan) error {
+	scheduled, ok := cfg.ScheduledUpgrade(plan.Name)
+	if !ok || scheduled.Height != plan.Height {
+		return nil
+	}
+
+	haltHeight, err := getHaltHeight(cfg)
+	if err != nil || haltHeight != plan.Height-1 {
+		return err
+	}
+
+	return setHaltHeight(cfg, 0)
+}
+
+func getHaltHeight(cfg *Config) (int64, error) {
+	bz, err := os.ReadFile(cfg.AppConfigPath())
+	if err != nil {
+		return 0, fmt.Errorf("error while reading app config: %w", err)
+	}
+
+	match := haltHeightRegexp.FindSubmatch(bz)
+	if match == nil {
+		return 0, fmt.Errorf("halt-height not found in %s", cfg.AppConfigPath())
+	}
+
+	return strconv.ParseInt(string(match[1]), 10, 64)
+}
+
+// setHaltHeight sets the halt-height option in app.toml, leaving the rest of the file untouched.
+func setHaltHeight(cfg *Config, height int64) error {
+	path := cfg.AppConfigPath()
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error while reading app config: %w", err)
+	}
+
+	if !haltHeightRegexp.Match(bz) {
+		return fmt.Errorf("halt-height not found in %s", path)
+	}
+	bz = haltHeightRegexp.ReplaceAll(bz, []byte(fmt.Sprintf("halt-height = %d", height)))
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, info.Mode().Perm())
+}
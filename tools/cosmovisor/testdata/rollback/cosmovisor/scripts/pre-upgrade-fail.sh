@@ -0,0 +1,4 @@
+#!/bin/sh
+
+echo Pre-upgrade $@
+exit 1
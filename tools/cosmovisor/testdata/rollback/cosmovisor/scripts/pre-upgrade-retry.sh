@@ -0,0 +1,8 @@
+#!/bin/sh
+
+echo Pre-upgrade $@
+# fails with the retry exit code on the first run
+if [ ! -f $(dirname $0)/attempted ]; then
+  touch $(dirname $0)/attempted
+  exit 31
+fi
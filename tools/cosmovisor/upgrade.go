@@ -1,15 +1,23 @@
 package cosmovisor
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
+	"io"
 	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/x/upgrade/plan"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/hashicorp/go-getter"
 	"github.com/otiai10/copy"
@@ -23,6 +31,16 @@ func UpgradeBinary(logger *zerolog.Logger, cfg *Config, info upgradetypes.Plan)
 	// simplest case is to switch the link
 	err := EnsureBinary(cfg.UpgradeBin(info.Name))
 	if err == nil {
+		if cfg.VerifyBinaryChecksum {
+			err := VerifyBinaryChecksum(cfg.UpgradeBin(info.Name), info)
+			switch {
+			case errors.Is(err, ErrArchiveChecksum):
+				logger.Warn().Err(err).Msg("skipping the checksum verification of the upgrade binary")
+			case err != nil:
+				return err
+			}
+		}
+
 		// we have the binary - do it
 		return cfg.SetCurrentUpgrade(info)
 	}
@@ -44,6 +62,17 @@ func UpgradeBinary(logger *zerolog.Logger, cfg *Config, info upgradetypes.Plan)
 		return fmt.Errorf("unhandled error: %w", err)
 	}
 
+	// the download verifies the checksum of the url, if any
+	if cfg.VerifyBinaryChecksum {
+		url, err := GetDownloadURL(info)
+		if err != nil {
+			return err
+		}
+		if err := plan.ValidateIsURLWithChecksum(url); err != nil {
+			return fmt.Errorf("cannot verify the checksum of upgrade %q: %w", info.Name, err)
+		}
+	}
+
 	// If not there, then we try to download it... maybe
 	logger.Info().Msg("no upgrade binary found, beginning to download it")
 	if err := DownloadBinary(cfg, info); err != nil {
@@ -154,6 +183,99 @@ func GetDownloadURL(info upgradetypes.Plan) (string, error) {
 	return "", errors.New("upgrade info doesn't contain binary map")
 }
 
+// ErrArchiveChecksum is returned by VerifyBinaryChecksum when the download url of the binary references
+// an archive, whose checksum can only be verified by downloading it.
+var ErrArchiveChecksum = errors.New("the checksum of the download url is the checksum of an archive")
+
+// VerifyBinaryChecksum checks that the binary at path matches the checksum of its download url in the
+// upgrade info, given as the checksum=type:value query parameter used to verify downloads.
+// The supported checksum types are md5, sha1, sha256 and sha512.
+// If the url references an archive, which go-getter unpacks, it returns ErrArchiveChecksum.
+func VerifyBinaryChecksum(path string, info upgradetypes.Plan) error {
+	url, err := GetDownloadURL(info)
+	if err != nil {
+		return err
+	}
+
+	h, expected, err := parseChecksum(url)
+	if err != nil {
+		return fmt.Errorf("cannot verify the checksum of upgrade %q: %w", info.Name, err)
+	}
+
+	if isArchiveURL(url) {
+		return fmt.Errorf("cannot verify binary %s of upgrade %q: %w", path, info.Name, ErrArchiveChecksum)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("reading binary %s: %w", path, err)
+	}
+
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != expected {
+		return fmt.Errorf("checksum mismatch for binary %s of upgrade %q: expected %s, got %s", path, info.Name, expected, actual)
+	}
+
+	return nil
+}
+
+// isArchiveURL reports whether go-getter unpacks the file downloaded from rawURL, as selected by its
+// archive query parameter or else by its extension.
+func isArchiveURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	if archive := u.Query().Get("archive"); archive != "" {
+		_, ok := getter.Decompressors[archive]
+		return ok
+	}
+
+	for ext := range getter.Decompressors {
+		if strings.HasSuffix(u.Path, "."+ext) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseChecksum returns the hash and the expected hex encoded checksum of the checksum query parameter of url.
+func parseChecksum(rawURL string) (hash.Hash, string, error) {
+	if err := plan.ValidateIsURLWithChecksum(rawURL); err != nil {
+		return nil, "", err
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	checksum := u.Query().Get("checksum")
+	typ, value, ok := strings.Cut(checksum, ":")
+	if !ok {
+		return nil, "", fmt.Errorf("invalid checksum %q, expected type:value", checksum)
+	}
+
+	switch typ {
+	case "md5":
+		return md5.New(), strings.ToLower(value), nil
+	case "sha1":
+		return sha1.New(), strings.ToLower(value), nil
+	case "sha256":
+		return sha256.New(), strings.ToLower(value), nil
+	case "sha512":
+		return sha512.New(), strings.ToLower(value), nil
+	default:
+		return nil, "", fmt.Errorf("unsupported checksum type %q", typ)
+	}
+}
+
 func OSArch() string {
 	return fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
 }
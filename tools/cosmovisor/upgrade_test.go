@@ -4,6 +4,8 @@
 package cosmovisor_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
@@ -127,6 +129,62 @@ func (s *upgradeTestSuite) TestUpgradeBinaryNoDownloadUrl() {
 	}
 }
 
+func (s *upgradeTestSuite) TestUpgradeBinaryVerifyChecksum() {
+	home := copyTestData(s.T(), "validate")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", AllowDownloadBinaries: true, VerifyBinaryChecksum: true}
+	logger := cosmovisor.NewLogger()
+
+	bz, err := os.ReadFile(cfg.UpgradeBin("chain2"))
+	s.Require().NoError(err)
+	checksum := sha256.Sum256(bz)
+
+	planInfo := func(query string) string {
+		return fmt.Sprintf(`{"binaries":{"any":"https://example.com/dummyd%s"}}`, query)
+	}
+
+	// the binary must match the checksum of the plan info
+	for name, info := range map[string]string{
+		"no download url":   "",
+		"no checksum":       planInfo(""),
+		"bad checksum type": planInfo("?checksum=crc32:00000000"),
+		"checksum mismatch": planInfo("?checksum=sha256:" + strings.Repeat("0", 64)),
+	} {
+		err := cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "chain2", Info: info})
+		s.Require().Error(err, name)
+		currentBin, err := cfg.CurrentBin()
+		s.Require().NoError(err)
+		s.Require().Equal(cfg.GenesisBin(), currentBin, name)
+	}
+
+	// downloads without checksum are rejected before fetching the url
+	err = cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "chain4", Info: planInfo("")})
+	s.Require().ErrorContains(err, "missing checksum query parameter")
+
+	info := upgradetypes.Plan{Name: "chain2", Info: planInfo("?checksum=sha256:" + hex.EncodeToString(checksum[:]))}
+	s.Require().NoError(cosmovisor.VerifyBinaryChecksum(cfg.UpgradeBin("chain2"), info))
+	s.Require().Error(cosmovisor.VerifyBinaryChecksum(cfg.UpgradeBin("chain3"), info))
+
+	// the checksum of an archive cannot be verified against the unpacked binary, so it is skipped
+	zeroChecksum := "checksum=sha256:" + strings.Repeat("0", 64)
+	for _, url := range []string{"dummyd.zip?" + zeroChecksum, "dummyd.tar.gz?" + zeroChecksum, "dummyd?archive=zip&" + zeroChecksum} {
+		archive := upgradetypes.Plan{Name: "chain2", Info: fmt.Sprintf(`{"binaries":{"any":"https://example.com/%s"}}`, url)}
+		s.Require().ErrorIs(cosmovisor.VerifyBinaryChecksum(cfg.UpgradeBin("chain2"), archive), cosmovisor.ErrArchiveChecksum, url)
+	}
+	notArchive := upgradetypes.Plan{Name: "chain2", Info: planInfo("?archive=false&" + zeroChecksum)}
+	s.Require().ErrorContains(cosmovisor.VerifyBinaryChecksum(cfg.UpgradeBin("chain2"), notArchive), "checksum mismatch")
+
+	s.Require().NoError(cosmovisor.UpgradeBinary(logger, cfg, info))
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("chain2"), currentBin)
+
+	// the upgrade to a binary placed manually is not blocked by the checksum of its archive
+	s.Require().NoError(cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "chain3", Info: planInfo(".zip?" + zeroChecksum)}))
+	currentBin, err = cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("chain3"), currentBin)
+}
+
 func (s *upgradeTestSuite) TestOsArch() {
 	// all download tests will fail if we are not on linux...
 	s.Require().Equal("linux/amd64", cosmovisor.OSArch())
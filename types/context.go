@@ -279,12 +279,21 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.kvGasConfig)
+	return c.wrapGasKVStore(key, gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.kvGasConfig))
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.transientKVGasConfig)
+	return c.wrapGasKVStore(key, gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.transientKVGasConfig))
+}
+
+// wrapGasKVStore lets the gas meter wrap the gas KVStore of key if it is a
+// StoreGasMeter.
+func (c Context) wrapGasKVStore(key storetypes.StoreKey, store KVStore) KVStore {
+	if meter, ok := c.GasMeter().(storetypes.StoreGasMeter); ok {
+		return meter.WrapKVStore(key, store)
+	}
+	return store
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -0,0 +1,285 @@
+package module
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// genesisStreamEntryPrefix is the prefix of the lines of a JSONL genesis stream
+// holding the genesis state of a module.
+var genesisStreamEntryPrefix = []byte(`{"module":`)
+
+// GenesisStreamEntry is a line of a JSONL genesis stream, holding the genesis
+// state of a module.
+//
+// A JSONL genesis stream starts with a line holding the genesis document without
+// its app state, followed by a line per module, starting with the module name:
+//
+//	{"app_hash":"","chain_id":"testchain","consensus_params":{...},...}
+//	{"module":"auth","app_state":{...}}
+//	{"module":"bank","app_state":{...}}
+type GenesisStreamEntry struct {
+	Module   string          `json:"module"`
+	AppState json.RawMessage `json:"app_state"`
+}
+
+// GenesisStream provides the genesis states of the modules one at a time.
+type GenesisStream interface {
+	// ModuleGenesis returns the genesis state of a module, or nil if the
+	// stream has none.
+	ModuleGenesis(moduleName string) (json.RawMessage, error)
+}
+
+// InitGenesisStream performs init genesis functionality for modules like
+// InitGenesis, but reads the genesis state of each module from stream only when
+// initializing it, so that the app state is never held in memory as a whole.
+func (m *Manager) InitGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, stream GenesisStream) abci.ResponseInitChain {
+	ctx.Logger().Info("initializing blockchain state from genesis stream")
+	return m.initGenesis(ctx, cdc, stream.ModuleGenesis)
+}
+
+// InitGenesisFromStreamFile performs init genesis functionality for modules
+// with the JSONL genesis stream file at path, whose chain ID must match the
+// chain ID of ctx.
+func (m *Manager) InitGenesisFromStreamFile(ctx sdk.Context, cdc codec.JSONCodec, path string) abci.ResponseInitChain {
+	stream, err := OpenGenesisStreamFile(path)
+	if err != nil {
+		panic(err)
+	}
+	defer stream.Close()
+
+	if chainID := stream.GenesisDoc().ChainID; chainID != ctx.ChainID() {
+		panic(fmt.Sprintf("genesis stream %s is for chain %s, expected %s", path, chainID, ctx.ChainID()))
+	}
+
+	return m.InitGenesisStream(ctx, cdc, stream)
+}
+
+// ExportGenesisStream performs export genesis functionality for modules like
+// ExportGenesisForModules, but exports the modules one at a time and passes the
+// genesis state of each module to fn as soon as it is exported, so that the app
+// state is never held in memory as a whole.
+func (m *Manager) ExportGenesisStream(ctx sdk.Context, cdc codec.JSONCodec, modulesToExport []string, fn func(moduleName string, genesis json.RawMessage) error) error {
+	if len(modulesToExport) == 0 {
+		modulesToExport = m.OrderExportGenesis
+	}
+
+	// verify modules exists in app, so that we don't fail in the middle of an export
+	if err := m.checkModulesExists(modulesToExport); err != nil {
+		return err
+	}
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, moduleName := range modulesToExport {
+		module, ok := m.Modules[moduleName].(HasGenesis)
+		if !ok {
+			continue
+		}
+
+		ctx.Logger().Debug("exporting genesis for module", "module", moduleName)
+		if err := fn(moduleName, module.ExportGenesis(ctx, cdc)); err != nil {
+			return fmt.Errorf("failed to write the genesis state of module %s: %w", moduleName, err)
+		}
+	}
+
+	return nil
+}
+
+// GenesisStreamWriter writes a JSONL genesis stream.
+type GenesisStreamWriter struct {
+	w *bufio.Writer
+}
+
+// NewGenesisStreamWriter returns a GenesisStreamWriter writing to w, after
+// writing the genesis document doc without its app state.
+func NewGenesisStreamWriter(w io.Writer, doc *tmtypes.GenesisDoc) (*GenesisStreamWriter, error) {
+	header := *doc
+	header.AppState = nil
+
+	bz, err := tmjson.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	if bz, err = sdk.SortJSON(bz); err != nil {
+		return nil, err
+	}
+
+	sw := &GenesisStreamWriter{w: bufio.NewWriter(w)}
+	if _, err := sw.w.Write(append(bz, '\n')); err != nil {
+		return nil, err
+	}
+
+	return sw, nil
+}
+
+// WriteModuleGenesis writes the genesis state of a module. Empty genesis states
+// are skipped, as the modules without genesis state are not initialized.
+func (w *GenesisStreamWriter) WriteModuleGenesis(moduleName string, genesis json.RawMessage) error {
+	if len(genesis) == 0 {
+		return nil
+	}
+
+	// sorting the genesis state also compacts it to a single line
+	state, err := sdk.SortJSON(genesis)
+	if err != nil {
+		return err
+	}
+
+	name, err := json.Marshal(moduleName)
+	if err != nil {
+		return err
+	}
+
+	for _, bz := range [][]byte{genesisStreamEntryPrefix, name, []byte(`,"app_state":`), state, []byte("}\n")} {
+		if _, err := w.w.Write(bz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *GenesisStreamWriter) Flush() error {
+	return w.w.Flush()
+}
+
+var _ GenesisStream = (*GenesisStreamFile)(nil)
+
+// GenesisStreamFile is a GenesisStream reading a JSONL genesis stream file. It
+// indexes the lines of the modules when opened, and reads the genesis state of
+// a module only when requested.
+type GenesisStreamFile struct {
+	file    *os.File
+	doc     *tmtypes.GenesisDoc
+	offsets map[string]int64
+}
+
+// OpenGenesisStreamFile opens the JSONL genesis stream file at path.
+func OpenGenesisStreamFile(path string) (*GenesisStreamFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &GenesisStreamFile{file: file, offsets: make(map[string]int64)}
+	if err := stream.index(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("invalid genesis stream %s: %w", path, err)
+	}
+
+	return stream, nil
+}
+
+// index reads the genesis document and the offsets of the lines of the modules,
+// without holding their genesis states in memory.
+func (f *GenesisStreamFile) index() error {
+	r := bufio.NewReaderSize(f.file, 64*1024)
+
+	header, err := r.ReadBytes('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && len(header) > 0) {
+		return fmt.Errorf("failed to read the genesis document: %w", err)
+	}
+
+	f.doc = &tmtypes.GenesisDoc{}
+	if err := tmjson.Unmarshal(header, f.doc); err != nil {
+		return fmt.Errorf("failed to decode the genesis document: %w", err)
+	}
+
+	offset := int64(len(header))
+	for {
+		line, err := r.ReadSlice('\n')
+		if len(line) == 0 && errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil && !errors.Is(err, bufio.ErrBufferFull) && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		lineOffset, lineLen := offset, int64(len(line))
+		if len(bytes.TrimSpace(line)) > 0 {
+			moduleName, err := decodeGenesisStreamModule(line)
+			if err != nil {
+				return fmt.Errorf("line at offset %d: %w", lineOffset, err)
+			}
+			if _, ok := f.offsets[moduleName]; ok {
+				return fmt.Errorf("duplicate genesis state for module %s", moduleName)
+			}
+			f.offsets[moduleName] = lineOffset
+		}
+
+		// skip the rest of the line
+		for errors.Is(err, bufio.ErrBufferFull) {
+			line, err = r.ReadSlice('\n')
+			lineLen += int64(len(line))
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		offset += lineLen
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+}
+
+// decodeGenesisStreamModule decodes the module name at the start of a line.
+func decodeGenesisStreamModule(line []byte) (string, error) {
+	if !bytes.HasPrefix(line, genesisStreamEntryPrefix) {
+		return "", fmt.Errorf("expected a line starting with %s", genesisStreamEntryPrefix)
+	}
+
+	var moduleName string
+	if err := json.NewDecoder(bytes.NewReader(line[len(genesisStreamEntryPrefix):])).Decode(&moduleName); err != nil {
+		return "", fmt.Errorf("failed to decode the module name: %w", err)
+	}
+
+	return moduleName, nil
+}
+
+// GenesisDoc returns the genesis document of the stream, without app state.
+func (f *GenesisStreamFile) GenesisDoc() *tmtypes.GenesisDoc {
+	return f.doc
+}
+
+// ModuleGenesis implements the GenesisStream interface.
+func (f *GenesisStreamFile) ModuleGenesis(moduleName string) (json.RawMessage, error) {
+	offset, ok := f.offsets[moduleName]
+	if !ok {
+		return nil, nil
+	}
+
+	if _, err := f.file.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	line, err := bufio.NewReader(f.file).ReadBytes('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	var entry GenesisStreamEntry
+	if err := json.Unmarshal(line, &entry); err != nil {
+		return nil, err
+	}
+
+	return entry.AppState, nil
+}
+
+// Close closes the genesis stream file.
+func (f *GenesisStreamFile) Close() error {
+	return f.file.Close()
+}
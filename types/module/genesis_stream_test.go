@@ -0,0 +1,93 @@
+package module_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+func TestManager_GenesisStream(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mock.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule2 := mock.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+
+	ctx := sdk.NewContext(nil, tmproto.Header{ChainID: "test-chain"}, false, log.NewNopLogger())
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	// the genesis state of module2 exceeds the read buffer of the stream index
+	largeValue := strings.Repeat("a", 100*1024)
+	mockAppModule1.EXPECT().ExportGenesis(gomock.Any(), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key1": "value1"}`))
+	mockAppModule2.EXPECT().ExportGenesis(gomock.Any(), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key2": "` + largeValue + `"}`))
+
+	path := filepath.Join(t.TempDir(), "genesis.jsonl")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+
+	sw, err := module.NewGenesisStreamWriter(file, &tmtypes.GenesisDoc{ChainID: "test-chain", AppState: json.RawMessage(`{}`)})
+	require.NoError(t, err)
+	require.NoError(t, mm.ExportGenesisStream(ctx, cdc, []string{"module2", "module1"}, sw.WriteModuleGenesis))
+	require.NoError(t, sw.Flush())
+	require.NoError(t, file.Close())
+
+	require.Error(t, mm.ExportGenesisStream(ctx, cdc, []string{"modulefoo"}, sw.WriteModuleGenesis))
+
+	stream, err := module.OpenGenesisStreamFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "test-chain", stream.GenesisDoc().ChainID)
+	require.Nil(t, stream.GenesisDoc().AppState)
+
+	genesis, err := stream.ModuleGenesis("module1")
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(`{"key1":"value1"}`), genesis)
+
+	genesis, err = stream.ModuleGenesis("modulefoo")
+	require.NoError(t, err)
+	require.Nil(t, genesis)
+	require.NoError(t, stream.Close())
+
+	// the modules are initialized in the init genesis order, whatever the stream order
+	module1Init := mockAppModule1.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{"key1":"value1"}`))).Times(1).Return([]abci.ValidatorUpdate{{}})
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(json.RawMessage(`{"key2":"`+largeValue+`"}`))).Times(1).Return(nil).After(module1Init)
+	res := mm.InitGenesisFromStreamFile(ctx, cdc, path)
+	require.Len(t, res.Validators, 1)
+
+	require.Panics(t, func() {
+		mm.InitGenesisFromStreamFile(ctx.WithChainID("other-chain"), cdc, path)
+	})
+}
+
+func TestOpenGenesisStreamFile_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"no header":        "",
+		"invalid entry":    `{"chain_id":"test-chain"}` + "\n" + `{"app_state":{}}` + "\n",
+		"duplicate module": `{"chain_id":"test-chain"}` + "\n" + `{"module":"bank","app_state":{}}` + "\n" + `{"module":"bank","app_state":{}}` + "\n",
+	} {
+		path := filepath.Join(dir, strings.ReplaceAll(name, " ", "_"))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		_, err := module.OpenGenesisStreamFile(path)
+		require.Error(t, err, name)
+	}
+}
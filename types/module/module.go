@@ -344,17 +344,29 @@ func (m *Manager) RegisterServices(cfg Configurator) {
 // module must return a non-empty validator set update to correctly initialize
 // the chain.
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
-	var validatorUpdates []abci.ValidatorUpdate
 	ctx.Logger().Info("initializing blockchain state from genesis.json")
+	return m.initGenesis(ctx, cdc, func(moduleName string) (json.RawMessage, error) {
+		return genesisData[moduleName], nil
+	})
+}
+
+// initGenesis initializes the modules in OrderInitGenesis with the genesis
+// states returned by moduleGenesis, skipping the modules without any.
+func (m *Manager) initGenesis(ctx sdk.Context, cdc codec.JSONCodec, moduleGenesis func(moduleName string) (json.RawMessage, error)) abci.ResponseInitChain {
+	var validatorUpdates []abci.ValidatorUpdate
 	for _, moduleName := range m.OrderInitGenesis {
-		if genesisData[moduleName] == nil {
+		genesis, err := moduleGenesis(moduleName)
+		if err != nil {
+			panic(fmt.Sprintf("failed to read the genesis state of module %s: %v", moduleName, err))
+		}
+		if genesis == nil {
 			continue
 		}
 
 		if module, ok := m.Modules[moduleName].(HasGenesis); ok {
 			ctx.Logger().Debug("running initialization for module", "module", moduleName)
 
-			moduleValUpdates := module.InitGenesis(ctx, cdc, genesisData[moduleName])
+			moduleValUpdates := module.InitGenesis(ctx, cdc, genesis)
 
 			// use these validator updates if provided, the module manager assumes
 			// only one module will update the validator set
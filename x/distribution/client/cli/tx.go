@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -40,6 +41,7 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
 	)
 
 	return distTxCmd
@@ -254,3 +256,40 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 
 	return cmd
 }
+
+// NewWithdrawTokenizeShareRecordRewardCmd returns a CLI command handler for creating a MsgWithdrawTokenizeShareRecordReward transaction.
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards [record-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw the rewards of a tokenize share record",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of a tokenize share record to its owner.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			ownerAddr := clientCtx.GetFromAddress()
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(ownerAddr, recordID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cometbft/cometbft/libs/log"
 
@@ -10,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -102,6 +104,51 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation
+// of a tokenize share record and sends all the rewards held by the record
+// module account to the record owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Owner != ownerAddr.String() {
+		return nil, stakingtypes.ErrNotTokenizeShareRecordOwner
+	}
+
+	recordAddr := record.GetModuleAddress()
+	valAddr := record.GetValidatorAddr()
+
+	// the rewards of the delegation are withdrawn to the record module account
+	// first, as it may also hold rewards withdrawn by delegation changes
+	if val := k.stakingKeeper.Validator(ctx, valAddr); val != nil {
+		if del := k.stakingKeeper.Delegation(ctx, recordAddr, valAddr); del != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	rewards := k.bankKeeper.SpendableCoins(ctx, recordAddr)
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, ownerAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(recordID, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		),
+	)
+
+	return rewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
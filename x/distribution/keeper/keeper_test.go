@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -199,3 +200,54 @@ func TestFundCommunityPool(t *testing.T) {
 
 	require.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), distrKeeper.GetFeePool(ctx).CommunityPool)
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+
+	owner := addrs[0]
+	valAddr := sdk.ValAddress(addrs[1])
+	record := stakingtypes.NewTokenizeShareRecord(1, owner, valAddr)
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(1)).Return(record, nil).AnyTimes()
+	stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(2)).Return(stakingtypes.TokenizeShareRecord{}, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	// the record does not exist
+	_, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner, 2)
+	require.ErrorIs(t, err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	// only the record owner can withdraw its rewards
+	_, err = distrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[1], 1)
+	require.ErrorIs(t, err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	// the rewards held by the record module account are sent to the owner,
+	// even once the validator is gone
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(nil)
+	bankKeeper.EXPECT().SpendableCoins(gomock.Any(), record.GetModuleAddress()).Return(rewards)
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), record.GetModuleAddress(), owner, rewards).Return(nil)
+
+	res, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner, 1)
+	require.NoError(t, err)
+	require.Equal(t, rewards, res)
+}
@@ -82,6 +82,7 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 					{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 					{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 					{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+					{Account: stakingtypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 				},
 			}),
 		},
@@ -9,7 +9,10 @@ const (
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
 
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyShareRecordID   = "share_record_id"
 )
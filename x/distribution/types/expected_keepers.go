@@ -23,6 +23,7 @@ type BankKeeper interface {
 
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -52,6 +53,8 @@ type StakingKeeper interface {
 	GetAllValidators(ctx sdk.Context) (validators []stakingtypes.Validator)
 	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
 	GetParams(ctx sdk.Context) stakingtypes.Params
+
+	GetTokenizeShareRecord(ctx sdk.Context, id uint64) (stakingtypes.TokenizeShareRecord, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -15,6 +15,8 @@ const (
 	TypeMsgFundCommunityPool           = "fund_community_pool"
 	TypeMsgUpdateParams                = "update_params"
 	TypeMsgCommunityPoolSpend          = "community_pool_spend"
+
+	TypeMsgWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
 )
 
 // Verify interface at compile time
@@ -24,6 +26,7 @@ var (
 	_ sdk.Msg = (*MsgWithdrawValidatorCommission)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -93,6 +96,41 @@ func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr sdk.AccAddress, recordID uint64) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr.String(),
+		RecordId:     recordID,
+	}
+}
+
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return ModuleName }
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return TypeMsgWithdrawTokenizeShareRecordReward
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	return []sdk.AccAddress{owner}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if msg.RecordId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("tokenize share record id cannot be zero")
+	}
+	return nil
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr sdk.ValAddress) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr.String(),
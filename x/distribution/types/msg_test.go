@@ -73,6 +73,27 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgWithdrawTokenizeShareRecordReward
+func TestMsgWithdrawTokenizeShareRecordReward(t *testing.T) {
+	tests := []struct {
+		ownerAddr  sdk.AccAddress
+		recordID   uint64
+		expectPass bool
+	}{
+		{delAddr1, 1, true},
+		{emptyDelAddr, 1, false},
+		{delAddr1, 0, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawTokenizeShareRecordReward(tc.ownerAddr, tc.recordID)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDepositIntoCommunityPool
 func TestMsgDepositIntoCommunityPool(t *testing.T) {
 	tests := []struct {
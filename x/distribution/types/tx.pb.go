@@ -568,6 +568,96 @@ func (m *MsgCommunityPoolSpendResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCommunityPoolSpendResponse proto.InternalMessageInfo
 
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards of the delegation
+// tokenized by a tokenize share record to the record owner.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	RecordId     uint64 `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{12}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the
+// Msg/WithdrawTokenizeShareRecordReward response type.
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{13}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -581,6 +671,8 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.distribution.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgCommunityPoolSpend)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpend")
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
 }
 
 func init() {
@@ -588,59 +680,66 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6b, 0x13, 0x4f,
-	0x18, 0xce, 0xb4, 0xfc, 0x02, 0x99, 0xfe, 0xa0, 0x4d, 0xa8, 0xb4, 0xdd, 0xd6, 0x4d, 0xd9, 0x4a,
-	0x2d, 0xc5, 0xee, 0x92, 0xf8, 0x45, 0xe3, 0x41, 0x4c, 0x6c, 0xc1, 0x43, 0xb0, 0xa4, 0xa8, 0xe0,
-	0xa5, 0x6c, 0xb2, 0xeb, 0x66, 0xb0, 0xbb, 0xb3, 0xec, 0x4c, 0x9a, 0xe6, 0xa6, 0xe2, 0x41, 0x3c,
-	0x88, 0xd4, 0x3f, 0xc0, 0x1e, 0x8b, 0x17, 0x7b, 0xf0, 0x8f, 0x28, 0x82, 0x50, 0x3c, 0x79, 0x52,
-	0x49, 0x0f, 0x15, 0xbc, 0xea, 0x5d, 0xf6, 0x33, 0xbb, 0xd9, 0x4d, 0xd6, 0xd4, 0xaf, 0x4b, 0x5b,
-	0x66, 0xde, 0xe7, 0xd9, 0xe7, 0x79, 0xe6, 0x9d, 0x77, 0x0a, 0xcf, 0xd4, 0x30, 0x51, 0x31, 0x11,
-	0x24, 0x44, 0xa8, 0x81, 0xaa, 0x0d, 0x8a, 0xb0, 0x26, 0x6c, 0xe5, 0xaa, 0x32, 0x15, 0x73, 0x02,
-	0xdd, 0xe6, 0x75, 0x03, 0x53, 0x9c, 0x99, 0xb6, 0xab, 0x78, 0x7f, 0x15, 0xef, 0x54, 0x31, 0xe3,
-	0x0a, 0x56, 0xb0, 0x55, 0x27, 0x98, 0x7f, 0xd9, 0x10, 0x86, 0x75, 0x88, 0xab, 0x22, 0x91, 0x3d,
-	0xc2, 0x1a, 0x46, 0x9a, 0xb3, 0x3f, 0x65, 0xef, 0x6f, 0xd8, 0x40, 0x87, 0xdf, 0xde, 0x9a, 0x70,
-	0xa0, 0x2a, 0x51, 0x84, 0xad, 0x9c, 0xf9, 0xcb, 0xd9, 0x48, 0x8b, 0x2a, 0xd2, 0xb0, 0x60, 0xfd,
-	0x74, 0x96, 0xf8, 0x7e, 0xfa, 0x03, 0x72, 0xad, 0x7a, 0xee, 0x2b, 0x80, 0xa7, 0xca, 0x44, 0x59,
-	0x97, 0xe9, 0x1d, 0x44, 0xeb, 0x92, 0x21, 0x36, 0xaf, 0x49, 0x92, 0x21, 0x13, 0x92, 0x59, 0x81,
-	0x69, 0x49, 0xde, 0x94, 0x15, 0x91, 0x62, 0x63, 0x43, 0xb4, 0x17, 0x27, 0xc1, 0x2c, 0x58, 0x48,
-	0x15, 0x27, 0xdf, 0xbf, 0x59, 0x1a, 0x77, 0x24, 0x3a, 0xe5, 0xeb, 0xd4, 0x40, 0x9a, 0x52, 0x19,
-	0xf3, 0x20, 0x2e, 0x4d, 0x09, 0x8e, 0x35, 0x1d, 0x66, 0x8f, 0x65, 0x28, 0x86, 0x65, 0xb4, 0x19,
-	0xd4, 0x52, 0x58, 0x7d, 0xb2, 0x9b, 0x4d, 0x7c, 0xd9, 0xcd, 0x26, 0x1e, 0x1d, 0xef, 0x2f, 0x86,
-	0x65, 0x3d, 0x3d, 0xde, 0x5f, 0x9c, 0xb3, 0x99, 0x96, 0x88, 0x74, 0x5f, 0x28, 0x13, 0xa5, 0x8c,
-	0x25, 0x74, 0xaf, 0xd5, 0xe5, 0x89, 0xcb, 0xc2, 0xd3, 0x91, 0x66, 0x2b, 0x32, 0xd1, 0xb1, 0x46,
-	0x64, 0xee, 0x3b, 0x80, 0x4c, 0x99, 0x28, 0xee, 0xf6, 0x75, 0xf7, 0x4b, 0x15, 0xb9, 0x29, 0x1a,
-	0xd2, 0xef, 0xca, 0x64, 0x05, 0xa6, 0xb7, 0xc4, 0x4d, 0x24, 0x05, 0x68, 0xe2, 0x42, 0x19, 0xf3,
-	0x20, 0x6e, 0x2a, 0x37, 0xe2, 0x53, 0x99, 0x0f, 0xa6, 0xd2, 0xe5, 0x0b, 0x61, 0xcd, 0x36, 0xc6,
-	0x3d, 0x03, 0x90, 0xeb, 0xed, 0xdb, 0x8d, 0x27, 0x53, 0x87, 0x49, 0x51, 0xc5, 0x0d, 0x8d, 0x4e,
-	0x82, 0xd9, 0xe1, 0x85, 0x91, 0xfc, 0x94, 0xd3, 0x6e, 0xbc, 0xd9, 0xd5, 0xee, 0x05, 0xe0, 0x4b,
-	0x18, 0x69, 0xc5, 0x8b, 0x07, 0x1f, 0xb3, 0x89, 0x57, 0x9f, 0xb2, 0x0b, 0x0a, 0xa2, 0xf5, 0x46,
-	0x95, 0xaf, 0x61, 0xd5, 0xe9, 0x6a, 0xc1, 0xa7, 0x89, 0xb6, 0x74, 0x99, 0x58, 0x00, 0xb2, 0x77,
-	0xbc, 0xbf, 0x08, 0x2a, 0x0e, 0x3f, 0xf7, 0x1a, 0x40, 0xd6, 0x27, 0xe8, 0xb6, 0xeb, 0xbd, 0x84,
-	0x55, 0x15, 0x11, 0x82, 0xb0, 0x16, 0x9d, 0x22, 0x18, 0x38, 0xc5, 0x60, 0x6f, 0x85, 0x18, 0x23,
-	0x7a, 0xcb, 0x27, 0xaa, 0x23, 0x87, 0xdb, 0x01, 0x70, 0xbe, 0xbf, 0xe2, 0x7f, 0x10, 0xe3, 0x37,
-	0x00, 0xc7, 0xcb, 0x44, 0x59, 0x6d, 0x68, 0x92, 0xa9, 0xa3, 0xa1, 0x21, 0xda, 0x5a, 0xc3, 0x78,
-	0xf3, 0xef, 0x49, 0xc8, 0x5c, 0x82, 0x29, 0x49, 0xd6, 0x31, 0x41, 0x14, 0x1b, 0xb1, 0x4d, 0xde,
-	0x29, 0x2d, 0x14, 0xfc, 0xe7, 0xd2, 0x59, 0x37, 0xcf, 0x23, 0x1b, 0x3c, 0x8f, 0x90, 0x3b, 0x8e,
-	0x85, 0x33, 0x51, 0xeb, 0xde, 0x35, 0x7f, 0x07, 0xe0, 0x68, 0x99, 0x28, 0xb7, 0x74, 0x49, 0xa4,
-	0xf2, 0x9a, 0x68, 0x88, 0x2a, 0x31, 0x75, 0x8a, 0x0d, 0x5a, 0xc7, 0x06, 0xa2, 0xad, 0xd8, 0x36,
-	0xea, 0x94, 0x66, 0x56, 0x61, 0x52, 0xb7, 0x18, 0x2c, 0x73, 0x23, 0xf9, 0x39, 0xbe, 0xcf, 0xe3,
-	0xc0, 0xdb, 0x1f, 0x2b, 0xa6, 0xcc, 0x4c, 0x9d, 0x9c, 0x6c, 0x74, 0xa1, 0x60, 0xf9, 0xf4, 0x78,
-	0x4d, 0x9f, 0x67, 0x7d, 0x3e, 0x03, 0x03, 0xbd, 0x4b, 0x3b, 0x37, 0x05, 0x27, 0xba, 0x96, 0x3c,
-	0xab, 0x3b, 0x43, 0xd6, 0x80, 0x0f, 0xe4, 0xb0, 0xae, 0xcb, 0x9a, 0x74, 0x62, 0xc3, 0x33, 0x30,
-	0x65, 0xc8, 0x35, 0xa4, 0x23, 0x59, 0xa3, 0xf6, 0x81, 0x56, 0x3a, 0x0b, 0xbe, 0xc6, 0x1a, 0xfe,
-	0xb3, 0x8d, 0x55, 0x58, 0x0e, 0x07, 0x36, 0xdf, 0x1d, 0x98, 0x10, 0x69, 0xdd, 0x79, 0x07, 0xc2,
-	0x1b, 0x6e, 0x6a, 0xf9, 0xb7, 0x49, 0x38, 0x5c, 0x26, 0x4a, 0xe6, 0x31, 0x80, 0x99, 0x88, 0xb7,
-	0x31, 0xdf, 0xf7, 0x8c, 0x23, 0x9f, 0x18, 0xa6, 0x30, 0x38, 0xc6, 0x1b, 0x18, 0x2f, 0x00, 0x9c,
-	0xe8, 0xf5, 0x26, 0x5d, 0x8e, 0xe3, 0xed, 0x01, 0x64, 0xae, 0x9e, 0x10, 0xe8, 0xa9, 0x7a, 0x09,
-	0xe0, 0x74, 0xbf, 0x01, 0x7d, 0xe5, 0x67, 0x3f, 0x10, 0x01, 0x66, 0x4a, 0xbf, 0x00, 0xf6, 0x14,
-	0x3e, 0x04, 0x30, 0x1d, 0x9e, 0x7d, 0xb9, 0x38, 0xea, 0x10, 0x84, 0x59, 0x1e, 0x18, 0xe2, 0x69,
-	0x30, 0xe0, 0xff, 0x81, 0x39, 0x73, 0x2e, 0x8e, 0xca, 0x5f, 0xcd, 0x5c, 0x18, 0xa4, 0xda, 0xfb,
-	0xa6, 0xd9, 0xb6, 0x11, 0x37, 0x3e, 0xb6, 0x6d, 0xc3, 0x98, 0xf8, 0xb6, 0xed, 0x7d, 0x8b, 0x98,
-	0xff, 0x1e, 0x98, 0x17, 0xb6, 0x78, 0x73, 0xaf, 0xcd, 0x82, 0x83, 0x36, 0x0b, 0x0e, 0xdb, 0x2c,
-	0xf8, 0xdc, 0x66, 0xc1, 0xf3, 0x23, 0x36, 0x71, 0x78, 0xc4, 0x26, 0x3e, 0x1c, 0xb1, 0x89, 0xbb,
-	0xb9, 0xbe, 0xb7, 0x7f, 0x3b, 0x38, 0xf8, 0xac, 0x61, 0x50, 0x4d, 0x5a, 0xff, 0xbb, 0x9e, 0xff,
-	0x11, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xc9, 0x2e, 0x04, 0xad, 0x0b, 0x00, 0x00,
+	// 936 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x34, 0x10, 0xc8, 0xb4, 0xa8, 0xc9, 0x2a, 0x28, 0xc9, 0xa6, 0xac, 0xcb, 0x36, 0x0a,
+	0x51, 0xd4, 0xee, 0xca, 0xe6, 0x97, 0xba, 0x08, 0xa1, 0xda, 0x34, 0x52, 0x0e, 0x16, 0x95, 0xcd,
+	0x0f, 0x89, 0x4b, 0xb4, 0xf6, 0x0c, 0xeb, 0x51, 0xb3, 0x3b, 0xab, 0x9d, 0x71, 0x5c, 0x73, 0x02,
+	0xc4, 0x01, 0x71, 0x40, 0xa8, 0x5c, 0xb8, 0xd1, 0x63, 0xc5, 0x85, 0x20, 0xf1, 0x3f, 0xd0, 0x0b,
+	0x52, 0xc5, 0x89, 0x13, 0x20, 0xe7, 0x10, 0x24, 0xae, 0x70, 0x45, 0x68, 0x77, 0x67, 0xd7, 0xbb,
+	0xde, 0xb5, 0xd7, 0x6e, 0xa1, 0xbd, 0x24, 0xd6, 0xcc, 0x7b, 0xdf, 0x7c, 0xdf, 0x37, 0x6f, 0xde,
+	0xb3, 0xe1, 0x56, 0x87, 0x32, 0x9b, 0x32, 0x1d, 0x11, 0xc6, 0x3d, 0xd2, 0xee, 0x71, 0x42, 0x1d,
+	0xfd, 0xa8, 0xd2, 0xc6, 0xdc, 0xac, 0xe8, 0xfc, 0x96, 0xe6, 0x7a, 0x94, 0x53, 0x69, 0x33, 0x8c,
+	0xd2, 0x92, 0x51, 0x9a, 0x88, 0x92, 0x57, 0x2d, 0x6a, 0xd1, 0x20, 0x4e, 0xf7, 0x3f, 0x85, 0x29,
+	0xb2, 0x22, 0x80, 0xdb, 0x26, 0xc3, 0x31, 0x60, 0x87, 0x12, 0x47, 0xec, 0x6f, 0x84, 0xfb, 0x07,
+	0x61, 0xa2, 0xc0, 0x0f, 0xb7, 0xd6, 0x44, 0xaa, 0xcd, 0x2c, 0xfd, 0xa8, 0xe2, 0xff, 0x13, 0x1b,
+	0x2b, 0xa6, 0x4d, 0x1c, 0xaa, 0x07, 0x7f, 0xc5, 0x92, 0x36, 0x8d, 0x7f, 0x8a, 0x6e, 0x10, 0xaf,
+	0xfe, 0x09, 0xe0, 0xb3, 0x0d, 0x66, 0xb5, 0x30, 0x7f, 0x8f, 0xf0, 0x2e, 0xf2, 0xcc, 0xfe, 0x35,
+	0x84, 0x3c, 0xcc, 0x98, 0x74, 0x1d, 0xae, 0x20, 0x7c, 0x88, 0x2d, 0x93, 0x53, 0xef, 0xc0, 0x0c,
+	0x17, 0xd7, 0xc1, 0x45, 0xb0, 0xb3, 0x54, 0x5b, 0xff, 0xf9, 0x87, 0x2b, 0xab, 0x82, 0xa2, 0x08,
+	0x6f, 0x71, 0x8f, 0x38, 0x56, 0x73, 0x39, 0x4e, 0x89, 0x60, 0xea, 0x70, 0xb9, 0x2f, 0x90, 0x63,
+	0x94, 0x33, 0x05, 0x28, 0xe7, 0xfb, 0x69, 0x2e, 0xc6, 0xde, 0x67, 0x77, 0xca, 0xa5, 0x3f, 0xee,
+	0x94, 0x4b, 0x9f, 0x9c, 0x1e, 0xef, 0x66, 0x69, 0x7d, 0x7e, 0x7a, 0xbc, 0x7b, 0x29, 0x44, 0xba,
+	0xc2, 0xd0, 0x4d, 0xbd, 0xc1, 0xac, 0x06, 0x45, 0xe4, 0x83, 0xc1, 0x98, 0x26, 0xb5, 0x0c, 0x9f,
+	0xcb, 0x15, 0xdb, 0xc4, 0xcc, 0xa5, 0x0e, 0xc3, 0xea, 0xdf, 0x00, 0xca, 0x0d, 0x66, 0x45, 0xdb,
+	0x6f, 0x46, 0x27, 0x35, 0x71, 0xdf, 0xf4, 0xd0, 0x7f, 0xe5, 0xc9, 0x75, 0xb8, 0x72, 0x64, 0x1e,
+	0x12, 0x94, 0x82, 0x29, 0x32, 0x65, 0x39, 0x4e, 0x89, 0x5c, 0xd9, 0x2f, 0x76, 0x65, 0x3b, 0xed,
+	0xca, 0x98, 0x2e, 0x42, 0x9d, 0x50, 0x98, 0xfa, 0x05, 0x80, 0xea, 0x64, 0xdd, 0x91, 0x3d, 0x52,
+	0x17, 0x2e, 0x9a, 0x36, 0xed, 0x39, 0x7c, 0x1d, 0x5c, 0x5c, 0xd8, 0x39, 0x5b, 0xdd, 0x10, 0xe5,
+	0xa6, 0xf9, 0x55, 0x1d, 0x3d, 0x00, 0xad, 0x4e, 0x89, 0x53, 0x7b, 0xf9, 0xde, 0xaf, 0xe5, 0xd2,
+	0xb7, 0xbf, 0x95, 0x77, 0x2c, 0xc2, 0xbb, 0xbd, 0xb6, 0xd6, 0xa1, 0xb6, 0xa8, 0x6a, 0x3d, 0xc1,
+	0x89, 0x0f, 0x5c, 0xcc, 0x82, 0x04, 0x76, 0xf7, 0xf4, 0x78, 0x17, 0x34, 0x05, 0xbe, 0xfa, 0x1d,
+	0x80, 0x4a, 0x82, 0xd0, 0xbb, 0x91, 0xf6, 0x3a, 0xb5, 0x6d, 0xc2, 0x18, 0xa1, 0x4e, 0xbe, 0x8b,
+	0x60, 0x6e, 0x17, 0xd3, 0xb5, 0x95, 0x41, 0xcc, 0xa9, 0xad, 0x04, 0xa9, 0x11, 0x1d, 0xf5, 0x36,
+	0x80, 0xdb, 0xd3, 0x19, 0x3f, 0x06, 0x1b, 0xff, 0x02, 0x70, 0xb5, 0xc1, 0xac, 0xbd, 0x9e, 0x83,
+	0x7c, 0x1e, 0x3d, 0x87, 0xf0, 0xc1, 0x0d, 0x4a, 0x0f, 0x1f, 0x1d, 0x05, 0xe9, 0x15, 0xb8, 0x84,
+	0xb0, 0x4b, 0x19, 0xe1, 0xd4, 0x2b, 0x2c, 0xf2, 0x51, 0xa8, 0x61, 0x24, 0xef, 0x65, 0xb4, 0xee,
+	0xdf, 0x47, 0x39, 0x7d, 0x1f, 0x19, 0x75, 0xaa, 0x02, 0x2f, 0xe4, 0xad, 0xc7, 0xcf, 0xfc, 0x27,
+	0x00, 0xcf, 0x37, 0x98, 0xf5, 0x8e, 0x8b, 0x4c, 0x8e, 0x6f, 0x98, 0x9e, 0x69, 0x33, 0x9f, 0xa7,
+	0xd9, 0xe3, 0x5d, 0xea, 0x11, 0x3e, 0x28, 0x2c, 0xa3, 0x51, 0xa8, 0xb4, 0x07, 0x17, 0xdd, 0x00,
+	0x21, 0x10, 0x77, 0xb6, 0x7a, 0x49, 0x9b, 0x32, 0x1c, 0xb4, 0xf0, 0xb0, 0xda, 0x92, 0xef, 0xa9,
+	0xf0, 0x29, 0xcc, 0x36, 0x8c, 0x40, 0x67, 0x8c, 0xeb, 0xeb, 0x7c, 0x21, 0xa1, 0x33, 0xd5, 0xd0,
+	0xc7, 0xb8, 0xab, 0x1b, 0x70, 0x6d, 0x6c, 0x29, 0x96, 0x7a, 0xfb, 0x4c, 0xd0, 0xe0, 0x53, 0x3e,
+	0xb4, 0x5c, 0xec, 0xa0, 0x07, 0x16, 0x7c, 0x01, 0x2e, 0x79, 0xb8, 0x43, 0x5c, 0x82, 0x1d, 0x1e,
+	0x5e, 0x68, 0x73, 0xb4, 0x90, 0x28, 0xac, 0x85, 0xff, 0xb7, 0xb0, 0x8c, 0xab, 0x59, 0xc3, 0xb6,
+	0xc7, 0x0d, 0xd3, 0x73, 0xa5, 0x8b, 0x39, 0x90, 0xdd, 0x88, 0x5d, 0xfb, 0x11, 0xc0, 0xad, 0xc4,
+	0x63, 0x7e, 0x9b, 0xde, 0xc4, 0x0e, 0xf9, 0x10, 0xb7, 0xba, 0xa6, 0x87, 0x9b, 0xb8, 0x43, 0x3d,
+	0x14, 0x76, 0x46, 0xe9, 0x75, 0xf8, 0x0c, 0xed, 0x3b, 0x78, 0xf6, 0x06, 0x74, 0x2e, 0x08, 0x8f,
+	0x26, 0xc1, 0x66, 0xe0, 0x25, 0xf5, 0xd0, 0x01, 0x41, 0x81, 0x97, 0x4f, 0x34, 0x9f, 0x0e, 0x17,
+	0xf6, 0x91, 0x51, 0x4f, 0xbe, 0x80, 0xf4, 0x31, 0xbe, 0xd8, 0xad, 0xfc, 0xae, 0x14, 0x71, 0x15,
+	0x9d, 0xfd, 0x6b, 0x00, 0x2f, 0xcf, 0xa2, 0xe4, 0xd1, 0x37, 0xa7, 0xea, 0x3f, 0x4f, 0xc1, 0x85,
+	0x06, 0xb3, 0xa4, 0x4f, 0x01, 0x94, 0x72, 0xbe, 0x80, 0x54, 0xa7, 0x3e, 0xa4, 0xdc, 0x39, 0x2e,
+	0x1b, 0xf3, 0xe7, 0xc4, 0xc2, 0xbf, 0x02, 0x70, 0x6d, 0xd2, 0xe0, 0x7f, 0xb5, 0x08, 0x77, 0x42,
+	0xa2, 0xfc, 0xc6, 0x03, 0x26, 0xc6, 0xac, 0xbe, 0x01, 0x70, 0x73, 0xda, 0x14, 0x7c, 0x6d, 0xd6,
+	0x03, 0x72, 0x92, 0xe5, 0xfa, 0x43, 0x24, 0xc7, 0x0c, 0x3f, 0x06, 0x70, 0x25, 0x3b, 0x60, 0x2a,
+	0x45, 0xd0, 0x99, 0x14, 0xf9, 0xea, 0xdc, 0x29, 0x31, 0x07, 0x0f, 0x9e, 0x4b, 0x35, 0xf3, 0xcb,
+	0x45, 0x50, 0xc9, 0x68, 0xf9, 0xa5, 0x79, 0xa2, 0xe3, 0x33, 0xfd, 0xb2, 0xcd, 0x69, 0xab, 0x85,
+	0x65, 0x9b, 0xcd, 0x91, 0x8d, 0xf9, 0x73, 0x62, 0x1a, 0xdf, 0x03, 0xf8, 0x7c, 0x71, 0x9f, 0xba,
+	0x36, 0xeb, 0x4d, 0x4f, 0x84, 0x90, 0xf7, 0x1f, 0x1a, 0x22, 0xe2, 0x2c, 0x3f, 0xf9, 0x91, 0xdf,
+	0x08, 0x6a, 0x6f, 0xdd, 0x1d, 0x2a, 0xe0, 0xde, 0x50, 0x01, 0xf7, 0x87, 0x0a, 0xf8, 0x7d, 0xa8,
+	0x80, 0x2f, 0x4f, 0x94, 0xd2, 0xfd, 0x13, 0xa5, 0xf4, 0xcb, 0x89, 0x52, 0x7a, 0xbf, 0x32, 0xb5,
+	0xab, 0xdc, 0x4a, 0x4f, 0xc4, 0xa0, 0xc9, 0xb4, 0x17, 0x83, 0x1f, 0x35, 0x2f, 0xfe, 0x3b, 0x00,
+	0x1a, 0x27, 0x7a, 0x75, 0xc6, 0x0d, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -847,6 +946,35 @@ func (this *MsgCommunityPoolSpendResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -884,6 +1012,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	CommunityPoolSpend(ctx context.Context, in *MsgCommunityPoolSpend, opts ...grpc.CallOption) (*MsgCommunityPoolSpendResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegation tokenized by a tokenize share record to its owner.
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 type msgClient struct {
@@ -948,6 +1079,15 @@ func (c *msgClient) CommunityPoolSpend(ctx context.Context, in *MsgCommunityPool
 	return out, nil
 }
 
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -974,6 +1114,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	CommunityPoolSpend(context.Context, *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegation tokenized by a tokenize share record to its owner.
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -998,6 +1141,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolSpend not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1111,6 +1257,24 @@ func _Msg_CommunityPoolSpend_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1139,6 +1303,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPoolSpend",
 			Handler:    _Msg_CommunityPoolSpend_Handler,
 		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1549,6 +1717,78 @@ func (m *MsgCommunityPoolSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1730,6 +1970,37 @@ func (m *MsgCommunityPoolSpendResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2793,6 +3064,191 @@ func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,7 +1,14 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 
 	"github.com/spf13/cobra"
@@ -22,11 +29,12 @@ func GetTxCmd(childCmds []*cobra.Command) *cobra.Command {
 	}
 
 	submitEvidenceCmd := SubmitEvidenceCmd()
+	submitEvidenceCmd.AddCommand(NewSubmitDoubleSignEvidenceCmd())
 	for _, childCmd := range childCmds {
 		submitEvidenceCmd.AddCommand(childCmd)
 	}
 
-	// TODO: Add tx commands.
+	cmd.AddCommand(submitEvidenceCmd)
 
 	return cmd
 }
@@ -42,3 +50,67 @@ func SubmitEvidenceCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewSubmitDoubleSignEvidenceCmd returns a CLI command handler for submitting
+// double sign evidence, whose submitter is paid a share of the slashed tokens.
+func NewSubmitDoubleSignEvidenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "double-sign [evidence-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit evidence of a validator signing two conflicting votes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit evidence of a validator signing two votes for different blocks at the same height, round and step.
+The votes are verified against the validator set at their height and the submitter is paid the double sign
+evidence bounty, a share of the tokens slashed from the validator.
+
+Example:
+$ %s tx evidence submit double-sign path/to/evidence.json --from mykey
+
+Where evidence.json contains:
+
+{
+  "vote_a": {
+    "type": "SIGNED_MSG_TYPE_PRECOMMIT",
+    "height": "1000",
+    "round": 0,
+    "block_id": {"hash": "...", "part_set_header": {"total": 1, "hash": "..."}},
+    "timestamp": "2023-01-01T00:00:00Z",
+    "validator_address": "...",
+    "validator_index": 0,
+    "signature": "..."
+  },
+  "vote_b": {...}
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var evidence types.DoubleSignEvidence
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &evidence); err != nil {
+				return fmt.Errorf("failed to parse double sign evidence: %w", err)
+			}
+
+			msg, err := types.NewMsgSubmitEvidence(clientCtx.GetFromAddress(), &evidence)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,109 @@
+package cli_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/evidence/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+func TestNewSubmitDoubleSignEvidenceCmd(t *testing.T) {
+	encCfg := testutilmod.MakeTestEncodingConfig(evidence.AppModuleBasic{})
+	kr := keyring.NewInMemory(encCfg.Codec)
+	bz, _ := encCfg.Codec.Marshal(&sdk.TxResponse{})
+	clientCtx := client.Context{}.
+		WithKeyring(kr).
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithClient(clitestutil.NewMockTendermintRPC(abci.ResponseQuery{Value: bz})).
+		WithAccountRetriever(client.MockAccountRetriever{}).
+		WithOutput(io.Discard).
+		WithChainID("test-chain")
+
+	accounts := testutil.CreateKeyringAccounts(t, kr, 1)
+
+	privKey := ed25519.GenPrivKey()
+	signVote := func(block string) *tmproto.Vote {
+		vote := &tmproto.Vote{
+			Type:   tmproto.PrecommitType,
+			Height: 10,
+			BlockID: tmproto.BlockID{
+				Hash:          tmhash.Sum([]byte(block)),
+				PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte(block + "parts"))},
+			},
+			Timestamp:        time.Now().UTC(),
+			ValidatorAddress: privKey.PubKey().Address(),
+		}
+		signature, err := privKey.Sign(tmtypes.VoteSignBytes("test-chain", vote))
+		require.NoError(t, err)
+		vote.Signature = signature
+		return vote
+	}
+
+	writeEvidence := func(voteA, voteB *tmproto.Vote) string {
+		bz, err := encCfg.Codec.MarshalJSON(types.NewDoubleSignEvidence(voteA, voteB))
+		require.NoError(t, err)
+		return testutil.WriteToNewTempFile(t, string(bz)).Name()
+	}
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"missing evidence file",
+			[]string{"missing.json"},
+			true,
+		},
+		{
+			"votes for the same block",
+			[]string{writeEvidence(signVote("a"), signVote("a"))},
+			true,
+		},
+		{
+			"valid evidence",
+			[]string{writeEvidence(signVote("a"), signVote("b"))},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			var outBuf bytes.Buffer
+			args := append(tc.args,
+				"--"+flags.FlagFrom+"="+accounts[0].Address.String(),
+				"--"+flags.FlagSkipConfirmation+"=true",
+				"--"+flags.FlagBroadcastMode+"="+flags.BroadcastSync,
+			)
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx.WithOutput(&outBuf), cli.NewSubmitDoubleSignEvidenceCmd(), args)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, clientCtx.Codec.UnmarshalJSON(out.Bytes(), &sdk.TxResponse{}), out.String())
+			}
+		})
+	}
+}
@@ -2,6 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -50,6 +55,15 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		}
 	}
 
+	// The equivocation may have been committed with a consensus pubkey the
+	// validator has rotated away from since, in which case its signing info is
+	// found at its current consensus address.
+	valConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ignore evidence; invalid consensus address of validator %s: %s", consAddr, err))
+		return
+	}
+
 	// calculate the age of the evidence
 	infractionHeight := evidence.GetHeight()
 	infractionTime := evidence.GetTime()
@@ -74,12 +88,12 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		}
 	}
 
-	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, valConsAddr); !ok {
 		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
 	}
 
 	// ignore if the validator is already tombstoned
-	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+	if k.slashingKeeper.IsTombstoned(ctx, valConsAddr) {
 		logger.Info(
 			"ignored equivocation; validator already tombstoned",
 			"validator", consAddr,
@@ -96,6 +110,148 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		"infraction_time", infractionTime,
 	)
 
+	k.slashEquivocation(ctx, validator, valConsAddr, infractionHeight, evidence.GetValidatorPower(), nil)
+	k.SetEvidence(ctx, evidence)
+}
+
+// HandleDoubleSignEvidence implements the handler of double sign evidence
+// submitted by users. The votes of the evidence are verified against the
+// validator set at the double sign height, as tracked by the x/staking
+// historical info. Assuming the evidence is valid, the validator is slashed,
+// jailed and tombstoned as for an equivocation, and the submitter is paid the
+// x/slashing DoubleSignEvidenceBounty share of the slashed tokens.
+//
+// Unlike the equivocations reported by CometBFT, the evidence is rejected with
+// an error if:
+// - the votes are malformed or do not conflict
+// - the historical info at the double sign height does not exist
+// - the validator is not part of the validator set at the double sign height
+// - the signature of a vote is invalid
+// - the evidence is too old
+// - the validator is unbonded or does not exist
+// - the submitter is the operator of the validator
+// - the signing info does not exist
+// - the validator is already tombstoned
+func (k Keeper) HandleDoubleSignEvidence(ctx sdk.Context, submitter sdk.AccAddress, evidence *types.DoubleSignEvidence) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return types.ErrInvalidEvidence.Wrap(err.Error())
+	}
+
+	logger := k.Logger(ctx)
+	consAddr := evidence.GetConsensusAddress()
+	infractionHeight := evidence.GetHeight()
+
+	power, infractionTime, err := k.verifyDoubleSignEvidence(ctx, evidence)
+	if err != nil {
+		return err
+	}
+
+	// reject evidence if the double sign is too old, as for equivocations
+	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
+	ageBlocks := ctx.BlockHeader().Height - infractionHeight
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Evidence != nil {
+		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
+			return types.ErrInvalidEvidence.Wrapf("double sign at height %d is too old", infractionHeight)
+		}
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return types.ErrInvalidEvidence.Wrapf("validator %s does not exist or is unbonded", consAddr)
+	}
+
+	// the operator of the validator would otherwise get back part of its own
+	// slashed stake
+	if submitter.Equals(sdk.AccAddress(validator.GetOperator())) {
+		return types.ErrInvalidEvidence.Wrapf("submitter %s is the operator of validator %s", submitter, consAddr)
+	}
+
+	// the double sign may have been committed with a consensus pubkey the
+	// validator has rotated away from since
+	valConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, valConsAddr); !ok {
+		return types.ErrInvalidEvidence.Wrapf("signing info of validator %s not found", consAddr)
+	}
+	if k.slashingKeeper.IsTombstoned(ctx, valConsAddr) {
+		return types.ErrInvalidEvidence.Wrapf("validator %s is already tombstoned", consAddr)
+	}
+
+	logger.Info(
+		"confirmed double sign evidence",
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+		"infraction_time", infractionTime,
+		"submitter", submitter,
+	)
+
+	bounty := k.slashEquivocation(ctx, validator, valConsAddr, infractionHeight, power, submitter)
+	if !bounty.IsPositive() {
+		return nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEvidenceBounty,
+			sdk.NewAttribute(types.AttributeKeySubmitter, submitter.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, consAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), bounty).String()),
+		),
+	)
+
+	return nil
+}
+
+// verifyDoubleSignEvidence verifies the signatures of the votes of double sign
+// evidence against the validator set at the double sign height, and returns the
+// power of the validator and the block time at that height.
+func (k Keeper) verifyDoubleSignEvidence(ctx sdk.Context, evidence *types.DoubleSignEvidence) (int64, time.Time, error) {
+	consAddr := evidence.GetConsensusAddress()
+	infractionHeight := evidence.GetHeight()
+
+	historicalInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, infractionHeight)
+	if !found {
+		return 0, time.Time{}, types.ErrInvalidEvidence.Wrapf("historical info at height %d not found", infractionHeight)
+	}
+
+	for _, validator := range historicalInfo.Valset {
+		valConsAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		if !consAddr.Equals(sdk.ConsAddress(valConsAddr)) {
+			continue
+		}
+
+		pubKey, err := validator.ConsPubKey()
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		for _, vote := range []*tmproto.Vote{evidence.VoteA, evidence.VoteB} {
+			if !pubKey.VerifySignature(tmtypes.VoteSignBytes(ctx.ChainID(), vote), vote.Signature) {
+				return 0, time.Time{}, types.ErrInvalidEvidence.Wrapf("invalid vote signature of validator %s", consAddr)
+			}
+		}
+
+		return validator.ConsensusPower(k.stakingKeeper.PowerReduction(ctx)), historicalInfo.Header.Time, nil
+	}
+
+	return 0, time.Time{}, types.ErrInvalidEvidence.Wrapf(
+		"validator %s not in the validator set at height %d", consAddr, infractionHeight,
+	)
+}
+
+// slashEquivocation slashes, jails and tombstones the validator which double
+// signed at the infraction height with the given power. If a bounty recipient
+// is given, it is paid the double sign evidence bounty share of the slashed
+// tokens, and the amount paid is returned.
+func (k Keeper) slashEquivocation(
+	ctx sdk.Context, validator stakingtypes.ValidatorI, consAddr sdk.ConsAddress, infractionHeight, power int64,
+	bountyRecipient sdk.AccAddress,
+) math.Int {
 	// We need to retrieve the stake distribution which signed the block, so we
 	// subtract ValidatorUpdateDelay from the evidence height.
 	// Note, that this *can* result in a negative "distributionHeight", up to
@@ -108,13 +264,24 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
 	// to slash unbonding and rebonding delegations.
-	k.slashingKeeper.SlashWithInfractionReason(
-		ctx,
-		consAddr,
-		k.slashingKeeper.SlashFractionDoubleSign(ctx),
-		evidence.GetValidatorPower(), distributionHeight,
-		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
-	)
+	bounty := math.ZeroInt()
+	if bountyRecipient.Empty() {
+		k.slashingKeeper.SlashWithInfractionReason(
+			ctx,
+			consAddr,
+			k.slashingKeeper.SlashFractionDoubleSign(ctx),
+			power, distributionHeight,
+			stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+		)
+	} else {
+		bounty = k.slashingKeeper.SlashWithBounty(
+			ctx,
+			consAddr,
+			k.slashingKeeper.SlashFractionDoubleSign(ctx),
+			power, distributionHeight,
+			bountyRecipient,
+		)
+	}
 
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
@@ -124,5 +291,6 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
-	k.SetEvidence(ctx, evidence)
+
+	return bounty
 }
@@ -100,6 +100,30 @@ func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) erro
 	return nil
 }
 
+// SubmitDoubleSignEvidence attempts to handle double sign evidence submitted by
+// a user. An error is returned if the evidence already exists or is invalid.
+// Otherwise, the validator is slashed, the submitter is paid the double sign
+// evidence bounty and the evidence is persisted.
+func (k Keeper) SubmitDoubleSignEvidence(ctx sdk.Context, submitter sdk.AccAddress, evidence *types.DoubleSignEvidence) error {
+	if _, ok := k.GetEvidence(ctx, evidence.Hash()); ok {
+		return sdkerrors.Wrap(types.ErrEvidenceExists, evidence.Hash().String())
+	}
+
+	if err := k.HandleDoubleSignEvidence(ctx, submitter, evidence); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitEvidence,
+			sdk.NewAttribute(types.AttributeKeyEvidenceHash, evidence.Hash().String()),
+		),
+	)
+
+	k.SetEvidence(ctx, evidence)
+	return nil
+}
+
 // SetEvidence sets Evidence by hash in the module's KVStore.
 func (k Keeper) SetEvidence(ctx sdk.Context, evidence exported.Evidence) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEvidence)
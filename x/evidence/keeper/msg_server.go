@@ -24,7 +24,16 @@ func (ms msgServer) SubmitEvidence(goCtx context.Context, msg *types.MsgSubmitEv
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	evidence := msg.GetEvidence()
-	if err := ms.Keeper.SubmitEvidence(ctx, evidence); err != nil {
+
+	// double sign evidence is handled by the module itself, as its submitter is
+	// paid a bounty, while other evidence is routed to the registered handlers
+	var err error
+	if doubleSign, ok := evidence.(*types.DoubleSignEvidence); ok {
+		err = ms.Keeper.SubmitDoubleSignEvidence(ctx, msg.GetSubmitter(), doubleSign)
+	} else {
+		err = ms.Keeper.SubmitEvidence(ctx, evidence)
+	}
+	if err != nil {
 		return nil, err
 	}
 
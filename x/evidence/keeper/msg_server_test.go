@@ -3,9 +3,16 @@ package keeper_test
 import (
 	"time"
 
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/golang/mock/gomock"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (s *KeeperTestSuite) TestSubmitEvidence() {
@@ -63,3 +70,182 @@ func (s *KeeperTestSuite) TestSubmitEvidence() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSubmitDoubleSignEvidence() {
+	ctx := s.ctx.WithChainID("test-chain").WithBlockHeader(tmproto.Header{Height: 20, Time: time.Now().UTC()})
+	submitter := sdk.AccAddress(valAddresses[1])
+
+	privKey := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+	validator, err := stakingtypes.NewValidator(valAddresses[0], privKey.PubKey(), stakingtypes.Description{})
+	s.Require().NoError(err)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+
+	historicalInfo := stakingtypes.NewHistoricalInfo(
+		tmproto.Header{Height: 10, Time: ctx.BlockTime().Add(-time.Minute)},
+		stakingtypes.Validators{validator},
+		sdk.DefaultPowerReduction,
+	)
+
+	signVote := func(signer *ed25519.PrivKey, height int64, block string) *tmproto.Vote {
+		vote := &tmproto.Vote{
+			Type:   tmproto.PrecommitType,
+			Height: height,
+			Round:  0,
+			BlockID: tmproto.BlockID{
+				Hash:          tmhash.Sum([]byte(block)),
+				PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte(block + "parts"))},
+			},
+			Timestamp:        ctx.BlockTime(),
+			ValidatorAddress: signer.PubKey().Address(),
+			ValidatorIndex:   0,
+		}
+		vote.Signature, err = signer.Sign(tmtypes.VoteSignBytes(ctx.ChainID(), vote))
+		s.Require().NoError(err)
+		return vote
+	}
+
+	otherKey := ed25519.GenPrivKey()
+	forgedVote := signVote(otherKey, 10, "b")
+	forgedVote.ValidatorAddress = privKey.PubKey().Address()
+
+	slashFraction := sdk.NewDecWithPrec(5, 2)
+
+	expectSlash := func(bounty math.Int) {
+		s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator)
+		s.slashingKeeper.EXPECT().HasValidatorSigningInfo(gomock.Any(), consAddr).Return(true)
+		s.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+		s.slashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(slashFraction)
+		s.slashingKeeper.EXPECT().SlashWithBounty(
+			gomock.Any(), consAddr, slashFraction, int64(100), int64(9), submitter,
+		).Return(bounty)
+		s.slashingKeeper.EXPECT().Jail(gomock.Any(), consAddr)
+		s.slashingKeeper.EXPECT().JailUntil(gomock.Any(), consAddr, types.DoubleSignJailEndTime)
+		s.slashingKeeper.EXPECT().Tombstone(gomock.Any(), consAddr)
+	}
+
+	testCases := []struct {
+		name      string
+		submitter sdk.AccAddress
+		evidence  *types.DoubleSignEvidence
+		malleate  func()
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "votes for the same block",
+			evidence:  types.NewDoubleSignEvidence(signVote(privKey, 10, "a"), signVote(privKey, 10, "a")),
+			malleate:  func() {},
+			expErr:    true,
+			expErrMsg: "double sign votes are for the same block",
+		},
+		{
+			name:     "historical info not found",
+			evidence: types.NewDoubleSignEvidence(signVote(privKey, 11, "a"), signVote(privKey, 11, "b")),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(11)).Return(stakingtypes.HistoricalInfo{}, false)
+			},
+			expErr:    true,
+			expErrMsg: "historical info at height 11 not found",
+		},
+		{
+			name:     "validator not in the validator set",
+			evidence: types.NewDoubleSignEvidence(signVote(otherKey, 10, "a"), signVote(otherKey, 10, "b")),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(10)).Return(historicalInfo, true)
+			},
+			expErr:    true,
+			expErrMsg: "not in the validator set at height 10",
+		},
+		{
+			name:     "invalid vote signature",
+			evidence: types.NewDoubleSignEvidence(signVote(privKey, 10, "a"), forgedVote),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(10)).Return(historicalInfo, true)
+			},
+			expErr:    true,
+			expErrMsg: "invalid vote signature",
+		},
+		{
+			name:      "submitter is the operator of the validator",
+			submitter: sdk.AccAddress(valAddresses[0]),
+			evidence:  types.NewDoubleSignEvidence(signVote(privKey, 10, "a"), signVote(privKey, 10, "b")),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(10)).Return(historicalInfo, true)
+				s.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator)
+			},
+			expErr:    true,
+			expErrMsg: "is the operator of validator",
+		},
+		{
+			name:     "validator already tombstoned",
+			evidence: types.NewDoubleSignEvidence(signVote(privKey, 10, "a"), signVote(privKey, 10, "b")),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(10)).Return(historicalInfo, true)
+				s.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+				s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator)
+				s.slashingKeeper.EXPECT().HasValidatorSigningInfo(gomock.Any(), consAddr).Return(true)
+				s.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(true)
+			},
+			expErr:    true,
+			expErrMsg: "already tombstoned",
+		},
+		{
+			name:     "valid evidence without bounty",
+			evidence: types.NewDoubleSignEvidence(signVote(privKey, 10, "c"), signVote(privKey, 10, "d")),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(10)).Return(historicalInfo, true)
+				s.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+				expectSlash(math.ZeroInt())
+			},
+			expErr: false,
+		},
+		{
+			name:     "valid evidence with bounty",
+			evidence: types.NewDoubleSignEvidence(signVote(privKey, 10, "a"), signVote(privKey, 10, "b")),
+			malleate: func() {
+				s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(10)).Return(historicalInfo, true)
+				s.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+				expectSlash(sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction).QuoRaw(2))
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom)
+			},
+			expErr: false,
+		},
+		{
+			name:      "duplicate evidence",
+			evidence:  types.NewDoubleSignEvidence(signVote(privKey, 10, "a"), signVote(privKey, 10, "b")),
+			malleate:  func() {},
+			expErr:    true,
+			expErrMsg: "evidence already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			tc.malleate()
+
+			from := submitter
+			if tc.submitter != nil {
+				from = tc.submitter
+			}
+			msg, err := types.NewMsgSubmitEvidence(from, tc.evidence)
+			s.Require().NoError(err)
+
+			res, err := s.msgServer.SubmitEvidence(ctx, msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.evidence.Hash().Bytes(), res.Hash)
+
+				stored, ok := s.evidenceKeeper.GetEvidence(ctx, tc.evidence.Hash())
+				s.Require().True(ok)
+				s.Require().Equal(tc.evidence.Hash(), stored.Hash())
+			}
+		})
+	}
+}
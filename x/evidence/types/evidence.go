@@ -1,12 +1,15 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,9 +20,15 @@ import (
 const (
 	RouteEquivocation = "equivocation"
 	TypeEquivocation  = "equivocation"
+
+	RouteDoubleSign = "double_sign"
+	TypeDoubleSign  = "double_sign"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &DoubleSignEvidence{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -101,3 +110,83 @@ func FromABCIEvidence(e abci.Misbehavior) exported.Evidence {
 		Time:             e.Time,
 	}
 }
+
+// NewDoubleSignEvidence returns a new DoubleSignEvidence from two conflicting
+// votes of a validator.
+func NewDoubleSignEvidence(voteA, voteB *tmproto.Vote) *DoubleSignEvidence {
+	return &DoubleSignEvidence{
+		VoteA: voteA,
+		VoteB: voteB,
+	}
+}
+
+// Route returns the Evidence Handler route for a DoubleSignEvidence type.
+func (e *DoubleSignEvidence) Route() string { return RouteDoubleSign }
+
+// Type returns the Evidence Handler type for a DoubleSignEvidence type.
+func (e *DoubleSignEvidence) Type() string { return TypeDoubleSign }
+
+func (e *DoubleSignEvidence) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a DoubleSignEvidence object.
+func (e *DoubleSignEvidence) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a DoubleSignEvidence
+// object. Both votes must be well-formed and signed by the same validator at the
+// same height, round and step, for different blocks. Their signatures are
+// verified against the validator set of the double sign height when the
+// evidence is handled.
+func (e *DoubleSignEvidence) ValidateBasic() error {
+	voteA, err := tmtypes.VoteFromProto(e.VoteA)
+	if err != nil {
+		return fmt.Errorf("invalid double sign vote a: %w", err)
+	}
+	voteB, err := tmtypes.VoteFromProto(e.VoteB)
+	if err != nil {
+		return fmt.Errorf("invalid double sign vote b: %w", err)
+	}
+
+	if voteA.Height != voteB.Height || voteA.Round != voteB.Round || voteA.Type != voteB.Type {
+		return fmt.Errorf(
+			"double sign votes are for different height, round or step: %d/%d/%s and %d/%d/%s",
+			voteA.Height, voteA.Round, voteA.Type, voteB.Height, voteB.Round, voteB.Type,
+		)
+	}
+	if !bytes.Equal(voteA.ValidatorAddress, voteB.ValidatorAddress) {
+		return fmt.Errorf(
+			"double sign votes are from different validators: %s and %s",
+			voteA.ValidatorAddress, voteB.ValidatorAddress,
+		)
+	}
+	if voteA.BlockID.Equals(voteB.BlockID) {
+		return fmt.Errorf("double sign votes are for the same block: %s", voteA.BlockID)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the consensus address of the validator which
+// signed the votes of the DoubleSignEvidence.
+func (e DoubleSignEvidence) GetConsensusAddress() sdk.ConsAddress {
+	if e.VoteA == nil {
+		return nil
+	}
+	return sdk.ConsAddress(e.VoteA.ValidatorAddress)
+}
+
+// GetHeight returns the height of the votes of the DoubleSignEvidence.
+func (e DoubleSignEvidence) GetHeight() int64 {
+	if e.VoteA == nil {
+		return 0
+	}
+	return e.VoteA.Height
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -74,8 +75,54 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// DoubleSignEvidence implements the Evidence interface and defines evidence of
+// double signing misbehavior submitted by a user, made of two conflicting votes
+// signed by the same validator.
+//
+// Since: cosmos-sdk 0.47
+type DoubleSignEvidence struct {
+	// vote_a is the first vote of the validator.
+	VoteA *types.Vote `protobuf:"bytes,1,opt,name=vote_a,json=voteA,proto3" json:"vote_a,omitempty"`
+	// vote_b is the second vote of the validator, for another block at the same
+	// height, round and step.
+	VoteB *types.Vote `protobuf:"bytes,2,opt,name=vote_b,json=voteB,proto3" json:"vote_b,omitempty"`
+}
+
+func (m *DoubleSignEvidence) Reset()      { *m = DoubleSignEvidence{} }
+func (*DoubleSignEvidence) ProtoMessage() {}
+func (*DoubleSignEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *DoubleSignEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleSignEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoubleSignEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoubleSignEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignEvidence.Merge(m, src)
+}
+func (m *DoubleSignEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleSignEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignEvidence proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*DoubleSignEvidence)(nil), "cosmos.evidence.v1beta1.DoubleSignEvidence")
 }
 
 func init() {
@@ -83,30 +130,35 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0x84, 0x0b, 0xe8, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x43, 0xd4, 0xe9,
-	0xc1, 0x85, 0xa1, 0xea, 0xa4, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0xad,
-	0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xe5, 0xd3, 0xf3, 0xf3,
-	0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2, 0x34, 0xfd, 0x92, 0xcc, 0xdc, 0xd4, 0xe2, 0x92,
-	0xc4, 0xdc, 0x02, 0xa8, 0x02, 0x49, 0x88, 0x15, 0xf1, 0x10, 0x9d, 0x50, 0xfb, 0xc0, 0x1c, 0xa5,
-	0x37, 0x8c, 0x5c, 0x3c, 0xae, 0x85, 0xa5, 0x99, 0x65, 0xf9, 0xc9, 0x89, 0x25, 0x99, 0xf9, 0x79,
-	0x42, 0x62, 0x5c, 0x6c, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc,
-	0x41, 0x50, 0x9e, 0x90, 0x2d, 0x17, 0x0b, 0xc8, 0x58, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x29, 0x3d, 0x88, 0x9d, 0x7a, 0x30, 0x3b, 0xf5, 0x42, 0x60, 0x76, 0x3a, 0xf1, 0x9e, 0xb8, 0x27,
-	0xcf, 0x30, 0xe1, 0xbe, 0x3c, 0xe3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xc0, 0xda, 0x84, 0x44,
-	0xb8, 0x58, 0x0b, 0xf2, 0xcb, 0x53, 0x8b, 0x24, 0x98, 0xc1, 0xa6, 0x42, 0x38, 0x42, 0xae, 0x5c,
-	0x82, 0xc9, 0xf9, 0x79, 0xc5, 0xa9, 0x79, 0xc5, 0xa5, 0xc5, 0xf1, 0x89, 0x29, 0x29, 0x45, 0xa9,
-	0xc5, 0xc5, 0x12, 0x2c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x9d,
-	0xea, 0x08, 0x91, 0x09, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x12, 0x80, 0x6b, 0x81, 0x8a, 0x5b,
-	0x69, 0x74, 0x2c, 0x90, 0x67, 0x98, 0xb1, 0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x86, 0xae, 0xe7,
-	0x1b, 0xb4, 0xa0, 0x61, 0xaa, 0x5b, 0x9c, 0x92, 0xad, 0x8f, 0xec, 0x3b, 0x27, 0xef, 0x15, 0x8f,
-	0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37, 0x3d, 0xb3,
-	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0x1a, 0x4a, 0xfa, 0x48, 0x06, 0x55, 0x20, 0xa2,
-	0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x79, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x8d, 0x61, 0xa8, 0x30, 0xea, 0x01, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xbd, 0x6e, 0x13, 0x41,
+	0x10, 0xbe, 0xcd, 0x8f, 0x25, 0x36, 0x20, 0x91, 0x93, 0x15, 0x0e, 0x0b, 0xee, 0xac, 0x14, 0xc8,
+	0x8a, 0xe4, 0x5d, 0x25, 0x74, 0x91, 0x28, 0x62, 0xe1, 0x8a, 0xce, 0x41, 0x14, 0x34, 0xd6, 0xfd,
+	0x0c, 0xeb, 0x15, 0xb9, 0x1d, 0x73, 0xbb, 0x67, 0xe0, 0x0d, 0x10, 0x55, 0x4a, 0x4a, 0x8b, 0x2a,
+	0x65, 0x0a, 0x1e, 0x22, 0x65, 0x44, 0x45, 0x05, 0xc8, 0x2e, 0x42, 0xc1, 0x43, 0x20, 0xef, 0x6e,
+	0x1c, 0x0b, 0x0a, 0x9a, 0xd5, 0xcc, 0x37, 0xdf, 0xcc, 0x7c, 0x33, 0xb3, 0xf4, 0x51, 0x8e, 0xba,
+	0x44, 0xcd, 0x61, 0x22, 0x0b, 0x50, 0x39, 0xf0, 0xc9, 0x7e, 0x06, 0x26, 0xdd, 0x5f, 0x02, 0x6c,
+	0x5c, 0xa1, 0xc1, 0xf0, 0x9e, 0xe3, 0xb1, 0x25, 0xec, 0x79, 0xad, 0xed, 0xb4, 0x94, 0x0a, 0xb9,
+	0x7d, 0x1d, 0xb7, 0xd5, 0x14, 0x28, 0xd0, 0x9a, 0x7c, 0x61, 0x79, 0x34, 0x11, 0x88, 0xe2, 0x04,
+	0xb8, 0xf5, 0xb2, 0xfa, 0x15, 0x37, 0xb2, 0x04, 0x6d, 0xd2, 0x72, 0xec, 0x09, 0xf7, 0x5d, 0x8b,
+	0xa1, 0xcb, 0xf4, 0xfd, 0x5c, 0xe8, 0x81, 0x01, 0x55, 0x40, 0x55, 0x4a, 0x65, 0xb8, 0x79, 0x3f,
+	0x06, 0xed, 0x5e, 0x17, 0xdd, 0xfd, 0x4d, 0xe8, 0xed, 0xfe, 0x9b, 0x5a, 0x4e, 0x30, 0x4f, 0x8d,
+	0x44, 0x15, 0xee, 0xd0, 0xc6, 0x08, 0xa4, 0x18, 0x99, 0x88, 0xb4, 0x49, 0x67, 0x7d, 0xe0, 0xbd,
+	0xf0, 0x09, 0xdd, 0x58, 0x34, 0x8d, 0xd6, 0xda, 0xa4, 0xb3, 0x75, 0xd0, 0x62, 0x4e, 0x11, 0xbb,
+	0x56, 0xc4, 0x9e, 0x5f, 0x2b, 0xea, 0xdd, 0xb9, 0xf8, 0x9e, 0x04, 0xa7, 0x3f, 0x12, 0x72, 0x76,
+	0x75, 0xbe, 0x47, 0x06, 0x36, 0x2d, 0x6c, 0xd2, 0xcd, 0x31, 0xbe, 0x85, 0x2a, 0x5a, 0xb7, 0x55,
+	0x9d, 0x13, 0xf6, 0xe9, 0x76, 0x8e, 0x4a, 0x83, 0xd2, 0xb5, 0x1e, 0xa6, 0x45, 0x51, 0x81, 0xd6,
+	0xd1, 0x46, 0x9b, 0x74, 0x6e, 0xf5, 0xa2, 0xaf, 0x5f, 0xba, 0x4d, 0x3f, 0xc8, 0x91, 0x8b, 0x1c,
+	0x9b, 0x4a, 0x2a, 0x31, 0xb8, 0xbb, 0x4c, 0xf1, 0xf8, 0x61, 0xe7, 0xc3, 0x34, 0x09, 0x3e, 0x4d,
+	0x93, 0xe0, 0xd7, 0x34, 0x09, 0x3e, 0x5e, 0x9d, 0xef, 0xf9, 0x8d, 0x77, 0x75, 0xf1, 0x9a, 0xaf,
+	0x4e, 0xb7, 0xfb, 0x99, 0xd0, 0xf0, 0x29, 0xd6, 0xd9, 0x09, 0x1c, 0x4b, 0xa1, 0xfa, 0xfe, 0x20,
+	0x61, 0x97, 0x36, 0x26, 0x68, 0x60, 0x98, 0xda, 0xa1, 0xb7, 0x0e, 0x76, 0xd8, 0xcd, 0xd2, 0x98,
+	0x5b, 0xd7, 0x0b, 0x34, 0x30, 0xd8, 0x5c, 0xb0, 0x8e, 0x96, 0xf4, 0x2c, 0x5a, 0xfb, 0x3f, 0xbd,
+	0x77, 0xc8, 0xfe, 0x96, 0xf7, 0x70, 0x45, 0xde, 0xbf, 0x6a, 0x7a, 0xcf, 0xce, 0x66, 0x31, 0xb9,
+	0x98, 0xc5, 0xe4, 0x72, 0x16, 0x93, 0x9f, 0xb3, 0x98, 0x9c, 0xce, 0xe3, 0xe0, 0x72, 0x1e, 0x07,
+	0xdf, 0xe6, 0x71, 0xf0, 0xb2, 0x2b, 0xa4, 0x19, 0xd5, 0x19, 0xcb, 0xb1, 0xf4, 0x87, 0xe6, 0x2b,
+	0xe5, 0xde, 0xdd, 0xfc, 0x46, 0x2b, 0x24, 0x6b, 0xd8, 0x0b, 0x3d, 0xfe, 0x33, 0x00, 0x60, 0x09,
+	0x22, 0xcd, 0xad, 0x02, 0x00, 0x00,
 }
 
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
@@ -157,6 +209,53 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DoubleSignEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleSignEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleSignEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteB != nil {
+		{
+			size, err := m.VoteB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VoteA != nil {
+		{
+			size, err := m.VoteA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +288,23 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *DoubleSignEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteA != nil {
+		l = m.VoteA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.VoteB != nil {
+		l = m.VoteB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +464,128 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DoubleSignEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoubleSignEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoubleSignEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteA == nil {
+				m.VoteA = &types.Vote{}
+			}
+			if err := m.VoteA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteB == nil {
+				m.VoteB = &types.Vote{}
+			}
+			if err := m.VoteB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -70,6 +72,53 @@ func TestEquivocationValidateBasic(t *testing.T) {
 	}
 }
 
+func TestDoubleSignEvidenceValidateBasic(t *testing.T) {
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	addr := sdk.ConsAddress("foo_________________")
+
+	newVote := func(height int64, round int32, block string, addr sdk.ConsAddress) *tmproto.Vote {
+		return &tmproto.Vote{
+			Type:   tmproto.PrecommitType,
+			Height: height,
+			Round:  round,
+			BlockID: tmproto.BlockID{
+				Hash:          tmhash.Sum([]byte(block)),
+				PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte(block))},
+			},
+			Timestamp:        n,
+			ValidatorAddress: addr,
+			Signature:        []byte("signature"),
+		}
+	}
+
+	e := types.NewDoubleSignEvidence(newVote(100, 0, "a", addr), newVote(100, 0, "b", addr))
+	require.Equal(t, addr, e.GetConsensusAddress())
+	require.Equal(t, int64(100), e.GetHeight())
+	require.Equal(t, types.TypeDoubleSign, e.Type())
+	require.Equal(t, types.RouteDoubleSign, e.Route())
+
+	testCases := []struct {
+		name      string
+		e         *types.DoubleSignEvidence
+		expectErr bool
+	}{
+		{"valid", e, false},
+		{"missing vote", types.NewDoubleSignEvidence(newVote(100, 0, "a", addr), nil), true},
+		{"invalid vote", types.NewDoubleSignEvidence(newVote(0, 0, "a", addr), newVote(0, 0, "b", addr)), true},
+		{"different heights", types.NewDoubleSignEvidence(newVote(100, 0, "a", addr), newVote(101, 0, "b", addr)), true},
+		{"different rounds", types.NewDoubleSignEvidence(newVote(100, 0, "a", addr), newVote(100, 1, "b", addr)), true},
+		{"different validators", types.NewDoubleSignEvidence(newVote(100, 0, "a", addr), newVote(100, 0, "b", sdk.ConsAddress("bar_________________"))), true},
+		{"same block", types.NewDoubleSignEvidence(newVote(100, 0, "a", addr), newVote(100, 0, "a", addr)), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
 func TestEvidenceAddressConversion(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForConsensusNode("testcnclcons", "testcnclconspub")
 	tmEvidence := abci.Misbehavior{
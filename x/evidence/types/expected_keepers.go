@@ -3,6 +3,8 @@ package types
 import (
 	"time"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -16,6 +18,9 @@ type (
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
 		GetParams(ctx sdk.Context) (params stakingtypes.Params)
+		GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
+		PowerReduction(ctx sdk.Context) math.Int
+		BondDenom(ctx sdk.Context) string
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -26,8 +31,9 @@ type (
 		HasValidatorSigningInfo(sdk.Context, sdk.ConsAddress) bool
 		Tombstone(sdk.Context, sdk.ConsAddress)
 		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
-		SlashWithInfractionReason(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64, stakingtypes.Infraction)
+		SlashWithInfractionReason(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64, stakingtypes.Infraction) math.Int
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
+		SlashWithBounty(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64, sdk.AccAddress) math.Int
 		Jail(sdk.Context, sdk.ConsAddress)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
 	}
@@ -0,0 +1,110 @@
+package ante
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// FeeAbsKeeper defines the expected feeabs keeper used to price and convert
+// fees paid in non-native denoms.
+type FeeAbsKeeper interface {
+	GetBaseDenom(ctx sdk.Context) string
+	ConvertToBaseDenom(ctx sdk.Context, fee sdk.Coins) (sdk.Coin, error)
+	ConvertCollectedFee(ctx sdk.Context, fee sdk.Coins) error
+}
+
+// NewTxFeeChecker returns a TxFeeChecker, to be passed to
+// authante.NewDeductFeeDecorator, that accepts fees paid in the base denom or
+// in any denom of the on-chain conversion table.
+//
+// The fee is converted to its base denom equivalent, which must cover the
+// validator minimum gas price of the base denom, so validators only have to
+// set their prices once in base denom terms. The tx priority is computed from
+// the equivalent gas price. The fee is deducted in the denoms it was paid in,
+// and converted into the base denom by the ConvertFeeDecorator.
+func NewTxFeeChecker(k FeeAbsKeeper) authante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		equivalentFee, err := k.ConvertToBaseDenom(ctx, feeCoins)
+		if err != nil {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrInsufficientFee, err.Error())
+		}
+
+		// Ensure that the equivalent fee meets the minimum threshold for the
+		// validator, if this is a CheckTx. This is only for local mempool
+		// purposes, and thus is only ran on check tx.
+		if ctx.IsCheckTx() {
+			minGasPrice := ctx.MinGasPrices().AmountOf(k.GetBaseDenom(ctx))
+			if minGasPrice.IsPositive() {
+				// fee = ceil(minGasPrice * gasLimit)
+				required := minGasPrice.Mul(sdkmath.LegacyNewDec(int64(gas))).Ceil().RoundInt()
+				if equivalentFee.Amount.LT(required) {
+					return nil, 0, errorsmod.Wrapf(
+						sdkerrors.ErrInsufficientFee,
+						"insufficient fees; got: %s (%s) required: %s",
+						feeCoins, equivalentFee, sdk.NewCoin(equivalentFee.Denom, required),
+					)
+				}
+			}
+		}
+
+		priority := getTxPriority(equivalentFee, int64(gas))
+		return feeCoins, priority, nil
+	}
+}
+
+// ConvertFeeDecorator converts the fee collected by the DeductFeeDecorator of
+// x/auth into the base denom, so that the fee collector only receives base
+// denom coins. It must be placed right after the DeductFeeDecorator.
+type ConvertFeeDecorator struct {
+	k FeeAbsKeeper
+}
+
+// NewConvertFeeDecorator returns a new ConvertFeeDecorator.
+func NewConvertFeeDecorator(k FeeAbsKeeper) ConvertFeeDecorator {
+	return ConvertFeeDecorator{k: k}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (cfd ConvertFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if fee := feeTx.GetFee(); !fee.IsZero() {
+		if err := cfd.k.ConvertCollectedFee(ctx, fee); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// getTxPriority returns the tx priority based on the base denom equivalent gas
+// price of the fee.
+func getTxPriority(fee sdk.Coin, gas int64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	gasPrice := fee.Amount.QuoRaw(gas)
+	if !gasPrice.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return gasPrice.Int64()
+}
@@ -0,0 +1,158 @@
+package ante_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/ante"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/keeper"
+	feeabstestutil "github.com/cosmos/cosmos-sdk/x/feeabs/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+// feeTx is a minimal sdk.FeeTx used to exercise the fee checker.
+type feeTx struct {
+	sdk.FeeTx
+
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx feeTx) GetFee() sdk.Coins { return tx.fee }
+func (tx feeTx) GetGas() uint64    { return tx.gas }
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *feeabstestutil.MockBankKeeper, *feeabstestutil.MockDistributionKeeper) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feeabs.AppModuleBasic{})
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+
+	ctrl := gomock.NewController(t)
+	accountKeeper := feeabstestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(gomock.Any()).DoAndReturn(authtypes.NewModuleAddress).AnyTimes()
+	bankKeeper := feeabstestutil.NewMockBankKeeper(ctrl)
+	distrKeeper := feeabstestutil.NewMockDistributionKeeper(ctrl)
+
+	k := keeper.NewKeeper(encCfg.Codec, key, accountKeeper, bankKeeper, distrKeeper, "")
+	require.NoError(t, k.SetParams(testCtx.Ctx, types.NewParams("stake", []types.FeeDenom{
+		types.NewFeeDenom("uusdc", sdk.NewDec(2)),
+	})))
+
+	return testCtx.Ctx, k, bankKeeper, distrKeeper
+}
+
+func TestTxFeeChecker(t *testing.T) {
+	sdkCtx, k, _, _ := setupKeeper(t)
+
+	checker := ante.NewTxFeeChecker(k)
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)))
+
+	testCases := []struct {
+		name        string
+		fee         sdk.Coins
+		gas         uint64
+		checkTx     bool
+		expPriority int64
+		expErr      error
+	}{
+		{
+			name:        "base denom fee",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 20000)),
+			gas:         200000,
+			checkTx:     true,
+			expPriority: 0,
+		},
+		{
+			name:        "converted fee covers min gas price",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uusdc", 400000)),
+			gas:         200000,
+			checkTx:     true,
+			expPriority: 4,
+		},
+		{
+			name:    "converted fee below min gas price",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uusdc", 9999)),
+			gas:     200000,
+			checkTx: true,
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "min gas price not enforced in deliver tx",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1)),
+			gas:         200000,
+			expPriority: 0,
+		},
+		{
+			name:    "unsupported denom",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uatom", 400000)),
+			gas:     200000,
+			checkTx: true,
+			expErr:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "priority is capped",
+			fee:         sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewIntFromUint64(math.MaxUint64))),
+			gas:         1,
+			expPriority: math.MaxInt64,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdkCtx.WithIsCheckTx(tc.checkTx).WithMinGasPrices(minGasPrices)
+
+			fee, priority, err := checker(ctx, feeTx{fee: tc.fee, gas: tc.gas})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.fee, fee)
+			require.Equal(t, tc.expPriority, priority)
+		})
+	}
+}
+
+func TestConvertFeeDecorator(t *testing.T) {
+	ctx, k, bankKeeper, distrKeeper := setupKeeper(t)
+	decorator := ante.NewConvertFeeDecorator(k)
+
+	nextCalled := false
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	// the fees paid in the base denom are left to the fee collector
+	_, err := decorator.AnteHandle(ctx, feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}, false, next)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+
+	// the other fees are converted
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))
+	equivalent := sdk.NewCoins(sdk.NewInt64Coin("stake", 200))
+	bankKeeper.EXPECT().GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), "stake").Return(sdk.NewInt64Coin("stake", 200))
+	distrKeeper.EXPECT().FundCommunityPool(ctx, fee, authtypes.NewModuleAddress(authtypes.FeeCollectorName)).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, equivalent).Return(nil)
+
+	nextCalled = false
+	_, err = decorator.AnteHandle(ctx, feeTx{fee: fee}, false, next)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+
+	// the tx fails if the reserve cannot cover the fee
+	bankKeeper.EXPECT().GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), "stake").Return(sdk.NewInt64Coin("stake", 199))
+
+	nextCalled = false
+	_, err = decorator.AnteHandle(ctx, feeTx{fee: fee}, false, next)
+	require.ErrorIs(t, err, types.ErrInsufficientReserve)
+	require.False(t, nextCalled)
+}
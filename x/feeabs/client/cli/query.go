@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+// GetQueryCmd returns the cli query commands for the feeabs module.
+func GetQueryCmd() *cobra.Command {
+	feeabsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the fee abstraction module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeabsQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryFeeDenom(),
+		GetCmdQueryEquivalentFee(),
+		GetCmdQueryReserve(),
+	)
+
+	return feeabsQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current fee abstraction
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current fee abstraction parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFeeDenom implements a command to return the conversion rate of an
+// accepted fee denom.
+func GetCmdQueryFeeDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-denom [denom]",
+		Short: "Query the conversion rate of an accepted fee denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the conversion rate to the base denom of an accepted fee denom.
+
+Example:
+$ %s query %s fee-denom uusdc
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeDenom(cmd.Context(), &types.QueryFeeDenomRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.FeeDenom)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEquivalentFee implements a command to return the base denom
+// equivalent of a fee.
+func GetCmdQueryEquivalentFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "equivalent-fee [fee]",
+		Short: "Query the base denom equivalent of a fee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the base denom equivalent of a fee paid in accepted fee denoms.
+
+Example:
+$ %s query %s equivalent-fee 100uusdc
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EquivalentFee(cmd.Context(), &types.QueryEquivalentFeeRequest{Fee: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Fee)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryReserve implements a command to return the base denom reserve.
+func GetCmdQueryReserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve",
+		Short: "Query the base denom reserve paying the equivalent of the converted fees",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Reserve(cmd.Context(), &types.QueryReserveRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Reserve)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/feeabs transaction commands.
+func NewTxCmd() *cobra.Command {
+	feeabsTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee abstraction transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeabsTxCmd.AddCommand(
+		NewFundReserveCmd(),
+	)
+
+	return feeabsTxCmd
+}
+
+// NewFundReserveCmd returns a CLI command handler for creating a MsgFundReserve transaction.
+func NewFundReserveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-reserve [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Funds the base denom reserve with the specified amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Funds the base denom reserve, which pays the base denom equivalent of the
+fees paid in the accepted fee denoms, with the specified amount of base denom.
+
+Example:
+$ %s tx %s fund-reserve 1000000stake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			depositorAddr := clientCtx.GetFromAddress()
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundReserve(amount, depositorAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
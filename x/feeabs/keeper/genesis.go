@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+// InitGenesis new feeabs genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the feeabs module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// FeeDenom returns the conversion table entry of an accepted fee denom.
+func (k Keeper) FeeDenom(c context.Context, req *types.QueryFeeDenomRequest) (*types.QueryFeeDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fd, ok := k.GetFeeDenom(ctx, req.Denom)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "fee denom %s not found", req.Denom)
+	}
+
+	return &types.QueryFeeDenomResponse{FeeDenom: fd}, nil
+}
+
+// EquivalentFee returns the base denom equivalent of a fee.
+func (k Keeper) EquivalentFee(c context.Context, req *types.QueryEquivalentFeeRequest) (*types.QueryEquivalentFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fee, err := sdk.ParseCoinsNormalized(req.Fee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	equivalent, err := k.ConvertToBaseDenom(ctx, fee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEquivalentFeeResponse{Fee: equivalent}, nil
+}
+
+// Reserve returns the base denom reserve.
+func (k Keeper) Reserve(c context.Context, _ *types.QueryReserveRequest) (*types.QueryReserveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryReserveResponse{Reserve: k.GetReserve(ctx)}, nil
+}
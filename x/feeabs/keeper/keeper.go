@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+// Keeper of the feeabs store
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	storeKey    storetypes.StoreKey
+	authKeeper  types.AccountKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new feeabs Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, ak types.AccountKeeper,
+	bk types.BankKeeper, dk types.DistributionKeeper, authority string,
+) Keeper {
+	// ensure the reserve module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    key,
+		authKeeper:  ak,
+		bankKeeper:  bk,
+		distrKeeper: dk,
+		authority:   authority,
+	}
+}
+
+// GetAuthority returns the x/feeabs module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/feeabs module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&p)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/feeabs module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
+// GetBaseDenom returns the denom fees are converted into.
+func (k Keeper) GetBaseDenom(ctx sdk.Context) string {
+	return k.GetParams(ctx).BaseDenom
+}
+
+// GetFeeDenom returns the conversion table entry of an accepted fee denom.
+func (k Keeper) GetFeeDenom(ctx sdk.Context, denom string) (types.FeeDenom, bool) {
+	return k.GetParams(ctx).GetFeeDenom(denom)
+}
+
+// ConvertToBaseDenom returns the base denom equivalent of the given fee.
+// Coins of the base denom are counted as is, coins of an accepted fee denom
+// are converted using its rate, rounding down. Any other denom is rejected.
+func (k Keeper) ConvertToBaseDenom(ctx sdk.Context, fee sdk.Coins) (sdk.Coin, error) {
+	params := k.GetParams(ctx)
+
+	total := sdk.ZeroDec()
+	for _, coin := range fee {
+		if coin.Denom == params.BaseDenom {
+			total = total.Add(sdk.NewDecFromInt(coin.Amount))
+			continue
+		}
+
+		fd, ok := params.GetFeeDenom(coin.Denom)
+		if !ok {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrUnsupportedFeeDenom, "%s is not accepted to pay fees", coin.Denom)
+		}
+		total = total.Add(fd.Rate.MulInt(coin.Amount))
+	}
+
+	return sdk.NewCoin(params.BaseDenom, total.TruncateInt()), nil
+}
+
+// GetReserve returns the base denom reserve held by the module account.
+func (k Keeper) GetReserve(ctx sdk.Context) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, k.authKeeper.GetModuleAddress(types.ModuleName), k.GetBaseDenom(ctx))
+}
+
+// FundReserve adds the given amount of base denom from the depositor to the
+// reserve the equivalent of the converted fees is paid from.
+func (k Keeper) FundReserve(ctx sdk.Context, amount sdk.Coin, depositor sdk.AccAddress) error {
+	if baseDenom := k.GetBaseDenom(ctx); amount.Denom != baseDenom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "reserve can only be funded with %s, got %s", baseDenom, amount.Denom)
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.NewCoins(amount))
+}
+
+// ConvertCollectedFee converts the coins of a fee collected by the fee
+// collector which are not of the base denom into their base denom equivalent.
+// The equivalent is paid to the fee collector from the base denom reserve held
+// by the module account, and the converted coins are sent to the community
+// pool. It fails if the reserve does not cover the equivalent, until it is
+// refilled with FundReserve.
+func (k Keeper) ConvertCollectedFee(ctx sdk.Context, fee sdk.Coins) error {
+	baseDenom := k.GetBaseDenom(ctx)
+
+	converted := sdk.NewCoins()
+	for _, coin := range fee {
+		if coin.Denom != baseDenom {
+			converted = converted.Add(coin)
+		}
+	}
+	if converted.IsZero() {
+		return nil
+	}
+
+	equivalent, err := k.ConvertToBaseDenom(ctx, converted)
+	if err != nil {
+		return err
+	}
+
+	reserve := k.GetReserve(ctx)
+	if reserve.IsLT(equivalent) {
+		return errorsmod.Wrapf(types.ErrInsufficientReserve, "reserve %s cannot cover the equivalent %s of %s", reserve, equivalent, converted)
+	}
+
+	feeCollector := k.authKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	if err := k.distrKeeper.FundCommunityPool(ctx, converted, feeCollector); err != nil {
+		return err
+	}
+
+	if equivalent.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(equivalent))
+}
@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/keeper"
+	feeabstestutil "github.com/cosmos/cosmos-sdk/x/feeabs/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	reserveAddr      = authtypes.NewModuleAddress(types.ModuleName)
+	feeCollectorAddr = authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	bankKeeper  *feeabstestutil.MockBankKeeper
+	distrKeeper *feeabstestutil.MockDistributionKeeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feeabs.AppModuleBasic{})
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := feeabstestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(reserveAddr).AnyTimes()
+	accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollectorAddr).AnyTimes()
+	s.bankKeeper = feeabstestutil.NewMockBankKeeper(ctrl)
+	s.distrKeeper = feeabstestutil.NewMockDistributionKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, key, accountKeeper, s.bankKeeper, s.distrKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.NewParams("stake", []types.FeeDenom{
+		types.NewFeeDenom("uusdc", sdk.NewDec(2)),
+		types.NewFeeDenom("ueth", sdk.NewDecWithPrec(5, 1)),
+	})))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.keeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (s *KeeperTestSuite) TestConvertToBaseDenom() {
+	testCases := []struct {
+		name      string
+		fee       sdk.Coins
+		expected  sdk.Coin
+		expectErr bool
+	}{
+		{
+			name:     "empty fee",
+			fee:      sdk.NewCoins(),
+			expected: sdk.NewInt64Coin("stake", 0),
+		},
+		{
+			name:     "base denom",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expected: sdk.NewInt64Coin("stake", 100),
+		},
+		{
+			name:     "fee denom",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)),
+			expected: sdk.NewInt64Coin("stake", 200),
+		},
+		{
+			name:     "fractional rate rounds down",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("ueth", 101)),
+			expected: sdk.NewInt64Coin("stake", 50),
+		},
+		{
+			name:     "mixed denoms",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uusdc", 100), sdk.NewInt64Coin("ueth", 100)),
+			expected: sdk.NewInt64Coin("stake", 260),
+		},
+		{
+			name:      "unsupported denom",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			converted, err := s.keeper.ConvertToBaseDenom(s.ctx, tc.fee)
+			if tc.expectErr {
+				s.Require().ErrorIs(err, types.ErrUnsupportedFeeDenom)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, converted)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestConvertCollectedFee() {
+	// the base denom coins are not converted
+	s.Require().NoError(s.keeper.ConvertCollectedFee(s.ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+
+	// the equivalent of the other coins is paid from the reserve, and the
+	// converted coins go to the community pool
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uusdc", 100), sdk.NewInt64Coin("ueth", 101))
+	converted := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100), sdk.NewInt64Coin("ueth", 101))
+	equivalent := sdk.NewCoins(sdk.NewInt64Coin("stake", 250))
+
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, reserveAddr, "stake").Return(sdk.NewInt64Coin("stake", 250))
+	gomock.InOrder(
+		s.distrKeeper.EXPECT().FundCommunityPool(s.ctx, converted, feeCollectorAddr).Return(nil),
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, equivalent).Return(nil),
+	)
+	s.Require().NoError(s.keeper.ConvertCollectedFee(s.ctx, fee))
+
+	// the reserve must cover the equivalent
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, reserveAddr, "stake").Return(sdk.NewInt64Coin("stake", 249))
+	s.Require().ErrorIs(s.keeper.ConvertCollectedFee(s.ctx, fee), types.ErrInsufficientReserve)
+
+	// the converted coins must be accepted
+	s.Require().ErrorIs(s.keeper.ConvertCollectedFee(s.ctx, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))), types.ErrUnsupportedFeeDenom)
+}
+
+func (s *KeeperTestSuite) TestFundReserve() {
+	depositor := sdk.AccAddress("depositor")
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))
+	funds := sdk.NewInt64Coin("stake", 1000)
+
+	// the fees paid in the accepted fee denoms cannot be converted while the
+	// reserve is empty
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, reserveAddr, "stake").Return(sdk.NewInt64Coin("stake", 0))
+	s.Require().ErrorIs(s.keeper.ConvertCollectedFee(s.ctx, fee), types.ErrInsufficientReserve)
+
+	// the reserve can only be funded with the base denom
+	_, err := s.msgServer.FundReserve(s.ctx, types.NewMsgFundReserve(sdk.NewInt64Coin("uusdc", 1000), depositor))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	_, err = s.msgServer.FundReserve(s.ctx, &types.MsgFundReserve{Depositor: "invalid", Amount: funds})
+	s.Require().Error(err)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, depositor, types.ModuleName, sdk.NewCoins(funds)).Return(nil)
+	_, err = s.msgServer.FundReserve(s.ctx, types.NewMsgFundReserve(funds, depositor))
+	s.Require().NoError(err)
+
+	// the refilled reserve pays the equivalent of the converted fees again
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, reserveAddr, "stake").Return(funds)
+	gomock.InOrder(
+		s.distrKeeper.EXPECT().FundCommunityPool(s.ctx, fee, feeCollectorAddr).Return(nil),
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))).Return(nil),
+	)
+	s.Require().NoError(s.keeper.ConvertCollectedFee(s.ctx, fee))
+}
+
+func (s *KeeperTestSuite) TestParams() {
+	testCases := []struct {
+		name      string
+		input     types.Params
+		expectErr bool
+	}{
+		{
+			name:  "valid params",
+			input: types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("uusdc", sdk.OneDec())}),
+		},
+		{
+			name:      "invalid base denom",
+			input:     types.NewParams("", nil),
+			expectErr: true,
+		},
+		{
+			name:      "non positive rate",
+			input:     types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("uusdc", sdk.ZeroDec())}),
+			expectErr: true,
+		},
+		{
+			name:      "base denom in table",
+			input:     types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("stake", sdk.OneDec())}),
+			expectErr: true,
+		},
+		{
+			name: "duplicate fee denom",
+			input: types.NewParams("stake", []types.FeeDenom{
+				types.NewFeeDenom("uusdc", sdk.OneDec()),
+				types.NewFeeDenom("uusdc", sdk.NewDec(2)),
+			}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			expected := s.keeper.GetParams(s.ctx)
+			err := s.keeper.SetParams(s.ctx, tc.input)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				expected = tc.input
+				s.Require().NoError(err)
+			}
+
+			s.Require().Equal(expected, s.keeper.GetParams(s.ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams("stake", []types.FeeDenom{types.NewFeeDenom("uusdc", sdk.OneDec())})
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: "invalid",
+		Params:    params,
+	})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: s.keeper.GetAuthority(),
+		Params:    params,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.keeper.GetParams(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	res, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetParams(s.ctx), res.Params)
+
+	fdRes, err := s.queryClient.FeeDenom(s.ctx, &types.QueryFeeDenomRequest{Denom: "uusdc"})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewFeeDenom("uusdc", sdk.NewDec(2)), fdRes.FeeDenom)
+
+	_, err = s.queryClient.FeeDenom(s.ctx, &types.QueryFeeDenomRequest{Denom: "uatom"})
+	s.Require().Error(err)
+
+	feeRes, err := s.queryClient.EquivalentFee(s.ctx, &types.QueryEquivalentFeeRequest{Fee: "100uusdc"})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 200), feeRes.Fee)
+
+	_, err = s.queryClient.EquivalentFee(s.ctx, &types.QueryEquivalentFeeRequest{Fee: "100uatom"})
+	s.Require().Error(err)
+
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), reserveAddr, "stake").Return(sdk.NewInt64Coin("stake", 1000))
+	reserveRes, err := s.queryClient.Reserve(s.ctx, &types.QueryReserveRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 1000), reserveRes.Reserve)
+}
@@ -0,0 +1,8 @@
+// Package modulev1 holds the app config object of the feeabs module, generated
+// from cosmos/feeabs/module/v1/module.proto. Unlike the config objects of the
+// other modules, which are imported from the cosmossdk.io/api release this
+// module depends on, it is generated in this module, as that release does not
+// include it:
+//
+//	protoc --go_out=paths=source_relative,Mcosmos/feeabs/module/v1/module.proto=github.com/cosmos/cosmos-sdk/x/feeabs/module/v1:. cosmos/feeabs/module/v1/module.proto
+package modulev1
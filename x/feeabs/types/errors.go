@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/feeabs module sentinel errors
+var (
+	ErrInvalidFeeDenom     = errors.Register(ModuleName, 2, "invalid fee denom")
+	ErrUnsupportedFeeDenom = errors.Register(ModuleName, 3, "unsupported fee denom")
+	ErrInsufficientReserve = errors.Register(ModuleName, 4, "insufficient base denom reserve")
+)
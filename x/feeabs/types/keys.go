@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeabs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// ParamsKey is the key to use for the params in the keeper store.
+var ParamsKey = []byte{0x00}
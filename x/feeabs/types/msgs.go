@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgFundReserve{}
+)
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if err := m.Params.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewMsgFundReserve returns a new MsgFundReserve with a depositor and an amount
+// of base denom.
+func NewMsgFundReserve(amount sdk.Coin, depositor sdk.AccAddress) *MsgFundReserve {
+	return &MsgFundReserve{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgFundReserve) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgFundReserve message.
+func (m *MsgFundReserve) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Depositor)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgFundReserve) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Depositor); err != nil {
+		return sdkerrors.Wrap(err, "invalid depositor address")
+	}
+
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.Amount.String())
+	}
+
+	return nil
+}
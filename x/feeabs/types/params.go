@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(baseDenom string, feeDenoms []FeeDenom) Params {
+	return Params{
+		BaseDenom: baseDenom,
+		FeeDenoms: feeDenoms,
+	}
+}
+
+// DefaultParams returns default x/feeabs module parameters.
+func DefaultParams() Params {
+	return Params{
+		BaseDenom: sdk.DefaultBondDenom,
+		FeeDenoms: []FeeDenom{},
+	}
+}
+
+// NewFeeDenom returns a FeeDenom instance with the given values.
+func NewFeeDenom(denom string, rate sdk.Dec) FeeDenom {
+	return FeeDenom{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.BaseDenom); err != nil {
+		return fmt.Errorf("invalid base denom: %w", err)
+	}
+
+	seen := make(map[string]bool, len(p.FeeDenoms))
+	for _, fd := range p.FeeDenoms {
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+		if fd.Denom == p.BaseDenom {
+			return fmt.Errorf("fee denom %s must not be the base denom", fd.Denom)
+		}
+		if seen[fd.Denom] {
+			return fmt.Errorf("duplicate fee denom %s", fd.Denom)
+		}
+		seen[fd.Denom] = true
+	}
+
+	return nil
+}
+
+// GetFeeDenom returns the FeeDenom entry for the given denom, if any.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, fd := range p.FeeDenoms {
+		if fd.Denom == denom {
+			return fd, true
+		}
+	}
+
+	return FeeDenom{}, false
+}
+
+// Validate does the sanity check on the fee denom.
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+	if fd.Rate.IsNil() || !fd.Rate.IsPositive() {
+		return fmt.Errorf("conversion rate of fee denom %s must be positive: %s", fd.Denom, fd.Rate)
+	}
+
+	return nil
+}
@@ -0,0 +1,1658 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feeabs/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314fb88d48c3fe46, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314fb88d48c3fe46, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryFeeDenomRequest is the request type for the Query/FeeDenom RPC method.
+type QueryFeeDenomRequest struct {
+	// denom is the fee denom to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFeeDenomRequest) Reset()         { *m = QueryFeeDenomRequest{} }
+func (m *QueryFeeDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDenomRequest) ProtoMessage()    {}
+func (*QueryFeeDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314fb88d48c3fe46, []int{2}
+}
+func (m *QueryFeeDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDenomRequest.Merge(m, src)
+}
+func (m *QueryFeeDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDenomRequest proto.InternalMessageInfo
+
+func (m *QueryFeeDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryFeeDenomResponse is the response type for the Query/FeeDenom RPC method.
+type QueryFeeDenomResponse struct {
+	// fee_denom is the accepted fee denom along with its conversion rate.
+	FeeDenom FeeDenom `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom"`
+}
+
+func (m *QueryFeeDenomResponse) Reset()         { *m = QueryFeeDenomResponse{} }
+func (m *QueryFeeDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDenomResponse) ProtoMessage()    {}
+func (*QueryFeeDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314fb88d48c3fe46, []int{3}
+}
+func (m *QueryFeeDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDenomResponse.Merge(m, src)
+}
+func (m *QueryFeeDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDenomResponse proto.InternalMessageInfo
+
+func (m *QueryFeeDenomResponse) GetFeeDenom() FeeDenom {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return FeeDenom{}
+}
+
+// QueryEquivalentFeeRequest is the request type for the Query/EquivalentFee RPC
+// method.
+type QueryEquivalentFeeRequest struct {
+	// fee is the fee to convert, e.g. 100uusdc.
+	Fee string `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (m *QueryEquivalentFeeRequest) Reset()         { *m = QueryEquivalentFeeRequest{} }
+func (m *QueryEquivalentFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEquivalentFeeRequest) ProtoMessage()    {}
+func (*QueryEquivalentFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314fb88d48c3fe46, []int{4}
+}
+func (m *QueryEquivalentFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEquivalentFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEquivalentFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEquivalentFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEquivalentFeeRequest.Merge(m, src)
+}
+func (m *QueryEquivalentFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEquivalentFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEquivalentFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEquivalentFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEquivalentFeeRequest) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+// QueryEquivalentFeeResponse is the response type for the Query/EquivalentFee
+// RPC method.
+type QueryEquivalentFeeResponse struct {
+	// fee is the base denom equivalent of the requested fee.
+	Fee types.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *QueryEquivalentFeeResponse) Reset()         { *m = QueryEquivalentFeeResponse{} }
+func (m *QueryEquivalentFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEquivalentFeeResponse) ProtoMessage()    {}
+func (*QueryEquivalentFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314fb88d48c3fe46, []int{5}
+}
+func (m *QueryEquivalentFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEquivalentFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEquivalentFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEquivalentFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEquivalentFeeResponse.Merge(m, src)
+}
+func (m *QueryEquivalentFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEquivalentFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEquivalentFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEquivalentFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEquivalentFeeResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+// QueryReserveRequest is the request type for the Query/Reserve RPC method.
+type QueryReserveRequest struct {
+}
+
+func (m *QueryReserveRequest) Reset()         { *m = QueryReserveRequest{} }
+func (m *QueryReserveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReserveRequest) ProtoMessage()    {}
+func (*QueryReserveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314fb88d48c3fe46, []int{6}
+}
+func (m *QueryReserveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReserveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserveRequest.Merge(m, src)
+}
+func (m *QueryReserveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReserveRequest proto.InternalMessageInfo
+
+// QueryReserveResponse is the response type for the Query/Reserve RPC method.
+type QueryReserveResponse struct {
+	// reserve is the base denom balance of the reserve.
+	Reserve types.Coin `protobuf:"bytes,1,opt,name=reserve,proto3" json:"reserve"`
+}
+
+func (m *QueryReserveResponse) Reset()         { *m = QueryReserveResponse{} }
+func (m *QueryReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReserveResponse) ProtoMessage()    {}
+func (*QueryReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_314fb88d48c3fe46, []int{7}
+}
+func (m *QueryReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReserveResponse.Merge(m, src)
+}
+func (m *QueryReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReserveResponse proto.InternalMessageInfo
+
+func (m *QueryReserveResponse) GetReserve() types.Coin {
+	if m != nil {
+		return m.Reserve
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.feeabs.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.feeabs.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryFeeDenomRequest)(nil), "cosmos.feeabs.v1.QueryFeeDenomRequest")
+	proto.RegisterType((*QueryFeeDenomResponse)(nil), "cosmos.feeabs.v1.QueryFeeDenomResponse")
+	proto.RegisterType((*QueryEquivalentFeeRequest)(nil), "cosmos.feeabs.v1.QueryEquivalentFeeRequest")
+	proto.RegisterType((*QueryEquivalentFeeResponse)(nil), "cosmos.feeabs.v1.QueryEquivalentFeeResponse")
+	proto.RegisterType((*QueryReserveRequest)(nil), "cosmos.feeabs.v1.QueryReserveRequest")
+	proto.RegisterType((*QueryReserveResponse)(nil), "cosmos.feeabs.v1.QueryReserveResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/feeabs/v1/query.proto", fileDescriptor_314fb88d48c3fe46) }
+
+var fileDescriptor_314fb88d48c3fe46 = []byte{
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x63, 0xa0, 0x2f, 0xb9, 0x08, 0xa9, 0x1c, 0xae, 0x94, 0x98, 0x62, 0x82, 0x55, 0x42,
+	0x45, 0x53, 0x9f, 0x12, 0x24, 0x16, 0x24, 0x86, 0x40, 0x3b, 0x83, 0x85, 0x18, 0x60, 0xa8, 0xce,
+	0xe9, 0x63, 0x63, 0x51, 0xfb, 0x1c, 0x9f, 0x63, 0xa8, 0x2a, 0x16, 0x36, 0x36, 0x24, 0xf8, 0x00,
+	0x8c, 0x8c, 0x7c, 0x8c, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0x90, 0xf8, 0x1a, 0xc8, 0x77, 0x67,
+	0xda, 0xc4, 0x89, 0x02, 0x4b, 0xec, 0x7b, 0xde, 0xfe, 0xbf, 0xdc, 0xf3, 0x4f, 0xd0, 0x46, 0x9f,
+	0xf1, 0x90, 0x71, 0xe2, 0x01, 0x50, 0x97, 0x93, 0xac, 0x43, 0x06, 0x43, 0x48, 0x8e, 0xec, 0x38,
+	0x61, 0x29, 0xc3, 0x6b, 0x32, 0x6b, 0xcb, 0xac, 0x9d, 0x75, 0x0c, 0xdd, 0x67, 0x3e, 0x13, 0x49,
+	0x92, 0xbf, 0xc9, 0x3a, 0x63, 0xc3, 0x67, 0xcc, 0x3f, 0x04, 0x42, 0xe3, 0x80, 0xd0, 0x28, 0x62,
+	0x29, 0x4d, 0x03, 0x16, 0x71, 0x95, 0xbd, 0x5e, 0xd2, 0x50, 0xf3, 0x64, 0xda, 0x54, 0x69, 0x97,
+	0x72, 0x20, 0x59, 0xc7, 0x85, 0x94, 0x76, 0x48, 0x9f, 0x05, 0x91, 0xca, 0x5f, 0xa1, 0x61, 0x10,
+	0x31, 0x22, 0x3e, 0x65, 0xc8, 0xd2, 0x11, 0x7e, 0x92, 0x63, 0x3e, 0xa6, 0x09, 0x0d, 0xb9, 0x03,
+	0x83, 0x21, 0xf0, 0xd4, 0x72, 0xd0, 0xd5, 0x89, 0x28, 0x8f, 0x59, 0xc4, 0x01, 0xdf, 0x47, 0xcb,
+	0xb1, 0x88, 0xd4, 0xb5, 0xa6, 0xb6, 0x55, 0xeb, 0xd6, 0xed, 0xe9, 0x6f, 0x65, 0xcb, 0x8e, 0x5e,
+	0xf5, 0xe4, 0xc7, 0x8d, 0xca, 0x97, 0xdf, 0x5f, 0xef, 0x68, 0x8e, 0x6a, 0xb1, 0xda, 0x48, 0x17,
+	0x33, 0xf7, 0x00, 0x1e, 0x41, 0xc4, 0x42, 0xa5, 0x85, 0x75, 0xb4, 0x74, 0x90, 0x9f, 0xc5, 0xcc,
+	0xaa, 0x23, 0x0f, 0xd6, 0x0b, 0xb4, 0x3e, 0x55, 0xad, 0x18, 0x7a, 0xa8, 0xea, 0x01, 0xec, 0x9f,
+	0xb5, 0xd4, 0xba, 0x46, 0x19, 0xa3, 0x68, 0x3b, 0x0f, 0xb2, 0xea, 0xa9, 0xa0, 0xb5, 0x83, 0x1a,
+	0x62, 0xf8, 0xee, 0x60, 0x18, 0x64, 0xf4, 0x10, 0xa2, 0x74, 0x0f, 0xa0, 0xe0, 0x59, 0x43, 0x17,
+	0x3d, 0x00, 0x45, 0x93, 0xbf, 0x5a, 0x4f, 0x91, 0x31, 0xab, 0x5c, 0x01, 0xdd, 0x3b, 0xab, 0xaf,
+	0x75, 0x1b, 0x05, 0x4a, 0xbe, 0x02, 0x5b, 0xad, 0xc0, 0x7e, 0xc8, 0x82, 0xe8, 0x3c, 0x89, 0x98,
+	0xba, 0xae, 0xee, 0xd8, 0x01, 0x0e, 0x49, 0x56, 0xc8, 0x5b, 0xcf, 0x90, 0x3e, 0x19, 0x56, 0x32,
+	0x0f, 0xd0, 0x4a, 0x22, 0x43, 0xff, 0x25, 0x55, 0x34, 0x75, 0x3f, 0x5f, 0x42, 0x4b, 0x62, 0x30,
+	0x7e, 0x8d, 0x96, 0xe5, 0x96, 0xf0, 0x66, 0xf9, 0xe2, 0xca, 0x66, 0x30, 0x6e, 0x2d, 0xa8, 0x92,
+	0x80, 0x56, 0xf3, 0xdd, 0xb7, 0x5f, 0x1f, 0x2f, 0x18, 0xb8, 0x4e, 0x4a, 0x26, 0x95, 0x0e, 0xc0,
+	0xef, 0x35, 0xb4, 0x5a, 0x2c, 0x06, 0xb7, 0xe6, 0x4c, 0x9d, 0xb2, 0x87, 0x71, 0x7b, 0x61, 0x9d,
+	0xd2, 0x6f, 0x0b, 0xfd, 0x16, 0xde, 0x24, 0xb3, 0x7e, 0x24, 0xd2, 0x30, 0x9c, 0x1c, 0x8b, 0xe7,
+	0x5b, 0xfc, 0x49, 0x43, 0x97, 0x27, 0xf6, 0x89, 0xb7, 0xe7, 0x08, 0xcd, 0x32, 0x89, 0xd1, 0xfe,
+	0xb7, 0x62, 0x85, 0xb6, 0x25, 0xd0, 0x2c, 0xdc, 0x2c, 0xa3, 0xc1, 0xdf, 0x86, 0x7d, 0x0f, 0x00,
+	0x1f, 0xa3, 0x15, 0xb5, 0x78, 0x3c, 0xef, 0xda, 0x27, 0xfd, 0x62, 0xb4, 0x16, 0x95, 0x29, 0x86,
+	0x9b, 0x82, 0xe1, 0x1a, 0x6e, 0x94, 0x19, 0x94, 0x45, 0x7a, 0xbb, 0x27, 0x23, 0x53, 0x3b, 0x1d,
+	0x99, 0xda, 0xcf, 0x91, 0xa9, 0x7d, 0x18, 0x9b, 0x95, 0xd3, 0xb1, 0x59, 0xf9, 0x3e, 0x36, 0x2b,
+	0xcf, 0xb7, 0xfd, 0x20, 0x7d, 0x39, 0x74, 0xed, 0x3e, 0x0b, 0x8b, 0x76, 0xf9, 0xd8, 0xe1, 0x07,
+	0xaf, 0xc8, 0x9b, 0x62, 0x56, 0x7a, 0x14, 0x03, 0x77, 0x97, 0xc5, 0x3f, 0xcb, 0xdd, 0x3f, 0x03,
+	0x00, 0x92, 0xe3, 0x13, 0xcc, 0x11, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of fee abstraction parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeeDenom returns the conversion rate of an accepted fee denom.
+	FeeDenom(ctx context.Context, in *QueryFeeDenomRequest, opts ...grpc.CallOption) (*QueryFeeDenomResponse, error)
+	// EquivalentFee returns the base denom equivalent of a fee.
+	EquivalentFee(ctx context.Context, in *QueryEquivalentFeeRequest, opts ...grpc.CallOption) (*QueryEquivalentFeeResponse, error)
+	// Reserve returns the base denom reserve the equivalent of the converted
+	// fees is paid from.
+	Reserve(ctx context.Context, in *QueryReserveRequest, opts ...grpc.CallOption) (*QueryReserveResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feeabs.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeDenom(ctx context.Context, in *QueryFeeDenomRequest, opts ...grpc.CallOption) (*QueryFeeDenomResponse, error) {
+	out := new(QueryFeeDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feeabs.v1.Query/FeeDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EquivalentFee(ctx context.Context, in *QueryEquivalentFeeRequest, opts ...grpc.CallOption) (*QueryEquivalentFeeResponse, error) {
+	out := new(QueryEquivalentFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feeabs.v1.Query/EquivalentFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reserve(ctx context.Context, in *QueryReserveRequest, opts ...grpc.CallOption) (*QueryReserveResponse, error) {
+	out := new(QueryReserveResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feeabs.v1.Query/Reserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of fee abstraction parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeeDenom returns the conversion rate of an accepted fee denom.
+	FeeDenom(context.Context, *QueryFeeDenomRequest) (*QueryFeeDenomResponse, error)
+	// EquivalentFee returns the base denom equivalent of a fee.
+	EquivalentFee(context.Context, *QueryEquivalentFeeRequest) (*QueryEquivalentFeeResponse, error)
+	// Reserve returns the base denom reserve the equivalent of the converted
+	// fees is paid from.
+	Reserve(context.Context, *QueryReserveRequest) (*QueryReserveResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) FeeDenom(ctx context.Context, req *QueryFeeDenomRequest) (*QueryFeeDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDenom not implemented")
+}
+func (*UnimplementedQueryServer) EquivalentFee(ctx context.Context, req *QueryEquivalentFeeRequest) (*QueryEquivalentFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquivalentFee not implemented")
+}
+func (*UnimplementedQueryServer) Reserve(ctx context.Context, req *QueryReserveRequest) (*QueryReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserve not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feeabs.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feeabs.v1.Query/FeeDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDenom(ctx, req.(*QueryFeeDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EquivalentFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEquivalentFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EquivalentFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feeabs.v1.Query/EquivalentFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EquivalentFee(ctx, req.(*QueryEquivalentFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feeabs.v1.Query/Reserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reserve(ctx, req.(*QueryReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.feeabs.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FeeDenom",
+			Handler:    _Query_FeeDenom_Handler,
+		},
+		{
+			MethodName: "EquivalentFee",
+			Handler:    _Query_EquivalentFee_Handler,
+		},
+		{
+			MethodName: "Reserve",
+			Handler:    _Query_Reserve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/feeabs/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquivalentFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquivalentFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquivalentFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquivalentFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquivalentFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquivalentFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReserveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeDenom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEquivalentFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEquivalentFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReserveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEquivalentFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEquivalentFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEquivalentFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEquivalentFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEquivalentFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEquivalentFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
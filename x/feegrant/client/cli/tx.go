@@ -17,11 +17,13 @@ import (
 
 // flag for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration    = "expiration"
+	FlagPeriod        = "period"
+	FlagPeriodLimit   = "period-limit"
+	FlagSpendLimit    = "spend-limit"
+	FlagAllowedMsgs   = "allowed-messages"
+	FlagAllowedAddrs  = "allowed-addresses"
+	FlagPeriodTxLimit = "period-tx-limit"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -57,8 +59,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-tx-limit 5
+	--allowed-addresses "cosmos1contract...,cosmos1recipient..."
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -117,8 +122,14 @@ Examples:
 				return err
 			}
 
+			periodTxLimit, err := cmd.Flags().GetUint64(FlagPeriodTxLimit)
+			if err != nil {
+				return err
+			}
+
 			// Check any of period or periodLimit flags set, If set consider it as periodic fee allowance.
-			if periodClock > 0 || periodLimitVal != "" {
+			// The period alone may also be used to scope the period tx limit.
+			if periodLimitVal != "" || (periodClock > 0 && periodTxLimit == 0) {
 				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
 				if err != nil {
 					return err
@@ -160,6 +171,27 @@ Examples:
 				}
 			}
 
+			allowedAddrs, err := cmd.Flags().GetStringSlice(FlagAllowedAddrs)
+			if err != nil {
+				return err
+			}
+
+			// Check any of allowedAddrs or periodTxLimit flags set, If set consider it as scoped fee allowance.
+			if len(allowedAddrs) > 0 || periodTxLimit > 0 {
+				if len(allowedAddrs) == 0 {
+					return fmt.Errorf("allowed addresses were not set")
+				}
+
+				if periodTxLimit > 0 && periodClock <= 0 {
+					return fmt.Errorf("period clock was not set")
+				}
+
+				grant, err = feegrant.NewScopedAllowance(grant, allowedAddrs, getPeriod(periodClock), periodTxLimit, getPeriodReset(periodClock))
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
@@ -175,6 +207,8 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringSlice(FlagAllowedAddrs, []string{}, "Set of target addresses (contracts or recipients) the sponsored messages must interact with")
+	cmd.Flags().Uint64(FlagPeriodTxLimit, 0, "period tx limit specifies the maximum number of transactions that can be sponsored in the period")
 
 	return cmd
 }
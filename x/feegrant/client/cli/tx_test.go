@@ -406,6 +406,20 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 			),
 			true, 0, nil,
 		},
+		{
+			"period tx limit without allowed addresses",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, oneHour),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriodTxLimit, 5),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -22,4 +22,10 @@ var (
 	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrNoAddresses error if there is no allowed address
+	ErrNoAddresses = sdkerrors.Register(DefaultCodespace, 8, "allowed addresses are empty")
+	// ErrAddressNotAllowed error if a message targets an address that is not allowed
+	ErrAddressNotAllowed = sdkerrors.Register(DefaultCodespace, 9, "address not allowed")
+	// ErrTxLimitExceeded error if there are not enough transactions left in the period
+	ErrTxLimitExceeded = sdkerrors.Register(DefaultCodespace, 10, "transaction limit exceeded")
 )
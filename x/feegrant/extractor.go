@@ -0,0 +1,64 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressExtractor returns the target addresses a message interacts with,
+// e.g. the recipient of a transfer or the contract being executed.
+// It is used by ScopedAllowance to decide whether a message is sponsored.
+type AddressExtractor func(msg sdk.Msg) ([]string, error)
+
+// AddressExtractors maps message type URLs to the AddressExtractor used for
+// them. It is built by the app and given to the feegrant keeper.
+type AddressExtractors map[string]AddressExtractor
+
+// NewAddressExtractors returns an empty set of address extractors.
+func NewAddressExtractors() AddressExtractors {
+	return make(AddressExtractors)
+}
+
+// Register registers the AddressExtractor used for messages of the given type
+// URL. It returns an error if an extractor is already registered for it.
+func (e AddressExtractors) Register(msgTypeURL string, extractor AddressExtractor) error {
+	if _, ok := e[msgTypeURL]; ok {
+		return fmt.Errorf("address extractor already registered for %s", msgTypeURL)
+	}
+
+	e[msgTypeURL] = extractor
+	return nil
+}
+
+// ExtractTargetAddresses returns the target addresses of msg using the
+// extractor registered for its type URL.
+func (e AddressExtractors) ExtractTargetAddresses(msg sdk.Msg) ([]string, error) {
+	typeURL := sdk.MsgTypeURL(msg)
+	extractor, ok := e[typeURL]
+	if !ok {
+		return nil, fmt.Errorf("no address extractor registered for %s", typeURL)
+	}
+
+	return extractor(msg)
+}
+
+type addressExtractorsKey struct{}
+
+// WithAddressExtractors returns a copy of ctx carrying the address extractors
+// used by ScopedAllowance.Accept. It is called by the keeper before checking
+// an allowance.
+func WithAddressExtractors(ctx sdk.Context, extractors AddressExtractors) sdk.Context {
+	return ctx.WithValue(addressExtractorsKey{}, extractors)
+}
+
+// addressExtractorsFromContext returns the address extractors carried by ctx,
+// or an empty set if there are none.
+func addressExtractorsFromContext(ctx sdk.Context) AddressExtractors {
+	extractors, ok := ctx.Value(addressExtractorsKey{}).(AddressExtractors)
+	if !ok {
+		return AddressExtractors{}
+	}
+
+	return extractors
+}
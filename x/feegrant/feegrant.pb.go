@@ -283,11 +283,72 @@ func (m *Grant) GetAllowance() *types1.Any {
 	return nil
 }
 
+// ScopedAllowance wraps another allowance and only sponsors transactions whose
+// messages target addresses on an allowlist. Target addresses are resolved per
+// message type through the address extractors registered with the feegrant
+// module. It can additionally limit the number of sponsored transactions per
+// period, while coin limits are enforced by the wrapped allowance.
+type ScopedAllowance struct {
+	// allowance can be any of basic, periodic and allowed message fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowed_addresses are the target addresses (e.g. contracts or recipients)
+	// that every message of a sponsored transaction must interact with.
+	AllowedAddresses []string `protobuf:"bytes,2,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty"`
+	// period specifies the time duration in which period_tx_limit transactions
+	// can be sponsored before the counter is reset.
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+	// period_tx_limit is the maximum number of transactions that can be sponsored
+	// in the period. Zero means there is no transaction count limit.
+	PeriodTxLimit uint64 `protobuf:"varint,4,opt,name=period_tx_limit,json=periodTxLimit,proto3" json:"period_tx_limit,omitempty"`
+	// period_tx_count is the number of transactions sponsored in the current period.
+	PeriodTxCount uint64 `protobuf:"varint,5,opt,name=period_tx_count,json=periodTxCount,proto3" json:"period_tx_count,omitempty"`
+	// period_reset is the time at which the current period resets and a new one
+	// begins.
+	PeriodReset time.Time `protobuf:"bytes,6,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+	// total_tx_count is the number of transactions sponsored by this allowance
+	// over its lifetime.
+	TotalTxCount uint64 `protobuf:"varint,7,opt,name=total_tx_count,json=totalTxCount,proto3" json:"total_tx_count,omitempty"`
+}
+
+func (m *ScopedAllowance) Reset()         { *m = ScopedAllowance{} }
+func (m *ScopedAllowance) String() string { return proto.CompactTextString(m) }
+func (*ScopedAllowance) ProtoMessage()    {}
+func (*ScopedAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *ScopedAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopedAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopedAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopedAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopedAllowance.Merge(m, src)
+}
+func (m *ScopedAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopedAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopedAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopedAllowance proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*ScopedAllowance)(nil), "cosmos.feegrant.v1beta1.ScopedAllowance")
 }
 
 func init() {
@@ -295,47 +356,54 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xb6, 0x28, 0x17, 0x28, 0xad, 0xa9, 0x84, 0x53, 0x21, 0xbb, 0x8a, 0x04, 0x4d,
-	0x2b, 0xd5, 0x56, 0x8b, 0x58, 0x3a, 0x35, 0x2e, 0xa2, 0x80, 0x5a, 0xa9, 0x72, 0x99, 0x90, 0x50,
-	0x74, 0xb6, 0xaf, 0xe6, 0x44, 0xec, 0x33, 0x3e, 0x17, 0x1a, 0x06, 0x66, 0xc4, 0x80, 0x32, 0x32,
-	0x32, 0x22, 0xa6, 0x0e, 0xe5, 0x3b, 0x54, 0x0c, 0xa8, 0x62, 0x62, 0x22, 0x28, 0x19, 0x3a, 0xf3,
-	0x0d, 0x90, 0xef, 0xce, 0x8e, 0x9b, 0x50, 0x68, 0x25, 0xba, 0x24, 0x77, 0xef, 0xde, 0xfb, 0xfd,
-	0x79, 0xef, 0x45, 0x01, 0xb7, 0x1c, 0x42, 0x7d, 0x42, 0x8d, 0x1d, 0x84, 0xbc, 0x08, 0x06, 0xb1,
-	0xf1, 0x62, 0xc9, 0x46, 0x31, 0x5c, 0xca, 0x02, 0x7a, 0x18, 0x91, 0x98, 0xc8, 0xd7, 0x79, 0x9e,
-	0x9e, 0x85, 0x45, 0xde, 0xcc, 0xb4, 0x47, 0x3c, 0xc2, 0x72, 0x8c, 0xe4, 0xc4, 0xd3, 0x67, 0x2a,
-	0x1e, 0x21, 0x5e, 0x13, 0x19, 0xec, 0x66, 0xef, 0xee, 0x18, 0x30, 0x68, 0xa5, 0x4f, 0x1c, 0xa9,
-	0xc1, 0x6b, 0x04, 0x2c, 0x7f, 0x52, 0x85, 0x18, 0x1b, 0x52, 0x94, 0x09, 0x71, 0x08, 0x0e, 0xc4,
-	0xfb, 0x14, 0xf4, 0x71, 0x40, 0x0c, 0xf6, 0x29, 0x42, 0xda, 0x20, 0x51, 0x8c, 0x7d, 0x44, 0x63,
-	0xe8, 0x87, 0x29, 0xe6, 0x60, 0x82, 0xbb, 0x1b, 0xc1, 0x18, 0x13, 0x81, 0x59, 0x7d, 0x37, 0x02,
-	0x26, 0x4c, 0x48, 0xb1, 0x53, 0x6f, 0x36, 0xc9, 0x4b, 0x18, 0x38, 0x48, 0x7e, 0x0e, 0xca, 0x34,
-	0x44, 0x81, 0xdb, 0x68, 0x62, 0x1f, 0xc7, 0x8a, 0x34, 0x5b, 0xac, 0x95, 0x97, 0x2b, 0xba, 0x90,
-	0x9a, 0x88, 0x4b, 0xdd, 0xeb, 0x6b, 0x04, 0x07, 0xe6, 0x9d, 0xc3, 0x1f, 0x5a, 0xe1, 0x53, 0x47,
-	0xab, 0x79, 0x38, 0x7e, 0xba, 0x6b, 0xeb, 0x0e, 0xf1, 0x85, 0x2f, 0xf1, 0xb5, 0x48, 0xdd, 0x67,
-	0x46, 0xdc, 0x0a, 0x11, 0x65, 0x05, 0xf4, 0xe3, 0xf1, 0xfe, 0x82, 0x64, 0x01, 0x46, 0xb2, 0x91,
-	0x70, 0xc8, 0xab, 0x00, 0xa0, 0xbd, 0x10, 0x73, 0x65, 0xca, 0xc8, 0xac, 0x54, 0x2b, 0x2f, 0xcf,
-	0xe8, 0x5c, 0xba, 0x9e, 0x4a, 0xd7, 0x1f, 0xa5, 0xde, 0xcc, 0xd1, 0x76, 0x47, 0x93, 0xac, 0x5c,
-	0xcd, 0xca, 0xfa, 0x97, 0x83, 0xc5, 0x9b, 0xa7, 0x0c, 0x49, 0xbf, 0x87, 0x50, 0x66, 0xef, 0xc1,
-	0xdb, 0xe3, 0xfd, 0x85, 0x4a, 0x4e, 0xd8, 0x49, 0xf7, 0xd5, 0xcf, 0xa3, 0x60, 0x6a, 0x0b, 0x45,
-	0x98, 0xb8, 0xf9, 0x9e, 0xdc, 0x07, 0x63, 0x76, 0x92, 0xa7, 0x48, 0x4c, 0xdb, 0x9c, 0x7e, 0x1a,
-	0xd5, 0x49, 0x34, 0xb3, 0x94, 0xf4, 0x86, 0xfb, 0xe5, 0x00, 0xf2, 0x2a, 0x18, 0x0f, 0x19, 0xbc,
-	0xb0, 0x59, 0x19, 0xb2, 0x79, 0x57, 0x4c, 0xc8, 0xbc, 0x92, 0x14, 0xbf, 0xef, 0x68, 0x12, 0x07,
-	0x10, 0x75, 0xf2, 0x6b, 0x20, 0xf3, 0x53, 0x23, 0x3f, 0xa6, 0xe2, 0x05, 0x8d, 0x69, 0x92, 0x73,
-	0x6d, 0xf7, 0x87, 0xf5, 0x0a, 0x88, 0x58, 0xc3, 0x81, 0x01, 0xd7, 0xa0, 0x8c, 0x5e, 0x10, 0xfb,
-	0x04, 0x67, 0x5a, 0x83, 0x01, 0x13, 0x20, 0x6f, 0x80, 0xcb, 0x82, 0x3b, 0x42, 0x14, 0xc5, 0xca,
-	0xd8, 0x3f, 0x57, 0x85, 0x35, 0xb1, 0x9d, 0x35, 0xb1, 0xcc, 0xcb, 0xad, 0xa4, 0x7a, 0xe5, 0xe1,
-	0xb9, 0x96, 0xe6, 0x46, 0x4e, 0xe8, 0xd0, 0x86, 0x54, 0x7f, 0x49, 0xe0, 0x1a, 0xbb, 0x21, 0x77,
-	0x93, 0x7a, 0xfd, 0xcd, 0x79, 0x02, 0x4a, 0x30, 0xbd, 0x88, 0xed, 0x99, 0x1e, 0x92, 0x5b, 0x0f,
-	0x5a, 0xe6, 0xfc, 0x99, 0xc5, 0x58, 0x7d, 0x44, 0x79, 0x1e, 0x4c, 0x42, 0xce, 0xda, 0xf0, 0x11,
-	0xa5, 0xd0, 0x43, 0x54, 0x19, 0x99, 0x2d, 0xd6, 0x4a, 0xd6, 0x55, 0x11, 0xdf, 0x14, 0xe1, 0x95,
-	0xad, 0x37, 0x1f, 0xb4, 0xc2, 0xb9, 0x1c, 0xab, 0x39, 0xc7, 0x7f, 0xf0, 0x56, 0xfd, 0x2a, 0x81,
-	0xb1, 0xf5, 0x04, 0x42, 0x5e, 0x06, 0x97, 0x18, 0x16, 0x8a, 0x98, 0xc7, 0x92, 0xa9, 0x7c, 0x3b,
-	0x58, 0x9c, 0x16, 0x44, 0x75, 0xd7, 0x8d, 0x10, 0xa5, 0xdb, 0x71, 0x84, 0x03, 0xcf, 0x4a, 0x13,
-	0xfb, 0x35, 0x88, 0xfd, 0x14, 0xce, 0x50, 0x33, 0xd0, 0xcd, 0xe2, 0xff, 0xee, 0xa6, 0x59, 0x3f,
-	0xec, 0xaa, 0xd2, 0x51, 0x57, 0x95, 0x7e, 0x76, 0x55, 0xa9, 0xdd, 0x53, 0x0b, 0x47, 0x3d, 0xb5,
-	0xf0, 0xbd, 0xa7, 0x16, 0x1e, 0xcf, 0xfd, 0x75, 0x6f, 0xf7, 0xb2, 0xff, 0x0b, 0x7b, 0x9c, 0xc9,
-	0xb8, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x3d, 0x09, 0x1d, 0x5a, 0x06, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0xb6, 0x05, 0xd2, 0x29, 0x3f, 0xf7, 0x4b, 0xf2, 0xdd, 0x36, 0x66, 0xdb, 0x34, 0x0a,
+	0x85, 0x84, 0x6d, 0xc0, 0x78, 0xe9, 0x89, 0x2e, 0x2a, 0x6a, 0x20, 0x21, 0x0b, 0x27, 0x13, 0xd3,
+	0x4c, 0x77, 0x87, 0x75, 0x63, 0x77, 0x67, 0xdd, 0x99, 0x6a, 0xf1, 0xe0, 0xd9, 0x68, 0x62, 0x38,
+	0x7a, 0xf4, 0x68, 0x3c, 0x71, 0xc0, 0xff, 0x81, 0x78, 0x30, 0xc4, 0x93, 0x27, 0x31, 0x70, 0xe0,
+	0xec, 0x7f, 0x60, 0x76, 0x66, 0xb6, 0x5d, 0x5a, 0x41, 0x50, 0xf1, 0x02, 0xbb, 0x6f, 0xdf, 0xfb,
+	0xfc, 0x78, 0xef, 0xcd, 0xa4, 0x60, 0xca, 0xc4, 0xc4, 0xc5, 0xa4, 0xb2, 0x89, 0x90, 0x1d, 0x40,
+	0x8f, 0x56, 0x9e, 0xcc, 0x37, 0x10, 0x85, 0xf3, 0x9d, 0x80, 0xe6, 0x07, 0x98, 0x62, 0xf9, 0x7f,
+	0x9e, 0xa7, 0x75, 0xc2, 0x22, 0x2f, 0x3f, 0x69, 0x63, 0x1b, 0xb3, 0x9c, 0x4a, 0xf8, 0xc4, 0xd3,
+	0xf3, 0x39, 0x1b, 0x63, 0xbb, 0x89, 0x2a, 0xec, 0xad, 0xd1, 0xda, 0xac, 0x40, 0x6f, 0x2b, 0xfa,
+	0xc4, 0x91, 0xea, 0xbc, 0x46, 0xc0, 0xf2, 0x4f, 0xaa, 0x10, 0xd3, 0x80, 0x04, 0x75, 0x84, 0x98,
+	0xd8, 0xf1, 0xc4, 0xf7, 0x09, 0xe8, 0x3a, 0x1e, 0xae, 0xb0, 0xbf, 0x22, 0x54, 0xe8, 0x25, 0xa2,
+	0x8e, 0x8b, 0x08, 0x85, 0xae, 0x1f, 0x61, 0xf6, 0x26, 0x58, 0xad, 0x00, 0x52, 0x07, 0x0b, 0xcc,
+	0xd2, 0xeb, 0x24, 0x18, 0xd5, 0x21, 0x71, 0xcc, 0x5a, 0xb3, 0x89, 0x9f, 0x42, 0xcf, 0x44, 0xf2,
+	0x63, 0x90, 0x25, 0x3e, 0xf2, 0xac, 0x7a, 0xd3, 0x71, 0x1d, 0xaa, 0x48, 0xc5, 0x54, 0x39, 0xbb,
+	0x90, 0xd3, 0x84, 0xd4, 0x50, 0x5c, 0xe4, 0x5e, 0x5b, 0xc2, 0x8e, 0xa7, 0xdf, 0xd8, 0xfb, 0x5a,
+	0x48, 0xbc, 0x3f, 0x28, 0x94, 0x6d, 0x87, 0x3e, 0x6c, 0x35, 0x34, 0x13, 0xbb, 0xc2, 0x97, 0xf8,
+	0x37, 0x47, 0xac, 0x47, 0x15, 0xba, 0xe5, 0x23, 0xc2, 0x0a, 0xc8, 0xbb, 0xe3, 0x9d, 0x59, 0xc9,
+	0x00, 0x8c, 0x64, 0x25, 0xe4, 0x90, 0x17, 0x01, 0x40, 0x6d, 0xdf, 0xe1, 0xca, 0x94, 0x64, 0x51,
+	0x2a, 0x67, 0x17, 0xf2, 0x1a, 0x97, 0xae, 0x45, 0xd2, 0xb5, 0x8d, 0xc8, 0x9b, 0x9e, 0xde, 0x3e,
+	0x28, 0x48, 0x46, 0xac, 0xa6, 0xba, 0xfc, 0x71, 0x77, 0xee, 0xda, 0x29, 0x43, 0xd2, 0x6e, 0x23,
+	0xd4, 0xb1, 0x77, 0xf7, 0xe5, 0xf1, 0xce, 0x6c, 0x2e, 0x26, 0xec, 0xa4, 0xfb, 0xd2, 0x87, 0x34,
+	0x98, 0x58, 0x43, 0x81, 0x83, 0xad, 0x78, 0x4f, 0xee, 0x80, 0x81, 0x46, 0x98, 0xa7, 0x48, 0x4c,
+	0xdb, 0xb4, 0x76, 0x1a, 0xd5, 0x49, 0x34, 0x3d, 0x13, 0xf6, 0x86, 0xfb, 0xe5, 0x00, 0xf2, 0x22,
+	0x18, 0xf4, 0x19, 0xbc, 0xb0, 0x99, 0xeb, 0xb3, 0x79, 0x53, 0x4c, 0x48, 0x1f, 0x09, 0x8b, 0xdf,
+	0x1c, 0x14, 0x24, 0x0e, 0x20, 0xea, 0xe4, 0xe7, 0x40, 0xe6, 0x4f, 0xf5, 0xf8, 0x98, 0x52, 0x97,
+	0x34, 0xa6, 0x71, 0xce, 0xb5, 0xde, 0x1d, 0xd6, 0x33, 0x20, 0x62, 0x75, 0x13, 0x7a, 0x5c, 0x83,
+	0x92, 0xbe, 0x24, 0xf6, 0x51, 0xce, 0xb4, 0x04, 0x3d, 0x26, 0x40, 0x5e, 0x01, 0xc3, 0x82, 0x3b,
+	0x40, 0x04, 0x51, 0x65, 0xe0, 0x97, 0xab, 0xc2, 0x9a, 0xb8, 0xdd, 0x69, 0x62, 0x96, 0x97, 0x1b,
+	0x61, 0x75, 0xf5, 0xde, 0x85, 0x96, 0xe6, 0x4a, 0x4c, 0x68, 0xdf, 0x86, 0x94, 0xbe, 0x4b, 0xe0,
+	0x3f, 0xf6, 0x86, 0xac, 0x55, 0x62, 0x77, 0x37, 0xe7, 0x01, 0xc8, 0xc0, 0xe8, 0x45, 0x6c, 0xcf,
+	0x64, 0x9f, 0xdc, 0x9a, 0xb7, 0xa5, 0xcf, 0x9c, 0x5b, 0x8c, 0xd1, 0x45, 0x94, 0x67, 0xc0, 0x38,
+	0xe4, 0xac, 0x75, 0x17, 0x11, 0x02, 0x6d, 0x44, 0x94, 0x64, 0x31, 0x55, 0xce, 0x18, 0x63, 0x22,
+	0xbe, 0x2a, 0xc2, 0xd5, 0xb5, 0x17, 0x6f, 0x0b, 0x89, 0x0b, 0x39, 0x56, 0x63, 0x8e, 0x7f, 0xe2,
+	0xad, 0xf4, 0x49, 0x02, 0x03, 0xcb, 0x21, 0x84, 0xbc, 0x00, 0x86, 0x18, 0x16, 0x0a, 0x98, 0xc7,
+	0x8c, 0xae, 0x7c, 0xde, 0x9d, 0x9b, 0x14, 0x44, 0x35, 0xcb, 0x0a, 0x10, 0x21, 0xeb, 0x34, 0x70,
+	0x3c, 0xdb, 0x88, 0x12, 0xbb, 0x35, 0x48, 0x49, 0x9e, 0xaf, 0xa6, 0xa7, 0x9b, 0xa9, 0xbf, 0xdd,
+	0xcd, 0xd2, 0xab, 0x34, 0x18, 0x5b, 0x37, 0xb1, 0x8f, 0xac, 0x7f, 0x36, 0xc0, 0x5b, 0x60, 0x22,
+	0x1a, 0x20, 0xe4, 0x9e, 0xa3, 0x09, 0x9e, 0xd1, 0x8f, 0x68, 0xe6, 0xb5, 0xa8, 0x22, 0x76, 0xad,
+	0xa4, 0x7e, 0xf3, 0x5a, 0x99, 0x02, 0x63, 0xe2, 0x68, 0xd1, 0xb6, 0xb8, 0x53, 0xd2, 0x45, 0xa9,
+	0x9c, 0x36, 0x46, 0x78, 0x78, 0xa3, 0xcd, 0x8f, 0xff, 0x89, 0x3c, 0x13, 0xb7, 0x3c, 0x7e, 0x0a,
+	0x63, 0x79, 0x4b, 0x61, 0xb0, 0xef, 0xa8, 0x0e, 0xfe, 0xc9, 0x51, 0x95, 0xaf, 0x82, 0x51, 0x8a,
+	0x29, 0x6c, 0x76, 0x49, 0x87, 0x18, 0xe9, 0x30, 0x8b, 0x0a, 0xce, 0xea, 0xca, 0x85, 0x57, 0x3c,
+	0x1f, 0x5b, 0xf1, 0x9e, 0xc9, 0xeb, 0xb5, 0xbd, 0x43, 0x55, 0xda, 0x3f, 0x54, 0xa5, 0x6f, 0x87,
+	0xaa, 0xb4, 0x7d, 0xa4, 0x26, 0xf6, 0x8f, 0xd4, 0xc4, 0x97, 0x23, 0x35, 0x71, 0x7f, 0xfa, 0xcc,
+	0x5b, 0xac, 0xdd, 0xf9, 0xf5, 0xd0, 0x18, 0x64, 0x36, 0xaf, 0xff, 0x18, 0x00, 0x31, 0xe3, 0x79,
+	0xd8, 0x68, 0x08, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -555,6 +623,81 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScopedAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopedAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopedAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalTxCount != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.TotalTxCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintFeegrant(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if m.PeriodTxCount != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.PeriodTxCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PeriodTxLimit != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.PeriodTxLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintFeegrant(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AllowedAddresses) > 0 {
+		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedAddresses[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeegrant(v)
 	base := offset
@@ -652,6 +795,38 @@ func (m *Grant) Size() (n int) {
 	return n
 }
 
+func (m *ScopedAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedAddresses) > 0 {
+		for _, s := range m.AllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if m.PeriodTxLimit != 0 {
+		n += 1 + sovFeegrant(uint64(m.PeriodTxLimit))
+	}
+	if m.PeriodTxCount != 0 {
+		n += 1 + sovFeegrant(uint64(m.PeriodTxCount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if m.TotalTxCount != 0 {
+		n += 1 + sovFeegrant(uint64(m.TotalTxCount))
+	}
+	return n
+}
+
 func sovFeegrant(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1263,6 +1438,247 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScopedAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopedAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopedAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTxLimit", wireType)
+			}
+			m.PeriodTxLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodTxLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTxCount", wireType)
+			}
+			m.PeriodTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTxCount", wireType)
+			}
+			m.TotalTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFeegrant(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
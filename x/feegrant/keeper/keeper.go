@@ -22,6 +22,8 @@ type Keeper struct {
 	storeKey   storetypes.StoreKey
 	authKeeper feegrant.AccountKeeper
 	bankKeeper feegrant.BankKeeper
+
+	addressExtractors feegrant.AddressExtractors
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -42,6 +44,13 @@ func (k Keeper) SetBankKeeper(bk feegrant.BankKeeper) Keeper {
 	return k
 }
 
+// SetAddressExtractors sets the address extractors used to resolve the target
+// addresses of the messages checked against a ScopedAllowance.
+func (k Keeper) SetAddressExtractors(extractors feegrant.AddressExtractors) Keeper {
+	k.addressExtractors = extractors
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", feegrant.ModuleName))
@@ -251,7 +260,7 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 		return err
 	}
 
-	remove, err := grant.Accept(ctx, fee, msgs)
+	remove, err := grant.Accept(feegrant.WithAddressExtractors(ctx, k.addressExtractors), fee, msgs)
 
 	if remove {
 		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/module"
@@ -255,6 +256,31 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Contains(err.Error(), "fee-grant not found")
 }
 
+func (suite *KeeperTestSuite) TestUseGrantedFeeScoped() {
+	granter, grantee, target := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+	msgs := []sdk.Msg{&banktypes.MsgSend{FromAddress: grantee.String(), ToAddress: target.String()}}
+
+	scoped, err := feegrant.NewScopedAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom}, []string{target.String()}, 0, 0, suite.ctx.BlockTime())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, scoped))
+
+	// no address extractor is given to the keeper
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, fee, msgs)
+	suite.Require().ErrorIs(err, feegrant.ErrMessageNotAllowed)
+
+	extractors := feegrant.NewAddressExtractors()
+	suite.Require().NoError(extractors.Register(sdk.MsgTypeURL(&banktypes.MsgSend{}), func(msg sdk.Msg) ([]string, error) {
+		return []string{msg.(*banktypes.MsgSend).ToAddress}, nil
+	}))
+	k := suite.feegrantKeeper.SetAddressExtractors(extractors)
+	suite.Require().NoError(k.UseGrantedFees(suite.ctx, granter, grantee, fee, msgs))
+
+	grant, err := k.GetAllowance(suite.ctx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), grant.(*feegrant.ScopedAllowance).TotalTxCount)
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.ctx.BlockTime().AddDate(1, 0, 0)
@@ -192,10 +192,13 @@ type FeegrantInputs struct {
 	AccountKeeper feegrant.AccountKeeper
 	BankKeeper    feegrant.BankKeeper
 	Registry      cdctypes.InterfaceRegistry
+
+	// AddressExtractors are the address extractors used by ScopedAllowance.
+	AddressExtractors feegrant.AddressExtractors `optional:"true"`
 }
 
 func ProvideModule(in FeegrantInputs) (keeper.Keeper, appmodule.AppModule) {
-	k := keeper.NewKeeper(in.Cdc, in.Key, in.AccountKeeper)
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.AccountKeeper).SetAddressExtractors(in.AddressExtractors)
 	m := NewAppModule(in.Cdc, in.AccountKeeper, in.BankKeeper, k, in.Registry)
 	return k.SetBankKeeper(in.BankKeeper) /* depinject ux improvement */, m
 }
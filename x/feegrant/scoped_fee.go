@@ -0,0 +1,184 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*ScopedAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*ScopedAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *ScopedAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewScopedAllowance creates a new scoped fee allowance. A zero periodTxLimit
+// disables the transaction count limit.
+func NewScopedAllowance(allowance FeeAllowanceI, allowedAddrs []string, period time.Duration, periodTxLimit uint64, periodReset time.Time) (*ScopedAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScopedAllowance{
+		Allowance:        any,
+		AllowedAddresses: allowedAddrs,
+		Period:           period,
+		PeriodTxLimit:    periodTxLimit,
+		PeriodReset:      periodReset,
+	}, nil
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *ScopedAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *ScopedAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept checks that every message targets an allowed address and that the
+// period transaction limit is not reached, before delegating the fee check to
+// the wrapped allowance. The usage counters are updated on acceptance.
+func (a *ScopedAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if err := a.allMsgTargetsAllowed(ctx, msgs); err != nil {
+		return false, err
+	}
+
+	if a.PeriodTxLimit > 0 {
+		a.tryResetPeriod(ctx.BlockTime())
+		if a.PeriodTxCount >= a.PeriodTxLimit {
+			return false, sdkerrors.Wrap(ErrTxLimitExceeded, "period limit")
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		a.PeriodTxCount++
+		a.TotalTxCount++
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// tryResetPeriod resets PeriodTxCount once PeriodReset has been hit, following
+// the same stepping rules as PeriodicAllowance.
+func (a *ScopedAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodTxCount = 0
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+func (a *ScopedAllowance) allowedAddrsToMap(ctx sdk.Context) map[string]bool {
+	addrsMap := make(map[string]bool, len(a.AllowedAddresses))
+	for _, addr := range a.AllowedAddresses {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check address")
+		addrsMap[addr] = true
+	}
+
+	return addrsMap
+}
+
+func (a *ScopedAllowance) allMsgTargetsAllowed(ctx sdk.Context, msgs []sdk.Msg) error {
+	addrsMap := a.allowedAddrsToMap(ctx)
+	extractors := addressExtractorsFromContext(ctx)
+
+	for _, msg := range msgs {
+		targets, err := extractors.ExtractTargetAddresses(msg)
+		if err != nil {
+			return sdkerrors.Wrap(ErrMessageNotAllowed, err.Error())
+		}
+		if len(targets) == 0 {
+			return sdkerrors.Wrapf(ErrAddressNotAllowed, "message %s has no target address", sdk.MsgTypeURL(msg))
+		}
+
+		for _, target := range targets {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check address")
+			if !addrsMap[target] {
+				return sdkerrors.Wrapf(ErrAddressNotAllowed, "address %s does not exist in allowed addresses", target)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *ScopedAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedAddresses) == 0 {
+		return sdkerrors.Wrap(ErrNoAddresses, "allowed addresses shouldn't be empty")
+	}
+	for _, addr := range a.AllowedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowed address %s: %s", addr, err)
+		}
+	}
+
+	if a.Period < 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "negative clock step")
+	}
+	if a.PeriodTxLimit > 0 && a.Period == 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "period must be set when period tx limit is set")
+	}
+	if a.PeriodTxLimit > 0 && a.PeriodTxCount > a.PeriodTxLimit {
+		return sdkerrors.Wrap(ErrTxLimitExceeded, "period tx count is greater than period tx limit")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *ScopedAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
@@ -0,0 +1,210 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	ocproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func msgSendExtractor(msg sdk.Msg) ([]string, error) {
+	return []string{msg.(*banktypes.MsgSend).ToAddress}, nil
+}
+
+func TestAddressExtractors(t *testing.T) {
+	extractors := feegrant.NewAddressExtractors()
+	typeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	require.NoError(t, extractors.Register(typeURL, msgSendExtractor))
+	require.Error(t, extractors.Register(typeURL, msgSendExtractor))
+
+	to := sdk.AccAddress("recipient___________").String()
+	targets, err := extractors.ExtractTargetAddresses(&banktypes.MsgSend{ToAddress: to})
+	require.NoError(t, err)
+	require.Equal(t, []string{to}, targets)
+
+	_, err = extractors.ExtractTargetAddresses(&govv1.MsgVote{})
+	require.Error(t, err)
+}
+
+func TestScopedFeeValidAllow(t *testing.T) {
+	extractors := feegrant.NewAddressExtractors()
+	require.NoError(t, extractors.Register(sdk.MsgTypeURL(&banktypes.MsgSend{}), msgSendExtractor))
+
+	key := sdk.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModuleBasic{})
+
+	ctx := testCtx.Ctx.WithBlockHeader(ocproto.Header{Time: time.Now()})
+	ctx = feegrant.WithAddressExtractors(ctx, extractors)
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	bigAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	now := ctx.BlockTime()
+	oneHour := now.Add(1 * time.Hour)
+
+	contract := sdk.AccAddress("contract____________").String()
+	other := sdk.AccAddress("other_______________").String()
+	toContract := &banktypes.MsgSend{ToAddress: contract}
+	toOther := &banktypes.MsgSend{ToAddress: other}
+
+	cases := map[string]struct {
+		allowance     *feegrant.BasicAllowance
+		addrs         []string
+		periodTxLimit uint64
+		periodTxCount uint64
+		periodReset   time.Time
+		msgs          []sdk.Msg
+		fee           sdk.Coins
+		accept        bool
+		remove        bool
+		remains       sdk.Coins
+		txCount       uint64
+	}{
+		"address allowed": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			addrs:     []string{contract},
+			msgs:      []sdk.Msg{toContract},
+			fee:       smallAtom,
+			accept:    true,
+			remains:   leftAtom,
+			txCount:   1,
+		},
+		"address not allowed": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			addrs:     []string{contract},
+			msgs:      []sdk.Msg{toContract, toOther},
+			fee:       smallAtom,
+			accept:    false,
+		},
+		"no address extractor": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			addrs:     []string{contract},
+			msgs:      []sdk.Msg{&govv1.MsgVote{}},
+			fee:       smallAtom,
+			accept:    false,
+		},
+		"fee more than allowed": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			addrs:     []string{contract},
+			msgs:      []sdk.Msg{toContract},
+			fee:       bigAtom,
+			accept:    false,
+		},
+		"all fee used": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: smallAtom},
+			addrs:     []string{contract},
+			msgs:      []sdk.Msg{toContract},
+			fee:       smallAtom,
+			accept:    true,
+			remove:    true,
+		},
+		"tx limit not reached": {
+			allowance:     &feegrant.BasicAllowance{SpendLimit: atom},
+			addrs:         []string{contract},
+			periodTxLimit: 2,
+			periodTxCount: 1,
+			periodReset:   oneHour,
+			msgs:          []sdk.Msg{toContract},
+			fee:           smallAtom,
+			accept:        true,
+			remains:       leftAtom,
+			txCount:       2,
+		},
+		"tx limit reached": {
+			allowance:     &feegrant.BasicAllowance{SpendLimit: atom},
+			addrs:         []string{contract},
+			periodTxLimit: 2,
+			periodTxCount: 2,
+			periodReset:   oneHour,
+			msgs:          []sdk.Msg{toContract},
+			fee:           smallAtom,
+			accept:        false,
+		},
+		"tx limit reset": {
+			allowance:     &feegrant.BasicAllowance{SpendLimit: atom},
+			addrs:         []string{contract},
+			periodTxLimit: 2,
+			periodTxCount: 2,
+			periodReset:   now,
+			msgs:          []sdk.Msg{toContract},
+			fee:           smallAtom,
+			accept:        true,
+			remains:       leftAtom,
+			txCount:       1,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewScopedAllowance(tc.allowance, tc.addrs, time.Hour, tc.periodTxLimit, tc.periodReset)
+			require.NoError(t, err)
+			allowance.PeriodTxCount = tc.periodTxCount
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(ctx, tc.fee, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, removed)
+			if !removed {
+				// mimic save & load process
+				var granter, grantee sdk.AccAddress
+				grant, err := feegrant.NewGrant(granter, grantee, allowance)
+				require.NoError(t, err)
+
+				bz, err := encCfg.Codec.Marshal(&grant)
+				require.NoError(t, err)
+
+				var loadedGrant feegrant.Grant
+				err = encCfg.Codec.Unmarshal(bz, &loadedGrant)
+				require.NoError(t, err)
+
+				newAllowance, err := loadedGrant.GetGrant()
+				require.NoError(t, err)
+				scoped := newAllowance.(*feegrant.ScopedAllowance)
+				require.Equal(t, tc.txCount, scoped.PeriodTxCount)
+				require.Equal(t, uint64(1), scoped.TotalTxCount)
+
+				feeAllowance, err := scoped.GetAllowance()
+				require.NoError(t, err)
+				require.Equal(t, tc.remains, feeAllowance.(*feegrant.BasicAllowance).SpendLimit)
+			}
+		})
+	}
+}
+
+func TestScopedFeeValidateBasic(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+	basic := &feegrant.BasicAllowance{}
+
+	allowance, err := feegrant.NewScopedAllowance(basic, nil, 0, 0, time.Time{})
+	require.NoError(t, err)
+	require.ErrorIs(t, allowance.ValidateBasic(), feegrant.ErrNoAddresses)
+
+	allowance, err = feegrant.NewScopedAllowance(basic, []string{"invalid"}, 0, 0, time.Time{})
+	require.NoError(t, err)
+	require.Error(t, allowance.ValidateBasic())
+
+	allowance, err = feegrant.NewScopedAllowance(basic, []string{contract}, 0, 1, time.Time{})
+	require.NoError(t, err)
+	require.ErrorIs(t, allowance.ValidateBasic(), feegrant.ErrInvalidDuration)
+
+	allowance, err = feegrant.NewScopedAllowance(basic, []string{contract}, time.Hour, 1, time.Time{})
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+}
@@ -0,0 +1,32 @@
+package feemarket
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker records the gas used by the block and adjusts the base fee of the
+// next block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	var gasUsed uint64
+	if gasMeter := ctx.BlockGasMeter(); gasMeter != nil {
+		gasUsed = gasMeter.GasConsumedToLimit()
+	}
+
+	baseFee := k.UpdateBaseFee(ctx, gasUsed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGas, fmt.Sprintf("%d", gasUsed)),
+		),
+	)
+}
@@ -0,0 +1,48 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettestutil "github.com/cosmos/cosmos-sdk/x/feemarket/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestEndBlocker(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+
+	ctrl := gomock.NewController(t)
+	accountKeeper := feemarkettestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+
+	k := keeper.NewKeeper(encCfg.Codec, key, accountKeeper, feemarkettestutil.NewMockBankKeeper(ctrl), authtypes.FeeCollectorName, "")
+	k.InitGenesis(testCtx.Ctx, types.NewGenesisState(types.NewParams("stake", 8, 1000, sdk.ZeroDec(), false), sdk.NewDec(1), 0))
+
+	// full block
+	gasMeter := storetypes.NewGasMeter(2000)
+	gasMeter.ConsumeGas(2000, "txs")
+	ctx := testCtx.Ctx.WithBlockGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+	feemarket.EndBlocker(ctx, k)
+
+	require.Equal(t, sdk.NewDecWithPrec(1125, 3), k.GetBaseFee(ctx))
+	require.Equal(t, uint64(2000), k.GetBlockGas(ctx))
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	// empty block
+	ctx = testCtx.Ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	feemarket.EndBlocker(ctx, k)
+
+	require.Equal(t, sdk.NewDecWithPrec(984375, 6), k.GetBaseFee(ctx))
+	require.Equal(t, uint64(0), k.GetBlockGas(ctx))
+}
@@ -0,0 +1,116 @@
+package ante
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// FeeMarketKeeper defines the expected feemarket keeper used to enforce the
+// base fee.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetRequiredFee(ctx sdk.Context, gas uint64) sdk.Coin
+	BurnBaseFee(ctx sdk.Context, baseFee sdk.Coins) error
+}
+
+// FeeConverter defines the expected fee converter, such as the x/feeabs keeper,
+// used to price fees paid in other denoms than the fee denom.
+type FeeConverter interface {
+	ConvertToBaseDenom(ctx sdk.Context, fee sdk.Coins) (sdk.Coin, error)
+}
+
+// BaseFeeDecorator enforces that the fee of a tx covers the base fee of the
+// current block, multiplied by its gas limit. Unlike the validator minimum gas
+// prices, the base fee is part of the state and is therefore enforced in both
+// CheckTx and DeliverTx. The tx priority is set to the tip per unit of gas paid
+// on top of the base fee.
+//
+// If a FeeConverter is given, the fee is priced by its equivalent in the fee
+// denom, so that fees paid in the denoms accepted by the converter cover the
+// base fee and are prioritized as fees paid in the fee denom. Otherwise, only
+// the fee denom coins of the fee are taken into account.
+//
+// If the BurnBaseFee param is set, the base fee portion of the fee is burned
+// from the fee collector, otherwise it is distributed along with the rest of the
+// fees. The decorator must therefore be placed after the DeductFeeDecorator,
+// and after the x/feeabs ConvertFeeDecorator if any.
+// CONTRACT: Tx must implement FeeTx interface to use BaseFeeDecorator
+type BaseFeeDecorator struct {
+	feemarketKeeper FeeMarketKeeper
+	feeConverter    FeeConverter
+}
+
+func NewBaseFeeDecorator(fk FeeMarketKeeper, fc FeeConverter) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		feemarketKeeper: fk,
+		feeConverter:    fc,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// genesis txs and simulations do not pay the base fee
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	gas := feeTx.GetGas()
+	required := bfd.feemarketKeeper.GetRequiredFee(ctx, gas)
+	paid, err := bfd.paidFee(ctx, feeTx.GetFee(), required.Denom)
+	if err != nil {
+		return ctx, err
+	}
+	if paid.LT(required.Amount) {
+		return ctx, errorsmod.Wrapf(types.ErrInsufficientBaseFee, "got: %s required: %s", feeTx.GetFee(), required)
+	}
+
+	if bfd.feemarketKeeper.GetParams(ctx).BurnBaseFee {
+		if err := bfd.feemarketKeeper.BurnBaseFee(ctx, sdk.NewCoins(required)); err != nil {
+			return ctx, err
+		}
+	}
+
+	newCtx := ctx.WithPriority(getTipPriority(paid.Sub(required.Amount), gas))
+
+	return next(newCtx, tx, simulate)
+}
+
+// paidFee returns the amount of fee denom the fee is worth.
+func (bfd BaseFeeDecorator) paidFee(ctx sdk.Context, fee sdk.Coins, feeDenom string) (sdk.Int, error) {
+	if bfd.feeConverter == nil {
+		return fee.AmountOf(feeDenom), nil
+	}
+
+	equivalent, err := bfd.feeConverter.ConvertToBaseDenom(ctx, fee)
+	if err != nil {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrInsufficientBaseFee, "got: %s: %s", fee, err)
+	}
+	if equivalent.Denom != feeDenom {
+		return sdk.Int{}, errorsmod.Wrapf(sdkerrors.ErrLogic, "fee converted into %s, base fee charged in %s", equivalent.Denom, feeDenom)
+	}
+
+	return equivalent.Amount, nil
+}
+
+// getTipPriority returns the tip paid per unit of gas on top of the base fee.
+func getTipPriority(tip sdk.Int, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	tipPerGas := tip.Quo(sdk.NewIntFromUint64(gas))
+	if !tipPerGas.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return tipPerGas.Int64()
+}
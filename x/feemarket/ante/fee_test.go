@@ -0,0 +1,173 @@
+package ante_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettestutil "github.com/cosmos/cosmos-sdk/x/feemarket/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// feeTx is a minimal sdk.FeeTx used to exercise the decorator.
+type feeTx struct {
+	sdk.FeeTx
+
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx feeTx) GetFee() sdk.Coins { return tx.fee }
+func (tx feeTx) GetGas() uint64    { return tx.gas }
+
+// feeConverter converts uusdc into stake at a rate of 2, as x/feeabs would.
+type feeConverter struct{}
+
+func (feeConverter) ConvertToBaseDenom(_ sdk.Context, fee sdk.Coins) (sdk.Coin, error) {
+	for _, coin := range fee {
+		if coin.Denom != "stake" && coin.Denom != "uusdc" {
+			return sdk.Coin{}, fmt.Errorf("%s is not accepted to pay fees", coin.Denom)
+		}
+	}
+
+	return sdk.NewCoin("stake", fee.AmountOf("stake").Add(fee.AmountOf("uusdc").MulRaw(2))), nil
+}
+
+func TestBaseFeeDecorator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+
+	ctrl := gomock.NewController(t)
+	accountKeeper := feemarkettestutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := feemarkettestutil.NewMockBankKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+
+	k := keeper.NewKeeper(encCfg.Codec, key, accountKeeper, bankKeeper, authtypes.FeeCollectorName, "")
+	k.InitGenesis(testCtx.Ctx, types.NewGenesisState(types.NewParams("stake", 8, 1000, sdk.ZeroDec(), false), sdk.NewDec(1), 0))
+
+	var priority int64
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		priority = ctx.Priority()
+		return ctx, nil
+	}
+
+	testCases := []struct {
+		name        string
+		fee         sdk.Coins
+		gas         uint64
+		height      int64
+		simulate    bool
+		burn        bool
+		converter   ante.FeeConverter
+		expPriority int64
+		expErr      error
+	}{
+		{
+			name:        "fee equals base fee",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+			gas:         1000,
+			height:      1,
+			expPriority: 0,
+		},
+		{
+			name:        "tip sets priority",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 3000)),
+			gas:         1000,
+			height:      1,
+			expPriority: 2,
+		},
+		{
+			name:   "fee below base fee",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", 999)),
+			gas:    1000,
+			height: 1,
+			expErr: types.ErrInsufficientBaseFee,
+		},
+		{
+			name:   "fee in other denom",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gas:    1000,
+			height: 1,
+			expErr: types.ErrInsufficientBaseFee,
+		},
+		{
+			name:        "converted fee covers base fee",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1500)),
+			gas:         1000,
+			height:      1,
+			converter:   feeConverter{},
+			expPriority: 2,
+		},
+		{
+			name:      "converted fee below base fee",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("uusdc", 499)),
+			gas:       1000,
+			height:    1,
+			converter: feeConverter{},
+			expErr:    types.ErrInsufficientBaseFee,
+		},
+		{
+			name:      "fee in denom not accepted by converter",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gas:       1000,
+			height:    1,
+			converter: feeConverter{},
+			expErr:    types.ErrInsufficientBaseFee,
+		},
+		{
+			name:     "simulate skips base fee",
+			gas:      1000,
+			height:   1,
+			simulate: true,
+		},
+		{
+			name: "genesis skips base fee",
+			gas:  1000,
+		},
+		{
+			name:        "base fee is burned",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 1500)),
+			gas:         1000,
+			height:      1,
+			burn:        true,
+			expPriority: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			priority = -1
+			ctx := testCtx.Ctx.WithBlockHeight(tc.height)
+
+			params := k.GetParams(ctx)
+			params.BurnBaseFee = tc.burn
+			require.NoError(t, k.SetParams(ctx, params))
+			if tc.burn {
+				baseFee := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+				bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, baseFee).Return(nil)
+				bankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName, baseFee).Return(nil)
+			}
+
+			decorator := ante.NewBaseFeeDecorator(k, tc.converter)
+			_, err := decorator.AnteHandle(ctx, feeTx{fee: tc.fee, gas: tc.gas}, tc.simulate, next)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			if !tc.simulate && tc.height > 0 {
+				require.Equal(t, tc.expPriority, priority)
+			}
+		})
+	}
+}
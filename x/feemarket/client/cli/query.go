@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// GetQueryCmd returns the cli query commands for the feemarket module.
+func GetQueryCmd() *cobra.Command {
+	feemarketQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the fee market module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feemarketQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryBaseFee(),
+		GetCmdQueryBlockGas(),
+	)
+
+	return feemarketQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current fee market
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current fee market parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBaseFee implements a command to return the current base fee per
+// unit of gas.
+func GetCmdQueryBaseFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee",
+		Short: "Query the current base fee per unit of gas",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.BaseFee)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBlockGas implements a command to return the amount of gas used by
+// the last block.
+func GetCmdQueryBlockGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-gas",
+		Short: "Query the amount of gas used by the last block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockGas(cmd.Context(), &types.QueryBlockGasRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
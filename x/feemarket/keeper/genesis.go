@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// InitGenesis new feemarket genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	k.SetBaseFee(ctx, data.BaseFee)
+	k.SetBlockGas(ctx, data.BlockGas)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetBaseFee(ctx), k.GetBlockGas(ctx))
+}
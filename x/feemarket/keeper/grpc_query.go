@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the feemarket module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// BaseFee returns the current base fee per unit of gas.
+func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryBaseFeeResponse{BaseFee: sdk.NewDecCoinFromDec(params.FeeDenom, k.GetBaseFee(ctx))}, nil
+}
+
+// BlockGas returns the amount of gas used by the last block.
+func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*types.QueryBlockGasResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBlockGasResponse{Gas: k.GetBlockGas(ctx)}, nil
+}
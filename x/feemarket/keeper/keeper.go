@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeKey         storetypes.StoreKey
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+	}
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/feemarket module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&p)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/feemarket module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
+// GetBaseFee returns the current base fee per unit of gas.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetBaseFee sets the current base fee per unit of gas.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BaseFeeKey, bz)
+}
+
+// GetBlockGas returns the amount of gas used by the last block.
+func (k Keeper) GetBlockGas(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockGasKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetBlockGas sets the amount of gas used by the last block.
+func (k Keeper) SetBlockGas(ctx sdk.Context, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockGasKey, sdk.Uint64ToBigEndian(gas))
+}
+
+// GetRequiredFee returns the minimum fee, in the fee denom, a tx with the
+// given gas limit has to pay: ceil(baseFee * gasLimit).
+func (k Keeper) GetRequiredFee(ctx sdk.Context, gas uint64) sdk.Coin {
+	params := k.GetParams(ctx)
+	amount := k.GetBaseFee(ctx).MulInt(sdk.NewIntFromUint64(gas)).Ceil().TruncateInt()
+
+	return sdk.NewCoin(params.FeeDenom, amount)
+}
+
+// BurnBaseFee burns the given base fee from the fee collector.
+func (k Keeper) BurnBaseFee(ctx sdk.Context, baseFee sdk.Coins) error {
+	if baseFee.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, baseFee); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, baseFee)
+}
+
+// UpdateBaseFee records the gas used by the current block and adjusts the base
+// fee of the next block accordingly.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, gasUsed uint64) sdk.Dec {
+	params := k.GetParams(ctx)
+	baseFee := params.NextBaseFee(k.GetBaseFee(ctx), gasUsed)
+
+	k.SetBlockGas(ctx, gasUsed)
+	k.SetBaseFee(ctx, baseFee)
+
+	return baseFee
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettestutil "github.com/cosmos/cosmos-sdk/x/feemarket/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	bankKeeper  *feemarkettestutil.MockBankKeeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := feemarkettestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = feemarkettestutil.NewMockBankKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+
+	s.keeper = keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		s.bankKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	s.keeper.InitGenesis(s.ctx, types.NewGenesisState(types.NewParams("stake", 8, 1000, sdk.ZeroDec(), false), sdk.NewDec(1), 0))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.keeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (s *KeeperTestSuite) TestUpdateBaseFee() {
+	s.Require().Equal(sdk.NewDec(1), s.keeper.GetBaseFee(s.ctx))
+
+	baseFee := s.keeper.UpdateBaseFee(s.ctx, 2000)
+	s.Require().Equal(sdk.NewDecWithPrec(1125, 3), baseFee)
+	s.Require().Equal(baseFee, s.keeper.GetBaseFee(s.ctx))
+	s.Require().Equal(uint64(2000), s.keeper.GetBlockGas(s.ctx))
+
+	s.Require().Equal(sdk.NewInt64Coin("stake", 1125), s.keeper.GetRequiredFee(s.ctx, 1000))
+	s.Require().Equal(sdk.NewInt64Coin("stake", 2), s.keeper.GetRequiredFee(s.ctx, 1))
+
+	// gas limits above the int64 range do not overflow
+	s.Require().Equal(
+		sdk.NewDecFromInt(sdk.NewIntFromUint64(math.MaxUint64)).Mul(baseFee).Ceil().TruncateInt(),
+		s.keeper.GetRequiredFee(s.ctx, math.MaxUint64).Amount,
+	)
+}
+
+func (s *KeeperTestSuite) TestBurnBaseFee() {
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, authtypes.FeeCollectorName, types.ModuleName, baseFee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.ModuleName, baseFee).Return(nil)
+	s.Require().NoError(s.keeper.BurnBaseFee(s.ctx, baseFee))
+
+	// nothing to burn
+	s.Require().NoError(s.keeper.BurnBaseFee(s.ctx, sdk.NewCoins()))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.keeper.UpdateBaseFee(s.ctx, 0)
+
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal(sdk.NewDecWithPrec(875, 3), genesis.BaseFee)
+	s.Require().Equal(uint64(0), genesis.BlockGas)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.BurnBaseFee = true
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: "invalid",
+		Params:    params,
+	})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	params.TargetBlockGas = 0
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: s.keeper.GetAuthority(),
+		Params:    params,
+	})
+	s.Require().Error(err)
+
+	params.TargetBlockGas = types.DefaultTargetBlockGas
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: s.keeper.GetAuthority(),
+		Params:    params,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.keeper.GetParams(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	res, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper.GetParams(s.ctx), res.Params)
+
+	s.keeper.UpdateBaseFee(s.ctx, 2000)
+
+	baseFeeRes, err := s.queryClient.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1125, 3)), baseFeeRes.BaseFee)
+
+	blockGasRes, err := s.queryClient.BlockGas(s.ctx, &types.QueryBlockGasRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2000), blockGasRes.Gas)
+}
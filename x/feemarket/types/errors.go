@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/feemarket module sentinel errors
+var (
+	ErrInsufficientBaseFee = errors.Register(ModuleName, 2, "fee is lower than the base fee")
+)
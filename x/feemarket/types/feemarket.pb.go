@@ -0,0 +1,502 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1/feemarket.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/feemarket module.
+type Params struct {
+	// fee_denom is the denom the base fee is charged in.
+	FeeDenom string `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	// base_fee_change_denominator bounds the amount the base fee can change
+	// between blocks: the base fee changes by at most 1/denominator per block.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// target_block_gas is the amount of gas per block the base fee adjustment
+	// aims for. The base fee increases when a block uses more gas than the target
+	// and decreases when it uses less.
+	TargetBlockGas uint64 `protobuf:"varint,3,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// min_base_fee is the lower bound of the base fee.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee"`
+	// burn_base_fee defines whether the base fee portion of the collected fees is
+	// burned. If false, it is left in the fee collector and distributed by
+	// x/distribution along with the rest of the fees.
+	BurnBaseFee bool `protobuf:"varint,5,opt,name=burn_base_fee,json=burnBaseFee,proto3" json:"burn_base_fee,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_481036a621b23787, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetBurnBaseFee() bool {
+	if m != nil {
+		return m.BurnBaseFee
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.feemarket.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feemarket/v1/feemarket.proto", fileDescriptor_481036a621b23787)
+}
+
+var fileDescriptor_481036a621b23787 = []byte{
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x3b, 0x5c, 0x2e, 0x81, 0xb9, 0x17, 0xa3, 0xd5, 0x45, 0x85, 0x58, 0x1a, 0x4c, 0x4c,
+	0x43, 0x42, 0x1b, 0xe2, 0xce, 0xc4, 0x85, 0xb5, 0x51, 0x97, 0xa6, 0x4b, 0x37, 0xcd, 0xb4, 0x1c,
+	0x4a, 0x83, 0xd3, 0x21, 0x9d, 0x42, 0xf4, 0x15, 0x5c, 0xf9, 0x18, 0x2e, 0x59, 0xb8, 0xf3, 0x05,
+	0x58, 0x12, 0x57, 0xc6, 0x05, 0x31, 0xb0, 0xe0, 0x35, 0x4c, 0x3b, 0x35, 0x74, 0xe5, 0xa6, 0xed,
+	0xfc, 0xff, 0xd7, 0x73, 0xfe, 0x33, 0x07, 0x1f, 0xfb, 0x8c, 0x53, 0xc6, 0xcd, 0x01, 0x00, 0x25,
+	0xf1, 0x08, 0x12, 0x73, 0xda, 0xdb, 0x1e, 0x8c, 0x71, 0xcc, 0x12, 0x26, 0xef, 0x0b, 0xc8, 0xd8,
+	0xea, 0xd3, 0x5e, 0xe3, 0x20, 0x60, 0x01, 0xcb, 0x7c, 0x33, 0xfd, 0x12, 0x68, 0xe3, 0x50, 0xa0,
+	0xae, 0x30, 0xf2, 0xff, 0x84, 0xb5, 0x47, 0x68, 0x18, 0x31, 0x33, 0x7b, 0x0a, 0xa9, 0xfd, 0x56,
+	0xc2, 0x95, 0x5b, 0x12, 0x13, 0xca, 0xe5, 0x26, 0xae, 0x0d, 0x00, 0xdc, 0x3e, 0x44, 0x8c, 0x2a,
+	0x48, 0x43, 0x7a, 0xcd, 0xa9, 0x0e, 0x00, 0xec, 0xf4, 0x2c, 0x9f, 0xe3, 0xa6, 0x47, 0x38, 0xb8,
+	0x29, 0xe1, 0x0f, 0x49, 0x14, 0xe4, 0x60, 0x18, 0x91, 0x84, 0xc5, 0x4a, 0x49, 0x43, 0x7a, 0xdd,
+	0x51, 0x52, 0xe4, 0x0a, 0xe0, 0x32, 0x03, 0xec, 0xad, 0x2f, 0xeb, 0x78, 0x37, 0x21, 0x71, 0x00,
+	0x89, 0xeb, 0xdd, 0x33, 0x7f, 0xe4, 0x06, 0x84, 0x2b, 0x7f, 0x34, 0xa4, 0x97, 0x9d, 0x1d, 0xa1,
+	0x5b, 0xa9, 0x7c, 0x4d, 0xb8, 0xec, 0xe3, 0xff, 0x34, 0x8c, 0xdc, 0x9f, 0x66, 0x4a, 0x39, 0x0d,
+	0x62, 0x5d, 0xcc, 0x97, 0x2d, 0xe9, 0x73, 0xd9, 0x3a, 0x09, 0xc2, 0x64, 0x38, 0xf1, 0x0c, 0x9f,
+	0xd1, 0x7c, 0xb4, 0xfc, 0xd5, 0xe5, 0xfd, 0x91, 0x99, 0x3c, 0x8e, 0x81, 0x1b, 0x36, 0xf8, 0xef,
+	0xaf, 0x5d, 0x9c, 0x4f, 0x6e, 0x83, 0xff, 0xb2, 0x99, 0x75, 0x90, 0x83, 0x69, 0x18, 0x59, 0x22,
+	0x9f, 0xdc, 0xc6, 0x75, 0x6f, 0x12, 0x17, 0xba, 0xfc, 0xd5, 0x90, 0x5e, 0x75, 0xfe, 0xa5, 0x62,
+	0xce, 0x9c, 0xb5, 0x9f, 0x36, 0xb3, 0xce, 0x51, 0xa1, 0xfa, 0x43, 0x61, 0x45, 0xe2, 0xca, 0xac,
+	0x9b, 0xf9, 0x4a, 0x45, 0x8b, 0x95, 0x8a, 0xbe, 0x56, 0x2a, 0x7a, 0x5e, 0xab, 0xd2, 0x62, 0xad,
+	0x4a, 0x1f, 0x6b, 0x55, 0xba, 0x33, 0x7e, 0x0d, 0x5a, 0x2c, 0x95, 0x85, 0xf6, 0x2a, 0xd9, 0x3a,
+	0x4e, 0xbf, 0x07, 0x00, 0xdb, 0xd0, 0x47, 0x61, 0x0e, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnBaseFee {
+		i--
+		if m.BurnBaseFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.TargetBlockGas))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BurnBaseFee {
+		n += 2
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnBaseFee = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFee sdk.Dec, blockGas uint64) *GenesisState {
+	return &GenesisState{
+		Params:   params,
+		BaseFee:  baseFee,
+		BlockGas: blockGas,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:  DefaultParams(),
+		BaseFee: DefaultBaseFee,
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.BaseFee.IsNil() || data.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", data.BaseFee)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the key to use for the params in the keeper store.
+	ParamsKey = []byte{0x00}
+	// BaseFeeKey is the key to use for the base fee in the keeper store.
+	BaseFeeKey = []byte{0x01}
+	// BlockGasKey is the key to use for the last block gas used in the keeper store.
+	BlockGasKey = []byte{0x02}
+)
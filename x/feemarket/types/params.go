@@ -0,0 +1,82 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default parameter values
+const (
+	DefaultBaseFeeChangeDenominator = uint32(8)
+	DefaultTargetBlockGas           = uint64(15_000_000)
+)
+
+// DefaultBaseFee is the base fee of a new chain, per unit of gas.
+var DefaultBaseFee = sdk.NewDecWithPrec(25, 4)
+
+// NewParams returns Params instance with the given values.
+func NewParams(feeDenom string, changeDenominator uint32, targetBlockGas uint64, minBaseFee sdk.Dec, burnBaseFee bool) Params {
+	return Params{
+		FeeDenom:                 feeDenom,
+		BaseFeeChangeDenominator: changeDenominator,
+		TargetBlockGas:           targetBlockGas,
+		MinBaseFee:               minBaseFee,
+		BurnBaseFee:              burnBaseFee,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters.
+func DefaultParams() Params {
+	return Params{
+		FeeDenom:                 sdk.DefaultBondDenom,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		TargetBlockGas:           DefaultTargetBlockGas,
+		MinBaseFee:               sdk.ZeroDec(),
+		BurnBaseFee:              false,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("base fee change denominator cannot be 0")
+	}
+	if p.TargetBlockGas == 0 {
+		return errors.New("target block gas cannot be 0")
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", p.MinBaseFee)
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee of the next block, given the current base
+// fee and the gas used by the current block, following EIP-1559:
+//
+//	next = current * (1 + (gasUsed - target) / target / denominator)
+//
+// The base fee increases by at least the smallest decimal unit when the block
+// is above target, and never goes below MinBaseFee.
+func (p Params) NextBaseFee(baseFee sdk.Dec, gasUsed uint64) sdk.Dec {
+	target := p.TargetBlockGas
+	if gasUsed == target {
+		return sdk.MaxDec(baseFee, p.MinBaseFee)
+	}
+
+	var next sdk.Dec
+	if gasUsed > target {
+		delta := baseFee.MulInt64(int64(gasUsed - target)).QuoInt64(int64(target)).QuoInt64(int64(p.BaseFeeChangeDenominator))
+		next = baseFee.Add(sdk.MaxDec(delta, sdk.SmallestDec()))
+	} else {
+		delta := baseFee.MulInt64(int64(target - gasUsed)).QuoInt64(int64(target)).QuoInt64(int64(p.BaseFeeChangeDenominator))
+		next = baseFee.Sub(delta)
+	}
+
+	return sdk.MaxDec(next, p.MinBaseFee)
+}
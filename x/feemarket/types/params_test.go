@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.FeeDenom = ""
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.BaseFeeChangeDenominator = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.TargetBlockGas = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinBaseFee = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams("stake", 8, 1000, sdk.NewDecWithPrec(1, 2), false)
+
+	testCases := []struct {
+		name     string
+		baseFee  sdk.Dec
+		gasUsed  uint64
+		expected sdk.Dec
+	}{
+		{
+			name:     "at target",
+			baseFee:  sdk.NewDec(1),
+			gasUsed:  1000,
+			expected: sdk.NewDec(1),
+		},
+		{
+			name:     "full block increases by 1/8",
+			baseFee:  sdk.NewDec(1),
+			gasUsed:  2000,
+			expected: sdk.NewDecWithPrec(1125, 3),
+		},
+		{
+			name:     "empty block decreases by 1/8",
+			baseFee:  sdk.NewDec(1),
+			gasUsed:  0,
+			expected: sdk.NewDecWithPrec(875, 3),
+		},
+		{
+			name:     "half target decreases by 1/16",
+			baseFee:  sdk.NewDec(1),
+			gasUsed:  500,
+			expected: sdk.NewDecWithPrec(9375, 4),
+		},
+		{
+			name:     "floored at min base fee",
+			baseFee:  sdk.NewDecWithPrec(1, 2),
+			gasUsed:  0,
+			expected: sdk.NewDecWithPrec(1, 2),
+		},
+		{
+			name:     "zero base fee still increases",
+			baseFee:  sdk.ZeroDec(),
+			gasUsed:  2000,
+			expected: sdk.NewDecWithPrec(1, 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.NextBaseFee(tc.baseFee, tc.gasUsed))
+		})
+	}
+
+	params.MinBaseFee = sdk.ZeroDec()
+	require.Equal(t, sdk.SmallestDec(), params.NextBaseFee(sdk.ZeroDec(), 2000))
+}
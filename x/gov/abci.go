@@ -2,6 +2,7 @@ package gov
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -120,18 +121,28 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 		// when proposal become active
 		keeper.Hooks().AfterProposalVotingPeriodEnded(ctx, proposal.Id)
 
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			sdk.NewAttribute(types.AttributeKeyOptimistic, strconv.FormatBool(proposal.Optimistic)),
+		}
+
+		// a multiple choice proposal has no messages, it only records its winning option
+		if proposal.IsMultipleChoice() {
+			winningOption := proposal.VoteOptions.OptionName(tallyResults.WinningOption)
+			logMsg = fmt.Sprintf("%s, winning option: %q", logMsg, winningOption)
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyWinningOption, winningOption))
+		}
+
 		logger.Info(
 			"proposal tallied",
 			"proposal", proposal.Id,
+			"optimistic", proposal.Optimistic,
 			"results", logMsg,
 		)
 
 		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeActiveProposal,
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
-				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
-			),
+			sdk.NewEvent(types.EventTypeActiveProposal, attributes...),
 		)
 		return false
 	})
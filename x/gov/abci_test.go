@@ -383,6 +383,182 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestOptimisticProposalEndBlocker(t *testing.T) {
+	testCases := []struct {
+		name              string
+		rejectedThreshold string
+		voteNo            bool
+		revokeProposer    bool
+		expStatus         v1.ProposalStatus
+	}{
+		{
+			name:              "passes without votes",
+			rejectedThreshold: "0.1",
+			expStatus:         v1.StatusPassed,
+		},
+		{
+			name:              "passes with no votes below the rejected threshold",
+			rejectedThreshold: "0.5",
+			voteNo:            true,
+			expStatus:         v1.StatusPassed,
+		},
+		{
+			name:              "rejected with no votes above the rejected threshold",
+			rejectedThreshold: "0.1",
+			voteNo:            true,
+			expStatus:         v1.StatusRejected,
+		},
+		{
+			name:              "tallied as a standard proposal once the proposer is not authorized anymore",
+			rejectedThreshold: "0.1",
+			revokeProposer:    true,
+			expStatus:         v1.StatusRejected,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 2, valTokens)
+
+			SortAddresses(addrs)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}, []int64{5, 15})
+			staking.EndBlocker(ctx, suite.StakingKeeper)
+
+			params := suite.GovKeeper.GetParams(ctx)
+			params.OptimisticMsgTypeUrls = []string{sdk.MsgTypeURL(&v1.MsgExecLegacyContent{})}
+			params.OptimisticAuthorizedAddresses = []string{addrs[1].String()}
+			params.OptimisticRejectedThreshold = tc.rejectedThreshold
+			require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+			proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[1])
+			require.NoError(t, err)
+			require.True(t, proposal.Optimistic)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[1], proposalCoins)
+			require.NoError(t, err)
+
+			if tc.voteNo {
+				err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), "")
+				require.NoError(t, err)
+			}
+
+			if tc.revokeProposer {
+				params.OptimisticAuthorizedAddresses = nil
+				require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+			}
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+		})
+	}
+}
+
+func TestMultipleChoiceProposalEndBlocker(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name             string
+		votes            []v1.WeightedVoteOptions
+		expStatus        v1.ProposalStatus
+		expWinningOption v1.VoteOption
+		expOptionCounts  []string
+	}{
+		{
+			name:             "rejected without votes",
+			expStatus:        v1.StatusRejected,
+			expWinningOption: v1.OptionEmpty,
+			expOptionCounts:  []string{"0", "0", "0"},
+		},
+		{
+			name: "passes with the option with the most voting power",
+			votes: []v1.WeightedVoteOptions{
+				v1.NewNonSplitVoteOption(v1.OptionTwo),
+				{v1.NewWeightedVoteOption(v1.OptionOne, sdk.NewDecWithPrec(2, 1)), v1.NewWeightedVoteOption(v1.OptionThree, sdk.NewDecWithPrec(8, 1))},
+			},
+			expStatus:        v1.StatusPassed,
+			expWinningOption: v1.OptionThree,
+			expOptionCounts:  []string{"3000000", "5000000", "12000000"},
+		},
+		{
+			name: "rejected with tied options",
+			votes: []v1.WeightedVoteOptions{
+				{v1.NewWeightedVoteOption(v1.OptionOne, half), v1.NewWeightedVoteOption(v1.OptionTwo, half)},
+				{v1.NewWeightedVoteOption(v1.OptionOne, half), v1.NewWeightedVoteOption(v1.OptionTwo, half)},
+			},
+			expStatus:        v1.StatusRejected,
+			expWinningOption: v1.OptionEmpty,
+			expOptionCounts:  []string{"10000000", "10000000", "0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 2, valTokens)
+
+			SortAddresses(addrs)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}, []int64{5, 15})
+			staking.EndBlocker(ctx, suite.StakingKeeper)
+
+			voteOptions, err := v1.NewProposalVoteOptions("Blue", "Green", "Red")
+			require.NoError(t, err)
+
+			proposal, err := suite.GovKeeper.SubmitMultipleChoiceProposal(ctx, voteOptions, "", "title", "summary", addrs[0])
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], proposalCoins)
+			require.NoError(t, err)
+
+			for i, options := range tc.votes {
+				err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[i], options, "")
+				require.NoError(t, err)
+			}
+
+			params := suite.GovKeeper.GetParams(ctx)
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+			require.Equal(t, tc.expWinningOption, proposal.FinalTallyResult.WinningOption)
+			require.Equal(t, tc.expOptionCounts, proposal.FinalTallyResult.OptionCounts)
+			require.Equal(t, "0", proposal.FinalTallyResult.YesCount)
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -38,6 +38,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryGovDelegation(),
+		GetCmdQueryRepresentative(),
+		GetCmdQueryRepresentatives(),
+		GetCmdQuerySimulateProposal(),
+		GetCmdQueryOptimisticProposals(),
 	)
 
 	return govQueryCmd
@@ -171,7 +176,7 @@ $ %s query gov proposals --page=2 --limit=100
 
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
-	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected/canceled")
 	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -652,3 +657,255 @@ $ %s query gov proposer 1
 
 	return cmd
 }
+
+// GetCmdQueryGovDelegation implements the query governance vote delegation command.
+func GetCmdQueryGovDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-delegation [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the representative a delegator delegated its governance voting power to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governance vote delegation of a delegator.
+
+Example:
+$ %s query gov gov-delegation cosmos1skjw..
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovDelegation(
+				cmd.Context(),
+				&v1.QueryGovDelegationRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.GovDelegation)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRepresentative implements the query representative command.
+func GetCmdQueryRepresentative() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "representative [representative-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a representative and its governance voting power",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of delegators and the governance voting power of a
+representative.
+
+Example:
+$ %s query gov representative cosmos1skjw..
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			representativeAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Representative(
+				cmd.Context(),
+				&v1.QueryRepresentativeRequest{Address: representativeAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Representative)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRepresentatives implements the query representatives command.
+func GetCmdQueryRepresentatives() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "representatives",
+		Args:  cobra.NoArgs,
+		Short: "Query all representatives and their governance voting power",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the accounts with governance voting power delegated to them.
+
+Example:
+$ %s query gov representatives
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Representatives(
+				cmd.Context(),
+				&v1.QueryRepresentativesRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "representatives")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySimulateProposal implements the query simulate-proposal command.
+func GetCmdQuerySimulateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-proposal [proposal-id|path/to/proposal.json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Dry-run the messages of a proposal against the current state",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of a proposal as the governance module account against
+the state at the current height, without committing any state change, and print
+their results, events and the error of the first failing message, if any. The
+proposal is either an existing proposal-id or a proposal JSON file, as accepted
+by "%s tx gov submit-proposal".
+
+Example:
+$ %s query gov simulate-proposal 1
+$ %s query gov simulate-proposal path/to/proposal.json
+`,
+				version.AppName, version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			var req *v1.QuerySimulateProposalRequest
+			if proposalID, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				req = &v1.QuerySimulateProposalRequest{ProposalId: proposalID}
+			} else {
+				msgs, _, _, _, _, err := parseSubmitProposal(clientCtx.Codec, args[0])
+				if err != nil {
+					return err
+				}
+
+				req, err = v1.NewQuerySimulateProposalRequest(msgs)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.SimulateProposal(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryOptimisticProposals implements the query optimistic-proposals command.
+func GetCmdQueryOptimisticProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "optimistic-proposals",
+		Args:  cobra.NoArgs,
+		Short: "Query the proposals on the optimistic track",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all paginated proposals on the optimistic track, i.e. submitted by an
+optimistic authorized address with optimistic message types only, optionally filtered by status.
+
+Example:
+$ %s query gov optimistic-proposals
+$ %s query gov optimistic-proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			strProposalStatus, _ := cmd.Flags().GetString(flagStatus)
+
+			var proposalStatus v1.ProposalStatus
+			if len(strProposalStatus) != 0 {
+				var err error
+				proposalStatus, err = v1.ProposalStatusFromString(gcutils.NormalizeProposalStatus(strProposalStatus))
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OptimisticProposals(
+				cmd.Context(),
+				&v1.QueryOptimisticProposalsRequest{
+					ProposalStatus: proposalStatus,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected/canceled")
+	flags.AddPaginationFlagsToCmd(cmd, "optimistic proposals")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
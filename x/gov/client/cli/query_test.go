@@ -381,3 +381,72 @@ func (s *CLITestSuite) TestCmdQueryVote() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestCmdSimulateProposal() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"with proposal id",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"1 --output=json",
+		},
+		{
+			"with proposal file",
+			[]string{
+				"proposal.json",
+				fmt.Sprintf("--%s=text", flags.FlagOutput),
+			},
+			"proposal.json --output=text",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySimulateProposal()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryOptimisticProposals() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"json output",
+			[]string{
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"--output=json",
+		},
+		{
+			"with status",
+			[]string{
+				"--status=VotingPeriod",
+				fmt.Sprintf("--%s=text", flags.FlagOutput),
+			},
+			"--status=VotingPeriod --output=text",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryOptimisticProposals()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
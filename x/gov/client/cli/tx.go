@@ -29,6 +29,7 @@ const (
 	flagVoter        = "voter"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
+	flagValidate     = "validate"
 	FlagMetadata     = "metadata"
 	FlagSummary      = "summary"
 	// Deprecated: only used for v1beta1 legacy proposals.
@@ -69,7 +70,11 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdDeposit(),
 		NewCmdVote(),
 		NewCmdWeightedVote(),
+		NewCmdDelegateVote(),
+		NewCmdUndelegateVote(),
 		NewCmdSubmitProposal(),
+		NewCmdSubmitMultipleChoiceProposal(),
+		NewCmdCancelProposal(),
 		NewCmdDraftProposal(),
 
 		// Deprecated
@@ -92,6 +97,10 @@ They should be defined in a JSON file.
 Example:
 $ %s tx gov submit-proposal path/to/proposal.json
 
+The --validate flag executes the proposal messages against the current state
+first, see "%s query gov simulate-proposal", and aborts the submission if any
+of them fails.
+
 Where proposal.json contains:
 
 {
@@ -122,7 +131,7 @@ metadata example:
 	"vote_option_context": "",
 }
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -136,6 +145,22 @@ metadata example:
 				return err
 			}
 
+			if validate, _ := cmd.Flags().GetBool(flagValidate); validate {
+				req, err := v1.NewQuerySimulateProposalRequest(msgs)
+				if err != nil {
+					return err
+				}
+
+				res, err := v1.NewQueryClient(clientCtx).SimulateProposal(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+
+				if res.Error != "" {
+					return fmt.Errorf("proposal messages failed on simulation: %s", res.Error)
+				}
+			}
+
 			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
@@ -145,6 +170,62 @@ metadata example:
 		},
 	}
 
+	cmd.Flags().Bool(flagValidate, false, "Dry-run the proposal messages against the current state and abort the submission if any of them fails")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitMultipleChoiceProposal implements submitting a multiple choice proposal transaction command.
+func NewCmdSubmitMultipleChoiceProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-multiple-choice-proposal [path/to/proposal.json]",
+		Short: "Submit a multiple choice proposal along with its options, metadata and deposit",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a multiple choice proposal along with its options, metadata and deposit.
+They should be defined in a JSON file. A multiple choice proposal has no messages,
+it is voted for with the options one/two/three/four and records its winning option.
+
+Example:
+$ %s tx gov submit-multiple-choice-proposal path/to/proposal.json
+
+Where proposal.json contains:
+
+{
+  // metadata can be any of base64 encoded, raw text, stringified json, IPFS link to json
+  "metadata": "4pIMOgIGx1vZGU=",
+  "deposit": "10stake",
+  "title": "My proposal",
+  "summary": "A short summary of my proposal",
+  // between 2 and 4 options, defined in order
+  "vote_options": {
+    "option_one": "Blue",
+    "option_two": "Green",
+    "option_three": "Red"
+  }
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			voteOptions, metadata, title, summary, deposit, err := parseSubmitMultipleChoiceProposal(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgSubmitMultipleChoiceProposal(deposit, clientCtx.GetFromAddress().String(), metadata, title, summary, voteOptions)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -272,13 +353,15 @@ func NewCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vote [proposal-id] [option]",
 		Args:  cobra.ExactArgs(2),
-		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
+		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain or one/two/three/four",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
 
 Example:
 $ %s tx gov vote 1 yes --from mykey
+
+The options of a multiple choice proposal are voted for with one/two/three/four.
 `,
 				version.AppName, version.AppName,
 			),
@@ -326,7 +409,7 @@ func NewCmdWeightedVote() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "weighted-vote [proposal-id] [weighted-options]",
 		Args:  cobra.ExactArgs(2),
-		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
+		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain or one/two/three/four",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
@@ -374,3 +457,112 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 
 	return cmd
 }
+
+// NewCmdDelegateVote implements delegating the governance voting power of an account.
+func NewCmdDelegateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-vote [representative-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delegate your governance voting power to a representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate your governance voting power to a representative, who must not
+be a validator. The representative votes on your behalf on the proposals you
+don't vote on, instead of the validators you delegated your stake to.
+
+Example:
+$ %s tx gov delegate-vote cosmos1skjw.. --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			representative, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgDelegateGovVote(clientCtx.GetFromAddress(), representative)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUndelegateVote implements removing the governance vote delegation of an account.
+func NewCmdUndelegateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-vote",
+		Args:  cobra.NoArgs,
+		Short: "Remove the delegation of your governance voting power",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the delegation of your governance voting power to a representative.
+
+Example:
+$ %s tx gov undelegate-vote --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgUndelegateGovVote(clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelProposal implements canceling a proposal by its proposer.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal in its deposit or voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal in its deposit or voting period. Only the proposer
+of the proposal can cancel it. The deposits are refunded minus the proposal
+cancel ratio of them, which is burned or sent to the proposal cancel destination.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := v1.NewMsgCancelProposal(proposalID, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
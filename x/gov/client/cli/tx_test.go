@@ -173,6 +173,84 @@ func (s *CLITestSuite) TestNewCmdSubmitProposal() {
 	}
 }
 
+func (s *CLITestSuite) TestNewCmdSubmitMultipleChoiceProposal() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	// Create a multiple choice proposal JSON with a single option.
+	invalidProp := fmt.Sprintf(`
+	{
+		"title": "My awesome title",
+		"summary": "My awesome description",
+		"deposit": "%s",
+		"vote_options": {
+			"option_one": "Blue"
+		}
+	}`, sdk.NewCoin("stake", sdk.NewInt(5431)))
+	invalidPropFile := testutil.WriteToNewTempFile(s.T(), invalidProp)
+	defer invalidPropFile.Close()
+
+	// Create a valid multiple choice proposal JSON.
+	validProp := fmt.Sprintf(`
+	{
+		"title": "My awesome title",
+		"summary": "My awesome description",
+		"metadata": "%s",
+		"deposit": "%s",
+		"vote_options": {
+			"option_one": "Blue",
+			"option_two": "Green",
+			"option_three": "Red"
+		}
+	}`, base64.StdEncoding.EncodeToString([]byte{42}), sdk.NewCoin("stake", sdk.NewInt(5431)))
+	validPropFile := testutil.WriteToNewTempFile(s.T(), validProp)
+	defer validPropFile.Close()
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+	}{
+		{
+			"invalid proposal",
+			[]string{
+				invalidPropFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			true, nil,
+		},
+		{
+			"valid proposal",
+			[]string{
+				validPropFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdSubmitMultipleChoiceProposal()
+
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestNewCmdSubmitLegacyProposal() {
 	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
 
@@ -122,6 +122,37 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, strin
 	return msgs, proposal.Metadata, proposal.Title, proposal.Summary, deposit, nil
 }
 
+// multipleChoiceProposal defines a multiple choice proposal.
+type multipleChoiceProposal struct {
+	Metadata    string                    `json:"metadata"`
+	Deposit     string                    `json:"deposit"`
+	Title       string                    `json:"title"`
+	Summary     string                    `json:"summary"`
+	VoteOptions govv1.ProposalVoteOptions `json:"vote_options"`
+}
+
+// parseSubmitMultipleChoiceProposal reads and parses the multiple choice proposal.
+func parseSubmitMultipleChoiceProposal(path string) (*govv1.ProposalVoteOptions, string, string, string, sdk.Coins, error) {
+	var proposal multipleChoiceProposal
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", "", "", nil, err
+	}
+
+	err = json.Unmarshal(contents, &proposal)
+	if err != nil {
+		return nil, "", "", "", nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	if err != nil {
+		return nil, "", "", "", nil, err
+	}
+
+	return &proposal.VoteOptions, proposal.Metadata, proposal.Title, proposal.Summary, deposit, nil
+}
+
 // AddGovPropFlagsToCmd adds flags for defining MsgSubmitProposal fields.
 //
 // See also ReadGovPropFlags.
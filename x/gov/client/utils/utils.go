@@ -3,6 +3,7 @@ package utils
 import (
 	"strings"
 
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -21,6 +22,19 @@ func NormalizeVoteOption(option string) string {
 	case "NoWithVeto", "no_with_veto":
 		return v1beta1.OptionNoWithVeto.String()
 
+	// options of multiple choice proposals
+	case "One", "one":
+		return "VOTE_OPTION_ONE"
+
+	case "Two", "two":
+		return "VOTE_OPTION_TWO"
+
+	case "Three", "three":
+		return "VOTE_OPTION_THREE"
+
+	case "Four", "four":
+		return "VOTE_OPTION_FOUR"
+
 	default:
 		return option
 	}
@@ -62,6 +76,8 @@ func NormalizeProposalStatus(status string) string {
 		return v1beta1.StatusPassed.String()
 	case "Rejected", "rejected":
 		return v1beta1.StatusRejected.String()
+	case "Canceled", "canceled":
+		return v1.StatusCanceled.String()
 	default:
 		return status
 	}
@@ -45,6 +45,10 @@ func TestNormalizeWeightedVoteOptions(t *testing.T) {
 			options:    "",
 			normalized: "=1",
 		},
+		"multiple choice options": {
+			options:    "one=0.5,Two=0.3,three=0.2",
+			normalized: "VOTE_OPTION_ONE=0.5,VOTE_OPTION_TWO=0.3,VOTE_OPTION_THREE=0.2",
+		},
 		"not available option": {
 			options:    "Yessss=1",
 			normalized: "Yessss=1",
@@ -75,6 +79,8 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		{"Passed", args{"Passed"}, "PROPOSAL_STATUS_PASSED"},
 		{"Rejected", args{"Rejected"}, "PROPOSAL_STATUS_REJECTED"},
 		{"rejected", args{"rejected"}, "PROPOSAL_STATUS_REJECTED"},
+		{"Canceled", args{"Canceled"}, "PROPOSAL_STATUS_CANCELED"},
+		{"canceled", args{"canceled"}, "PROPOSAL_STATUS_CANCELED"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -19,6 +19,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -115,6 +116,7 @@ func createTestSuite(t *testing.T) suite {
 			configurator.StakingModule(),
 			configurator.BankModule(),
 			configurator.GovModule(),
+			configurator.DistributionModule(),
 			configurator.ConsensusModule(),
 		),
 		simtestutil.DefaultStartUpConfig(),
@@ -40,6 +40,10 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetProposal(ctx, *proposal)
 	}
 
+	for _, delegation := range data.GovDelegations {
+		k.SetGovDelegation(ctx, *delegation)
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -68,11 +72,18 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 		proposalsVotes = append(proposalsVotes, votes...)
 	}
 
+	var govDelegations []*v1.GovDelegation
+	k.IterateGovDelegations(ctx, func(delegation v1.GovDelegation) bool {
+		govDelegations = append(govDelegations, &delegation)
+		return false
+	})
+
 	return &v1.GenesisState{
 		StartingProposalId: startingProposalID,
 		Deposits:           proposalsDeposits,
 		Votes:              proposalsVotes,
 		Proposals:          proposals,
 		Params:             &params,
+		GovDelegations:     govDelegations,
 	}
 }
@@ -0,0 +1,172 @@
+package gov_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGovDelegation(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 5, valTokens)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	// a validator with a power of 10 and 3 delegators with a power of 5 each
+	valAddr := sdk.ValAddress(addrs[0])
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	for _, delegator := range addrs[1:4] {
+		amount := sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 5))
+		_, err := stakingMsgSvr.Delegate(goCtx, stakingtypes.NewMsgDelegate(delegator, valAddr, amount))
+		require.NoError(t, err)
+	}
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	representative := addrs[4]
+
+	// invalid delegations
+	_, err := govMsgSvr.DelegateGovVote(goCtx, v1.NewMsgDelegateGovVote(addrs[1], addrs[0]))
+	require.ErrorIs(t, err, types.ErrInvalidGovDelegation)
+	_, err = govMsgSvr.DelegateGovVote(goCtx, v1.NewMsgDelegateGovVote(addrs[1], addrs[1]))
+	require.ErrorIs(t, err, types.ErrInvalidGovDelegation)
+	_, err = govMsgSvr.UndelegateGovVote(goCtx, v1.NewMsgUndelegateGovVote(addrs[1]))
+	require.ErrorIs(t, err, types.ErrNoGovDelegation)
+
+	_, err = govMsgSvr.DelegateGovVote(goCtx, v1.NewMsgDelegateGovVote(addrs[1], representative))
+	require.NoError(t, err)
+	_, err = govMsgSvr.DelegateGovVote(goCtx, v1.NewMsgDelegateGovVote(addrs[2], addrs[3]))
+	require.NoError(t, err)
+
+	// delegations cannot be chained
+	_, err = govMsgSvr.DelegateGovVote(goCtx, v1.NewMsgDelegateGovVote(representative, addrs[2]))
+	require.ErrorIs(t, err, types.ErrInvalidGovDelegation)
+	_, err = govMsgSvr.DelegateGovVote(goCtx, v1.NewMsgDelegateGovVote(addrs[3], representative))
+	require.ErrorIs(t, err, types.ErrInvalidGovDelegation)
+
+	// redelegate to the representative
+	_, err = govMsgSvr.DelegateGovVote(goCtx, v1.NewMsgDelegateGovVote(addrs[2], representative))
+	require.NoError(t, err)
+	require.Zero(t, suite.GovKeeper.GetRepresentativeDelegatorCount(ctx, addrs[3]))
+
+	// queries
+	res, err := suite.GovKeeper.GovDelegation(goCtx, &v1.QueryGovDelegationRequest{DelegatorAddress: addrs[2].String()})
+	require.NoError(t, err)
+	require.Equal(t, representative.String(), res.GovDelegation.RepresentativeAddress)
+
+	repRes, err := suite.GovKeeper.Representative(goCtx, &v1.QueryRepresentativeRequest{Address: representative.String()})
+	require.NoError(t, err)
+	delegatedPower := suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	require.Equal(t, v1.Representative{
+		Address:        representative.String(),
+		DelegatorCount: 2,
+		DelegatedPower: delegatedPower.String(),
+		TotalPower:     delegatedPower.String(),
+	}, *repRes.Representative)
+
+	repsRes, err := suite.GovKeeper.Representatives(goCtx, &v1.QueryRepresentativesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.Representative{repRes.Representative}, repsRes.Representatives)
+
+	_, err = suite.GovKeeper.Representative(goCtx, &v1.QueryRepresentativeRequest{Address: addrs[3].String()})
+	require.Error(t, err)
+
+	// the delegations are exported
+	genState := gov.ExportGenesis(ctx, suite.GovKeeper)
+	require.Len(t, genState.GovDelegations, 2)
+	require.NoError(t, v1.ValidateGenesis(genState))
+
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0])
+	require.NoError(t, err)
+	suite.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	power := func(power int64) string {
+		return suite.StakingKeeper.TokensFromConsensusPower(ctx, power).String()
+	}
+
+	testCases := []struct {
+		name            string
+		votes           map[int]v1.VoteOption
+		undelegate      bool
+		becomeValidator bool
+		expTally        v1.TallyResult
+	}{
+		{
+			"delegators inherit the vote of their validator without representative vote",
+			map[int]v1.VoteOption{0: v1.OptionNo},
+			false,
+			false,
+			v1.TallyResult{YesCount: "0", AbstainCount: "0", NoCount: power(25), NoWithVetoCount: "0"},
+		},
+		{
+			"delegators inherit the vote of their representative",
+			map[int]v1.VoteOption{0: v1.OptionNo, 4: v1.OptionYes},
+			false,
+			false,
+			v1.TallyResult{YesCount: power(10), AbstainCount: "0", NoCount: power(15), NoWithVetoCount: "0"},
+		},
+		{
+			"direct votes override the vote of the representative",
+			map[int]v1.VoteOption{0: v1.OptionNo, 2: v1.OptionAbstain, 4: v1.OptionYes},
+			false,
+			false,
+			v1.TallyResult{YesCount: power(5), AbstainCount: power(5), NoCount: power(15), NoWithVetoCount: "0"},
+		},
+		{
+			"undelegated delegators inherit the vote of their validator",
+			map[int]v1.VoteOption{0: v1.OptionNo, 4: v1.OptionYes},
+			true,
+			false,
+			v1.TallyResult{YesCount: power(5), AbstainCount: "0", NoCount: power(20), NoWithVetoCount: "0"},
+		},
+		{
+			"delegators don't inherit the vote of a representative which became a validator",
+			map[int]v1.VoteOption{0: v1.OptionNo, 4: v1.OptionYes},
+			false,
+			true,
+			v1.TallyResult{YesCount: power(1), AbstainCount: "0", NoCount: power(25), NoWithVetoCount: "0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+
+			if tc.undelegate {
+				require.NoError(t, suite.GovKeeper.UndelegateGovVote(cacheCtx, addrs[1]))
+			}
+
+			if tc.becomeValidator {
+				valCreateMsg, err := stakingtypes.NewMsgCreateValidator(
+					sdk.ValAddress(representative), pubkeys[1], sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(cacheCtx, 1)),
+					TestDescription, TestCommissionRates, sdk.OneInt(), sdk.ZeroInt(), false,
+				)
+				require.NoError(t, err)
+				_, err = stakingMsgSvr.CreateValidator(sdk.WrapSDKContext(cacheCtx), valCreateMsg)
+				require.NoError(t, err)
+				staking.EndBlocker(cacheCtx, suite.StakingKeeper)
+			}
+
+			for i, option := range tc.votes {
+				require.NoError(t, suite.GovKeeper.AddVote(cacheCtx, proposal.Id, addrs[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			_, _, tally := suite.GovKeeper.Tally(cacheCtx, proposal)
+			require.Equal(t, tc.expTally, tally)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
@@ -179,6 +180,64 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 	})
 }
 
+// ChargeDeposit refunds and deletes the deposits on a specific proposal, minus proposalCancelRatio of
+// them. The charged deposits are burned if destAddress is empty, sent to the community pool if
+// destAddress is the distribution module account, or sent to destAddress otherwise.
+func (keeper Keeper) ChargeDeposit(ctx sdk.Context, proposalID uint64, destAddress, proposalCancelRatio string) (sdk.Coins, error) {
+	store := ctx.KVStore(keeper.storeKey)
+	rate := sdk.MustNewDecFromStr(proposalCancelRatio)
+
+	var (
+		cancellationCharges sdk.Coins
+		err                 error
+	)
+	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		charges := make(sdk.Coins, 0, len(deposit.Amount))
+		for _, coin := range deposit.Amount {
+			charges = append(charges, sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(rate).TruncateInt()))
+		}
+		charges = sdk.NewCoins(charges...)
+
+		if remaining := sdk.NewCoins(deposit.Amount...).Sub(charges...); !remaining.IsZero() {
+			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, remaining)
+			if err != nil {
+				return true
+			}
+		}
+
+		cancellationCharges = cancellationCharges.Add(charges...)
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if cancellationCharges.IsZero() {
+		return cancellationCharges, nil
+	}
+
+	switch {
+	case destAddress == "":
+		err = keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, cancellationCharges)
+	case destAddress == keeper.authKeeper.GetModuleAddress(disttypes.ModuleName).String():
+		err = keeper.distrKeeper.FundCommunityPool(ctx, cancellationCharges, keeper.authKeeper.GetModuleAddress(types.ModuleName))
+	default:
+		var dest sdk.AccAddress
+		dest, err = sdk.AccAddressFromBech32(destAddress)
+		if err == nil {
+			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest, cancellationCharges)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return cancellationCharges, nil
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
 // the deposit parameters. Returns nil on success, error otherwise.
@@ -16,7 +16,7 @@ const (
 )
 
 func TestDeposits(t *testing.T) {
-	govKeeper, _, bankKeeper, stakingKeeper, _, ctx := setupGovKeeper(t)
+	govKeeper, _, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
 	trackMockBalances(bankKeeper)
 	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(10000000))
 
@@ -116,6 +116,82 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial.Sub(fourStake...), bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
 
+func TestChargeDeposit(t *testing.T) {
+	testCases := map[string]struct {
+		proposalCancelRatio string
+		proposalCancelDest  func(destAddr sdk.AccAddress) string
+		expRefund           int64
+		expCharge           int64
+		expDestBalance      int64
+		expCommunityPool    bool
+	}{
+		"charges are burned": {
+			proposalCancelRatio: "0.5",
+			proposalCancelDest:  func(sdk.AccAddress) string { return "" },
+			expRefund:           50,
+			expCharge:           50,
+		},
+		"charges are sent to the destination address": {
+			proposalCancelRatio: "0.25",
+			proposalCancelDest:  func(destAddr sdk.AccAddress) string { return destAddr.String() },
+			expRefund:           75,
+			expCharge:           25,
+			expDestBalance:      25,
+		},
+		"charges are sent to the community pool": {
+			proposalCancelRatio: "0.333",
+			proposalCancelDest:  func(sdk.AccAddress) string { return distAcct.String() },
+			expRefund:           67,
+			expCharge:           33,
+			expCommunityPool:    true,
+		},
+		"zero ratio refunds all the deposits": {
+			proposalCancelRatio: "0",
+			proposalCancelDest:  func(sdk.AccAddress) string { return "" },
+			expRefund:           100,
+		},
+		"full ratio charges all the deposits": {
+			proposalCancelRatio: "1",
+			proposalCancelDest:  func(sdk.AccAddress) string { return "" },
+			expCharge:           100,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			govKeeper, _, bankKeeper, stakingKeeper, distrKeeper, _, ctx := setupGovKeeper(t)
+			addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(10000000))
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", addrs[0])
+			require.NoError(t, err)
+
+			deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount)))
+			_, err = govKeeper.AddDeposit(ctx, proposal.Id, addrs[0], deposit)
+			require.NoError(t, err)
+			_, err = govKeeper.AddDeposit(ctx, proposal.Id, addrs[1], deposit)
+			require.NoError(t, err)
+
+			addr0Initial := bankKeeper.GetAllBalances(ctx, addrs[0])
+			addr1Initial := bankKeeper.GetAllBalances(ctx, addrs[1])
+
+			expCharges := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2*tc.expCharge)))
+			if tc.expCommunityPool {
+				distrKeeper.EXPECT().FundCommunityPool(ctx, expCharges, govAcct).Return(nil).Times(1)
+			}
+
+			charges, err := govKeeper.ChargeDeposit(ctx, proposal.Id, tc.proposalCancelDest(addrs[2]), tc.proposalCancelRatio)
+			require.NoError(t, err)
+			require.Equal(t, expCharges, charges)
+
+			refund := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(tc.expRefund)))
+			require.Equal(t, addr0Initial.Add(refund...), bankKeeper.GetAllBalances(ctx, addrs[0]))
+			require.Equal(t, addr1Initial.Add(refund...), bankKeeper.GetAllBalances(ctx, addrs[1]))
+			require.Equal(t, sdk.NewInt(10000000+2*tc.expDestBalance), bankKeeper.GetAllBalances(ctx, addrs[2]).AmountOf(sdk.DefaultBondDenom))
+			require.Empty(t, govKeeper.GetDeposits(ctx, proposal.Id))
+		})
+	}
+}
+
 func TestValidateInitialDeposit(t *testing.T) {
 	testcases := map[string]struct {
 		minDeposit               sdk.Coins
@@ -193,7 +269,7 @@ func TestValidateInitialDeposit(t *testing.T) {
 
 	for name, tc := range testcases {
 		t.Run(name, func(t *testing.T) {
-			govKeeper, _, _, _, _, ctx := setupGovKeeper(t)
+			govKeeper, _, _, _, _, _, ctx := setupGovKeeper(t)
 
 			params := v1.DefaultParams()
 			params.MinDeposit = tc.minDeposit
@@ -7,3 +7,10 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
 	return k.validateInitialDeposit(ctx, initialDeposit)
 }
+
+// WithMaxSimulationGas returns a copy of the keeper whose proposal simulations consume at most the
+// given gas, used only in simulation tests.
+func (k Keeper) WithMaxSimulationGas(gas uint64) Keeper {
+	k.config.MaxSimulationGas = gas
+	return k
+}
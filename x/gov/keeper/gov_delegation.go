@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DelegateGovVote delegates the governance voting power of delegatorAddr to representativeAddr, replacing
+// its previous delegation if any. The representative votes on behalf of the delegator on the proposals
+// the delegator doesn't vote on. Representatives must neither be validators nor delegate their own voting
+// power, and have at most MaxRepresentativeDelegators delegators.
+func (keeper Keeper) DelegateGovVote(ctx sdk.Context, delegatorAddr, representativeAddr sdk.AccAddress) error {
+	if delegatorAddr.Equals(representativeAddr) {
+		return sdkerrors.Wrap(types.ErrInvalidGovDelegation, "cannot delegate to self")
+	}
+	if keeper.sk.Validator(ctx, sdk.ValAddress(representativeAddr)) != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidGovDelegation, "representative %s is a validator", representativeAddr)
+	}
+	if _, found := keeper.GetGovDelegation(ctx, representativeAddr); found {
+		return sdkerrors.Wrapf(types.ErrInvalidGovDelegation, "representative %s delegated its voting power", representativeAddr)
+	}
+	if keeper.GetRepresentativeDelegatorCount(ctx, delegatorAddr) > 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidGovDelegation, "delegator %s is a representative", delegatorAddr)
+	}
+	if previous, found := keeper.GetGovDelegation(ctx, delegatorAddr); !found || !previous.Equals(representativeAddr) {
+		if keeper.GetRepresentativeDelegatorCount(ctx, representativeAddr) >= keeper.config.MaxRepresentativeDelegators {
+			return sdkerrors.Wrapf(types.ErrInvalidGovDelegation, "representative %s has reached the maximum number of delegators: %d", representativeAddr, keeper.config.MaxRepresentativeDelegators)
+		}
+	}
+
+	keeper.SetGovDelegation(ctx, v1.NewGovDelegation(delegatorAddr, representativeAddr))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateVote,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyRepresentative, representativeAddr.String()),
+		),
+	)
+
+	return nil
+}
+
+// UndelegateGovVote removes the governance vote delegation of delegatorAddr.
+func (keeper Keeper) UndelegateGovVote(ctx sdk.Context, delegatorAddr sdk.AccAddress) error {
+	representativeAddr, found := keeper.GetGovDelegation(ctx, delegatorAddr)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoGovDelegation, "delegator %s", delegatorAddr)
+	}
+
+	keeper.deleteGovDelegation(ctx, delegatorAddr, representativeAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateVote,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyRepresentative, representativeAddr.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetGovDelegation returns the representative of delegatorAddr.
+func (keeper Keeper) GetGovDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress) (representativeAddr sdk.AccAddress, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovDelegationKey(delegatorAddr))
+	if bz == nil {
+		return nil, false
+	}
+
+	return sdk.AccAddress(bz), true
+}
+
+// SetGovDelegation sets a GovDelegation to the gov store, replacing the previous delegation of the
+// delegator if any.
+func (keeper Keeper) SetGovDelegation(ctx sdk.Context, delegation v1.GovDelegation) {
+	delegatorAddr := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+	representativeAddr := sdk.MustAccAddressFromBech32(delegation.RepresentativeAddress)
+
+	if previous, found := keeper.GetGovDelegation(ctx, delegatorAddr); found {
+		keeper.deleteGovDelegation(ctx, delegatorAddr, previous)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GovDelegationKey(delegatorAddr), representativeAddr)
+	store.Set(types.RepresentativeDelegatorKey(representativeAddr, delegatorAddr), []byte{0x01})
+	keeper.setRepresentativeDelegatorCount(ctx, representativeAddr, keeper.GetRepresentativeDelegatorCount(ctx, representativeAddr)+1)
+}
+
+func (keeper Keeper) deleteGovDelegation(ctx sdk.Context, delegatorAddr, representativeAddr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GovDelegationKey(delegatorAddr))
+	store.Delete(types.RepresentativeDelegatorKey(representativeAddr, delegatorAddr))
+	keeper.setRepresentativeDelegatorCount(ctx, representativeAddr, keeper.GetRepresentativeDelegatorCount(ctx, representativeAddr)-1)
+}
+
+// GetRepresentativeDelegatorCount returns the number of delegators of representativeAddr.
+func (keeper Keeper) GetRepresentativeDelegatorCount(ctx sdk.Context, representativeAddr sdk.AccAddress) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.RepresentativeKey(representativeAddr))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (keeper Keeper) setRepresentativeDelegatorCount(ctx sdk.Context, representativeAddr sdk.AccAddress, count uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	if count == 0 {
+		store.Delete(types.RepresentativeKey(representativeAddr))
+		return
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.RepresentativeKey(representativeAddr), bz)
+}
+
+// IterateGovDelegations iterates over all the governance vote delegations and performs a callback function
+func (keeper Keeper) IterateGovDelegations(ctx sdk.Context, cb func(delegation v1.GovDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GovDelegationsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// <prefix (1 Byte)><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>
+		delegation := v1.NewGovDelegation(iterator.Key()[2:], iterator.Value())
+
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// IterateRepresentativeDelegators iterates over the delegators of representativeAddr and performs a callback
+// function
+func (keeper Keeper) IterateRepresentativeDelegators(ctx sdk.Context, representativeAddr sdk.AccAddress, cb func(delegatorAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := types.RepresentativeDelegatorsKey(representativeAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// <prefix><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>
+		if cb(sdk.AccAddress(iterator.Key()[len(prefix)+1:])) {
+			break
+		}
+	}
+}
+
+// GetRepresentative returns representativeAddr with the voting power delegated to it, if it has delegators.
+func (keeper Keeper) GetRepresentative(ctx sdk.Context, representativeAddr sdk.AccAddress) (v1.Representative, bool) {
+	count := keeper.GetRepresentativeDelegatorCount(ctx, representativeAddr)
+	if count == 0 {
+		return v1.Representative{}, false
+	}
+
+	delegatedPower := math.LegacyZeroDec()
+	keeper.IterateRepresentativeDelegators(ctx, representativeAddr, func(delegatorAddr sdk.AccAddress) bool {
+		delegatedPower = delegatedPower.Add(keeper.votingPower(ctx, delegatorAddr))
+		return false
+	})
+	totalPower := delegatedPower.Add(keeper.votingPower(ctx, representativeAddr))
+
+	return v1.Representative{
+		Address:        representativeAddr.String(),
+		DelegatorCount: count,
+		DelegatedPower: delegatedPower.TruncateInt().String(),
+		TotalPower:     totalPower.TruncateInt().String(),
+	}, true
+}
+
+// votingPower returns the voting power of the delegations of addr to bonded validators, as counted by Tally.
+func (keeper Keeper) votingPower(ctx sdk.Context, addr sdk.AccAddress) math.LegacyDec {
+	power := math.LegacyZeroDec()
+	keeper.sk.IterateDelegations(ctx, addr, func(_ int64, delegation stakingtypes.DelegationI) bool {
+		validator := keeper.sk.Validator(ctx, delegation.GetValidatorAddr())
+		if validator == nil || !validator.IsBonded() || validator.GetDelegatorShares().IsZero() {
+			return false
+		}
+
+		// delegation shares * bonded / total shares
+		power = power.Add(delegation.GetShares().MulInt(validator.GetBondedTokens()).Quo(validator.GetDelegatorShares()))
+		return false
+	})
+
+	return power
+}
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestDelegateGovVoteMaxDelegators(t *testing.T) {
+	govKeeper, _, _, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	maxDelegators := types.DefaultConfig().MaxRepresentativeDelegators
+	representative := sdk.AccAddress("representative")
+	delegator := func(i uint64) sdk.AccAddress {
+		return sdk.AccAddress(sdk.Uint64ToBigEndian(i))
+	}
+
+	for i := uint64(0); i < maxDelegators; i++ {
+		require.NoError(t, govKeeper.DelegateGovVote(ctx, delegator(i), representative))
+	}
+	require.Equal(t, maxDelegators, govKeeper.GetRepresentativeDelegatorCount(ctx, representative))
+
+	// the representative cannot have more delegators
+	err := govKeeper.DelegateGovVote(ctx, delegator(maxDelegators), representative)
+	require.ErrorIs(t, err, types.ErrInvalidGovDelegation)
+
+	// but its delegators can delegate to it again
+	require.NoError(t, govKeeper.DelegateGovVote(ctx, delegator(0), representative))
+
+	// and new delegators once one of them undelegated
+	require.NoError(t, govKeeper.UndelegateGovVote(ctx, delegator(0)))
+	require.NoError(t, govKeeper.DelegateGovVote(ctx, delegator(maxDelegators), representative))
+	require.Equal(t, maxDelegators, govKeeper.GetRepresentativeDelegatorCount(ctx, representative))
+}
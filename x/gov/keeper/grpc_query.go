@@ -270,7 +270,8 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	case proposal.Status == v1.StatusDepositPeriod:
 		tallyResult = v1.EmptyTallyResult()
 
-	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed:
+	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed ||
+		proposal.Status == v1.StatusCanceled:
 		tallyResult = *proposal.FinalTallyResult
 
 	default:
@@ -281,6 +282,161 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// GovDelegation queries the governance vote delegation of a delegator
+func (q Keeper) GovDelegation(c context.Context, req *v1.QueryGovDelegationRequest) (*v1.QueryGovDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegator, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	representative, found := q.GetGovDelegation(ctx, delegator)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "gov delegation of %s not found", req.DelegatorAddress)
+	}
+
+	delegation := v1.NewGovDelegation(delegator, representative)
+	return &v1.QueryGovDelegationResponse{GovDelegation: &delegation}, nil
+}
+
+// Representative queries a representative and its voting power
+func (q Keeper) Representative(c context.Context, req *v1.QueryRepresentativeRequest) (*v1.QueryRepresentativeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty representative address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	representative, found := q.GetRepresentative(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "representative %s not found", req.Address)
+	}
+
+	return &v1.QueryRepresentativeResponse{Representative: &representative}, nil
+}
+
+// Representatives queries all representatives and their voting power
+func (q Keeper) Representatives(c context.Context, req *v1.QueryRepresentativesRequest) (*v1.QueryRepresentativesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var representatives []*v1.Representative
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	representativeStore := prefix.NewStore(store, types.RepresentativesKeyPrefix)
+
+	pageRes, err := query.Paginate(representativeStore, req.Pagination, func(key []byte, value []byte) error {
+		// <representativeAddrLen (1 Byte)><representativeAddr_Bytes>
+		representative, found := q.GetRepresentative(ctx, key[1:])
+		if !found {
+			return nil
+		}
+
+		representatives = append(representatives, &representative)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryRepresentativesResponse{Representatives: representatives, Pagination: pageRes}, nil
+}
+
+// SimulateProposal executes the messages of a proposal without committing their state changes
+func (q Keeper) SimulateProposal(c context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId != 0 && len(req.Messages) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id and messages can not be both set")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		msgs []sdk.Msg
+		err  error
+	)
+	if req.ProposalId != 0 {
+		proposal, ok := q.GetProposal(ctx, req.ProposalId)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+
+		msgs, err = proposal.GetMsgs()
+	} else {
+		msgs, err = req.GetMsgs()
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results, gasUsed, err := q.SimulateProposalMsgs(ctx, msgs)
+	res := &v1.QuerySimulateProposalResponse{Results: results, GasUsed: gasUsed}
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+// OptimisticProposals implements the Query/OptimisticProposals gRPC method
+func (q Keeper) OptimisticProposals(c context.Context, req *v1.QueryOptimisticProposalsRequest) (*v1.QueryOptimisticProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	proposalStore := prefix.NewStore(store, types.ProposalsKeyPrefix)
+
+	filteredProposals, pageRes, err := query.GenericFilteredPaginate(
+		q.cdc,
+		proposalStore,
+		req.Pagination,
+		func(key []byte, p *v1.Proposal) (*v1.Proposal, error) {
+			if !p.Optimistic {
+				return nil, nil
+			}
+
+			// match status (if supplied/valid)
+			if v1.ValidProposalStatus(req.ProposalStatus) && p.Status != req.ProposalStatus {
+				return nil, nil
+			}
+
+			return p, nil
+		}, func() *v1.Proposal {
+			return &v1.Proposal{}
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryOptimisticProposalsResponse{Proposals: filteredProposals, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
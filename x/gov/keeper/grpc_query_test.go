@@ -1695,3 +1695,204 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	params := v1.DefaultParams()
+	updatedParams := params
+	updatedParams.BurnVoteQuorum = !params.BurnVoteQuorum
+	invalidParams := params
+	invalidParams.Quorum = "abc"
+
+	updateParamsMsg := &v1.MsgUpdateParams{Authority: govAcct.String(), Params: updatedParams}
+
+	var req *v1.QuerySimulateProposalRequest
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expPass    bool
+		expResults int
+		expErrMsg  string
+	}{
+		{
+			"empty request",
+			func() {
+				req = &v1.QuerySimulateProposalRequest{}
+			},
+			true,
+			0,
+			"",
+		},
+		{
+			"both proposal id and messages",
+			func() {
+				var err error
+				req, err = v1.NewQuerySimulateProposalRequest([]sdk.Msg{updateParamsMsg})
+				suite.Require().NoError(err)
+				req.ProposalId = 1
+			},
+			false,
+			0,
+			"",
+		},
+		{
+			"non existing proposal",
+			func() {
+				req = &v1.QuerySimulateProposalRequest{ProposalId: 100}
+			},
+			false,
+			0,
+			"",
+		},
+		{
+			"valid messages",
+			func() {
+				var err error
+				req, err = v1.NewQuerySimulateProposalRequest([]sdk.Msg{updateParamsMsg, updateParamsMsg})
+				suite.Require().NoError(err)
+			},
+			true,
+			2,
+			"",
+		},
+		{
+			"existing proposal",
+			func() {
+				proposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{updateParamsMsg}, "", "title", "summary", suite.addrs[0])
+				suite.Require().NoError(err)
+				req = &v1.QuerySimulateProposalRequest{ProposalId: proposal.Id}
+			},
+			true,
+			1,
+			"",
+		},
+		{
+			"failing message",
+			func() {
+				var err error
+				req, err = v1.NewQuerySimulateProposalRequest([]sdk.Msg{
+					updateParamsMsg,
+					&v1.MsgUpdateParams{Authority: govAcct.String(), Params: invalidParams},
+				})
+				suite.Require().NoError(err)
+			},
+			true,
+			1,
+			"msg 1 (/cosmos.gov.v1.MsgUpdateParams)",
+		},
+		{
+			"message not signed by the gov account",
+			func() {
+				var err error
+				req, err = v1.NewQuerySimulateProposalRequest([]sdk.Msg{
+					&v1.MsgUpdateParams{Authority: suite.addrs[0].String(), Params: updatedParams},
+				})
+				suite.Require().NoError(err)
+			},
+			true,
+			0,
+			"expected gov account as only signer for proposal message",
+		},
+		{
+			"panicking message",
+			func() {
+				req, _ = v1.NewQuerySimulateProposalRequest(TestProposal[:1])
+			},
+			true,
+			0,
+			"PANICKED",
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.SimulateProposal(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.Results, testCase.expResults)
+				if testCase.expErrMsg != "" {
+					suite.Require().Contains(res.Error, testCase.expErrMsg)
+				} else {
+					suite.Require().Empty(res.Error)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			// the state changes of the simulated messages are never committed
+			suite.Require().Equal(params, suite.govKeeper.GetParams(ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryOptimisticProposals() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	updateParamsMsg := &v1.MsgUpdateParams{Authority: govAcct.String(), Params: v1.DefaultParams()}
+
+	params := suite.govKeeper.GetParams(ctx)
+	params.OptimisticMsgTypeUrls = []string{sdk.MsgTypeURL(updateParamsMsg)}
+	params.OptimisticAuthorizedAddresses = []string{addrs[0].String()}
+	suite.Require().NoError(suite.govKeeper.SetParams(ctx, params))
+
+	// only the first proposal is submitted by an authorized proposer with optimistic messages only
+	optimisticProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{updateParamsMsg}, "", "title", "summary", addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().True(optimisticProposal.Optimistic)
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{updateParamsMsg}, "", "title", "summary", addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().False(proposal.Optimistic)
+
+	proposal, err = suite.govKeeper.SubmitProposal(ctx, append([]sdk.Msg{updateParamsMsg}, TestProposal...), "", "title", "summary", addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().False(proposal.Optimistic)
+
+	proposal, err = suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{}, "", "title", "summary", addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().False(proposal.Optimistic)
+
+	testCases := []struct {
+		msg          string
+		req          *v1.QueryOptimisticProposalsRequest
+		expProposals []uint64
+	}{
+		{
+			"all optimistic proposals",
+			&v1.QueryOptimisticProposalsRequest{},
+			[]uint64{optimisticProposal.Id},
+		},
+		{
+			"optimistic proposals in deposit period",
+			&v1.QueryOptimisticProposalsRequest{ProposalStatus: v1.StatusDepositPeriod},
+			[]uint64{optimisticProposal.Id},
+		},
+		{
+			"optimistic proposals in voting period",
+			&v1.QueryOptimisticProposalsRequest{ProposalStatus: v1.StatusVotingPeriod},
+			[]uint64{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			res, err := queryClient.OptimisticProposals(gocontext.Background(), testCase.req)
+
+			suite.Require().NoError(err)
+
+			ids := make([]uint64, 0, len(res.Proposals))
+			for _, p := range res.Proposals {
+				ids = append(ids, p.Id)
+			}
+			suite.Require().Equal(testCase.expProposals, ids)
+		})
+	}
+}
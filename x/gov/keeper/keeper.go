@@ -18,8 +18,9 @@ import (
 
 // Keeper defines the governance module Keeper
 type Keeper struct {
-	authKeeper types.AccountKeeper
-	bankKeeper types.BankKeeper
+	authKeeper  types.AccountKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
 
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
@@ -60,7 +61,7 @@ func (k Keeper) GetAuthority() string {
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, authKeeper types.AccountKeeper,
-	bankKeeper types.BankKeeper, sk types.StakingKeeper,
+	bankKeeper types.BankKeeper, sk types.StakingKeeper, distrKeeper types.DistributionKeeper,
 	router *baseapp.MsgServiceRouter, config types.Config, authority string,
 ) *Keeper {
 	// ensure governance module account is set
@@ -77,15 +78,26 @@ func NewKeeper(
 		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
 	}
 
+	// If MaxSimulationGas not set by app developer, set to default value.
+	if config.MaxSimulationGas == 0 {
+		config.MaxSimulationGas = types.DefaultConfig().MaxSimulationGas
+	}
+
+	// If MaxRepresentativeDelegators not set by app developer, set to default value.
+	if config.MaxRepresentativeDelegators == 0 {
+		config.MaxRepresentativeDelegators = types.DefaultConfig().MaxRepresentativeDelegators
+	}
+
 	return &Keeper{
-		storeKey:   key,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		sk:         sk,
-		cdc:        cdc,
-		router:     router,
-		config:     config,
-		authority:  authority,
+		storeKey:    key,
+		authKeeper:  authKeeper,
+		bankKeeper:  bankKeeper,
+		sk:          sk,
+		distrKeeper: distrKeeper,
+		cdc:         cdc,
+		router:      router,
+		config:      config,
+		authority:   authority,
 	}
 }
 
@@ -48,20 +48,61 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	bytes, err := proposal.Marshal()
+	if err := k.addInitialDeposit(ctx, proposal, proposer, initialDeposit); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgSubmitProposalResponse{
+		ProposalId: proposal.Id,
+	}, nil
+}
+
+// SubmitMultipleChoiceProposal implements the MsgServer.SubmitMultipleChoiceProposal method.
+func (k msgServer) SubmitMultipleChoiceProposal(goCtx context.Context, msg *v1.MsgSubmitMultipleChoiceProposal) (*v1.MsgSubmitMultipleChoiceProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	initialDeposit := msg.GetInitialDeposit()
+
+	if err := k.validateInitialDeposit(ctx, initialDeposit); err != nil {
+		return nil, err
+	}
+
+	proposer, err := sdk.AccAddressFromBech32(msg.GetProposer())
 	if err != nil {
 		return nil, err
 	}
 
+	proposal, err := k.Keeper.SubmitMultipleChoiceProposal(ctx, msg.VoteOptions, msg.Metadata, msg.Title, msg.Summary, proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.addInitialDeposit(ctx, proposal, proposer, initialDeposit); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgSubmitMultipleChoiceProposalResponse{
+		ProposalId: proposal.Id,
+	}, nil
+}
+
+// addInitialDeposit charges the gas for storing a newly submitted proposal and deposits the initial
+// deposit of its proposer.
+func (k msgServer) addInitialDeposit(ctx sdk.Context, proposal v1.Proposal, proposer sdk.AccAddress, initialDeposit sdk.Coins) error {
+	bytes, err := proposal.Marshal()
+	if err != nil {
+		return err
+	}
+
 	// ref: https://github.com/cosmos/cosmos-sdk/issues/9683
 	ctx.GasMeter().ConsumeGas(
 		3*ctx.KVGasConfig().WriteCostPerByte*uint64(len(bytes)),
 		"submit proposal",
 	)
 
-	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.Id, proposer, msg.GetInitialDeposit())
+	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.Id, proposer, initialDeposit)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if votingStarted {
@@ -72,9 +113,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		)
 	}
 
-	return &v1.MsgSubmitProposalResponse{
-		ProposalId: proposal.Id,
-	}, nil
+	return nil
 }
 
 // ExecLegacyContent implements the MsgServer.ExecLegacyContent method.
@@ -172,6 +211,58 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// DelegateGovVote implements the MsgServer.DelegateGovVote method.
+func (k msgServer) DelegateGovVote(goCtx context.Context, msg *v1.MsgDelegateGovVote) (*v1.MsgDelegateGovVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	representative, err := sdk.AccAddressFromBech32(msg.RepresentativeAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.DelegateGovVote(ctx, delegator, representative); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateGovVoteResponse{}, nil
+}
+
+// UndelegateGovVote implements the MsgServer.UndelegateGovVote method.
+func (k msgServer) UndelegateGovVote(goCtx context.Context, msg *v1.MsgUndelegateGovVote) (*v1.MsgUndelegateGovVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UndelegateGovVote(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateGovVoteResponse{}, nil
+}
+
+// CancelProposal implements the MsgServer.CancelProposal method.
+func (k msgServer) CancelProposal(goCtx context.Context, msg *v1.MsgCancelProposal) (*v1.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelProposal(ctx, msg.ProposalId, msg.Proposer); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelProposalResponse{
+		ProposalId:     msg.ProposalId,
+		CanceledTime:   ctx.BlockTime(),
+		CanceledHeight: uint64(ctx.BlockHeight()),
+	}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
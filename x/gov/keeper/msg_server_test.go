@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -132,6 +133,77 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposalReq() {
+	suite.reset()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	voteOptions := &v1.ProposalVoteOptions{OptionOne: "Blue", OptionTwo: "Green", OptionThree: "Red"}
+
+	cases := map[string]struct {
+		voteOptions *v1.ProposalVoteOptions
+		metadata    string
+		expErr      bool
+		expErrMsg   string
+	}{
+		"no vote options": {
+			voteOptions: nil,
+			expErr:      true,
+			expErrMsg:   "vote options cannot be empty",
+		},
+		"single vote option": {
+			voteOptions: &v1.ProposalVoteOptions{OptionOne: "Blue"},
+			expErr:      true,
+			expErrMsg:   "at least 2 options",
+		},
+		"vote option too long": {
+			voteOptions: &v1.ProposalVoteOptions{OptionOne: "Blue", OptionTwo: strings.Repeat("1", 300)},
+			expErr:      true,
+			expErrMsg:   "metadata too long",
+		},
+		"metadata too long": {
+			voteOptions: voteOptions,
+			metadata:    strings.Repeat("1", 300),
+			expErr:      true,
+			expErrMsg:   "metadata too long",
+		},
+		"all good": {
+			voteOptions: voteOptions,
+			expErr:      false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			msg := v1.NewMsgSubmitMultipleChoiceProposal(minDeposit, proposer.String(), tc.metadata, "Proposal", "description of proposal", tc.voteOptions)
+			res, err := suite.msgSrvr.SubmitMultipleChoiceProposal(suite.ctx, msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			proposal, found := suite.govKeeper.GetProposal(suite.ctx, res.ProposalId)
+			suite.Require().True(found)
+			suite.Require().True(proposal.IsMultipleChoice())
+			suite.Require().Empty(proposal.Messages)
+			suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+
+			// only the defined options of the proposal can be voted for
+			_, err = suite.msgSrvr.VoteWeighted(suite.ctx, v1.NewMsgVoteWeighted(proposer, res.ProposalId, v1.WeightedVoteOptions{
+				v1.NewWeightedVoteOption(v1.OptionOne, sdk.NewDecWithPrec(6, 1)),
+				v1.NewWeightedVoteOption(v1.OptionThree, sdk.NewDecWithPrec(4, 1)),
+			}, ""))
+			suite.Require().NoError(err)
+
+			_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(proposer, res.ProposalId, v1.OptionFour, ""))
+			suite.Require().ErrorIs(err, types.ErrInvalidVote)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestVoteReq() {
 	suite.reset()
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
@@ -441,6 +513,123 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	suite.reset()
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	submitProposal := func(deposit sdk.Coins) uint64 {
+		msg, err := v1.NewMsgSubmitProposal(
+			[]sdk.Msg{bankMsg},
+			deposit,
+			proposer.String(),
+			"",
+			"Proposal",
+			"description of proposal",
+		)
+		suite.Require().NoError(err)
+
+		res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+		suite.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	cases := map[string]struct {
+		preRun    func() uint64
+		proposer  sdk.AccAddress
+		expErr    bool
+		expErrMsg string
+	}{
+		"unknown proposal": {
+			preRun: func() uint64 {
+				return 0
+			},
+			proposer:  proposer,
+			expErr:    true,
+			expErrMsg: "unknown proposal",
+		},
+		"not the proposer": {
+			preRun: func() uint64 {
+				return submitProposal(coins)
+			},
+			proposer:  addrs[1],
+			expErr:    true,
+			expErrMsg: "invalid proposer",
+		},
+		"proposal in deposit period": {
+			preRun: func() uint64 {
+				return submitProposal(coins)
+			},
+			proposer: proposer,
+		},
+		"proposal in voting period": {
+			preRun: func() uint64 {
+				proposalID := submitProposal(minDeposit)
+				suite.Require().NoError(suite.govKeeper.AddVote(suite.ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+				return proposalID
+			},
+			proposer: proposer,
+		},
+		"proposal already canceled": {
+			preRun: func() uint64 {
+				proposalID := submitProposal(coins)
+				suite.Require().NoError(suite.govKeeper.CancelProposal(suite.ctx, proposalID, proposer.String()))
+				return proposalID
+			},
+			proposer:  proposer,
+			expErr:    true,
+			expErrMsg: "inactive proposal",
+		},
+		"voting period ended": {
+			preRun: func() uint64 {
+				proposalID := submitProposal(minDeposit)
+				proposal, ok := suite.govKeeper.GetProposal(suite.ctx, proposalID)
+				suite.Require().True(ok)
+				endTime := suite.ctx.BlockTime()
+				proposal.VotingEndTime = &endTime
+				suite.govKeeper.SetProposal(suite.ctx, proposal)
+				return proposalID
+			},
+			proposer:  proposer,
+			expErr:    true,
+			expErrMsg: "voting period already ended",
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			proposalID := tc.preRun()
+			res, err := suite.msgSrvr.CancelProposal(suite.ctx, v1.NewMsgCancelProposal(proposalID, tc.proposer.String()))
+			if tc.expErr {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(proposalID, res.ProposalId)
+
+			proposal, ok := suite.govKeeper.GetProposal(suite.ctx, proposalID)
+			suite.Require().True(ok)
+			suite.Require().Equal(v1.StatusCanceled, proposal.Status)
+			suite.Require().Empty(suite.govKeeper.GetDeposits(suite.ctx, proposalID))
+			suite.Require().Empty(suite.govKeeper.GetVotes(suite.ctx, proposalID))
+
+			tally, err := suite.queryClient.TallyResult(suite.ctx, &v1.QueryTallyResultRequest{ProposalId: proposalID})
+			suite.Require().NoError(err)
+			suite.Require().Equal(proposal.FinalTallyResult, tally.Tally)
+		})
+	}
+}
+
 // legacy msg server tests
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
@@ -1024,6 +1213,146 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "invalid proposal cancel ratio",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ProposalCancelRatio = "abc"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid cancel ratio of proposal",
+		},
+		{
+			name: "negative proposal cancel ratio",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ProposalCancelRatio = "-0.1"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "cancel ratio of proposal must not be negative",
+		},
+		{
+			name: "proposal cancel ratio too large",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ProposalCancelRatio = "1.1"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "cancel ratio of proposal is too large",
+		},
+		{
+			name: "invalid proposal cancel destination",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ProposalCancelDest = "dest"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid cancel destination address of proposal",
+		},
+		{
+			name: "empty optimistic message type URL",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.OptimisticMsgTypeUrls = []string{""}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "optimistic message type URL cannot be empty",
+		},
+		{
+			name: "duplicate optimistic message type URL",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.OptimisticMsgTypeUrls = []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "duplicate optimistic message type URL",
+		},
+		{
+			name: "invalid optimistic authorized address",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.OptimisticAuthorizedAddresses = []string{"addr"}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid optimistic authorized address",
+		},
+		{
+			name: "duplicate optimistic authorized address",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.OptimisticAuthorizedAddresses = []string{authority, authority}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "duplicate optimistic authorized address",
+		},
+		{
+			name: "zero optimistic rejected threshold",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.OptimisticRejectedThreshold = "0"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "optimistic rejected threshold must be positive",
+		},
+		{
+			name: "optimistic rejected threshold too large",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.OptimisticRejectedThreshold = "1.1"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "optimistic rejected threshold too large",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -3,7 +3,9 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,6 +15,31 @@ import (
 
 // SubmitProposal creates a new proposal given an array of messages
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, nil, metadata, title, summary, proposer)
+}
+
+// SubmitMultipleChoiceProposal creates a new multiple choice proposal given its vote options. A multiple
+// choice proposal has no messages, its final tally result records the winning option.
+func (keeper Keeper) SubmitMultipleChoiceProposal(ctx sdk.Context, voteOptions *v1.ProposalVoteOptions, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	if voteOptions == nil {
+		return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidVoteOptions, "vote options cannot be empty")
+	}
+
+	if err := voteOptions.ValidateBasic(); err != nil {
+		return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidVoteOptions, err.Error())
+	}
+
+	// assert the option names are no longer than predefined max length of metadata
+	for _, name := range voteOptions.Names() {
+		if err := keeper.assertMetadataLength(name); err != nil {
+			return v1.Proposal{}, err
+		}
+	}
+
+	return keeper.submitProposal(ctx, nil, voteOptions, metadata, title, summary, proposer)
+}
+
+func (keeper Keeper) submitProposal(ctx sdk.Context, messages []sdk.Msg, voteOptions *v1.ProposalVoteOptions, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -38,25 +65,9 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	for _, msg := range messages {
 		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
 
-		// perform a basic validation of the message
-		if err := msg.ValidateBasic(); err != nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
-		}
-
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
-		}
-
-		// use the msg service router to see that there is a valid route for that message.
-		handler := keeper.router.Handler(msg)
-		if handler == nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		handler, err := keeper.proposalMsgHandler(ctx, msg)
+		if err != nil {
+			return v1.Proposal{}, err
 		}
 
 		// Only if it's a MsgExecLegacyContent do we try to execute the
@@ -82,12 +93,14 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	}
 
 	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
+	params := keeper.GetParams(ctx)
 
-	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
+	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*params.MaxDepositPeriod), metadata, title, summary, proposer)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.Optimistic = params.IsOptimisticProposal(messages, proposal.Proposer)
+	proposal.VoteOptions = voteOptions
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
@@ -101,12 +114,103 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 			types.EventTypeSubmitProposal,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+			sdk.NewAttribute(types.AttributeKeyOptimistic, strconv.FormatBool(proposal.Optimistic)),
 		),
 	)
 
 	return proposal, nil
 }
 
+// SimulateProposalMsgs executes the given proposal messages the same way the messages of a passed
+// proposal are executed, against a cached context which is never written. It returns the results of
+// the executed messages and the gas they consumed. The execution stops at the first failing message,
+// whose error is returned along with the results of the previous messages. The messages consume at
+// most the MaxSimulationGas of the keeper config, beyond which the simulation fails with out of gas.
+func (keeper Keeper) SimulateProposalMsgs(ctx sdk.Context, messages []sdk.Msg) ([]*sdk.Result, uint64, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(keeper.config.MaxSimulationGas))
+
+	results := make([]*sdk.Result, 0, len(messages))
+	for idx, msg := range messages {
+		handler, err := keeper.proposalMsgHandler(cacheCtx, msg)
+		if err != nil {
+			return results, cacheCtx.GasMeter().GasConsumedToLimit(), sdkerrors.Wrapf(err, "msg %d (%s)", idx, sdk.MsgTypeURL(msg))
+		}
+
+		res, err := safeExecuteHandler(cacheCtx, msg, handler)
+		if err != nil {
+			if cacheCtx.GasMeter().IsOutOfGas() {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "gas limit: %d", cacheCtx.GasMeter().Limit())
+			}
+			return results, cacheCtx.GasMeter().GasConsumedToLimit(), sdkerrors.Wrapf(err, "msg %d (%s) failed on execution", idx, sdk.MsgTypeURL(msg))
+		}
+
+		results = append(results, res)
+	}
+
+	return results, cacheCtx.GasMeter().GasConsumedToLimit(), nil
+}
+
+// CancelProposal cancels a proposal in its deposit or voting period on behalf of its proposer. The
+// deposits are refunded minus the ProposalCancelRatio of them, which is charged with ChargeDeposit,
+// the votes are tallied into the final tally result, and the proposal is kept with the canceled status.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != proposer {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	switch proposal.Status {
+	case v1.StatusDepositPeriod:
+	case v1.StatusVotingPeriod:
+		// the proposal is tallied at the end of the block
+		if !ctx.BlockTime().Before(*proposal.VotingEndTime) {
+			return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "%d", proposalID)
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	params := keeper.GetParams(ctx)
+	charges, err := keeper.ChargeDeposit(ctx, proposalID, params.ProposalCancelDest, params.ProposalCancelRatio)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status == v1.StatusVotingPeriod {
+		_, _, tallyResults := keeper.Tally(ctx, proposal)
+		proposal.FinalTallyResult = &tallyResults
+		keeper.RemoveFromActiveProposalQueue(ctx, proposalID, *proposal.VotingEndTime)
+	} else {
+		keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
+	}
+
+	proposal.Status = v1.StatusCanceled
+	keeper.SetProposal(ctx, proposal)
+
+	keeper.Logger(ctx).Info(
+		"proposal canceled",
+		"proposal", proposalID,
+		"proposer", proposer,
+		"cancel_charge", charges.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer),
+			sdk.NewAttribute(types.AttributeKeyCancelCharge, charges.String()),
+		),
+	)
+
+	return nil
+}
+
 // GetProposal gets a proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool) {
@@ -289,3 +393,42 @@ func (keeper Keeper) UnmarshalProposal(bz []byte, proposal *v1.Proposal) error {
 	}
 	return nil
 }
+
+// proposalMsgHandler performs the stateless checks of a proposal message, asserts that the
+// governance module account is its only signer and returns the handler routing it.
+func (keeper Keeper) proposalMsgHandler(ctx sdk.Context, msg sdk.Msg) (baseapp.MsgServiceHandler, error) {
+	// perform a basic validation of the message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the messages
+	if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
+	}
+
+	// use the msg service router to see that there is a valid route for that message.
+	handler := keeper.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+
+	return handler, nil
+}
+
+// executes handle(msg) and recovers from panic.
+func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler,
+) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handling x/gov proposal msg [%s] PANICKED: %v", msg, r)
+		}
+	}()
+	res, err = handler(ctx, msg)
+	return
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -199,6 +200,30 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSimulateProposalMsgsOutOfGas() {
+	suite.reset()
+	ctx := suite.ctx
+
+	params := v1.DefaultParams()
+	updatedParams := params
+	updatedParams.BurnVoteQuorum = !params.BurnVoteQuorum
+	msgs := []sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct.String(), Params: updatedParams}}
+
+	results, gasUsed, err := suite.govKeeper.SimulateProposalMsgs(ctx, msgs)
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 1)
+	suite.Require().Positive(gasUsed)
+
+	// the simulation fails once the messages exceed the maximum simulation gas
+	maxGas := gasUsed - 1
+	govKeeper := suite.govKeeper.WithMaxSimulationGas(maxGas)
+	results, gasUsed, err = govKeeper.SimulateProposalMsgs(ctx, msgs)
+	suite.Require().ErrorIs(err, sdkerrors.ErrOutOfGas)
+	suite.Require().Empty(results)
+	suite.Require().Equal(maxGas, gasUsed)
+	suite.Require().Equal(params, suite.govKeeper.GetParams(ctx))
+}
+
 func TestMigrateProposalMessages(t *testing.T) {
 	content := v1beta1.NewTextProposal("Test", "description")
 	contentMsg, err := v1.NewLegacyContent(content, sdk.AccAddress("test1").String())
@@ -17,6 +17,11 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	results[v1.OptionAbstain] = math.LegacyZeroDec()
 	results[v1.OptionNo] = math.LegacyZeroDec()
 	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
+	if proposal.IsMultipleChoice() {
+		for _, option := range proposal.VoteOptions.Options() {
+			results[option] = math.LegacyZeroDec()
+		}
+	}
 
 	totalVotingPower := math.LegacyZeroDec()
 	currValidators := make(map[string]v1.ValidatorGovInfo)
@@ -34,17 +39,9 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		return false
 	})
 
-	keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
-		// if validator, just record it in the map
-		voter := sdk.MustAccAddressFromBech32(vote.Voter)
-
-		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			currValidators[valAddrStr] = val
-		}
-
-		// iterate over all delegations from voter, deduct from any delegated-to validators
+	// tallyDelegations iterates over all delegations from voter, deducts them from any delegated-to validators
+	// and tallies their voting power with options
+	tallyDelegations := func(voter sdk.AccAddress, options v1.WeightedVoteOptions) {
 		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
 
@@ -57,7 +54,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
-				for _, option := range vote.Options {
+				for _, option := range options {
 					weight, _ := sdk.NewDecFromStr(option.Weight)
 					subPower := votingPower.Mul(weight)
 					results[option.Option] = results[option.Option].Add(subPower)
@@ -67,11 +64,52 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 
 			return false
 		})
+	}
+
+	// voters who are representatives, in the order of their votes
+	voted := make(map[string]bool)
+	var representatives []v1.Vote
+
+	keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
+		// if validator, just record it in the map
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+
+		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			currValidators[valAddrStr] = val
+		}
+
+		tallyDelegations(voter, vote.Options)
+
+		voted[vote.Voter] = true
+		if keeper.GetRepresentativeDelegatorCount(ctx, voter) > 0 {
+			representatives = append(representatives, vote)
+		}
 
 		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
+	// the delegators of representatives who didn't vote inherit the vote of their representative instead of
+	// the votes of their validators. Representatives which became validators since they were delegated to
+	// are skipped, and at most MaxRepresentativeDelegators delegators of a representative are tallied.
+	for _, vote := range representatives {
+		representative := sdk.MustAccAddressFromBech32(vote.Voter)
+		if keeper.sk.Validator(ctx, sdk.ValAddress(representative)) != nil {
+			continue
+		}
+
+		var tallied uint64
+		keeper.IterateRepresentativeDelegators(ctx, representative, func(delegator sdk.AccAddress) bool {
+			if !voted[delegator.String()] {
+				tallyDelegations(delegator, vote.Options)
+			}
+			tallied++
+			return tallied >= keeper.config.MaxRepresentativeDelegators
+		})
+	}
+
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
 		if len(val.Vote) == 0 {
@@ -90,7 +128,11 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	}
 
 	params := keeper.GetParams(ctx)
-	tallyResults = v1.NewTallyResultFromMap(results)
+	if proposal.IsMultipleChoice() {
+		tallyResults = v1.NewMultipleChoiceTallyResult(proposal.VoteOptions, results)
+	} else {
+		tallyResults = v1.NewTallyResultFromMap(results)
+	}
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
@@ -98,6 +140,18 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		return false, false, tallyResults
 	}
 
+	// An optimistic proposal which is still eligible to the optimistic track, as the optimistic
+	// params may have changed since its submission, passes unless more than the optimistic rejected
+	// threshold of the bonded voting power votes No or NoWithVeto
+	if proposal.Optimistic {
+		msgs, err := proposal.GetMsgs()
+		if err == nil && params.IsOptimisticProposal(msgs, proposal.Proposer) {
+			percentNo := results[v1.OptionNo].Add(results[v1.OptionNoWithVeto]).Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
+			rejectedThreshold, _ := sdk.NewDecFromStr(params.OptimisticRejectedThreshold)
+			return !percentNo.GT(rejectedThreshold), false, tallyResults
+		}
+	}
+
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
 	quorum, _ := sdk.NewDecFromStr(params.Quorum)
@@ -105,6 +159,30 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		return false, params.BurnVoteQuorum, tallyResults
 	}
 
+	// A multiple choice proposal passes with its option which received the most voting power, unless
+	// no option did or several options are tied
+	if proposal.IsMultipleChoice() {
+		winningOption := v1.OptionEmpty
+		winningPower := math.LegacyZeroDec()
+		tied := false
+		for _, option := range proposal.VoteOptions.Options() {
+			power := results[option]
+			switch {
+			case power.GT(winningPower):
+				winningOption, winningPower, tied = option, power, false
+			case power.IsPositive() && power.Equal(winningPower):
+				tied = true
+			}
+		}
+
+		if winningOption == v1.OptionEmpty || tied {
+			return false, false, tallyResults
+		}
+
+		tallyResults.WinningOption = winningOption
+		return true, false, tallyResults
+	}
+
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
 		return false, false, tallyResults
@@ -22,10 +22,24 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return err
 	}
 
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
 	for _, option := range options {
 		if !v1.ValidWeightedVoteOption(*option) {
 			return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
 		}
+
+		// a multiple choice proposal is only voted on with its own options, other proposals with the
+		// standard options
+		if proposal.IsMultipleChoice() && !proposal.VoteOptions.HasOption(option.Option) {
+			return sdkerrors.Wrapf(types.ErrInvalidVote, "%s is not an option of multiple choice proposal %d", option.Option, proposalID)
+		}
+		if !proposal.IsMultipleChoice() && v1.IsMultipleChoiceOption(option.Option) {
+			return sdkerrors.Wrapf(types.ErrInvalidVote, "%s is only valid for multiple choice proposals", option.Option)
+		}
 	}
 
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
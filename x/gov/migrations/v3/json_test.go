@@ -83,6 +83,7 @@ func TestMigrateJSON(t *testing.T) {
 		]
 	},
 	"deposits": [],
+	"gov_delegations": [],
 	"params": null,
 	"proposals": [
 		{
@@ -91,6 +92,8 @@ func TestMigrateJSON(t *testing.T) {
 				"abstain_count": "0",
 				"no_count": "0",
 				"no_with_veto_count": "0",
+				"option_counts": [],
+				"winning_option": "VOTE_OPTION_UNSPECIFIED",
 				"yes_count": "0"
 			},
 			"id": "1",
@@ -106,6 +109,7 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"optimistic": false,
 			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
@@ -117,6 +121,7 @@ func TestMigrateJSON(t *testing.T) {
 					"denom": "stake"
 				}
 			],
+			"vote_options": null,
 			"voting_end_time": "2001-09-09T01:46:40Z",
 			"voting_start_time": "2001-09-09T01:46:40Z"
 		}
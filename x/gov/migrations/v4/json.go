@@ -21,6 +21,11 @@ func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
 		oldState.TallyParams.Threshold,
 		oldState.TallyParams.VetoThreshold,
 		defaultParams.MinInitialDepositRatio,
+		defaultParams.ProposalCancelRatio,
+		defaultParams.ProposalCancelDest,
+		defaultParams.OptimisticMsgTypeUrls,
+		defaultParams.OptimisticAuthorizedAddresses,
+		defaultParams.OptimisticRejectedThreshold,
 		defaultParams.BurnProposalDepositPrevote,
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
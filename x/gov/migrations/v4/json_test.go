@@ -58,6 +58,7 @@ func TestMigrateJSON(t *testing.T) {
 	expected := `{
 	"deposit_params": null,
 	"deposits": [],
+	"gov_delegations": [],
 	"params": {
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
@@ -70,6 +71,11 @@ func TestMigrateJSON(t *testing.T) {
 			}
 		],
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"optimistic_authorized_addresses": [],
+		"optimistic_msg_type_urls": [],
+		"optimistic_rejected_threshold": "0.100000000000000000",
+		"proposal_cancel_dest": "",
+		"proposal_cancel_ratio": "0.500000000000000000",
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000",
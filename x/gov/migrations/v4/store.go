@@ -33,6 +33,11 @@ func migrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace
 		tp.Threshold,
 		tp.VetoThreshold,
 		defaultParams.MinInitialDepositRatio,
+		defaultParams.ProposalCancelRatio,
+		defaultParams.ProposalCancelDest,
+		defaultParams.OptimisticMsgTypeUrls,
+		defaultParams.OptimisticAuthorizedAddresses,
+		defaultParams.OptimisticRejectedThreshold,
 		defaultParams.BurnProposalDepositPrevote,
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
@@ -0,0 +1,40 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v4 (v0.47) to v5. The
+// migration includes:
+//
+// Addition of the new proposal cancel ratio and proposal cancel destination
+// parameters that are set to their default values.
+// Addition of the new optimistic proposal parameters that are set to their
+// default values, leaving the optimistic track disabled.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params govv1.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &params); err != nil {
+		return err
+	}
+
+	defaultParams := govv1.DefaultParams()
+	params.ProposalCancelRatio = defaultParams.ProposalCancelRatio
+	params.ProposalCancelDest = defaultParams.ProposalCancelDest
+	params.OptimisticMsgTypeUrls = defaultParams.OptimisticMsgTypeUrls
+	params.OptimisticAuthorizedAddresses = defaultParams.OptimisticAuthorizedAddresses
+	params.OptimisticRejectedThreshold = defaultParams.OptimisticRejectedThreshold
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v5 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v5"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{}).Codec
+	govKey := sdk.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	// v4 params don't have the proposal cancel and optimistic proposal parameters
+	oldParams := v1.DefaultParams()
+	oldParams.Quorum = "0.5"
+	oldParams.ProposalCancelRatio = ""
+	oldParams.ProposalCancelDest = ""
+	oldParams.OptimisticMsgTypeUrls = nil
+	oldParams.OptimisticAuthorizedAddresses = nil
+	oldParams.OptimisticRejectedThreshold = ""
+	bz, err := cdc.Marshal(&oldParams)
+	require.NoError(t, err)
+	store.Set(v5.ParamsKey, bz)
+
+	// Run migrations.
+	require.NoError(t, v5.MigrateStore(ctx, govKey, cdc))
+
+	// Check params
+	var params v1.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v5.ParamsKey), &params))
+	require.NoError(t, params.ValidateBasic())
+	require.Equal(t, "0.5", params.Quorum)
+	require.Equal(t, v1.DefaultProposalCancelRatio.String(), params.ProposalCancelRatio)
+	require.Equal(t, v1.DefaultProposalCancelDest, params.ProposalCancelDest)
+	require.Empty(t, params.OptimisticMsgTypeUrls)
+	require.Empty(t, params.OptimisticAuthorizedAddresses)
+	require.Equal(t, v1.DefaultOptimisticRejectedThreshold.String(), params.OptimisticRejectedThreshold)
+}
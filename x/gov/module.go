@@ -37,7 +37,7 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-const ConsensusVersion = 4
+const ConsensusVersion = 5
 
 var (
 	_ module.EndBlockAppModule   = AppModule{}
@@ -174,6 +174,7 @@ type GovInputs struct {
 	AccountKeeper govtypes.AccountKeeper
 	BankKeeper    govtypes.BankKeeper
 	StakingKeeper govtypes.StakingKeeper
+	DistrKeeper   govtypes.DistributionKeeper
 
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace govtypes.ParamSubspace `optional:"true"`
@@ -205,6 +206,7 @@ func ProvideModule(in GovInputs) GovOutputs {
 		in.AccountKeeper,
 		in.BankKeeper,
 		in.StakingKeeper,
+		in.DistrKeeper,
 		in.MsgServiceRouter,
 		kConfig,
 		authority.String(),
@@ -294,6 +296,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(fmt.Sprintf("failed to migrate x/gov from version 3 to 4: %v", err))
 	}
+	err = cfg.RegisterMigration(govtypes.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 4 to 5: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -51,6 +52,17 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 		case bytes.Equal(kvA.Key[:1], types.VotingPeriodProposalKeyPrefix):
 			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 
+		case bytes.Equal(kvA.Key[:1], types.GovDelegationsKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.RepresentativeDelegatorsKeyPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.RepresentativesKeyPrefix):
+			countA := binary.BigEndian.Uint64(kvA.Value)
+			countB := binary.BigEndian.Uint64(kvB.Value)
+			return fmt.Sprintf("delegatorCountA: %d\ndelegatorCountB: %d", countA, countB)
+
 		default:
 			panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 		}
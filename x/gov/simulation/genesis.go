@@ -19,13 +19,15 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	DepositMinInitialRatio     = "deposit_params_min_initial_ratio"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit     = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod  = "deposit_params_deposit_period"
+	DepositMinInitialRatio      = "deposit_params_min_initial_ratio"
+	ProposalCancelRate          = "proposal_cancel_rate"
+	VotingParamsVotingPeriod    = "voting_params_voting_period"
+	TallyParamsQuorum           = "tally_params_quorum"
+	TallyParamsThreshold        = "tally_params_threshold"
+	TallyParamsVeto             = "tally_params_veto"
+	OptimisticRejectedThreshold = "optimistic_rejected_threshold"
 )
 
 // GenDepositParamsDepositPeriod returns randomized DepositParamsDepositPeriod
@@ -43,6 +45,11 @@ func GenDepositMinInitialDepositRatio(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(int64(simulation.RandIntBetween(r, 0, 99))).Quo(sdk.NewDec(100))
 }
 
+// GenProposalCancelRate returns randomized ProposalCancelRate
+func GenProposalCancelRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(int64(simulation.RandIntBetween(r, 0, 99))).Quo(sdk.NewDec(100))
+}
+
 // GenVotingParamsVotingPeriod returns randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
@@ -63,6 +70,11 @@ func GenTallyParamsVeto(r *rand.Rand) math.LegacyDec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenOptimisticRejectedThreshold returns randomized OptimisticRejectedThreshold
+func GenOptimisticRejectedThreshold(r *rand.Rand) math.LegacyDec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 50, 500)), 3)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -85,6 +97,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minInitialDepositRatio = GenDepositMinInitialDepositRatio(r) },
 	)
 
+	var proposalCancelRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ProposalCancelRate, &proposalCancelRate, simState.Rand,
+		func(r *rand.Rand) { proposalCancelRate = GenProposalCancelRate(r) },
+	)
+
 	var votingPeriod time.Duration
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, VotingParamsVotingPeriod, &votingPeriod, simState.Rand,
@@ -109,9 +127,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var optimisticRejectedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OptimisticRejectedThreshold, &optimisticRejectedThreshold, simState.Rand,
+		func(r *rand.Rand) { optimisticRejectedThreshold = GenOptimisticRejectedThreshold(r) },
+	)
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewParams(minDeposit, depositPeriod, votingPeriod, quorum.String(), threshold.String(), veto.String(), minInitialDepositRatio.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0),
+		v1.NewParams(minDeposit, depositPeriod, votingPeriod, quorum.String(), threshold.String(), veto.String(), minInitialDepositRatio.String(), proposalCancelRate.String(), "", nil, nil, optimisticRejectedThreshold.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
@@ -4,11 +4,17 @@ package types
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+	// MaxSimulationGas defines the maximum gas consumed by the messages of a proposal simulation.
+	MaxSimulationGas uint64
+	// MaxRepresentativeDelegators defines the maximum number of delegators of a governance vote representative.
+	MaxRepresentativeDelegators uint64
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:              255,
+		MaxSimulationGas:            50_000_000,
+		MaxRepresentativeDelegators: 1000,
 	}
 }
@@ -22,4 +22,9 @@ var (
 	ErrInvalidSignalMsg        = sdkerrors.Register(ModuleName, 14, "signal message is invalid")
 	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 15, "metadata too long")
 	ErrMinDepositTooSmall      = sdkerrors.Register(ModuleName, 16, "minimum deposit is too small")
+	ErrInvalidGovDelegation    = sdkerrors.Register(ModuleName, 17, "invalid governance vote delegation")
+	ErrNoGovDelegation         = sdkerrors.Register(ModuleName, 18, "governance vote delegation not found")
+	ErrInvalidProposer         = sdkerrors.Register(ModuleName, 19, "invalid proposer")
+	ErrVotingPeriodEnded       = sdkerrors.Register(ModuleName, 20, "voting period already ended")
+	ErrInvalidVoteOptions      = sdkerrors.Register(ModuleName, 21, "invalid proposal vote options")
 )
@@ -8,6 +8,9 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeSignalProposal   = "signal_proposal"
+	EventTypeDelegateVote     = "delegate_gov_vote"
+	EventTypeUndelegateVote   = "undelegate_gov_vote"
+	EventTypeCancelProposal   = "cancel_proposal"
 
 	AttributeKeyVoter              = "voter"
 	AttributeKeyProposalResult     = "proposal_result"
@@ -22,4 +25,10 @@ const (
 	AttributeKeyProposalType       = "proposal_type"
 	AttributeSignalTitle           = "signal_title"
 	AttributeSignalDescription     = "signal_description"
+	AttributeKeyDelegator          = "delegator"
+	AttributeKeyRepresentative     = "representative"
+	AttributeKeyProposer           = "proposer"
+	AttributeKeyCancelCharge       = "cancel_charge"
+	AttributeKeyOptimistic         = "optimistic"
+	AttributeKeyWinningOption      = "winning_option"
 )
@@ -25,6 +25,8 @@ type StakingKeeper interface {
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
 	)
+
+	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI // get a particular validator by operator address
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -50,6 +52,11 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// DistributionKeeper defines the expected distribution keeper (noalias)
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // Event Hooks
 // These can be utilized to communicate between a governance keeper and another
 // keepers.
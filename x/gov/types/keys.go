@@ -38,6 +38,12 @@ const (
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
 // - 0x30: Params
+//
+// - 0x40<delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: GovDelegation
+//
+// - 0x41<representativeAddrLen (1 Byte)><representativeAddr_Bytes><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: []byte{0x01}
+//
+// - 0x42<representativeAddrLen (1 Byte)><representativeAddr_Bytes>: delegator count of the representative
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
@@ -51,6 +57,10 @@ var (
 
 	// ParamsKey is the key to query all gov params
 	ParamsKey = []byte{0x30}
+
+	GovDelegationsKeyPrefix           = []byte{0x40}
+	RepresentativeDelegatorsKeyPrefix = []byte{0x41}
+	RepresentativesKeyPrefix          = []byte{0x42}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -117,6 +127,26 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// GovDelegationKey key of the governance vote delegation of a delegator
+func GovDelegationKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(GovDelegationsKeyPrefix, address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// RepresentativeDelegatorsKey gets the first part of the delegators key based on the representative address
+func RepresentativeDelegatorsKey(representativeAddr sdk.AccAddress) []byte {
+	return append(RepresentativeDelegatorsKeyPrefix, address.MustLengthPrefix(representativeAddr.Bytes())...)
+}
+
+// RepresentativeDelegatorKey key of a delegator of a representative
+func RepresentativeDelegatorKey(representativeAddr, delegatorAddr sdk.AccAddress) []byte {
+	return append(RepresentativeDelegatorsKey(representativeAddr), address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// RepresentativeKey key of the delegator count of a representative
+func RepresentativeKey(representativeAddr sdk.AccAddress) []byte {
+	return append(RepresentativesKeyPrefix, address.MustLengthPrefix(representativeAddr.Bytes())...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
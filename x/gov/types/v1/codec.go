@@ -20,6 +20,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateGovVote{}, "cosmos-sdk/v1/MsgDelegateGovVote")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateGovVote{}, "cosmos-sdk/v1/MsgUndelegateGovVote")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelProposal{}, "cosmos-sdk/v1/MsgCancelProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitMultipleChoiceProposal{}, "cosmos-sdk/v1/MsgSubmitMultipleChoice")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -31,6 +35,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
+		&MsgDelegateGovVote{},
+		&MsgUndelegateGovVote{},
+		&MsgCancelProposal{},
+		&MsgSubmitMultipleChoiceProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -7,6 +7,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -97,6 +98,35 @@ func ValidateGenesis(data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate and chained governance vote delegations
+	errGroup.Go(func() error {
+		representatives := make(map[string]string)
+		for _, d := range data.GovDelegations {
+			if _, err := sdk.AccAddressFromBech32(d.DelegatorAddress); err != nil {
+				return fmt.Errorf("invalid delegator address in gov delegation %v: %w", d, err)
+			}
+			if _, err := sdk.AccAddressFromBech32(d.RepresentativeAddress); err != nil {
+				return fmt.Errorf("invalid representative address in gov delegation %v: %w", d, err)
+			}
+			if d.DelegatorAddress == d.RepresentativeAddress {
+				return fmt.Errorf("gov delegation to self: %v", d)
+			}
+			if _, ok := representatives[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate gov delegation: %v", d)
+			}
+
+			representatives[d.DelegatorAddress] = d.RepresentativeAddress
+		}
+
+		for _, d := range data.GovDelegations {
+			if _, ok := representatives[d.RepresentativeAddress]; ok {
+				return fmt.Errorf("representative of gov delegation %v delegated its voting power", d)
+			}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic()
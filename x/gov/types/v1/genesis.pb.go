@@ -45,6 +45,9 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Params *Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty"`
+	// gov_delegations defines all the governance vote delegations present at
+	// genesis.
+	GovDelegations []*GovDelegation `protobuf:"bytes,9,rep,name=gov_delegations,json=govDelegations,proto3" json:"gov_delegations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -139,6 +142,13 @@ func (m *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (m *GenesisState) GetGovDelegations() []*GovDelegation {
+	if m != nil {
+		return m.GovDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -146,30 +156,32 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
-	0x14, 0xc5, 0x19, 0xbe, 0xfe, 0xfc, 0x07, 0x70, 0x31, 0x7e, 0xd0, 0x80, 0x69, 0x88, 0x2b, 0x8c,
-	0xa1, 0x15, 0x8c, 0x0f, 0x20, 0xc1, 0x10, 0x77, 0xa4, 0x1a, 0x17, 0x6e, 0x48, 0xa1, 0x93, 0xda,
-	0x08, 0xdc, 0xa6, 0x77, 0x9c, 0xc8, 0x5b, 0xf8, 0x58, 0x2e, 0xd9, 0xe9, 0xd2, 0xc0, 0x8b, 0x18,
-	0x66, 0x5a, 0xc1, 0xea, 0x6a, 0x92, 0x7b, 0x7e, 0xe7, 0xcc, 0xc9, 0xcd, 0xa5, 0x8d, 0x09, 0xe0,
-	0x0c, 0xd0, 0xf6, 0x41, 0xda, 0xb2, 0x63, 0xfb, 0x7c, 0xce, 0x31, 0x40, 0x2b, 0x8c, 0x40, 0x00,
-	0xab, 0x6a, 0xd1, 0xf2, 0x41, 0x5a, 0xb2, 0x53, 0xaf, 0xa5, 0x58, 0x90, 0x9a, 0x3b, 0x79, 0xcf,
-	0xd1, 0xca, 0x40, 0x3b, 0x6f, 0x85, 0x2b, 0x38, 0x3b, 0xa7, 0x07, 0x28, 0xdc, 0x48, 0x04, 0x73,
-	0x7f, 0x14, 0x46, 0x10, 0x02, 0xba, 0xd3, 0x51, 0xe0, 0x19, 0xa4, 0x49, 0x5a, 0x79, 0x87, 0x25,
-	0xda, 0x30, 0x96, 0x6e, 0x3c, 0xd6, 0xa5, 0x25, 0x8f, 0x87, 0x80, 0x81, 0x40, 0x23, 0xdb, 0xcc,
-	0xb5, 0xca, 0xdd, 0x23, 0xeb, 0xc7, 0xef, 0x56, 0x5f, 0xcb, 0xce, 0x37, 0xc7, 0x4e, 0x69, 0x41,
-	0x82, 0xe0, 0x68, 0xe4, 0x94, 0x61, 0x3f, 0x65, 0xb8, 0x07, 0xc1, 0x1d, 0x4d, 0xb0, 0x4b, 0xfa,
-	0x3f, 0xe9, 0x81, 0x46, 0x5e, 0xe1, 0xb5, 0x14, 0x9e, 0x94, 0x71, 0xb6, 0x24, 0x1b, 0xd0, 0xbd,
-	0xf8, 0xb7, 0x51, 0xe8, 0x46, 0xee, 0x0c, 0x8d, 0x42, 0x93, 0xb4, 0xca, 0xdd, 0xe3, 0xbf, 0xbb,
-	0x0d, 0x15, 0xd3, 0xcb, 0x1a, 0xc4, 0xa9, 0x7a, 0xbb, 0x23, 0xd6, 0xa7, 0x55, 0x09, 0x7a, 0x1d,
-	0x3a, 0xa7, 0xa8, 0x72, 0x1a, 0xbf, 0x2b, 0x6f, 0xd6, 0xb2, 0x8d, 0xa9, 0xc8, 0x9d, 0x09, 0xbb,
-	0xa2, 0x15, 0xe1, 0x4e, 0xa7, 0x8b, 0x24, 0xe4, 0x9f, 0x0a, 0xa9, 0xa7, 0x42, 0xee, 0x36, 0xc8,
-	0x4e, 0x46, 0x59, 0x6c, 0x07, 0xac, 0x4d, 0x8b, 0xb1, 0xb9, 0xa4, 0xcc, 0x87, 0xe9, 0x2d, 0x28,
-	0xd1, 0x89, 0xa1, 0xde, 0xf5, 0xdb, 0xca, 0x24, 0xcb, 0x95, 0x49, 0x3e, 0x57, 0x26, 0x79, 0x5d,
-	0x9b, 0x99, 0xe5, 0xda, 0xcc, 0x7c, 0xac, 0xcd, 0xcc, 0xc3, 0x99, 0x1f, 0x88, 0xc7, 0xe7, 0xb1,
-	0x35, 0x81, 0x99, 0x1d, 0xdf, 0x85, 0x7e, 0xda, 0xe8, 0x3d, 0xd9, 0x2f, 0xea, 0x48, 0xc4, 0x22,
-	0xe4, 0x68, 0xcb, 0xce, 0xb8, 0xa8, 0xee, 0xe4, 0xe2, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xb2,
-	0x17, 0xb2, 0x6e, 0x02, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0xda, 0x40,
+	0x14, 0x85, 0x31, 0x7f, 0x85, 0xe1, 0xa7, 0xd2, 0xf4, 0x07, 0x0b, 0x2a, 0xcb, 0xea, 0x8a, 0xaa,
+	0xc2, 0x2e, 0x54, 0x7d, 0x80, 0x22, 0x10, 0xca, 0x0e, 0x39, 0x51, 0x16, 0xd9, 0x58, 0x06, 0x8f,
+	0x26, 0x56, 0x0c, 0xd7, 0xf2, 0x4c, 0x46, 0xe1, 0x2d, 0xf2, 0x04, 0x79, 0x9e, 0x2c, 0x59, 0x66,
+	0x19, 0xc1, 0x8b, 0x44, 0xcc, 0xd8, 0xe0, 0x38, 0xac, 0x2c, 0xdf, 0xf3, 0x9d, 0x33, 0x57, 0x47,
+	0x17, 0xf5, 0x96, 0xc0, 0x56, 0xc0, 0x6c, 0x0a, 0xc2, 0x16, 0x43, 0x9b, 0x92, 0x35, 0x61, 0x01,
+	0xb3, 0xa2, 0x18, 0x38, 0xe0, 0x96, 0x12, 0x2d, 0x0a, 0xc2, 0x12, 0xc3, 0x6e, 0x27, 0xc7, 0x82,
+	0x50, 0xdc, 0xcf, 0xa7, 0x32, 0x6a, 0xce, 0x94, 0xf3, 0x92, 0x7b, 0x9c, 0xe0, 0x3f, 0xe8, 0x2b,
+	0xe3, 0x5e, 0xcc, 0x83, 0x35, 0x75, 0xa3, 0x18, 0x22, 0x60, 0x5e, 0xe8, 0x06, 0xbe, 0xae, 0x99,
+	0x5a, 0xbf, 0xec, 0xe0, 0x54, 0x9b, 0x27, 0xd2, 0x85, 0x8f, 0x47, 0xa8, 0xe6, 0x93, 0x08, 0x58,
+	0xc0, 0x99, 0x5e, 0x34, 0x4b, 0xfd, 0xc6, 0xe8, 0xbb, 0xf5, 0xee, 0x75, 0x6b, 0xa2, 0x64, 0xe7,
+	0xc8, 0xe1, 0x5f, 0xa8, 0x22, 0x80, 0x13, 0xa6, 0x97, 0xa4, 0xe1, 0x4b, 0xce, 0x70, 0x0d, 0x9c,
+	0x38, 0x8a, 0xc0, 0xff, 0x50, 0x3d, 0xdd, 0x83, 0xe9, 0x65, 0x89, 0x77, 0x72, 0x78, 0xba, 0x8c,
+	0x73, 0x22, 0xf1, 0x0c, 0xb5, 0x93, 0xd7, 0xdc, 0xc8, 0x8b, 0xbd, 0x15, 0xd3, 0x2b, 0xa6, 0xd6,
+	0x6f, 0x8c, 0x7e, 0x9c, 0xdf, 0x6d, 0x2e, 0x99, 0x71, 0x51, 0xd7, 0x9c, 0x96, 0x9f, 0x1d, 0xe1,
+	0x09, 0x6a, 0x09, 0x50, 0x75, 0xa8, 0x9c, 0xaa, 0xcc, 0xe9, 0x7d, 0x5c, 0xf9, 0x50, 0xcb, 0x29,
+	0xa6, 0x29, 0x32, 0x13, 0xfc, 0x1f, 0x35, 0xb9, 0x17, 0x86, 0x9b, 0x34, 0xe4, 0x93, 0x0c, 0xe9,
+	0xe6, 0x42, 0xae, 0x0e, 0x48, 0x26, 0xa3, 0xc1, 0x4f, 0x03, 0x3c, 0x40, 0xd5, 0xc4, 0x5c, 0x93,
+	0xe6, 0x6f, 0xf9, 0x16, 0xa4, 0xe8, 0x24, 0x10, 0x9e, 0xa2, 0xcf, 0x14, 0x84, 0xeb, 0x93, 0x90,
+	0x50, 0x8f, 0x07, 0xb0, 0x66, 0x7a, 0xdd, 0x2c, 0x9d, 0x69, 0x60, 0x06, 0x62, 0x72, 0x84, 0x9c,
+	0x36, 0xcd, 0xfe, 0xb2, 0xf1, 0xf4, 0x79, 0x67, 0x68, 0xdb, 0x9d, 0xa1, 0xbd, 0xee, 0x0c, 0xed,
+	0x71, 0x6f, 0x14, 0xb6, 0x7b, 0xa3, 0xf0, 0xb2, 0x37, 0x0a, 0x37, 0xbf, 0x69, 0xc0, 0x6f, 0xef,
+	0x17, 0xd6, 0x12, 0x56, 0x76, 0x72, 0x5e, 0xea, 0x33, 0x60, 0xfe, 0x9d, 0xfd, 0x20, 0x6f, 0x8d,
+	0x6f, 0x22, 0xc2, 0x6c, 0x31, 0x5c, 0x54, 0xe5, 0xb9, 0xfd, 0x7d, 0x1b, 0x00, 0x2d, 0xb7, 0x60,
+	0xe2, 0xb5, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -192,6 +204,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovDelegations) > 0 {
+		for iNdEx := len(m.GovDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -344,6 +370,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Params.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.GovDelegations) > 0 {
+		for _, e := range m.GovDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -647,6 +679,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovDelegations = append(m.GovDelegations, &GovDelegation{})
+			if err := m.GovDelegations[len(m.GovDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -18,6 +18,7 @@ func TestEmptyGenesis(t *testing.T) {
 
 func TestValidateGenesis(t *testing.T) {
 	params := v1.DefaultParams()
+	addrs := []sdk.AccAddress{sdk.AccAddress("addr1_______________"), sdk.AccAddress("addr2_______________"), sdk.AccAddress("addr3_______________")}
 
 	testCases := []struct {
 		name         string
@@ -167,6 +168,56 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid gov delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovDelegations = []*v1.GovDelegation{
+					{DelegatorAddress: addrs[0].String(), RepresentativeAddress: addrs[2].String()},
+					{DelegatorAddress: addrs[1].String(), RepresentativeAddress: addrs[2].String()},
+				}
+
+				return state
+			},
+		},
+		{
+			name: "duplicate gov delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovDelegations = []*v1.GovDelegation{
+					{DelegatorAddress: addrs[0].String(), RepresentativeAddress: addrs[1].String()},
+					{DelegatorAddress: addrs[0].String(), RepresentativeAddress: addrs[2].String()},
+				}
+
+				return state
+			},
+			expErrMsg: "duplicate gov delegation",
+		},
+		{
+			name: "chained gov delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovDelegations = []*v1.GovDelegation{
+					{DelegatorAddress: addrs[0].String(), RepresentativeAddress: addrs[1].String()},
+					{DelegatorAddress: addrs[1].String(), RepresentativeAddress: addrs[2].String()},
+				}
+
+				return state
+			},
+			expErrMsg: "delegated its voting power",
+		},
+		{
+			name: "gov delegation to self",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovDelegations = []*v1.GovDelegation{
+					{DelegatorAddress: addrs[0].String(), RepresentativeAddress: addrs[0].String()},
+				}
+
+				return state
+			},
+			expErrMsg: "gov delegation to self",
+		},
 	}
 
 	for _, tc := range testCases {
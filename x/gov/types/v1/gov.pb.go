@@ -46,6 +46,14 @@ const (
 	VoteOption_VOTE_OPTION_NO VoteOption = 3
 	// VOTE_OPTION_NO_WITH_VETO defines a no with veto vote option.
 	VoteOption_VOTE_OPTION_NO_WITH_VETO VoteOption = 4
+	// VOTE_OPTION_ONE defines the first option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_ONE VoteOption = 5
+	// VOTE_OPTION_TWO defines the second option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_TWO VoteOption = 6
+	// VOTE_OPTION_THREE defines the third option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_THREE VoteOption = 7
+	// VOTE_OPTION_FOUR defines the fourth option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_FOUR VoteOption = 8
 )
 
 var VoteOption_name = map[int32]string{
@@ -54,6 +62,10 @@ var VoteOption_name = map[int32]string{
 	2: "VOTE_OPTION_ABSTAIN",
 	3: "VOTE_OPTION_NO",
 	4: "VOTE_OPTION_NO_WITH_VETO",
+	5: "VOTE_OPTION_ONE",
+	6: "VOTE_OPTION_TWO",
+	7: "VOTE_OPTION_THREE",
+	8: "VOTE_OPTION_FOUR",
 }
 
 var VoteOption_value = map[string]int32{
@@ -62,6 +74,10 @@ var VoteOption_value = map[string]int32{
 	"VOTE_OPTION_ABSTAIN":      2,
 	"VOTE_OPTION_NO":           3,
 	"VOTE_OPTION_NO_WITH_VETO": 4,
+	"VOTE_OPTION_ONE":          5,
+	"VOTE_OPTION_TWO":          6,
+	"VOTE_OPTION_THREE":        7,
+	"VOTE_OPTION_FOUR":         8,
 }
 
 func (x VoteOption) String() string {
@@ -93,6 +109,9 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_CANCELED defines a proposal status of a proposal that has
+	// been canceled by its proposer.
+	ProposalStatus_PROPOSAL_STATUS_CANCELED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -102,6 +121,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_CANCELED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -111,6 +131,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_CANCELED":       6,
 }
 
 func (x ProposalStatus) String() string {
@@ -277,6 +298,14 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Proposer string `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// optimistic defines whether the proposal is on the optimistic track, i.e. it
+	// passes at the end of its voting period unless the No votes exceed the
+	// optimistic rejected threshold.
+	Optimistic bool `protobuf:"varint,14,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
+	// vote_options defines the named options of a multiple choice proposal. A
+	// multiple choice proposal has no messages and is voted on with
+	// VOTE_OPTION_ONE to VOTE_OPTION_FOUR. It is nil for other proposals.
+	VoteOptions *ProposalVoteOptions `protobuf:"bytes,15,opt,name=vote_options,json=voteOptions,proto3" json:"vote_options,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -403,6 +432,94 @@ func (m *Proposal) GetProposer() string {
 	return ""
 }
 
+func (m *Proposal) GetOptimistic() bool {
+	if m != nil {
+		return m.Optimistic
+	}
+	return false
+}
+
+func (m *Proposal) GetVoteOptions() *ProposalVoteOptions {
+	if m != nil {
+		return m.VoteOptions
+	}
+	return nil
+}
+
+// ProposalVoteOptions defines the named options of a multiple choice proposal.
+// The first two options are required, the following ones are optional.
+type ProposalVoteOptions struct {
+	// option_one is the name of the option voted on with VOTE_OPTION_ONE.
+	OptionOne string `protobuf:"bytes,1,opt,name=option_one,json=optionOne,proto3" json:"option_one,omitempty"`
+	// option_two is the name of the option voted on with VOTE_OPTION_TWO.
+	OptionTwo string `protobuf:"bytes,2,opt,name=option_two,json=optionTwo,proto3" json:"option_two,omitempty"`
+	// option_three is the name of the option voted on with VOTE_OPTION_THREE.
+	OptionThree string `protobuf:"bytes,3,opt,name=option_three,json=optionThree,proto3" json:"option_three,omitempty"`
+	// option_four is the name of the option voted on with VOTE_OPTION_FOUR.
+	OptionFour string `protobuf:"bytes,4,opt,name=option_four,json=optionFour,proto3" json:"option_four,omitempty"`
+}
+
+func (m *ProposalVoteOptions) Reset()         { *m = ProposalVoteOptions{} }
+func (m *ProposalVoteOptions) String() string { return proto.CompactTextString(m) }
+func (*ProposalVoteOptions) ProtoMessage()    {}
+func (*ProposalVoteOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
+}
+func (m *ProposalVoteOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalVoteOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalVoteOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalVoteOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalVoteOptions.Merge(m, src)
+}
+func (m *ProposalVoteOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalVoteOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalVoteOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalVoteOptions proto.InternalMessageInfo
+
+func (m *ProposalVoteOptions) GetOptionOne() string {
+	if m != nil {
+		return m.OptionOne
+	}
+	return ""
+}
+
+func (m *ProposalVoteOptions) GetOptionTwo() string {
+	if m != nil {
+		return m.OptionTwo
+	}
+	return ""
+}
+
+func (m *ProposalVoteOptions) GetOptionThree() string {
+	if m != nil {
+		return m.OptionThree
+	}
+	return ""
+}
+
+func (m *ProposalVoteOptions) GetOptionFour() string {
+	if m != nil {
+		return m.OptionFour
+	}
+	return ""
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -413,13 +530,20 @@ type TallyResult struct {
 	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
 	// no_with_veto_count is the number of no with veto votes on a proposal.
 	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+	// winning_option is the option with the most voting power of a multiple
+	// choice proposal, VOTE_OPTION_UNSPECIFIED for other proposals or if there
+	// is no single such option.
+	WinningOption VoteOption `protobuf:"varint,5,opt,name=winning_option,json=winningOption,proto3,enum=cosmos.gov.v1.VoteOption" json:"winning_option,omitempty"`
+	// option_counts is the number of votes on each option of a multiple choice
+	// proposal, in the order of its options. It is empty for other proposals.
+	OptionCounts []string `protobuf:"bytes,6,rep,name=option_counts,json=optionCounts,proto3" json:"option_counts,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{3}
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,6 +600,20 @@ func (m *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
+func (m *TallyResult) GetWinningOption() VoteOption {
+	if m != nil {
+		return m.WinningOption
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *TallyResult) GetOptionCounts() []string {
+	if m != nil {
+		return m.OptionCounts
+	}
+	return nil
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -493,7 +631,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,6 +688,138 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
+// GovDelegation defines the delegation of the governance voting power of a
+// delegator to a representative.
+type GovDelegation struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// representative_address is the address of the representative voting on
+	// behalf of the delegator.
+	RepresentativeAddress string `protobuf:"bytes,2,opt,name=representative_address,json=representativeAddress,proto3" json:"representative_address,omitempty"`
+}
+
+func (m *GovDelegation) Reset()         { *m = GovDelegation{} }
+func (m *GovDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovDelegation) ProtoMessage()    {}
+func (*GovDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
+}
+func (m *GovDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovDelegation.Merge(m, src)
+}
+func (m *GovDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovDelegation proto.InternalMessageInfo
+
+func (m *GovDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovDelegation) GetRepresentativeAddress() string {
+	if m != nil {
+		return m.RepresentativeAddress
+	}
+	return ""
+}
+
+// Representative defines an account voting with the governance voting power
+// delegated to it.
+type Representative struct {
+	// address is the address of the representative.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// delegator_count is the number of delegators of the representative.
+	DelegatorCount uint64 `protobuf:"varint,2,opt,name=delegator_count,json=delegatorCount,proto3" json:"delegator_count,omitempty"`
+	// delegated_power is the voting power delegated to the representative.
+	DelegatedPower string `protobuf:"bytes,3,opt,name=delegated_power,json=delegatedPower,proto3" json:"delegated_power,omitempty"`
+	// total_power is the voting power of the representative, including its own
+	// voting power.
+	TotalPower string `protobuf:"bytes,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *Representative) Reset()         { *m = Representative{} }
+func (m *Representative) String() string { return proto.CompactTextString(m) }
+func (*Representative) ProtoMessage()    {}
+func (*Representative) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
+}
+func (m *Representative) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Representative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Representative.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Representative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Representative.Merge(m, src)
+}
+func (m *Representative) XXX_Size() int {
+	return m.Size()
+}
+func (m *Representative) XXX_DiscardUnknown() {
+	xxx_messageInfo_Representative.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Representative proto.InternalMessageInfo
+
+func (m *Representative) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Representative) GetDelegatorCount() uint64 {
+	if m != nil {
+		return m.DelegatorCount
+	}
+	return 0
+}
+
+func (m *Representative) GetDelegatedPower() string {
+	if m != nil {
+		return m.DelegatedPower
+	}
+	return ""
+}
+
+func (m *Representative) GetTotalPower() string {
+	if m != nil {
+		return m.TotalPower
+	}
+	return ""
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 type DepositParams struct {
 	// Minimum deposit for a proposal to enter voting period.
@@ -563,7 +833,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +886,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,7 +938,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -729,29 +999,44 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
+	// The ratio of the deposits of a canceled proposal which is not refunded to
+	// the depositors.
+	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
+	// The address receiving the ratio of the deposits of a canceled proposal
+	// which is not refunded. If empty, the charged deposits are burned.
+	ProposalCancelDest string `protobuf:"bytes,9,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
 	// burn deposits if the proposal does not enter voting period
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// The message type URLs allowed in optimistic proposals. A proposal submitted
+	// by an optimistic authorized address whose messages all have one of these
+	// type URLs is on the optimistic track.
+	OptimisticMsgTypeUrls []string `protobuf:"bytes,16,rep,name=optimistic_msg_type_urls,json=optimisticMsgTypeUrls,proto3" json:"optimistic_msg_type_urls,omitempty"`
+	// The addresses allowed to submit optimistic proposals.
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,17,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
+	// Minimum proportion of No votes, including NoWithVeto votes, to the total
+	// bonded voting power for an optimistic proposal to be rejected.
+	OptimisticRejectedThreshold string `protobuf:"bytes,18,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -829,6 +1114,20 @@ func (m *Params) GetMinInitialDepositRatio() string {
 	return ""
 }
 
+func (m *Params) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
+}
+
+func (m *Params) GetProposalCancelDest() string {
+	if m != nil {
+		return m.ProposalCancelDest
+	}
+	return ""
+}
+
 func (m *Params) GetBurnVoteQuorum() bool {
 	if m != nil {
 		return m.BurnVoteQuorum
@@ -850,14 +1149,38 @@ func (m *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (m *Params) GetOptimisticMsgTypeUrls() []string {
+	if m != nil {
+		return m.OptimisticMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticAuthorizedAddresses() []string {
+	if m != nil {
+		return m.OptimisticAuthorizedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticRejectedThreshold() string {
+	if m != nil {
+		return m.OptimisticRejectedThreshold
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
+	proto.RegisterType((*ProposalVoteOptions)(nil), "cosmos.gov.v1.ProposalVoteOptions")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
+	proto.RegisterType((*GovDelegation)(nil), "cosmos.gov.v1.GovDelegation")
+	proto.RegisterType((*Representative)(nil), "cosmos.gov.v1.Representative")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
@@ -867,87 +1190,114 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x73, 0xd3, 0xc6,
-	0x17, 0x8f, 0x6c, 0xd9, 0x71, 0x9e, 0x63, 0x47, 0x2c, 0xf9, 0x82, 0x12, 0x88, 0x1d, 0x3c, 0x0c,
-	0x93, 0x2f, 0x3f, 0xec, 0x6f, 0xe0, 0x4b, 0x2f, 0xf4, 0xe2, 0xc4, 0xa2, 0x88, 0xa1, 0xb1, 0x2b,
-	0x8b, 0x30, 0xf4, 0xa2, 0x91, 0xa3, 0xc5, 0xd9, 0xa9, 0xa5, 0x75, 0xa5, 0xb5, 0xc1, 0x7f, 0x42,
-	0x6f, 0x1c, 0x3b, 0x3d, 0xf5, 0xd8, 0x63, 0x0f, 0x4c, 0xef, 0xbd, 0x71, 0x6a, 0x19, 0x2e, 0x6d,
-	0x2f, 0xb4, 0x03, 0x87, 0xce, 0xf0, 0x57, 0x74, 0x76, 0xb5, 0xb2, 0x1d, 0xc7, 0x9d, 0x04, 0x2e,
-	0xb1, 0xf4, 0xde, 0xe7, 0xf3, 0xde, 0xdb, 0xf7, 0x6b, 0x15, 0x38, 0x7f, 0x40, 0x23, 0x9f, 0x46,
-	0xb5, 0x2e, 0x1d, 0xd6, 0x86, 0xdb, 0xfc, 0xa7, 0xda, 0x0f, 0x29, 0xa3, 0xa8, 0x10, 0x2b, 0xaa,
-	0x5c, 0x32, 0xdc, 0x5e, 0x2f, 0x49, 0x5c, 0xc7, 0x8d, 0x70, 0x6d, 0xb8, 0xdd, 0xc1, 0xcc, 0xdd,
-	0xae, 0x1d, 0x50, 0x12, 0xc4, 0xf0, 0xf5, 0xd5, 0x2e, 0xed, 0x52, 0xf1, 0x58, 0xe3, 0x4f, 0x52,
-	0x5a, 0xee, 0x52, 0xda, 0xed, 0xe1, 0x9a, 0x78, 0xeb, 0x0c, 0x9e, 0xd4, 0x18, 0xf1, 0x71, 0xc4,
-	0x5c, 0xbf, 0x2f, 0x01, 0x6b, 0xb3, 0x00, 0x37, 0x18, 0x49, 0x55, 0x69, 0x56, 0xe5, 0x0d, 0x42,
-	0x97, 0x11, 0x9a, 0x78, 0x5c, 0x8b, 0x23, 0x72, 0x62, 0xa7, 0x32, 0xda, 0x58, 0x75, 0xc6, 0xf5,
-	0x49, 0x40, 0x6b, 0xe2, 0x6f, 0x2c, 0xaa, 0x50, 0x40, 0x8f, 0x30, 0xe9, 0x1e, 0x32, 0xec, 0xed,
-	0x53, 0x86, 0x9b, 0x7d, 0x6e, 0x09, 0x6d, 0x43, 0x96, 0x8a, 0x27, 0x5d, 0xd9, 0x54, 0xb6, 0x8a,
-	0x37, 0xd7, 0xaa, 0x47, 0x4e, 0x5d, 0x9d, 0x40, 0x2d, 0x09, 0x44, 0x57, 0x20, 0xfb, 0x54, 0x18,
-	0xd2, 0x53, 0x9b, 0xca, 0xd6, 0xd2, 0x4e, 0xf1, 0xf5, 0x8b, 0x1b, 0x20, 0x59, 0x0d, 0x7c, 0x60,
-	0x49, 0x6d, 0xe5, 0x7b, 0x05, 0x16, 0x1b, 0xb8, 0x4f, 0x23, 0xc2, 0x50, 0x19, 0xf2, 0xfd, 0x90,
-	0xf6, 0x69, 0xe4, 0xf6, 0x1c, 0xe2, 0x09, 0x5f, 0xaa, 0x05, 0x89, 0xc8, 0xf4, 0xd0, 0x27, 0xb0,
-	0xe4, 0xc5, 0x58, 0x1a, 0x4a, 0xbb, 0xfa, 0xeb, 0x17, 0x37, 0x56, 0xa5, 0xdd, 0xba, 0xe7, 0x85,
-	0x38, 0x8a, 0xda, 0x2c, 0x24, 0x41, 0xd7, 0x9a, 0x40, 0xd1, 0xa7, 0x90, 0x75, 0x7d, 0x3a, 0x08,
-	0x98, 0x9e, 0xde, 0x4c, 0x6f, 0xe5, 0x27, 0xf1, 0xf3, 0x32, 0x55, 0x65, 0x99, 0xaa, 0xbb, 0x94,
-	0x04, 0x3b, 0x4b, 0x2f, 0xdf, 0x94, 0x17, 0x7e, 0xf8, 0xfb, 0xc7, 0xab, 0x8a, 0x25, 0x39, 0x95,
-	0x9f, 0x33, 0x90, 0x6b, 0xc9, 0x20, 0x50, 0x11, 0x52, 0xe3, 0xd0, 0x52, 0xc4, 0x43, 0xff, 0x83,
-	0x9c, 0x8f, 0xa3, 0xc8, 0xed, 0xe2, 0x48, 0x4f, 0x09, 0xe3, 0xab, 0xd5, 0xb8, 0x22, 0xd5, 0xa4,
-	0x22, 0xd5, 0x7a, 0x30, 0xb2, 0xc6, 0x28, 0x74, 0x1b, 0xb2, 0x11, 0x73, 0xd9, 0x20, 0xd2, 0xd3,
-	0x22, 0x99, 0x1b, 0x33, 0xc9, 0x4c, 0x5c, 0xb5, 0x05, 0xc8, 0x92, 0x60, 0x74, 0x0f, 0xd0, 0x13,
-	0x12, 0xb8, 0x3d, 0x87, 0xb9, 0xbd, 0xde, 0xc8, 0x09, 0x71, 0x34, 0xe8, 0x31, 0x5d, 0xdd, 0x54,
-	0xb6, 0xf2, 0x37, 0xd7, 0x67, 0x4c, 0xd8, 0x1c, 0x62, 0x09, 0x84, 0xa5, 0x09, 0xd6, 0x94, 0x04,
-	0xd5, 0x21, 0x1f, 0x0d, 0x3a, 0x3e, 0x61, 0x0e, 0x6f, 0x33, 0x3d, 0x23, 0x4d, 0xcc, 0x46, 0x6d,
-	0x27, 0x3d, 0xb8, 0xa3, 0x3e, 0xff, 0xb3, 0xac, 0x58, 0x10, 0x93, 0xb8, 0x18, 0xdd, 0x07, 0x4d,
-	0x66, 0xd7, 0xc1, 0x81, 0x17, 0xdb, 0xc9, 0x9e, 0xd2, 0x4e, 0x51, 0x32, 0x8d, 0xc0, 0x13, 0xb6,
-	0x4c, 0x28, 0x30, 0xca, 0xdc, 0x9e, 0x23, 0xe5, 0xfa, 0xe2, 0x07, 0xd4, 0x68, 0x59, 0x50, 0x93,
-	0x06, 0x7a, 0x00, 0x67, 0x86, 0x94, 0x91, 0xa0, 0xeb, 0x44, 0xcc, 0x0d, 0xe5, 0xf9, 0x72, 0xa7,
-	0x8c, 0x6b, 0x25, 0xa6, 0xb6, 0x39, 0x53, 0x04, 0x76, 0x0f, 0xa4, 0x68, 0x72, 0xc6, 0xa5, 0x53,
-	0xda, 0x2a, 0xc4, 0xc4, 0xe4, 0x88, 0xeb, 0xbc, 0x49, 0x98, 0xeb, 0xb9, 0xcc, 0xd5, 0x81, 0xb7,
-	0xad, 0x35, 0x7e, 0x47, 0xab, 0x90, 0x61, 0x84, 0xf5, 0xb0, 0x9e, 0x17, 0x8a, 0xf8, 0x05, 0xe9,
-	0xb0, 0x18, 0x0d, 0x7c, 0xdf, 0x0d, 0x47, 0xfa, 0xb2, 0x90, 0x27, 0xaf, 0xe8, 0xff, 0x90, 0x8b,
-	0x27, 0x02, 0x87, 0x7a, 0xe1, 0x84, 0x11, 0x18, 0x23, 0x2b, 0xbf, 0x29, 0x90, 0x9f, 0xee, 0x81,
-	0x6b, 0xb0, 0x34, 0xc2, 0x91, 0x73, 0x20, 0x86, 0x42, 0x39, 0x36, 0xa1, 0x66, 0xc0, 0xac, 0xdc,
-	0x08, 0x47, 0xbb, 0x5c, 0x8f, 0x6e, 0x41, 0xc1, 0xed, 0x44, 0xcc, 0x25, 0x81, 0x24, 0xa4, 0xe6,
-	0x12, 0x96, 0x25, 0x28, 0x26, 0xfd, 0x17, 0x72, 0x01, 0x95, 0xf8, 0xf4, 0x5c, 0xfc, 0x62, 0x40,
-	0x63, 0xe8, 0x1d, 0x40, 0x01, 0x75, 0x9e, 0x12, 0x76, 0xe8, 0x0c, 0x31, 0x4b, 0x48, 0xea, 0x5c,
-	0xd2, 0x4a, 0x40, 0x1f, 0x11, 0x76, 0xb8, 0x8f, 0x59, 0x4c, 0xae, 0xfc, 0xa4, 0x80, 0xca, 0xf7,
-	0xcf, 0xc9, 0xdb, 0xa3, 0x0a, 0x99, 0x21, 0x65, 0xf8, 0xe4, 0xcd, 0x11, 0xc3, 0xd0, 0x1d, 0x58,
-	0x8c, 0x97, 0x59, 0xa4, 0xab, 0xa2, 0x25, 0x2f, 0xcd, 0x8c, 0xd9, 0xf1, 0x4d, 0x69, 0x25, 0x8c,
-	0x23, 0x25, 0xcf, 0x1c, 0x2d, 0xf9, 0x7d, 0x35, 0x97, 0xd6, 0xd4, 0xca, 0x1f, 0x0a, 0x14, 0x64,
-	0xe3, 0xb6, 0xdc, 0xd0, 0xf5, 0x23, 0xf4, 0x18, 0xf2, 0x3e, 0x09, 0xc6, 0x73, 0xa0, 0x9c, 0x34,
-	0x07, 0x1b, 0x7c, 0x0e, 0xde, 0xbf, 0x29, 0xff, 0x67, 0x8a, 0x75, 0x9d, 0xfa, 0x84, 0x61, 0xbf,
-	0xcf, 0x46, 0x16, 0xf8, 0x24, 0x48, 0x26, 0xc3, 0x07, 0xe4, 0xbb, 0xcf, 0x12, 0x90, 0xd3, 0xc7,
-	0x21, 0xa1, 0x9e, 0x48, 0x04, 0xf7, 0x30, 0xdb, 0xce, 0x0d, 0x79, 0x85, 0xec, 0x5c, 0x7e, 0xff,
-	0xa6, 0x7c, 0xf1, 0x38, 0x71, 0xe2, 0xe4, 0x5b, 0xde, 0xed, 0x9a, 0xef, 0x3e, 0x4b, 0x4e, 0x22,
-	0xf4, 0x15, 0x1b, 0x96, 0xf7, 0xc5, 0x04, 0xc8, 0x93, 0x35, 0x40, 0x4e, 0x44, 0xe2, 0x59, 0x39,
-	0xc9, 0xb3, 0x2a, 0x2c, 0x2f, 0xc7, 0x2c, 0x69, 0xf5, 0xbb, 0xa4, 0x89, 0xa5, 0xd5, 0x2b, 0x90,
-	0xfd, 0x7a, 0x40, 0xc3, 0x81, 0x3f, 0xa7, 0x83, 0xc5, 0x1d, 0x13, 0x6b, 0xd1, 0x75, 0x58, 0x62,
-	0x87, 0x21, 0x8e, 0x0e, 0x69, 0xcf, 0xfb, 0x97, 0xeb, 0x68, 0x02, 0x40, 0xb7, 0xa1, 0x28, 0xba,
-	0x70, 0x42, 0x49, 0xcf, 0xa5, 0x14, 0x38, 0xca, 0x4e, 0x40, 0x95, 0x5f, 0x55, 0xc8, 0xca, 0xb8,
-	0x8c, 0x0f, 0xac, 0xe3, 0xd4, 0x3e, 0x9b, 0xae, 0xd9, 0xe7, 0x1f, 0x57, 0x33, 0x75, 0x7e, 0x4d,
-	0x8e, 0xd7, 0x20, 0xfd, 0x11, 0x35, 0x98, 0xca, 0xb9, 0x7a, 0xfa, 0x9c, 0x67, 0x3e, 0x3c, 0xe7,
-	0xd9, 0x53, 0xe4, 0x1c, 0x99, 0xb0, 0xc6, 0x13, 0x4d, 0x02, 0xc2, 0xc8, 0xe4, 0x02, 0x71, 0x44,
-	0xf8, 0xfa, 0xe2, 0x5c, 0x0b, 0xe7, 0x7c, 0x12, 0x98, 0x31, 0x5e, 0xa6, 0xc7, 0xe2, 0x68, 0xb4,
-	0x05, 0x5a, 0x67, 0x10, 0x06, 0x0e, 0x1f, 0x7d, 0x47, 0x9e, 0x90, 0xaf, 0xd7, 0x9c, 0x55, 0xe4,
-	0x72, 0x3e, 0xe2, 0x5f, 0xc4, 0x27, 0xab, 0xc3, 0x86, 0x40, 0x8e, 0x97, 0xcd, 0xb8, 0x40, 0x21,
-	0xe6, 0x6c, 0xbd, 0x28, 0x68, 0xeb, 0x1c, 0x94, 0xdc, 0xe5, 0x49, 0x25, 0x62, 0x04, 0xba, 0x0c,
-	0xc5, 0x89, 0x33, 0x7e, 0x24, 0x7d, 0x45, 0x70, 0x96, 0x13, 0x57, 0x7c, 0xbd, 0x5d, 0xfd, 0x46,
-	0x01, 0x98, 0xfa, 0x08, 0xbb, 0x00, 0xe7, 0xf7, 0x9b, 0xb6, 0xe1, 0x34, 0x5b, 0xb6, 0xd9, 0xdc,
-	0x73, 0x1e, 0xee, 0xb5, 0x5b, 0xc6, 0xae, 0x79, 0xd7, 0x34, 0x1a, 0xda, 0x02, 0x3a, 0x0b, 0x2b,
-	0xd3, 0xca, 0xc7, 0x46, 0x5b, 0x53, 0xd0, 0x79, 0x38, 0x3b, 0x2d, 0xac, 0xef, 0xb4, 0xed, 0xba,
-	0xb9, 0xa7, 0xa5, 0x10, 0x82, 0xe2, 0xb4, 0x62, 0xaf, 0xa9, 0xa5, 0xd1, 0x45, 0xd0, 0x8f, 0xca,
-	0x9c, 0x47, 0xa6, 0x7d, 0xcf, 0xd9, 0x37, 0xec, 0xa6, 0xa6, 0x5e, 0xfd, 0x45, 0x81, 0xe2, 0xd1,
-	0x0f, 0x13, 0x54, 0x86, 0x0b, 0x2d, 0xab, 0xd9, 0x6a, 0xb6, 0xeb, 0x0f, 0x9c, 0xb6, 0x5d, 0xb7,
-	0x1f, 0xb6, 0x67, 0x62, 0xaa, 0x40, 0x69, 0x16, 0xd0, 0x30, 0x5a, 0xcd, 0xb6, 0x69, 0x3b, 0x2d,
-	0xc3, 0x32, 0x9b, 0x0d, 0x4d, 0x41, 0x97, 0x60, 0x63, 0x16, 0xb3, 0xdf, 0xb4, 0xcd, 0xbd, 0xcf,
-	0x12, 0x48, 0x0a, 0xad, 0xc3, 0xb9, 0x59, 0x48, 0xab, 0xde, 0x6e, 0x1b, 0x8d, 0x38, 0xe8, 0x59,
-	0x9d, 0x65, 0xdc, 0x37, 0x76, 0x6d, 0xa3, 0xa1, 0xa9, 0xf3, 0x98, 0x77, 0xeb, 0xe6, 0x03, 0xa3,
-	0xa1, 0x65, 0x76, 0x8c, 0x97, 0x6f, 0x4b, 0xca, 0xab, 0xb7, 0x25, 0xe5, 0xaf, 0xb7, 0x25, 0xe5,
-	0xf9, 0xbb, 0xd2, 0xc2, 0xab, 0x77, 0xa5, 0x85, 0xdf, 0xdf, 0x95, 0x16, 0xbe, 0xbc, 0xd6, 0x25,
-	0xec, 0x70, 0xd0, 0xa9, 0x1e, 0x50, 0x5f, 0x7e, 0x2e, 0xcb, 0x9f, 0x1b, 0x91, 0xf7, 0x55, 0xed,
-	0x99, 0xf8, 0x17, 0x80, 0x8d, 0xfa, 0x38, 0xe2, 0xdf, 0xf7, 0x59, 0x31, 0x35, 0xb7, 0xfe, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0xbc, 0x24, 0x6f, 0xb5, 0x20, 0x0c, 0x00, 0x00,
+	// 1702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x73, 0xdb, 0xc6,
+	0x15, 0x17, 0x48, 0x8a, 0x7f, 0x1e, 0x45, 0x0a, 0x5a, 0x4b, 0x36, 0x2c, 0x47, 0x94, 0xcc, 0xc9,
+	0xa4, 0xaa, 0x13, 0x93, 0x95, 0xd3, 0x34, 0x87, 0xf4, 0x50, 0x4a, 0x84, 0x63, 0x7a, 0x1c, 0x91,
+	0x05, 0x61, 0x79, 0xd2, 0x0b, 0x0a, 0x11, 0x6b, 0x0a, 0x2d, 0x81, 0x65, 0xb1, 0x4b, 0xca, 0xec,
+	0xa7, 0xc8, 0xad, 0x9d, 0x9e, 0x7a, 0x6b, 0x8e, 0x3d, 0x64, 0xfa, 0x19, 0x72, 0xca, 0x64, 0x72,
+	0x69, 0x7b, 0x71, 0x33, 0xf6, 0xa1, 0x33, 0x99, 0x7e, 0x88, 0xce, 0xfe, 0x01, 0x41, 0xd2, 0x74,
+	0x28, 0xfb, 0x22, 0x71, 0xdf, 0xfb, 0xfd, 0xde, 0xbf, 0x7d, 0xfb, 0x76, 0x49, 0xb8, 0xd1, 0x23,
+	0x34, 0x20, 0xb4, 0xde, 0x27, 0xe3, 0xfa, 0xf8, 0x88, 0xff, 0xab, 0x0d, 0x23, 0xc2, 0x08, 0x2a,
+	0x49, 0x45, 0x8d, 0x4b, 0xc6, 0x47, 0xbb, 0x15, 0x85, 0x3b, 0x77, 0x29, 0xae, 0x8f, 0x8f, 0xce,
+	0x31, 0x73, 0x8f, 0xea, 0x3d, 0xe2, 0x87, 0x12, 0xbe, 0xbb, 0xdd, 0x27, 0x7d, 0x22, 0x3e, 0xd6,
+	0xf9, 0x27, 0x25, 0xdd, 0xef, 0x13, 0xd2, 0x1f, 0xe0, 0xba, 0x58, 0x9d, 0x8f, 0x9e, 0xd6, 0x99,
+	0x1f, 0x60, 0xca, 0xdc, 0x60, 0xa8, 0x00, 0x37, 0x17, 0x01, 0x6e, 0x38, 0x51, 0xaa, 0xca, 0xa2,
+	0xca, 0x1b, 0x45, 0x2e, 0xf3, 0x49, 0xec, 0xf1, 0xa6, 0x8c, 0xc8, 0x91, 0x4e, 0x55, 0xb4, 0x52,
+	0xb5, 0xe5, 0x06, 0x7e, 0x48, 0xea, 0xe2, 0xaf, 0x14, 0x55, 0x09, 0xa0, 0x27, 0xd8, 0xef, 0x5f,
+	0x30, 0xec, 0x9d, 0x11, 0x86, 0xdb, 0x43, 0x6e, 0x09, 0x1d, 0x41, 0x96, 0x88, 0x4f, 0x86, 0x76,
+	0xa0, 0x1d, 0x96, 0xef, 0xdd, 0xac, 0xcd, 0x65, 0x5d, 0x4b, 0xa0, 0x96, 0x02, 0xa2, 0xf7, 0x20,
+	0x7b, 0x29, 0x0c, 0x19, 0xa9, 0x03, 0xed, 0xb0, 0x70, 0x5c, 0xfe, 0xee, 0xab, 0xbb, 0xa0, 0x58,
+	0x4d, 0xdc, 0xb3, 0x94, 0xb6, 0xfa, 0x57, 0x0d, 0x72, 0x4d, 0x3c, 0x24, 0xd4, 0x67, 0x68, 0x1f,
+	0x8a, 0xc3, 0x88, 0x0c, 0x09, 0x75, 0x07, 0x8e, 0xef, 0x09, 0x5f, 0x19, 0x0b, 0x62, 0x51, 0xcb,
+	0x43, 0xbf, 0x80, 0x82, 0x27, 0xb1, 0x24, 0x52, 0x76, 0x8d, 0xef, 0xbe, 0xba, 0xbb, 0xad, 0xec,
+	0x36, 0x3c, 0x2f, 0xc2, 0x94, 0x76, 0x59, 0xe4, 0x87, 0x7d, 0x2b, 0x81, 0xa2, 0x5f, 0x42, 0xd6,
+	0x0d, 0xc8, 0x28, 0x64, 0x46, 0xfa, 0x20, 0x7d, 0x58, 0x4c, 0xe2, 0xe7, 0xdb, 0x54, 0x53, 0xdb,
+	0x54, 0x3b, 0x21, 0x7e, 0x78, 0x5c, 0xf8, 0xfa, 0xf9, 0xfe, 0xda, 0x97, 0xff, 0xfd, 0xfb, 0x1d,
+	0xcd, 0x52, 0x9c, 0xea, 0x97, 0x59, 0xc8, 0x77, 0x54, 0x10, 0xa8, 0x0c, 0xa9, 0x69, 0x68, 0x29,
+	0xdf, 0x43, 0x3f, 0x83, 0x7c, 0x80, 0x29, 0x75, 0xfb, 0x98, 0x1a, 0x29, 0x61, 0x7c, 0xbb, 0x26,
+	0x77, 0xa4, 0x16, 0xef, 0x48, 0xad, 0x11, 0x4e, 0xac, 0x29, 0x0a, 0x7d, 0x04, 0x59, 0xca, 0x5c,
+	0x36, 0xa2, 0x46, 0x5a, 0x14, 0x73, 0x6f, 0xa1, 0x98, 0xb1, 0xab, 0xae, 0x00, 0x59, 0x0a, 0x8c,
+	0x1e, 0x00, 0x7a, 0xea, 0x87, 0xee, 0xc0, 0x61, 0xee, 0x60, 0x30, 0x71, 0x22, 0x4c, 0x47, 0x03,
+	0x66, 0x64, 0x0e, 0xb4, 0xc3, 0xe2, 0xbd, 0xdd, 0x05, 0x13, 0x36, 0x87, 0x58, 0x02, 0x61, 0xe9,
+	0x82, 0x35, 0x23, 0x41, 0x0d, 0x28, 0xd2, 0xd1, 0x79, 0xe0, 0x33, 0x87, 0xb7, 0x99, 0xb1, 0xae,
+	0x4c, 0x2c, 0x46, 0x6d, 0xc7, 0x3d, 0x78, 0x9c, 0xf9, 0xe2, 0x3f, 0xfb, 0x9a, 0x05, 0x92, 0xc4,
+	0xc5, 0xe8, 0x21, 0xe8, 0xaa, 0xba, 0x0e, 0x0e, 0x3d, 0x69, 0x27, 0x7b, 0x45, 0x3b, 0x65, 0xc5,
+	0x34, 0x43, 0x4f, 0xd8, 0x6a, 0x41, 0x89, 0x11, 0xe6, 0x0e, 0x1c, 0x25, 0x37, 0x72, 0x6f, 0xb0,
+	0x47, 0x1b, 0x82, 0x1a, 0x37, 0xd0, 0x23, 0xd8, 0x1a, 0x13, 0xe6, 0x87, 0x7d, 0x87, 0x32, 0x37,
+	0x52, 0xf9, 0xe5, 0xaf, 0x18, 0xd7, 0xa6, 0xa4, 0x76, 0x39, 0x53, 0x04, 0xf6, 0x00, 0x94, 0x28,
+	0xc9, 0xb1, 0x70, 0x45, 0x5b, 0x25, 0x49, 0x8c, 0x53, 0xdc, 0xe5, 0x4d, 0xc2, 0x5c, 0xcf, 0x65,
+	0xae, 0x01, 0xbc, 0x6d, 0xad, 0xe9, 0x1a, 0x6d, 0xc3, 0x3a, 0xf3, 0xd9, 0x00, 0x1b, 0x45, 0xa1,
+	0x90, 0x0b, 0x64, 0x40, 0x8e, 0x8e, 0x82, 0xc0, 0x8d, 0x26, 0xc6, 0x86, 0x90, 0xc7, 0x4b, 0xf4,
+	0x73, 0xc8, 0xcb, 0x13, 0x81, 0x23, 0xa3, 0xb4, 0xe2, 0x08, 0x4c, 0x91, 0xa8, 0x02, 0xc0, 0x0f,
+	0x66, 0xe0, 0x53, 0xe6, 0xf7, 0x8c, 0xf2, 0x81, 0x76, 0x98, 0xb7, 0x66, 0x24, 0xc8, 0x84, 0x8d,
+	0x31, 0x61, 0xd8, 0x91, 0xa7, 0x97, 0x1a, 0x9b, 0x22, 0xd1, 0xea, 0x6b, 0x5a, 0x33, 0x39, 0xef,
+	0xd4, 0x2a, 0x8e, 0x93, 0x45, 0xf5, 0x4f, 0x1a, 0x5c, 0x5b, 0x02, 0x42, 0x7b, 0xd2, 0x3d, 0x09,
+	0x1d, 0x12, 0x62, 0x71, 0x7a, 0x0a, 0x56, 0x41, 0x4a, 0xda, 0x21, 0x9e, 0x51, 0xb3, 0x4b, 0x62,
+	0xa4, 0x66, 0xd5, 0xf6, 0x25, 0x41, 0xb7, 0x61, 0x23, 0x56, 0x5f, 0x44, 0x18, 0x8b, 0x73, 0x53,
+	0xb0, 0x8a, 0x0a, 0xc0, 0x45, 0x7c, 0x74, 0x28, 0xc8, 0x53, 0x32, 0x8a, 0xc4, 0xb1, 0x28, 0x58,
+	0xca, 0xe8, 0x7d, 0x32, 0x8a, 0xaa, 0xdf, 0xa4, 0xa0, 0x38, 0x7b, 0x08, 0xde, 0x87, 0xc2, 0x04,
+	0x53, 0xa7, 0x27, 0xa6, 0x82, 0xf6, 0xca, 0x88, 0x6a, 0x85, 0xcc, 0xca, 0x4f, 0x30, 0x3d, 0xe1,
+	0x7a, 0xf4, 0x21, 0x94, 0xdc, 0x73, 0xca, 0x5c, 0x3f, 0x54, 0x84, 0xd4, 0x52, 0xc2, 0x86, 0x02,
+	0x49, 0xd2, 0x4f, 0x21, 0x1f, 0x12, 0x85, 0x4f, 0x2f, 0xc5, 0xe7, 0x42, 0x22, 0xa1, 0x9f, 0x00,
+	0x0a, 0x89, 0x73, 0xe9, 0xb3, 0x0b, 0x67, 0x8c, 0x59, 0x4c, 0xca, 0x2c, 0x25, 0x6d, 0x86, 0xe4,
+	0x89, 0xcf, 0x2e, 0xce, 0x30, 0x53, 0xe4, 0x5f, 0x41, 0xf9, 0xd2, 0x0f, 0x43, 0xde, 0xa7, 0x6a,
+	0x48, 0xaf, 0xaf, 0x1a, 0xd2, 0x25, 0x45, 0x90, 0x4b, 0x9e, 0x9e, 0x2a, 0x9e, 0x70, 0x4c, 0x8d,
+	0xec, 0x41, 0x7a, 0x59, 0x7a, 0x12, 0x24, 0xbc, 0xd2, 0xea, 0x3f, 0x34, 0xc8, 0x70, 0x93, 0xab,
+	0xa7, 0x76, 0x0d, 0xd6, 0x79, 0x8f, 0xac, 0x9e, 0xd8, 0x12, 0x86, 0x3e, 0x81, 0x5c, 0xdc, 0x86,
+	0x19, 0x31, 0x0a, 0x6e, 0x2f, 0x64, 0xf2, 0xea, 0x0d, 0x65, 0xc5, 0x8c, 0xb9, 0xa3, 0xb6, 0x3e,
+	0x7f, 0xd4, 0x1e, 0x66, 0xf2, 0x69, 0x3d, 0x53, 0xfd, 0x9b, 0x06, 0xa5, 0x4f, 0xc9, 0xb8, 0x89,
+	0x07, 0xb8, 0x2f, 0x2e, 0x4a, 0x64, 0xc2, 0x96, 0x27, 0x57, 0x24, 0x72, 0x5c, 0x19, 0x92, 0xa1,
+	0xad, 0x08, 0x56, 0x9f, 0x52, 0x94, 0x1c, 0xb5, 0xe1, 0x7a, 0x84, 0x87, 0x11, 0xa6, 0x38, 0x64,
+	0x2e, 0xf3, 0xc7, 0x78, 0x6a, 0x6b, 0x55, 0xe2, 0x3b, 0xf3, 0x3c, 0xa5, 0xac, 0xfe, 0x53, 0x83,
+	0xb2, 0x35, 0xa7, 0x41, 0xf7, 0x20, 0x77, 0xd5, 0x00, 0x63, 0x20, 0xfa, 0x09, 0x6c, 0x26, 0xe9,
+	0x25, 0xfd, 0x9b, 0xb1, 0xca, 0x53, 0xb1, 0xec, 0xa4, 0x8f, 0xa7, 0x40, 0xec, 0x39, 0x43, 0x72,
+	0x89, 0xa3, 0xd7, 0x34, 0x6e, 0x79, 0x0a, 0xeb, 0x70, 0x14, 0xaa, 0x43, 0x51, 0x8e, 0x70, 0x49,
+	0x5a, 0xde, 0xb8, 0x20, 0x20, 0x82, 0x50, 0xfd, 0xb7, 0x06, 0x25, 0x35, 0xb4, 0x3b, 0x6e, 0xe4,
+	0x06, 0x14, 0x7d, 0x0e, 0xc5, 0xc0, 0x0f, 0xa7, 0x77, 0x80, 0xb6, 0xea, 0x0e, 0xd8, 0xe3, 0x77,
+	0xc0, 0x0f, 0xcf, 0xf7, 0x77, 0x66, 0x58, 0x1f, 0x90, 0xc0, 0x67, 0x38, 0x18, 0xb2, 0x89, 0x05,
+	0x81, 0x1f, 0xc6, 0xb7, 0x42, 0x00, 0x28, 0x70, 0x9f, 0xc5, 0x20, 0x67, 0x88, 0x23, 0x9f, 0x78,
+	0xa2, 0x04, 0xdc, 0xc3, 0xe2, 0x28, 0x6f, 0xaa, 0xe7, 0xd3, 0xf1, 0xbb, 0x3f, 0x3c, 0xdf, 0x7f,
+	0xe7, 0x55, 0x62, 0xe2, 0xe4, 0xcf, 0x7c, 0xd2, 0xeb, 0x81, 0xfb, 0x2c, 0xce, 0x44, 0xe8, 0xab,
+	0x36, 0x6c, 0x9c, 0x89, 0xe9, 0xaf, 0x32, 0x6b, 0x82, 0xba, 0x0d, 0x62, 0xcf, 0xda, 0x2a, 0xcf,
+	0x19, 0x61, 0x79, 0x43, 0xb2, 0x94, 0xd5, 0xbf, 0x68, 0x6a, 0x7e, 0x29, 0xab, 0xef, 0x41, 0xf6,
+	0x0f, 0x23, 0x12, 0x8d, 0x02, 0x43, 0x5b, 0xfe, 0xbe, 0x92, 0x5a, 0xf4, 0x01, 0x14, 0xf8, 0xd0,
+	0xa4, 0x17, 0x64, 0xe0, 0xbd, 0xe6, 0x29, 0x96, 0x00, 0xd0, 0x47, 0x50, 0x16, 0x03, 0x28, 0xa1,
+	0xa4, 0x97, 0x52, 0x4a, 0x1c, 0x65, 0xc7, 0xa0, 0xea, 0x37, 0x39, 0xc8, 0xaa, 0xb8, 0xcc, 0x37,
+	0xdc, 0xc7, 0x99, 0xbb, 0x7c, 0x76, 0xcf, 0x3e, 0x7b, 0xbb, 0x3d, 0xcb, 0x2c, 0xdf, 0x93, 0x57,
+	0xf7, 0x20, 0xfd, 0x16, 0x7b, 0x30, 0x53, 0xf3, 0xcc, 0xd5, 0x6b, 0xbe, 0xfe, 0xe6, 0x35, 0xcf,
+	0x5e, 0xa1, 0xe6, 0xa8, 0x05, 0x37, 0x79, 0xa1, 0xfd, 0xd0, 0x67, 0x7e, 0xf2, 0x78, 0x72, 0x44,
+	0xf8, 0x46, 0x6e, 0xa9, 0x85, 0xeb, 0x81, 0x1f, 0xb6, 0x24, 0x5e, 0x95, 0xc7, 0xe2, 0x68, 0x74,
+	0x0c, 0x3b, 0xd3, 0x09, 0xde, 0x73, 0xc3, 0x1e, 0x1e, 0x28, 0x33, 0xf9, 0xa5, 0x66, 0xae, 0xc5,
+	0xe0, 0x13, 0x81, 0x95, 0x36, 0x1e, 0xc2, 0xf6, 0xa2, 0x0d, 0x0f, 0x53, 0x66, 0x14, 0x56, 0x4c,
+	0x29, 0x34, 0x6f, 0xac, 0x89, 0x29, 0x43, 0x87, 0xa0, 0x9f, 0x8f, 0xa2, 0xd0, 0x11, 0x2f, 0x12,
+	0x55, 0xf1, 0x92, 0x78, 0xb2, 0x94, 0xb9, 0x9c, 0x8f, 0xfd, 0x5f, 0xcb, 0x4a, 0x37, 0x60, 0x4f,
+	0x20, 0xa7, 0xae, 0xa7, 0x0d, 0x13, 0x61, 0xce, 0x56, 0x2f, 0x9d, 0x5d, 0x0e, 0x8a, 0xdf, 0x25,
+	0x71, 0x67, 0x48, 0x04, 0x7a, 0x17, 0xca, 0x89, 0x33, 0x5e, 0x62, 0xf1, 0xf6, 0xc9, 0x5b, 0x1b,
+	0xb1, 0x2b, 0x7e, 0xd3, 0xa2, 0x8f, 0xc1, 0x48, 0x5e, 0x4b, 0x4e, 0x40, 0xfb, 0x0e, 0x9b, 0x0c,
+	0xb1, 0x33, 0x8a, 0x06, 0xd4, 0xd0, 0xf9, 0x6d, 0x69, 0xed, 0x24, 0xfa, 0xcf, 0x68, 0xdf, 0x9e,
+	0x0c, 0xf1, 0xe3, 0x68, 0x40, 0xd1, 0x6f, 0x61, 0x7f, 0x86, 0xe8, 0x8e, 0xd8, 0x05, 0x89, 0xfc,
+	0x3f, 0x62, 0x2f, 0xbe, 0x1b, 0x30, 0x35, 0xb6, 0x0e, 0xd2, 0x3f, 0x5a, 0xa2, 0xbd, 0xc4, 0x40,
+	0x63, 0xca, 0x6f, 0xc4, 0x74, 0x64, 0xc1, 0x0c, 0xc0, 0x89, 0xf0, 0xef, 0x70, 0x8f, 0xcf, 0xef,
+	0xa4, 0x9d, 0xd0, 0xd2, 0x5d, 0xbc, 0x95, 0x90, 0x2c, 0xc5, 0x99, 0x36, 0xd7, 0x9d, 0xef, 0x35,
+	0x80, 0x99, 0xef, 0x7f, 0xb7, 0xe0, 0xc6, 0x59, 0xdb, 0x36, 0x9d, 0x76, 0xc7, 0x6e, 0xb5, 0x4f,
+	0x9d, 0xc7, 0xa7, 0xdd, 0x8e, 0x79, 0xd2, 0xba, 0xdf, 0x32, 0x9b, 0xfa, 0x1a, 0xba, 0x06, 0x9b,
+	0xb3, 0xca, 0xcf, 0xcd, 0xae, 0xae, 0xa1, 0x1b, 0x70, 0x6d, 0x56, 0xd8, 0x38, 0xee, 0xda, 0x8d,
+	0xd6, 0xa9, 0x9e, 0x42, 0x08, 0xca, 0xb3, 0x8a, 0xd3, 0xb6, 0x9e, 0x46, 0xef, 0x80, 0x31, 0x2f,
+	0x73, 0x9e, 0xb4, 0xec, 0x07, 0xce, 0x99, 0x69, 0xb7, 0xf5, 0xcc, 0xa2, 0xfd, 0xf6, 0xa9, 0xa9,
+	0xaf, 0x2f, 0x0a, 0xed, 0x27, 0x6d, 0x3d, 0x8b, 0x76, 0x60, 0x6b, 0x4e, 0xf8, 0xc0, 0x32, 0x4d,
+	0x3d, 0x87, 0xb6, 0x41, 0x9f, 0x15, 0xdf, 0x6f, 0x3f, 0xb6, 0xf4, 0xfc, 0x9d, 0xff, 0x69, 0x50,
+	0x9e, 0xff, 0xaa, 0x85, 0xf6, 0xe1, 0x56, 0xc7, 0x6a, 0x77, 0xda, 0xdd, 0xc6, 0x23, 0xa7, 0x6b,
+	0x37, 0xec, 0xc7, 0xdd, 0x85, 0x54, 0xab, 0x50, 0x59, 0x04, 0x34, 0xcd, 0x4e, 0xbb, 0xdb, 0xb2,
+	0x9d, 0x8e, 0x69, 0xb5, 0xda, 0x4d, 0x5d, 0x43, 0xb7, 0x61, 0x6f, 0x11, 0x73, 0xd6, 0xb6, 0x5b,
+	0xa7, 0x9f, 0xc6, 0x90, 0x14, 0xda, 0x85, 0xeb, 0x8b, 0x90, 0x4e, 0xa3, 0xdb, 0x35, 0x9b, 0xb2,
+	0x16, 0x8b, 0x3a, 0xcb, 0x7c, 0x68, 0x9e, 0xd8, 0x66, 0x53, 0xcf, 0x2c, 0x63, 0xde, 0x6f, 0xb4,
+	0x1e, 0x99, 0x4d, 0x7d, 0x7d, 0x19, 0xf3, 0xa4, 0x71, 0x7a, 0x62, 0x72, 0x6d, 0xf6, 0xd8, 0xfc,
+	0xfa, 0x45, 0x45, 0xfb, 0xf6, 0x45, 0x45, 0xfb, 0xfe, 0x45, 0x45, 0xfb, 0xe2, 0x65, 0x65, 0xed,
+	0xdb, 0x97, 0x95, 0xb5, 0x7f, 0xbd, 0xac, 0xac, 0xfd, 0xe6, 0xfd, 0xbe, 0xcf, 0x2e, 0x46, 0xe7,
+	0xb5, 0x1e, 0x09, 0xd4, 0xcf, 0x03, 0xea, 0xdf, 0x5d, 0xea, 0xfd, 0xbe, 0xfe, 0x4c, 0xfc, 0xe4,
+	0xc1, 0x9b, 0x9d, 0xf2, 0xdf, 0x33, 0xb2, 0x62, 0x52, 0x7e, 0xf8, 0xff, 0x01, 0x00, 0xb6, 0x91,
+	0xf0, 0x7b, 0x10, 0x11, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1054,6 +1404,28 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VoteOptions != nil {
+		{
+			size, err := m.VoteOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Optimistic {
+		i--
+		if m.Optimistic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -1083,22 +1455,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1117,22 +1489,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1175,6 +1547,57 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalVoteOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalVoteOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalVoteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OptionFour) > 0 {
+		i -= len(m.OptionFour)
+		copy(dAtA[i:], m.OptionFour)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionFour)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OptionThree) > 0 {
+		i -= len(m.OptionThree)
+		copy(dAtA[i:], m.OptionThree)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionThree)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OptionTwo) > 0 {
+		i -= len(m.OptionTwo)
+		copy(dAtA[i:], m.OptionTwo)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionTwo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OptionOne) > 0 {
+		i -= len(m.OptionOne)
+		copy(dAtA[i:], m.OptionOne)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionOne)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1195,6 +1618,20 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptionCounts) > 0 {
+		for iNdEx := len(m.OptionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptionCounts[iNdEx])
+			copy(dAtA[i:], m.OptionCounts[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptionCounts[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.WinningOption != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.WinningOption))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.NoWithVetoCount) > 0 {
 		i -= len(m.NoWithVetoCount)
 		copy(dAtA[i:], m.NoWithVetoCount)
@@ -1282,7 +1719,7 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+func (m *GovDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1292,23 +1729,109 @@ func (m *DepositParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *GovDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GovDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+	if len(m.RepresentativeAddress) > 0 {
+		i -= len(m.RepresentativeAddress)
+		copy(dAtA[i:], m.RepresentativeAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.RepresentativeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Representative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Representative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Representative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalPower) > 0 {
+		i -= len(m.TotalPower)
+		copy(dAtA[i:], m.TotalPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TotalPower)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DelegatedPower) > 0 {
+		i -= len(m.DelegatedPower)
+		copy(dAtA[i:], m.DelegatedPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatedPower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DelegatorCount != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.DelegatorCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDepositPeriod != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1350,12 +1873,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1426,6 +1949,37 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptimisticRejectedThreshold) > 0 {
+		i -= len(m.OptimisticRejectedThreshold)
+		copy(dAtA[i:], m.OptimisticRejectedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectedThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for iNdEx := len(m.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAuthorizedAddresses[iNdEx])
+			copy(dAtA[i:], m.OptimisticAuthorizedAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAuthorizedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.OptimisticMsgTypeUrls) > 0 {
+		for iNdEx := len(m.OptimisticMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.OptimisticMsgTypeUrls[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if m.BurnVoteVeto {
 		i--
 		if m.BurnVoteVeto {
@@ -1456,6 +2010,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x68
 	}
+	if len(m.ProposalCancelDest) > 0 {
+		i -= len(m.ProposalCancelDest)
+		copy(dAtA[i:], m.ProposalCancelDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.MinInitialDepositRatio) > 0 {
 		i -= len(m.MinInitialDepositRatio)
 		copy(dAtA[i:], m.MinInitialDepositRatio)
@@ -1485,22 +2053,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1630,6 +2198,38 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Optimistic {
+		n += 2
+	}
+	if m.VoteOptions != nil {
+		l = m.VoteOptions.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalVoteOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OptionOne)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionTwo)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionThree)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionFour)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1655,6 +2255,15 @@ func (m *TallyResult) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.WinningOption != 0 {
+		n += 1 + sovGov(uint64(m.WinningOption))
+	}
+	if len(m.OptionCounts) > 0 {
+		for _, s := range m.OptionCounts {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1684,6 +2293,47 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *GovDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.RepresentativeAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Representative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DelegatorCount != 0 {
+		n += 1 + sovGov(uint64(m.DelegatorCount))
+	}
+	l = len(m.DelegatedPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.TotalPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func (m *DepositParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1773,6 +2423,14 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelDest)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	if m.BurnVoteQuorum {
 		n += 2
 	}
@@ -1782,6 +2440,22 @@ func (m *Params) Size() (n int) {
 	if m.BurnVoteVeto {
 		n += 2
 	}
+	if len(m.OptimisticMsgTypeUrls) > 0 {
+		for _, s := range m.OptimisticMsgTypeUrls {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for _, s := range m.OptimisticAuthorizedAddresses {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.OptimisticRejectedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2470,6 +3144,62 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optimistic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optimistic = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteOptions == nil {
+				m.VoteOptions = &ProposalVoteOptions{}
+			}
+			if err := m.VoteOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2491,7 +3221,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2514,15 +3244,15 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalVoteOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalVoteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionOne", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2550,11 +3280,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
+			m.OptionOne = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2575,18 +3305,528 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionTwo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionThree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionThree = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionFour", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionFour = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningOption", wireType)
+			}
+			m.WinningOption = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinningOption |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionCounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionCounts = append(m.OptionCounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2614,11 +3854,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepresentativeAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2646,7 +3886,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			m.RepresentativeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2669,7 +3909,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *Representative) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,34 +3932,15 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: Representative: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Representative: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2747,13 +3968,32 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorCount", wireType)
+			}
+			m.DelegatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2763,29 +4003,27 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatedPower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2813,7 +4051,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.TotalPower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3451,6 +4689,70 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 13:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
@@ -3511,6 +4813,102 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BurnVoteVeto = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticMsgTypeUrls = append(m.OptimisticMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticAuthorizedAddresses = append(m.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -0,0 +1,12 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGovDelegation creates a new GovDelegation instance
+//
+//nolint:interfacer
+func NewGovDelegation(delegator, representative sdk.AccAddress) GovDelegation {
+	return GovDelegation{DelegatorAddress: delegator.String(), RepresentativeAddress: representative.String()}
+}
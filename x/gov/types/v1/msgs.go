@@ -15,8 +15,8 @@ import (
 )
 
 var (
-	_, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}
-	_, _             codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgDelegateGovVote{}, &MsgUndelegateGovVote{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}
+	_, _                         codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -321,3 +321,179 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{authority}
 }
+
+// NewMsgDelegateGovVote creates a message to delegate the governance voting power of delegator to representative
+//
+//nolint:interfacer
+func NewMsgDelegateGovVote(delegator, representative sdk.AccAddress) *MsgDelegateGovVote {
+	return &MsgDelegateGovVote{delegator.String(), representative.String()}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgDelegateGovVote) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgDelegateGovVote) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDelegateGovVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.RepresentativeAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid representative address: %s", err)
+	}
+	if msg.DelegatorAddress == msg.RepresentativeAddress {
+		return sdkerrors.Wrap(types.ErrInvalidGovDelegation, "cannot delegate to self")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgDelegateGovVote) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgDelegateGovVote.
+func (msg MsgDelegateGovVote) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgUndelegateGovVote creates a message to remove the governance vote delegation of delegator
+//
+//nolint:interfacer
+func NewMsgUndelegateGovVote(delegator sdk.AccAddress) *MsgUndelegateGovVote {
+	return &MsgUndelegateGovVote{delegator.String()}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUndelegateGovVote) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUndelegateGovVote) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUndelegateGovVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUndelegateGovVote) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgUndelegateGovVote.
+func (msg MsgUndelegateGovVote) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgCancelProposal creates a new MsgCancelProposal instance
+//
+//nolint:interfacer
+func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal {
+	return &MsgCancelProposal{
+		ProposalId: proposalID,
+		Proposer:   proposer,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelProposal) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelProposal) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCancelProposal.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// NewMsgSubmitMultipleChoiceProposal creates a new MsgSubmitMultipleChoiceProposal instance
+//
+//nolint:interfacer
+func NewMsgSubmitMultipleChoiceProposal(initialDeposit sdk.Coins, proposer, metadata, title, summary string, voteOptions *ProposalVoteOptions) *MsgSubmitMultipleChoiceProposal {
+	return &MsgSubmitMultipleChoiceProposal{
+		InitialDeposit: initialDeposit,
+		Proposer:       proposer,
+		Metadata:       metadata,
+		Title:          title,
+		Summary:        summary,
+		VoteOptions:    voteOptions,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgSubmitMultipleChoiceProposal) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgSubmitMultipleChoiceProposal) Type() string { return sdk.MsgTypeURL(&m) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSubmitMultipleChoiceProposal) ValidateBasic() error {
+	if m.Title == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal title cannot be empty")
+	}
+	if m.Summary == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal summary cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	deposit := sdk.NewCoins(m.InitialDeposit...)
+	if !deposit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, deposit.String())
+	}
+
+	if deposit.IsAnyNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, deposit.String())
+	}
+
+	if m.VoteOptions == nil {
+		return sdkerrors.Wrap(types.ErrInvalidVoteOptions, "vote options cannot be empty")
+	}
+
+	if err := m.VoteOptions.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidVoteOptions, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (m MsgSubmitMultipleChoiceProposal) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgSubmitMultipleChoiceProposal.
+func (m MsgSubmitMultipleChoiceProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return []sdk.AccAddress{proposer}
+}
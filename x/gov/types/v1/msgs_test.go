@@ -173,6 +173,35 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitMultipleChoiceProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name        string
+		proposer    string
+		title       string
+		summary     string
+		voteOptions *v1.ProposalVoteOptions
+		expErr      bool
+	}{
+		{"invalid addr", "", "Title", "Summary", &v1.ProposalVoteOptions{OptionOne: "a", OptionTwo: "b"}, true},
+		{"empty title and summary", addrs[0].String(), "", "", &v1.ProposalVoteOptions{OptionOne: "a", OptionTwo: "b"}, true},
+		{"no vote options", addrs[0].String(), "Title", "Summary", nil, true},
+		{"single vote option", addrs[0].String(), "Title", "Summary", &v1.ProposalVoteOptions{OptionOne: "a"}, true},
+		{"gap in vote options", addrs[0].String(), "Title", "Summary", &v1.ProposalVoteOptions{OptionOne: "a", OptionTwo: "b", OptionFour: "d"}, true},
+		{"duplicate vote options", addrs[0].String(), "Title", "Summary", &v1.ProposalVoteOptions{OptionOne: "a", OptionTwo: "a"}, true},
+		{"valid with two options", addrs[0].String(), "Title", "Summary", &v1.ProposalVoteOptions{OptionOne: "a", OptionTwo: "b"}, false},
+		{"valid with four options", addrs[0].String(), "Title", "Summary", &v1.ProposalVoteOptions{OptionOne: "a", OptionTwo: "b", OptionThree: "c", OptionFour: "d"}, false},
+	}
+
+	for _, tc := range tests {
+		msg := v1.NewMsgSubmitMultipleChoiceProposal(coinsPos, tc.proposer, "", tc.title, tc.summary, tc.voteOptions)
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	testcases := []struct {
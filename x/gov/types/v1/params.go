@@ -16,14 +16,17 @@ const (
 
 // Default governance params
 var (
-	DefaultMinDepositTokens       = sdk.NewInt(10000000)
-	DefaultQuorum                 = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold              = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold          = sdk.NewDecWithPrec(334, 3)
-	DefaultMinInitialDepositRatio = sdk.ZeroDec()
-	DefaultBurnProposalPrevote    = false // set to false to replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteQuorom         = false // set to false to  replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteVeto           = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositTokens            = sdk.NewInt(10000000)
+	DefaultQuorum                      = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                   = sdk.NewDecWithPrec(5, 1)
+	DefaultVetoThreshold               = sdk.NewDecWithPrec(334, 3)
+	DefaultMinInitialDepositRatio      = sdk.ZeroDec()
+	DefaultProposalCancelRatio         = sdk.NewDecWithPrec(5, 1)
+	DefaultProposalCancelDest          = "" // burn the charged deposits by default
+	DefaultOptimisticRejectedThreshold = sdk.NewDecWithPrec(1, 1)
+	DefaultBurnProposalPrevote         = false // set to false to replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteQuorom              = false // set to false to  replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteVeto                = true  // set to true to replicate behavior of when this change was made (0.47)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -53,19 +56,26 @@ func NewVotingParams(votingPeriod *time.Duration) VotingParams {
 // NewParams creates a new Params instance with given values.
 func NewParams(
 	minDeposit sdk.Coins, maxDepositPeriod, votingPeriod time.Duration,
-	quorum, threshold, vetoThreshold, minInitialDepositRatio string, burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
+	quorum, threshold, vetoThreshold, minInitialDepositRatio, proposalCancelRatio, proposalCancelDest string,
+	optimisticMsgTypeURLs, optimisticAuthorizedAddresses []string, optimisticRejectedThreshold string,
+	burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
 ) Params {
 	return Params{
-		MinDeposit:                 minDeposit,
-		MaxDepositPeriod:           &maxDepositPeriod,
-		VotingPeriod:               &votingPeriod,
-		Quorum:                     quorum,
-		Threshold:                  threshold,
-		VetoThreshold:              vetoThreshold,
-		MinInitialDepositRatio:     minInitialDepositRatio,
-		BurnProposalDepositPrevote: burnProposalDeposit,
-		BurnVoteQuorum:             burnVoteQuorum,
-		BurnVoteVeto:               burnVoteVeto,
+		MinDeposit:                    minDeposit,
+		MaxDepositPeriod:              &maxDepositPeriod,
+		VotingPeriod:                  &votingPeriod,
+		Quorum:                        quorum,
+		Threshold:                     threshold,
+		VetoThreshold:                 vetoThreshold,
+		MinInitialDepositRatio:        minInitialDepositRatio,
+		ProposalCancelRatio:           proposalCancelRatio,
+		ProposalCancelDest:            proposalCancelDest,
+		OptimisticMsgTypeUrls:         optimisticMsgTypeURLs,
+		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
+		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
+		BurnProposalDepositPrevote:    burnProposalDeposit,
+		BurnVoteQuorum:                burnVoteQuorum,
+		BurnVoteVeto:                  burnVoteVeto,
 	}
 }
 
@@ -79,6 +89,11 @@ func DefaultParams() Params {
 		DefaultThreshold.String(),
 		DefaultVetoThreshold.String(),
 		DefaultMinInitialDepositRatio.String(),
+		DefaultProposalCancelRatio.String(),
+		DefaultProposalCancelDest,
+		nil,
+		nil,
+		DefaultOptimisticRejectedThreshold.String(),
 		DefaultBurnProposalPrevote,
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
@@ -151,5 +166,90 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("mininum initial deposit ratio of proposal is too large: %s", minInitialDepositRatio)
 	}
 
+	proposalCancelRatio, err := math.LegacyNewDecFromStr(p.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid cancel ratio of proposal: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("cancel ratio of proposal must not be negative: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("cancel ratio of proposal is too large: %s", proposalCancelRatio)
+	}
+
+	if len(p.ProposalCancelDest) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.ProposalCancelDest); err != nil {
+			return fmt.Errorf("invalid cancel destination address of proposal: %w", err)
+		}
+	}
+
+	msgTypeURLs := make(map[string]bool, len(p.OptimisticMsgTypeUrls))
+	for _, msgTypeURL := range p.OptimisticMsgTypeUrls {
+		if len(msgTypeURL) == 0 {
+			return fmt.Errorf("optimistic message type URL cannot be empty")
+		}
+		if msgTypeURLs[msgTypeURL] {
+			return fmt.Errorf("duplicate optimistic message type URL: %s", msgTypeURL)
+		}
+		msgTypeURLs[msgTypeURL] = true
+	}
+
+	authorizedAddresses := make(map[string]bool, len(p.OptimisticAuthorizedAddresses))
+	for _, addr := range p.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid optimistic authorized address: %w", err)
+		}
+		if authorizedAddresses[addr] {
+			return fmt.Errorf("duplicate optimistic authorized address: %s", addr)
+		}
+		authorizedAddresses[addr] = true
+	}
+
+	optimisticRejectedThreshold, err := sdk.NewDecFromStr(p.OptimisticRejectedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid optimistic rejected threshold string: %w", err)
+	}
+	if !optimisticRejectedThreshold.IsPositive() {
+		return fmt.Errorf("optimistic rejected threshold must be positive: %s", optimisticRejectedThreshold)
+	}
+	if optimisticRejectedThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
+	}
+
 	return nil
 }
+
+// IsOptimisticProposal returns true if the given messages proposed by the given proposer
+// make a proposal on the optimistic track, i.e. the proposer is an optimistic authorized
+// address and there is at least one message, all of them with an optimistic message type URL.
+func (p Params) IsOptimisticProposal(messages []sdk.Msg, proposer string) bool {
+	if len(messages) == 0 {
+		return false
+	}
+
+	authorized := false
+	for _, addr := range p.OptimisticAuthorizedAddresses {
+		if addr == proposer {
+			authorized = true
+			break
+		}
+	}
+	if !authorized {
+		return false
+	}
+
+	for _, msg := range messages {
+		allowed := false
+		for _, msgTypeURL := range p.OptimisticMsgTypeUrls {
+			if msgTypeURL == sdk.MsgTypeURL(msg) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	return true
+}
@@ -20,6 +20,7 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusCanceled      = ProposalStatus_PROPOSAL_STATUS_CANCELED
 )
 
 // NewProposal creates a new Proposal instance
@@ -52,6 +53,11 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
 }
 
+// IsMultipleChoice returns true if the proposal is a multiple choice proposal.
+func (p Proposal) IsMultipleChoice() bool {
+	return p.VoteOptions != nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
@@ -83,6 +89,85 @@ func (p Proposals) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return nil
 }
 
+// NewProposalVoteOptions creates the vote options of a multiple choice proposal from the given
+// option names. Up to four options can be given.
+func NewProposalVoteOptions(names ...string) (*ProposalVoteOptions, error) {
+	if len(names) > 4 {
+		return nil, fmt.Errorf("too many options: %d, a multiple choice proposal has up to 4 options", len(names))
+	}
+
+	names = append(names, make([]string, 4-len(names))...)
+	return &ProposalVoteOptions{
+		OptionOne:   names[0],
+		OptionTwo:   names[1],
+		OptionThree: names[2],
+		OptionFour:  names[3],
+	}, nil
+}
+
+// Names returns the names of the defined options, in order.
+func (o ProposalVoteOptions) Names() []string {
+	names := []string{}
+	for _, name := range []string{o.OptionOne, o.OptionTwo, o.OptionThree, o.OptionFour} {
+		if name == "" {
+			break
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// HasOption returns true if the given vote option is one of the defined options.
+func (o ProposalVoteOptions) HasOption(option VoteOption) bool {
+	return IsMultipleChoiceOption(option) && int(option-OptionOne) < len(o.Names())
+}
+
+// Options returns the vote options of the defined options, in order.
+func (o ProposalVoteOptions) Options() []VoteOption {
+	names := o.Names()
+	options := make([]VoteOption, len(names))
+	for i := range names {
+		options[i] = OptionOne + VoteOption(i)
+	}
+	return options
+}
+
+// OptionName returns the name of the given vote option, or an empty string if it is not one of the
+// defined options.
+func (o ProposalVoteOptions) OptionName(option VoteOption) string {
+	if !o.HasOption(option) {
+		return ""
+	}
+	return o.Names()[option-OptionOne]
+}
+
+// ValidateBasic checks that at least the first two options are defined, without gaps
+// and with distinct names.
+func (o ProposalVoteOptions) ValidateBasic() error {
+	all := []string{o.OptionOne, o.OptionTwo, o.OptionThree, o.OptionFour}
+	names := o.Names()
+
+	if len(names) < 2 {
+		return fmt.Errorf("a multiple choice proposal must have at least 2 options")
+	}
+
+	for _, name := range all[len(names):] {
+		if name != "" {
+			return fmt.Errorf("options must be defined in order without gaps")
+		}
+	}
+
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			return fmt.Errorf("duplicate option: %s", name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
 type (
 	// ProposalQueue defines a queue for proposal ids
 	ProposalQueue []uint64
@@ -115,7 +200,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusCanceled {
 		return true
 	}
 	return false
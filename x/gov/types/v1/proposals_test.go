@@ -28,6 +28,26 @@ func TestProposalStatus_Format(t *testing.T) {
 	}
 }
 
+func TestProposalVoteOptions(t *testing.T) {
+	voteOptions, err := v1.NewProposalVoteOptions("Blue", "Green", "Red")
+	require.NoError(t, err)
+	require.NoError(t, voteOptions.ValidateBasic())
+	require.Equal(t, []string{"Blue", "Green", "Red"}, voteOptions.Names())
+
+	require.True(t, voteOptions.HasOption(v1.OptionOne))
+	require.True(t, voteOptions.HasOption(v1.OptionThree))
+	require.False(t, voteOptions.HasOption(v1.OptionFour))
+	require.False(t, voteOptions.HasOption(v1.OptionEmpty))
+	require.False(t, voteOptions.HasOption(v1.OptionYes))
+	require.Equal(t, []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree}, voteOptions.Options())
+
+	require.Equal(t, "Green", voteOptions.OptionName(v1.OptionTwo))
+	require.Equal(t, "", voteOptions.OptionName(v1.OptionFour))
+
+	_, err = v1.NewProposalVoteOptions("a", "b", "c", "d", "e")
+	require.Error(t, err)
+}
+
 // TestNestedAnys tests that we can call .String() on a struct with nested Anys.
 // Here, we're creating a proposal which has a Msg (1st any) with a legacy
 // content (2nd any).
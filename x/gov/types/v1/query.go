@@ -0,0 +1,29 @@
+package v1
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ codectypes.UnpackInterfacesMessage = QuerySimulateProposalRequest{}
+
+// NewQuerySimulateProposalRequest creates a new QuerySimulateProposalRequest for the given proposal messages.
+func NewQuerySimulateProposalRequest(messages []sdk.Msg) (*QuerySimulateProposalRequest, error) {
+	msgs, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuerySimulateProposalRequest{Messages: msgs}, nil
+}
+
+// GetMsgs unpacks the messages to simulate.
+func (req QuerySimulateProposalRequest) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(req.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (req QuerySimulateProposalRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, req.Messages)
+}
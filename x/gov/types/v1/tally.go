@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"golang.org/x/exp/slices"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -47,6 +49,20 @@ func NewTallyResultFromMap(results map[VoteOption]sdk.Dec) TallyResult {
 	)
 }
 
+// NewMultipleChoiceTallyResult creates a new TallyResult instance of a multiple choice proposal with
+// the given options from a Option -> Dec map
+func NewMultipleChoiceTallyResult(options *ProposalVoteOptions, results map[VoteOption]sdk.Dec) TallyResult {
+	tallyResult := EmptyTallyResult()
+	for _, option := range options.Options() {
+		count := math.ZeroInt()
+		if power, ok := results[option]; ok {
+			count = power.TruncateInt()
+		}
+		tallyResult.OptionCounts = append(tallyResult.OptionCounts, count.String())
+	}
+	return tallyResult
+}
+
 // EmptyTallyResult returns an empty TallyResult.
 func EmptyTallyResult() TallyResult {
 	return NewTallyResult(math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt())
@@ -57,5 +73,7 @@ func (tr TallyResult) Equals(comp TallyResult) bool {
 	return tr.YesCount == comp.YesCount &&
 		tr.AbstainCount == comp.AbstainCount &&
 		tr.NoCount == comp.NoCount &&
-		tr.NoWithVetoCount == comp.NoWithVetoCount
+		tr.NoWithVetoCount == comp.NoWithVetoCount &&
+		tr.WinningOption == comp.WinningOption &&
+		slices.Equal(tr.OptionCounts, comp.OptionCounts)
 }
@@ -15,6 +15,12 @@ const (
 	OptionNo         = VoteOption_VOTE_OPTION_NO
 	OptionNoWithVeto = VoteOption_VOTE_OPTION_NO_WITH_VETO
 	OptionAbstain    = VoteOption_VOTE_OPTION_ABSTAIN
+
+	// Options of multiple choice proposals
+	OptionOne   = VoteOption_VOTE_OPTION_ONE
+	OptionTwo   = VoteOption_VOTE_OPTION_TWO
+	OptionThree = VoteOption_VOTE_OPTION_THREE
+	OptionFour  = VoteOption_VOTE_OPTION_FOUR
 )
 
 // NewVote creates a new Vote instance
@@ -107,7 +113,7 @@ func (v WeightedVoteOptions) String() (out string) {
 func VoteOptionFromString(str string) (VoteOption, error) {
 	option, ok := VoteOption_value[str]
 	if !ok {
-		return OptionEmpty, fmt.Errorf("'%s' is not a valid vote option, available options: yes/no/no_with_veto/abstain", str)
+		return OptionEmpty, fmt.Errorf("'%s' is not a valid vote option, available options: yes/no/no_with_veto/abstain, or one/two/three/four for multiple choice proposals", str)
 	}
 	return VoteOption(option), nil
 }
@@ -142,7 +148,12 @@ func ValidVoteOption(option VoteOption) bool {
 		option == OptionNoWithVeto {
 		return true
 	}
-	return false
+	return IsMultipleChoiceOption(option)
+}
+
+// IsMultipleChoiceOption returns true if option is one of the options of multiple choice proposals.
+func IsMultipleChoiceOption(option VoteOption) bool {
+	return option >= OptionOne && option <= OptionFour
 }
 
 // Format implements the fmt.Formatter interface.
@@ -9,10 +9,26 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flag names and values
+const (
+	FlagName          = "name"
+	FlagSymbol        = "symbol"
+	FlagDescription   = "description"
+	FlagURI           = "uri"
+	FlagURIHash       = "uri-hash"
+	FlagMintAuthority = "mint-authority"
+	FlagTransferable  = "transferable"
+	FlagUpdatable     = "updatable"
+
+	FlagRoyaltyReceiver = "royalty-receiver"
+	FlagRoyaltyFraction = "royalty-fraction"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +42,11 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdCreateClass(),
+		NewCmdUpdateClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdateNFT(),
 	)
 
 	return nftTxCmd
@@ -59,3 +80,206 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCmdCreateClass creates a CLI command for MsgCreateClass.
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [creator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new nft class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-class <class-id> --name <name> --symbol <symbol> --transferable --updatable --from <creator> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgCreateClass{
+				Creator: clientCtx.GetFromAddress().String(),
+				Id:      args[0],
+			}
+			msg.Name, _ = cmd.Flags().GetString(FlagName)
+			msg.Symbol, _ = cmd.Flags().GetString(FlagSymbol)
+			msg.Description, _ = cmd.Flags().GetString(FlagDescription)
+			msg.Uri, _ = cmd.Flags().GetString(FlagURI)
+			msg.UriHash, _ = cmd.Flags().GetString(FlagURIHash)
+			msg.MintAuthority, _ = cmd.Flags().GetString(FlagMintAuthority)
+			if msg.RoyaltyInfo, err = parseRoyaltyInfo(cmd); err != nil {
+				return err
+			}
+			msg.Transferable, _ = cmd.Flags().GetBool(FlagTransferable)
+			msg.Updatable, _ = cmd.Flags().GetBool(FlagUpdatable)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addClassFlags(cmd)
+	cmd.Flags().Bool(FlagTransferable, false, "Allow owners to send nfts of the class")
+	cmd.Flags().Bool(FlagUpdatable, false, "Allow the class and its nfts to be updated after creation")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateClass creates a CLI command for MsgUpdateClass.
+func NewCmdUpdateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class [class-id] --from [creator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "update the metadata of an nft class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update-class <class-id> --name <name> --uri <uri> --from <creator> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdateClass{
+				Creator: clientCtx.GetFromAddress().String(),
+				Id:      args[0],
+			}
+			msg.Name, _ = cmd.Flags().GetString(FlagName)
+			msg.Symbol, _ = cmd.Flags().GetString(FlagSymbol)
+			msg.Description, _ = cmd.Flags().GetString(FlagDescription)
+			msg.Uri, _ = cmd.Flags().GetString(FlagURI)
+			msg.UriHash, _ = cmd.Flags().GetString(FlagURIHash)
+			msg.MintAuthority, _ = cmd.Flags().GetString(FlagMintAuthority)
+			if msg.RoyaltyInfo, err = parseRoyaltyInfo(cmd); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addClassFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdMint creates a CLI command for MsgMint.
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [minter]",
+		Args:  cobra.ExactArgs(3),
+		Short: "mint a new nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> <receiver> --uri <uri> --from <minter> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgMint{
+				Minter:   clientCtx.GetFromAddress().String(),
+				ClassId:  args[0],
+				Id:       args[1],
+				Receiver: args[2],
+			}
+			msg.Uri, _ = cmd.Flags().GetString(FlagURI)
+			msg.UriHash, _ = cmd.Flags().GetString(FlagURIHash)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addNFTFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdBurn creates a CLI command for MsgBurn.
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurn{
+				Owner:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateNFT creates a CLI command for MsgUpdateNFT.
+func NewCmdUpdateNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [class-id] [nft-id] --from [mint-authority]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the metadata of a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update <class-id> <nft-id> --uri <uri> --from <mint-authority> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdateNFT{
+				Updater: clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			msg.Uri, _ = cmd.Flags().GetString(FlagURI)
+			msg.UriHash, _ = cmd.Flags().GetString(FlagURIHash)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addNFTFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addClassFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagName, "", "Human-readable name of the class")
+	cmd.Flags().String(FlagSymbol, "", "Abbreviated name of the class")
+	cmd.Flags().String(FlagDescription, "", "Brief description of the class")
+	cmd.Flags().String(FlagURI, "", "URI of the class metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "Hash of the document pointed by the uri")
+	cmd.Flags().String(FlagMintAuthority, "", "Address allowed to mint nfts of the class (defaults to the creator)")
+	cmd.Flags().String(FlagRoyaltyReceiver, "", "Address receiving royalties on secondary sales of the class nfts")
+	cmd.Flags().String(FlagRoyaltyFraction, "", "Share of the sale price paid as royalty, e.g. 0.05")
+}
+
+// parseRoyaltyInfo returns the royalty info set through flags, or nil when no royalty receiver is given.
+func parseRoyaltyInfo(cmd *cobra.Command) (*nft.RoyaltyInfo, error) {
+	receiver, _ := cmd.Flags().GetString(FlagRoyaltyReceiver)
+	fractionStr, _ := cmd.Flags().GetString(FlagRoyaltyFraction)
+	if len(receiver) == 0 {
+		if len(fractionStr) > 0 {
+			return nil, fmt.Errorf("--%s requires --%s", FlagRoyaltyFraction, FlagRoyaltyReceiver)
+		}
+		return nil, nil
+	}
+
+	fraction, err := sdk.NewDecFromStr(fractionStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid royalty fraction: %w", err)
+	}
+	return &nft.RoyaltyInfo{Receiver: receiver, Fraction: fraction}, nil
+}
+
+func addNFTFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagURI, "", "URI of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "Hash of the document pointed by the uri")
+}
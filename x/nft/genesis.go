@@ -10,6 +10,11 @@ func ValidateGenesis(data GenesisState) error {
 		if len(class.Id) == 0 {
 			return ErrEmptyClassID
 		}
+		if class.RoyaltyInfo != nil {
+			if err := class.RoyaltyInfo.ValidateBasic(); err != nil {
+				return err
+			}
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
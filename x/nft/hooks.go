@@ -0,0 +1,79 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NFTHooks defines the hooks the nft keeper calls when the ownership of an nft changes.
+// Returning an error from a Before hook aborts the operation.
+type NFTHooks interface {
+	BeforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error // Must be called before a nft changes owner
+	AfterTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error  // Must be called after a nft changes owner
+	BeforeMint(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error             // Must be called before a nft is minted
+	AfterMint(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error              // Must be called after a nft is minted
+	BeforeBurn(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error                // Must be called before a nft is burned
+	AfterBurn(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error                 // Must be called after a nft is burned
+}
+
+var _ NFTHooks = MultiNFTHooks{}
+
+// combine multiple nft hooks, all hook functions are run in array sequence
+type MultiNFTHooks []NFTHooks
+
+func NewMultiNFTHooks(hooks ...NFTHooks) MultiNFTHooks {
+	return hooks
+}
+
+func (h MultiNFTHooks) BeforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNFTHooks) AfterTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNFTHooks) BeforeMint(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeMint(ctx, classID, nftID, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNFTHooks) AfterMint(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterMint(ctx, classID, nftID, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNFTHooks) BeforeBurn(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeBurn(ctx, classID, nftID, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiNFTHooks) AfterBurn(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterBurn(ctx, classID, nftID, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -193,3 +193,33 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// RoyaltyInfo return the royalty receiver and amount owed for a sale of an NFT of the given class, same as royaltyInfo in ERC2981
+func (k Keeper) RoyaltyInfo(goCtx context.Context, r *nft.QueryRoyaltyInfoRequest) (*nft.QueryRoyaltyInfoResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	salePrice, err := sdk.ParseCoinNormalized(r.SalePrice)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sale price: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	if class.RoyaltyInfo == nil {
+		return &nft.QueryRoyaltyInfoResponse{RoyaltyAmount: sdk.NewCoin(salePrice.Denom, sdk.ZeroInt())}, nil
+	}
+	return &nft.QueryRoyaltyInfoResponse{
+		Receiver:      class.RoyaltyInfo.Receiver,
+		RoyaltyAmount: class.RoyaltyInfo.RoyaltyAmount(salePrice),
+	}, nil
+}
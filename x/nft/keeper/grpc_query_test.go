@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -590,3 +591,71 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRoyaltyInfo() {
+	class := ExpClass
+	class.RoyaltyInfo = &nft.RoyaltyInfo{
+		Receiver: s.addrs[1].String(),
+		Fraction: sdk.NewDecWithPrec(5, 2),
+	}
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, class))
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "no-royalty"}))
+
+	testCases := []struct {
+		msg         string
+		req         *nft.QueryRoyaltyInfoRequest
+		expError    string
+		expReceiver string
+		expAmount   sdk.Coin
+	}{
+		{
+			"fail empty ClassId",
+			&nft.QueryRoyaltyInfoRequest{SalePrice: "1000stake"},
+			nft.ErrEmptyClassID.Error(),
+			"",
+			sdk.Coin{},
+		},
+		{
+			"fail invalid sale price",
+			&nft.QueryRoyaltyInfoRequest{ClassId: testClassID, SalePrice: "stake"},
+			"invalid sale price",
+			"",
+			sdk.Coin{},
+		},
+		{
+			"fail class not exists",
+			&nft.QueryRoyaltyInfoRequest{ClassId: "unknown", SalePrice: "1000stake"},
+			"not found class",
+			"",
+			sdk.Coin{},
+		},
+		{
+			"Success without royalty",
+			&nft.QueryRoyaltyInfoRequest{ClassId: "no-royalty", SalePrice: "1000stake"},
+			"",
+			"",
+			sdk.NewInt64Coin("stake", 0),
+		},
+		{
+			"Success",
+			&nft.QueryRoyaltyInfoRequest{ClassId: testClassID, SalePrice: "1019stake"},
+			"",
+			s.addrs[1].String(),
+			sdk.NewInt64Coin("stake", 50),
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			require := s.Require()
+			result, err := s.queryClient.RoyaltyInfo(gocontext.Background(), tc.req)
+			if tc.expError != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expError)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tc.expReceiver, result.Receiver)
+			require.Equal(tc.expAmount, result.RoyaltyAmount)
+		})
+	}
+}
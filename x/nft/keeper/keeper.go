@@ -11,6 +11,7 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	bk       nft.BankKeeper
+	hooks    nft.NFTHooks
 }
 
 // NewKeeper creates a new nft Keeper instance
@@ -28,3 +29,25 @@ func NewKeeper(key storetypes.StoreKey,
 		bk:       bk,
 	}
 }
+
+// Hooks gets the hooks for nft Keeper
+func (k Keeper) Hooks() nft.NFTHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return nft.MultiNFTHooks{}
+	}
+
+	return k.hooks
+}
+
+// SetHooks sets the nft hooks. In contrast to other receivers, this method must take a pointer due to nature
+// of the hooks interface and SDK start up sequence.
+func (k *Keeper) SetHooks(nh nft.NFTHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set nft hooks twice")
+	}
+
+	k.hooks = nh
+
+	return k
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -38,6 +39,7 @@ type TestSuite struct {
 	addrs       []sdk.AccAddress
 	queryClient nft.QueryClient
 	nftKeeper   keeper.Keeper
+	msgServer   nft.MsgServer
 
 	encCfg moduletestutil.TestEncodingConfig
 }
@@ -62,6 +64,7 @@ func (s *TestSuite) SetupTest() {
 	nft.RegisterQueryServer(queryHelper, nftKeeper)
 
 	s.nftKeeper = nftKeeper
+	s.msgServer = keeper.NewMsgServerImpl(nftKeeper)
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
 }
@@ -316,6 +319,79 @@ func (s *TestSuite) TestTransfer() {
 	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
 }
 
+// recordingHooks records the hook calls it receives and can veto transfers.
+type recordingHooks struct {
+	calls         []string
+	vetoTransfers bool
+}
+
+var _ nft.NFTHooks = &recordingHooks{}
+
+func (h *recordingHooks) BeforeTransfer(_ sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	h.calls = append(h.calls, "BeforeTransfer/"+classID+"/"+nftID)
+	if h.vetoTransfers {
+		return errors.New("transfer vetoed")
+	}
+	return nil
+}
+
+func (h *recordingHooks) AfterTransfer(_ sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	h.calls = append(h.calls, "AfterTransfer/"+classID+"/"+nftID)
+	return nil
+}
+
+func (h *recordingHooks) BeforeMint(_ sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	h.calls = append(h.calls, "BeforeMint/"+classID+"/"+nftID)
+	return nil
+}
+
+func (h *recordingHooks) AfterMint(_ sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	h.calls = append(h.calls, "AfterMint/"+classID+"/"+nftID)
+	return nil
+}
+
+func (h *recordingHooks) BeforeBurn(_ sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	h.calls = append(h.calls, "BeforeBurn/"+classID+"/"+nftID)
+	return nil
+}
+
+func (h *recordingHooks) AfterBurn(_ sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
+	h.calls = append(h.calls, "AfterBurn/"+classID+"/"+nftID)
+	return nil
+}
+
+func (s *TestSuite) TestHooks() {
+	hooks := &recordingHooks{}
+	s.nftKeeper.SetHooks(nft.NewMultiNFTHooks(hooks))
+	s.Require().Panics(func() { s.nftKeeper.SetHooks(hooks) })
+
+	err := s.nftKeeper.SaveClass(s.ctx, ExpClass)
+	s.Require().NoError(err)
+
+	expNFT := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+	}
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, expNFT, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1]))
+
+	// a vetoed transfer leaves the owner unchanged
+	hooks.vetoTransfers = true
+	s.Require().ErrorContains(s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[2]), "transfer vetoed")
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, testID))
+	s.Require().Equal([]string{
+		"BeforeMint/kitty/kitty1",
+		"AfterMint/kitty/kitty1",
+		"BeforeTransfer/kitty/kitty1",
+		"AfterTransfer/kitty/kitty1",
+		"BeforeTransfer/kitty/kitty1",
+		"BeforeBurn/kitty/kitty1",
+		"AfterBurn/kitty/kitty1",
+	}, hooks.calls)
+}
+
 func (s *TestSuite) TestExportGenesis() {
 	class := nft.Class{
 		Id:          testClassID,
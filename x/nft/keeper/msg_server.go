@@ -8,10 +8,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the x/nft MsgServer interface.
+func NewMsgServerImpl(k Keeper) nft.MsgServer {
+	return &msgServer{Keeper: k}
+}
 
 // Send implements Send method of the types.MsgServer.
-func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -23,6 +32,12 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
 	}
 
+	// classes without permissions predate the permission model and stay transferable
+	class, _ := k.GetClass(ctx, msg.ClassId)
+	if class.Permissions != nil && !class.Permissions.Transferable {
+		return nil, sdkerrors.Wrap(nft.ErrNFTNotTransferable, msg.ClassId)
+	}
+
 	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
 	if err != nil {
 		return nil, err
@@ -40,3 +55,175 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implements CreateClass method of the types.MsgServer.
+func (k msgServer) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mintAuthority := msg.MintAuthority
+	if len(mintAuthority) == 0 {
+		mintAuthority = msg.Creator
+	}
+
+	class := nft.Class{
+		Id:          msg.Id,
+		Name:        msg.Name,
+		Symbol:      msg.Symbol,
+		Description: msg.Description,
+		Uri:         msg.Uri,
+		UriHash:     msg.UriHash,
+		Data:        msg.Data,
+		RoyaltyInfo: msg.RoyaltyInfo,
+		Permissions: &nft.ClassPermissions{
+			Creator:       msg.Creator,
+			MintAuthority: mintAuthority,
+			Transferable:  msg.Transferable,
+			Updatable:     msg.Updatable,
+		},
+	}
+	if err := k.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: msg.Id,
+		Creator: msg.Creator,
+	})
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// UpdateClass implements UpdateClass method of the types.MsgServer.
+func (k msgServer) UpdateClass(goCtx context.Context, msg *nft.MsgUpdateClass) (*nft.MsgUpdateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, err := k.getManagedClass(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if class.Permissions.Creator != msg.Creator {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the creator of class %s", msg.Creator, msg.Id)
+	}
+
+	if !class.Permissions.Updatable {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotUpdatable, msg.Id)
+	}
+
+	class.Name = msg.Name
+	class.Symbol = msg.Symbol
+	class.Description = msg.Description
+	class.Uri = msg.Uri
+	class.UriHash = msg.UriHash
+	class.Data = msg.Data
+	class.RoyaltyInfo = msg.RoyaltyInfo
+	if len(msg.MintAuthority) > 0 {
+		class.Permissions.MintAuthority = msg.MintAuthority
+	}
+	if err := k.Keeper.UpdateClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdateClass{
+		ClassId: msg.Id,
+	})
+	return &nft.MsgUpdateClassResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, err := k.getManagedClass(ctx, msg.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if class.Permissions.MintAuthority != msg.Minter {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the mint authority of class %s", msg.Minter, msg.ClassId)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Keeper.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (k msgServer) Burn(goCtx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.getManagedClass(ctx, msg.ClassId); err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.GetOwner(ctx, msg.ClassId, msg.Id).Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", owner, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// UpdateNFT implements UpdateNFT method of the types.MsgServer.
+func (k msgServer) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, err := k.getManagedClass(ctx, msg.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if class.Permissions.MintAuthority != msg.Updater {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the mint authority of class %s", msg.Updater, msg.ClassId)
+	}
+
+	if !class.Permissions.Updatable {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotUpdatable, msg.ClassId)
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdateNFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+	})
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
+
+// getManagedClass returns the class of the specified id, it fails if the class does not exist
+// or was not created through the Msg service.
+func (k msgServer) getManagedClass(ctx sdk.Context, classID string) (nft.Class, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nft.Class{}, sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if class.Permissions == nil {
+		return nft.Class{}, sdkerrors.Wrap(nft.ErrClassNotManaged, classID)
+	}
+	return class, nil
+}
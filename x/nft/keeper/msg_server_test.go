@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -98,7 +99,7 @@ func (s *TestSuite) TestSend() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_, err := s.nftKeeper.Send(s.ctx, tc.req)
+			_, err := s.msgServer.Send(s.ctx, tc.req)
 			if tc.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.errMsg)
@@ -108,3 +109,209 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestSendNonTransferable() {
+	_, err := s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Creator: s.addrs[0].String(),
+		Id:      testClassID,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.addrs[0].String(),
+		ClassId:  testClassID,
+		Id:       testID,
+		Receiver: s.addrs[1].String(),
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Send(s.ctx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.addrs[1].String(),
+		Receiver: s.addrs[2].String(),
+	})
+	s.Require().ErrorIs(err, nft.ErrNFTNotTransferable)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestCreateClass() {
+	_, err := s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Creator:      s.addrs[0].String(),
+		Id:           testClassID,
+		Name:         testClassName,
+		Symbol:       testClassSymbol,
+		Transferable: true,
+	})
+	s.Require().NoError(err)
+
+	class, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(testClassName, class.Name)
+	s.Require().Equal(&nft.ClassPermissions{
+		Creator:       s.addrs[0].String(),
+		MintAuthority: s.addrs[0].String(),
+		Transferable:  true,
+	}, class.Permissions)
+
+	_, err = s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Creator: s.addrs[1].String(),
+		Id:      testClassID,
+	})
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+}
+
+func (s *TestSuite) TestUpdateClassMsg() {
+	err := s.nftKeeper.SaveClass(s.ctx, ExpClass)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Creator: s.addrs[0].String(),
+		Id:      "fixed",
+	})
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Creator:   s.addrs[0].String(),
+		Id:        "updatable",
+		Updatable: true,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgUpdateClass
+		expErr error
+	}{
+		{
+			name:   "class not exists",
+			req:    &nft.MsgUpdateClass{Creator: s.addrs[0].String(), Id: "unknown"},
+			expErr: nft.ErrClassNotExists,
+		},
+		{
+			name:   "class without permissions",
+			req:    &nft.MsgUpdateClass{Creator: s.addrs[0].String(), Id: testClassID},
+			expErr: nft.ErrClassNotManaged,
+		},
+		{
+			name:   "not the creator",
+			req:    &nft.MsgUpdateClass{Creator: s.addrs[1].String(), Id: "updatable"},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "class not updatable",
+			req:    &nft.MsgUpdateClass{Creator: s.addrs[0].String(), Id: "fixed"},
+			expErr: nft.ErrClassNotUpdatable,
+		},
+		{
+			name: "valid update",
+			req: &nft.MsgUpdateClass{
+				Creator:       s.addrs[0].String(),
+				Id:            "updatable",
+				Name:          testClassName,
+				MintAuthority: s.addrs[1].String(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateClass(s.ctx, tc.req)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			class, _ := s.nftKeeper.GetClass(s.ctx, tc.req.Id)
+			s.Require().Equal(tc.req.Name, class.Name)
+			s.Require().Equal(tc.req.MintAuthority, class.Permissions.MintAuthority)
+			s.Require().Equal(s.addrs[0].String(), class.Permissions.Creator)
+		})
+	}
+}
+
+func (s *TestSuite) TestMintBurnUpdateMsg() {
+	err := s.nftKeeper.SaveClass(s.ctx, ExpClass)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Creator:       s.addrs[0].String(),
+		Id:            "managed",
+		MintAuthority: s.addrs[1].String(),
+		Transferable:  true,
+		Updatable:     true,
+	})
+	s.Require().NoError(err)
+
+	// classes without permissions can only be managed through the keeper
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.addrs[0].String(),
+		ClassId:  testClassID,
+		Id:       testID,
+		Receiver: s.addrs[0].String(),
+	})
+	s.Require().ErrorIs(err, nft.ErrClassNotManaged)
+
+	// only the mint authority may mint
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.addrs[0].String(),
+		ClassId:  "managed",
+		Id:       testID,
+		Receiver: s.addrs[2].String(),
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.addrs[1].String(),
+		ClassId:  "managed",
+		Id:       testID,
+		Uri:      testURI,
+		Receiver: s.addrs[2].String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, "managed", testID))
+
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.addrs[1].String(),
+		ClassId:  "managed",
+		Id:       testID,
+		Receiver: s.addrs[2].String(),
+	})
+	s.Require().ErrorIs(err, nft.ErrNFTExists)
+
+	// only the mint authority may update
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{
+		Updater: s.addrs[2].String(),
+		ClassId: "managed",
+		Id:      testID,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{
+		Updater: s.addrs[1].String(),
+		ClassId: "managed",
+		Id:      testID,
+		Uri:     "updated uri",
+		UriHash: testURIHash,
+	})
+	s.Require().NoError(err)
+	token, has := s.nftKeeper.GetNFT(s.ctx, "managed", testID)
+	s.Require().True(has)
+	s.Require().Equal("updated uri", token.Uri)
+	s.Require().Equal(testURIHash, token.UriHash)
+
+	// only the owner may burn
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{
+		Owner:   s.addrs[1].String(),
+		ClassId: "managed",
+		Id:      testID,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{
+		Owner:   s.addrs[2].String(),
+		ClassId: "managed",
+		Id:      testID,
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, "managed", testID))
+	s.Require().Equal(uint64(0), s.nftKeeper.GetTotalSupply(s.ctx, "managed"))
+}
@@ -17,14 +17,17 @@ func (k Keeper) Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) er
 		return sdkerrors.Wrap(nft.ErrNFTExists, token.Id)
 	}
 
-	k.mintWithNoCheck(ctx, token, receiver)
-	return nil
+	return k.mintWithNoCheck(ctx, token, receiver)
 }
 
 // mintWithNoCheck defines a method for minting a new nft
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it.
-func (k Keeper) mintWithNoCheck(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) {
+func (k Keeper) mintWithNoCheck(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	if err := k.Hooks().BeforeMint(ctx, token.ClassId, token.Id, receiver); err != nil {
+		return err
+	}
+
 	k.setNFT(ctx, token)
 	k.setOwner(ctx, token.ClassId, token.Id, receiver)
 	k.incrTotalSupply(ctx, token.ClassId)
@@ -34,6 +37,7 @@ func (k Keeper) mintWithNoCheck(ctx sdk.Context, token nft.NFT, receiver sdk.Acc
 		Id:      token.Id,
 		Owner:   receiver.String(),
 	})
+	return k.Hooks().AfterMint(ctx, token.ClassId, token.Id, receiver)
 }
 
 // Burn defines a method for burning a nft from a specific account.
@@ -47,8 +51,7 @@ func (k Keeper) Burn(ctx sdk.Context, classID string, nftID string) error {
 		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
-	k.burnWithNoCheck(ctx, classID, nftID)
-	return nil
+	return k.burnWithNoCheck(ctx, classID, nftID)
 }
 
 // burnWithNoCheck defines a method for burning a nft from a specific account.
@@ -56,6 +59,10 @@ func (k Keeper) Burn(ctx sdk.Context, classID string, nftID string) error {
 // The upper-layer application needs to check it when it needs to use it
 func (k Keeper) burnWithNoCheck(ctx sdk.Context, classID string, nftID string) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	if err := k.Hooks().BeforeBurn(ctx, classID, nftID, owner); err != nil {
+		return err
+	}
+
 	nftStore := k.getNFTStore(ctx, classID)
 	nftStore.Delete([]byte(nftID))
 
@@ -66,7 +73,7 @@ func (k Keeper) burnWithNoCheck(ctx sdk.Context, classID string, nftID string) e
 		Id:      nftID,
 		Owner:   owner.String(),
 	})
-	return nil
+	return k.Hooks().AfterBurn(ctx, classID, nftID, owner)
 }
 
 // Update defines a method for updating an exist nft
@@ -105,8 +112,7 @@ func (k Keeper) Transfer(ctx sdk.Context,
 		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
-	k.transferWithNoCheck(ctx, classID, nftID, receiver)
-	return nil
+	return k.transferWithNoCheck(ctx, classID, nftID, receiver)
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
@@ -118,9 +124,13 @@ func (k Keeper) transferWithNoCheck(ctx sdk.Context,
 	receiver sdk.AccAddress,
 ) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	if err := k.Hooks().BeforeTransfer(ctx, classID, nftID, owner, receiver); err != nil {
+		return err
+	}
+
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
-	return nil
+	return k.Hooks().AfterTransfer(ctx, classID, nftID, owner, receiver)
 }
 
 // GetNFT returns the nft information of the specified classID and nftID
@@ -22,7 +22,9 @@ func (k Keeper) BatchMint(ctx sdk.Context,
 		}
 
 		checked[token.ClassId] = true
-		k.mintWithNoCheck(ctx, token, receiver)
+		if err := k.mintWithNoCheck(ctx, token, receiver); err != nil {
+			return err
+		}
 	}
 	return nil
 }
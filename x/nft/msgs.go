@@ -9,10 +9,22 @@ import (
 
 const (
 	// TypeMsgSend nft message types
-	TypeMsgSend = "send"
+	TypeMsgSend        = "send"
+	TypeMsgCreateClass = "create_class"
+	TypeMsgUpdateClass = "update_class"
+	TypeMsgMint        = "mint"
+	TypeMsgBurn        = "burn"
+	TypeMsgUpdateNFT   = "update_nft"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgCreateClass{}
+	_ sdk.Msg = &MsgUpdateClass{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgUpdateNFT{}
+)
 
 // ValidateBasic implements the Msg.ValidateBasic method.
 func (m MsgSend) ValidateBasic() error {
@@ -41,3 +53,133 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgCreateClass) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", m.Creator)
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.MintAuthority) > 0 {
+		if _, err := sdk.AccAddressFromBech32(m.MintAuthority); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid mint authority address (%s)", m.MintAuthority)
+		}
+	}
+
+	if m.RoyaltyInfo != nil {
+		if err := m.RoyaltyInfo.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCreateClass.
+func (m MsgCreateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateClass) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", m.Creator)
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.MintAuthority) > 0 {
+		if _, err := sdk.AccAddressFromBech32(m.MintAuthority); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid mint authority address (%s)", m.MintAuthority)
+		}
+	}
+
+	if m.RoyaltyInfo != nil {
+		if err := m.RoyaltyInfo.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateClass.
+func (m MsgUpdateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Minter); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", m.Minter)
+	}
+
+	if len(m.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", m.Receiver)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgMint.
+func (m MsgMint) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Minter)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+
+	if len(m.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgBurn.
+func (m MsgBurn) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Updater); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid updater address (%s)", m.Updater)
+	}
+
+	if len(m.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateNFT.
+func (m MsgUpdateNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Updater)
+	return []sdk.AccAddress{signer}
+}
@@ -5,7 +5,10 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -39,6 +42,13 @@ type Class struct {
 	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is the app specific metadata of the NFT class. Optional
 	Data *types.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// permissions defines who may manage the class through the Msg service and how its nfts
+	// may be used. Classes saved directly through the keeper leave it unset and can only be
+	// managed by the module that created them. Optional
+	Permissions *ClassPermissions `protobuf:"bytes,8,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	// royalty_info defines the royalty marketplaces should pay to the creator on secondary sales of the class
+	// nfts. Optional
+	RoyaltyInfo *RoyaltyInfo `protobuf:"bytes,9,opt,name=royalty_info,json=royaltyInfo,proto3" json:"royalty_info,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -123,6 +133,141 @@ func (m *Class) GetData() *types.Any {
 	return nil
 }
 
+func (m *Class) GetPermissions() *ClassPermissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *Class) GetRoyaltyInfo() *RoyaltyInfo {
+	if m != nil {
+		return m.RoyaltyInfo
+	}
+	return nil
+}
+
+// ClassPermissions defines the class-level permissions enforced by the nft Msg service.
+type ClassPermissions struct {
+	// creator is the address of the account that created the class. Only the creator may update the class
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// mint_authority is the address allowed to mint nfts of the class and to update their metadata
+	MintAuthority string `protobuf:"bytes,2,opt,name=mint_authority,json=mintAuthority,proto3" json:"mint_authority,omitempty"`
+	// transferable defines whether owners may send nfts of the class to other accounts
+	Transferable bool `protobuf:"varint,3,opt,name=transferable,proto3" json:"transferable,omitempty"`
+	// updatable defines whether the class and its nfts may be updated after creation
+	Updatable bool `protobuf:"varint,4,opt,name=updatable,proto3" json:"updatable,omitempty"`
+}
+
+func (m *ClassPermissions) Reset()         { *m = ClassPermissions{} }
+func (m *ClassPermissions) String() string { return proto.CompactTextString(m) }
+func (*ClassPermissions) ProtoMessage()    {}
+func (*ClassPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{1}
+}
+func (m *ClassPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassPermissions.Merge(m, src)
+}
+func (m *ClassPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassPermissions proto.InternalMessageInfo
+
+func (m *ClassPermissions) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ClassPermissions) GetMintAuthority() string {
+	if m != nil {
+		return m.MintAuthority
+	}
+	return ""
+}
+
+func (m *ClassPermissions) GetTransferable() bool {
+	if m != nil {
+		return m.Transferable
+	}
+	return false
+}
+
+func (m *ClassPermissions) GetUpdatable() bool {
+	if m != nil {
+		return m.Updatable
+	}
+	return false
+}
+
+// RoyaltyInfo defines the royalty paid on secondary sales of the nfts of a class, similar to ERC2981.
+type RoyaltyInfo struct {
+	// receiver is the address receiving the royalty
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// fraction is the share of the sale price paid as royalty, between 0 and 1
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+}
+
+func (m *RoyaltyInfo) Reset()         { *m = RoyaltyInfo{} }
+func (m *RoyaltyInfo) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyInfo) ProtoMessage()    {}
+func (*RoyaltyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *RoyaltyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoyaltyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoyaltyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoyaltyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyInfo.Merge(m, src)
+}
+func (m *RoyaltyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoyaltyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoyaltyInfo proto.InternalMessageInfo
+
+func (m *RoyaltyInfo) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
 // NFT defines the NFT.
 type NFT struct {
 	// class_id associated with the NFT, similar to the contract address of ERC721
@@ -141,7 +286,7 @@ func (m *NFT) Reset()         { *m = NFT{} }
 func (m *NFT) String() string { return proto.CompactTextString(m) }
 func (*NFT) ProtoMessage()    {}
 func (*NFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb8ebf8e8053172c, []int{1}
+	return fileDescriptor_eb8ebf8e8053172c, []int{3}
 }
 func (m *NFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,33 +352,50 @@ func (m *NFT) GetData() *types.Any {
 
 func init() {
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
+	proto.RegisterType((*ClassPermissions)(nil), "cosmos.nft.v1beta1.ClassPermissions")
+	proto.RegisterType((*RoyaltyInfo)(nil), "cosmos.nft.v1beta1.RoyaltyInfo")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x97, 0xb6, 0xdb, 0x34, 0x03, 0x91, 0x20, 0x92, 0x89, 0x84, 0xb1, 0xd3, 0x2e, 0x26,
-	0x4c, 0xaf, 0x5e, 0x54, 0x10, 0xbd, 0x78, 0x18, 0x9e, 0xbc, 0x8c, 0xb4, 0xe9, 0xd6, 0xe0, 0xda,
-	0x8c, 0x26, 0x15, 0xfb, 0x04, 0x5e, 0x7d, 0x20, 0x1f, 0xc0, 0xe3, 0x8e, 0x1e, 0xa5, 0x7d, 0x11,
-	0x49, 0x5a, 0x87, 0x87, 0x81, 0xa7, 0xfc, 0xf3, 0x7d, 0x1f, 0x7f, 0x7e, 0x1f, 0x7f, 0x78, 0x1a,
-	0x29, 0x9d, 0x2a, 0xcd, 0xb2, 0x85, 0x61, 0x2f, 0xd3, 0x30, 0x36, 0x7c, 0x6a, 0x67, 0xba, 0xce,
-	0x95, 0x51, 0x08, 0x35, 0x2e, 0xb5, 0x4a, 0xeb, 0x9e, 0x0c, 0x97, 0x4a, 0x2d, 0x57, 0x31, 0x73,
-	0x89, 0xb0, 0x58, 0x30, 0x9e, 0x95, 0x4d, 0x7c, 0xfc, 0x01, 0x60, 0xf7, 0x66, 0xc5, 0xb5, 0x46,
-	0x07, 0xd0, 0x93, 0x02, 0x83, 0x11, 0x98, 0xec, 0xcf, 0x3c, 0x29, 0x10, 0x82, 0x41, 0xc6, 0xd3,
-	0x18, 0x7b, 0x4e, 0x71, 0x33, 0x3a, 0x86, 0x3d, 0x5d, 0xa6, 0xa1, 0x5a, 0x61, 0xdf, 0xa9, 0xed,
-	0x0f, 0x8d, 0xe0, 0x40, 0xc4, 0x3a, 0xca, 0xe5, 0xda, 0x48, 0x95, 0xe1, 0xc0, 0x99, 0x7f, 0x25,
-	0x74, 0x08, 0xfd, 0x22, 0x97, 0xb8, 0xeb, 0x1c, 0x3b, 0xa2, 0x21, 0xdc, 0x2b, 0x72, 0x39, 0x4f,
-	0xb8, 0x4e, 0x70, 0xcf, 0xc9, 0xfd, 0x22, 0x97, 0x77, 0x5c, 0x27, 0x68, 0x02, 0x03, 0xc1, 0x0d,
-	0xc7, 0xfd, 0x11, 0x98, 0x0c, 0xce, 0x8f, 0x68, 0x83, 0x4f, 0x7f, 0xf1, 0xe9, 0x55, 0x56, 0xce,
-	0x5c, 0x62, 0xfc, 0x06, 0xa0, 0xff, 0x70, 0xfb, 0x68, 0x97, 0x45, 0xb6, 0xc5, 0x7c, 0x5b, 0xa1,
-	0xef, 0xfe, 0xf7, 0xa2, 0xed, 0xe5, 0x6d, 0x7b, 0xb5, 0x24, 0xfe, 0x6e, 0x92, 0x60, 0x37, 0x09,
-	0xfc, 0x8f, 0xe4, 0xfa, 0xf2, 0xb3, 0x22, 0x60, 0x53, 0x11, 0xf0, 0x5d, 0x11, 0xf0, 0x5e, 0x93,
-	0xce, 0xa6, 0x26, 0x9d, 0xaf, 0x9a, 0x74, 0x9e, 0xc6, 0x4b, 0x69, 0x92, 0x22, 0xa4, 0x91, 0x4a,
-	0x59, 0x7b, 0xba, 0xe6, 0x39, 0xd3, 0xe2, 0x99, 0xbd, 0xda, 0xdb, 0x85, 0x3d, 0xb7, 0xf1, 0xe2,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x94, 0x4b, 0x2c, 0xc3, 0xdc, 0x01, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcf, 0x6a, 0xdb, 0x4e,
+	0x10, 0xc7, 0x2d, 0xd9, 0x89, 0xed, 0x71, 0x7e, 0x21, 0x2c, 0xe6, 0x87, 0x1c, 0x82, 0x6c, 0x44,
+	0x29, 0xbe, 0x58, 0x22, 0x69, 0x8f, 0x81, 0x62, 0x37, 0x84, 0xe6, 0x52, 0x8a, 0xda, 0x43, 0xe9,
+	0xc5, 0xac, 0xa4, 0x95, 0xbc, 0xd4, 0xd2, 0x9a, 0xdd, 0x55, 0xa8, 0x9e, 0xa0, 0xd7, 0xbe, 0x40,
+	0xdf, 0x22, 0x0f, 0xe1, 0xde, 0x42, 0x4e, 0xa5, 0x87, 0x50, 0xec, 0x17, 0x29, 0x5a, 0xc9, 0x7f,
+	0xda, 0x9a, 0xfa, 0xe4, 0x99, 0xef, 0x7c, 0x66, 0x96, 0x19, 0x7f, 0x05, 0x67, 0x3e, 0x13, 0x31,
+	0x13, 0x4e, 0x12, 0x4a, 0xe7, 0xf6, 0xdc, 0x23, 0x12, 0x9f, 0xe7, 0xb1, 0x3d, 0xe3, 0x4c, 0x32,
+	0x84, 0x8a, 0xaa, 0x9d, 0x2b, 0x65, 0xf5, 0xb4, 0x13, 0x31, 0x16, 0x4d, 0x89, 0xa3, 0x08, 0x2f,
+	0x0d, 0x1d, 0x9c, 0x64, 0x05, 0x7e, 0xda, 0x29, 0xf0, 0xb1, 0xca, 0x9c, 0xb2, 0xb7, 0x28, 0xb5,
+	0x23, 0x16, 0xb1, 0x42, 0xcf, 0xa3, 0x42, 0xb5, 0xe6, 0x3a, 0x1c, 0xbc, 0x9c, 0x62, 0x21, 0xd0,
+	0x31, 0xe8, 0x34, 0x30, 0xb4, 0x9e, 0xd6, 0x6f, 0xba, 0x3a, 0x0d, 0x10, 0x82, 0x5a, 0x82, 0x63,
+	0x62, 0xe8, 0x4a, 0x51, 0x31, 0xfa, 0x1f, 0x0e, 0x45, 0x16, 0x7b, 0x6c, 0x6a, 0x54, 0x95, 0x5a,
+	0x66, 0xa8, 0x07, 0xad, 0x80, 0x08, 0x9f, 0xd3, 0x99, 0xa4, 0x2c, 0x31, 0x6a, 0xaa, 0xb8, 0x2d,
+	0xa1, 0x13, 0xa8, 0xa6, 0x9c, 0x1a, 0x07, 0xaa, 0x92, 0x87, 0xa8, 0x03, 0x8d, 0x94, 0xd3, 0xf1,
+	0x04, 0x8b, 0x89, 0x71, 0xa8, 0xe4, 0x7a, 0xca, 0xe9, 0x2b, 0x2c, 0x26, 0xa8, 0x0f, 0xb5, 0x00,
+	0x4b, 0x6c, 0xd4, 0x7b, 0x5a, 0xbf, 0x75, 0xd1, 0xb6, 0x8b, 0x7d, 0xed, 0xd5, 0xbe, 0xf6, 0x30,
+	0xc9, 0x5c, 0x45, 0xa0, 0x6b, 0x68, 0xcd, 0x08, 0x8f, 0xa9, 0x10, 0x94, 0x25, 0xc2, 0x68, 0xa8,
+	0x86, 0x27, 0xf6, 0xdf, 0x47, 0xb3, 0xd5, 0x92, 0x6f, 0x36, 0xac, 0xbb, 0xdd, 0x88, 0x46, 0x70,
+	0xc4, 0x59, 0x86, 0xa7, 0x32, 0x1b, 0xd3, 0x24, 0x64, 0x46, 0x53, 0x0d, 0xea, 0xee, 0x1a, 0xe4,
+	0x16, 0xdc, 0x4d, 0x12, 0x32, 0xb7, 0xc5, 0x37, 0x89, 0xf5, 0x4d, 0x83, 0x93, 0x3f, 0x5f, 0x41,
+	0x17, 0x50, 0xf7, 0x39, 0xc1, 0x92, 0xf1, 0xe2, 0xb4, 0x23, 0xe3, 0xe1, 0x6e, 0xd0, 0x2e, 0xc7,
+	0x0e, 0x83, 0x80, 0x13, 0x21, 0xde, 0x4a, 0x4e, 0x93, 0xc8, 0x5d, 0x81, 0xe8, 0x05, 0x1c, 0xc7,
+	0x34, 0x91, 0x63, 0x9c, 0xca, 0x09, 0xe3, 0x54, 0x66, 0x86, 0xbe, 0xa7, 0xf5, 0xbf, 0x9c, 0x1f,
+	0xae, 0x70, 0x64, 0xc1, 0x91, 0xe4, 0x38, 0x11, 0x21, 0xe1, 0xd8, 0x9b, 0x12, 0xf5, 0x67, 0x35,
+	0xdc, 0xdf, 0x34, 0x74, 0x06, 0xcd, 0x74, 0x96, 0xdf, 0x30, 0x07, 0x6a, 0x0a, 0xd8, 0x08, 0xd6,
+	0x57, 0x0d, 0x5a, 0x5b, 0x8b, 0xa2, 0xe7, 0xd0, 0xe0, 0xc4, 0x27, 0xf4, 0x96, 0xec, 0xdf, 0x63,
+	0x4d, 0xa2, 0xf7, 0xd0, 0x08, 0x39, 0xf6, 0x95, 0x27, 0x8a, 0x15, 0x2e, 0xe7, 0x8f, 0xdd, 0xca,
+	0x8f, 0xc7, 0xee, 0xd3, 0x88, 0xca, 0x49, 0xea, 0xd9, 0x3e, 0x8b, 0x4b, 0x97, 0x96, 0x3f, 0x03,
+	0x11, 0x7c, 0x74, 0x64, 0x36, 0x23, 0xc2, 0xbe, 0x22, 0xfe, 0xc3, 0xdd, 0x00, 0xca, 0x37, 0xae,
+	0x88, 0xef, 0xae, 0xa7, 0x59, 0x9f, 0x35, 0xa8, 0xbe, 0xbe, 0x7e, 0x97, 0x9b, 0xc8, 0xcf, 0x4f,
+	0x3e, 0x5e, 0x5b, 0xb7, 0xae, 0xf2, 0x9b, 0xa0, 0xf4, 0xb3, 0xbe, 0xf6, 0x73, 0xe9, 0xc0, 0xea,
+	0x6e, 0x07, 0xd6, 0x76, 0x3b, 0x10, 0xf6, 0x39, 0x70, 0x74, 0x39, 0x5f, 0x98, 0xda, 0xfd, 0xc2,
+	0xd4, 0x7e, 0x2e, 0x4c, 0xed, 0xcb, 0xd2, 0xac, 0xdc, 0x2f, 0xcd, 0xca, 0xf7, 0xa5, 0x59, 0xf9,
+	0x60, 0xfd, 0x73, 0xc7, 0x4f, 0xf9, 0x47, 0xee, 0x1d, 0xaa, 0x89, 0xcf, 0x7e, 0x0d, 0x00, 0x72,
+	0xa7, 0x6f, 0x7c, 0x05, 0x04, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -256,6 +418,30 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RoyaltyInfo != nil {
+		{
+			size, err := m.RoyaltyInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Permissions != nil {
+		{
+			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -313,6 +499,103 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Updatable {
+		i--
+		if m.Updatable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Transferable {
+		i--
+		if m.Transferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MintAuthority) > 0 {
+		i -= len(m.MintAuthority)
+		copy(dAtA[i:], m.MintAuthority)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.MintAuthority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoyaltyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoyaltyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoyaltyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -421,6 +704,52 @@ func (m *Class) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.RoyaltyInfo != nil {
+		l = m.RoyaltyInfo.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.MintAuthority)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Transferable {
+		n += 2
+	}
+	if m.Updatable {
+		n += 2
+	}
+	return n
+}
+
+func (m *RoyaltyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovNft(uint64(l))
 	return n
 }
 
@@ -716,6 +1045,348 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &ClassPermissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RoyaltyInfo == nil {
+				m.RoyaltyInfo = &RoyaltyInfo{}
+			}
+			if err := m.RoyaltyInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transferable = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updatable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Updatable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoyaltyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoyaltyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoyaltyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -0,0 +1,26 @@
+package nft
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic checks that the royalty receiver is a valid address and the fraction is within [0, 1].
+func (r RoyaltyInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Receiver); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid royalty receiver address (%s)", r.Receiver)
+	}
+
+	if r.Fraction.IsNil() || r.Fraction.IsNegative() || r.Fraction.GT(sdk.OneDec()) {
+		return errors.Wrapf(ErrInvalidRoyalty, "royalty fraction must be between 0 and 1, got %s", r.Fraction)
+	}
+	return nil
+}
+
+// RoyaltyAmount returns the royalty owed for a sale at the given price, truncated to an integer amount.
+func (r RoyaltyInfo) RoyaltyAmount(salePrice sdk.Coin) sdk.Coin {
+	amount := sdk.NewDecFromInt(salePrice.Amount).Mul(r.Fraction).TruncateInt()
+	return sdk.NewCoin(salePrice.Denom, amount)
+}
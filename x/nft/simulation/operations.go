@@ -18,13 +18,30 @@ import (
 
 const (
 	// OpWeightMsgSend Simulation operation weights constants
-	OpWeightMsgSend = "op_weight_msg_send" //nolint:gosec
+	OpWeightMsgSend        = "op_weight_msg_send"         //nolint:gosec
+	OpWeightMsgCreateClass = "op_weight_msg_create_class" //nolint:gosec
+	OpWeightMsgUpdateClass = "op_weight_msg_update_class" //nolint:gosec
+	OpWeightMsgMint        = "op_weight_msg_mint"         //nolint:gosec
+	OpWeightMsgBurn        = "op_weight_msg_burn"         //nolint:gosec
+	OpWeightMsgUpdateNFT   = "op_weight_msg_update_nft"   //nolint:gosec
 
 	// WeightSend nft operations weights
-	WeightSend = 100
+	WeightSend        = 100
+	WeightCreateClass = 20
+	WeightUpdateClass = 10
+	WeightMint        = 50
+	WeightBurn        = 10
+	WeightUpdateNFT   = 20
 )
 
-var TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
+var (
+	TypeMsgSend        = sdk.MsgTypeURL(&nft.MsgSend{})
+	TypeMsgCreateClass = sdk.MsgTypeURL(&nft.MsgCreateClass{})
+	TypeMsgUpdateClass = sdk.MsgTypeURL(&nft.MsgUpdateClass{})
+	TypeMsgMint        = sdk.MsgTypeURL(&nft.MsgMint{})
+	TypeMsgBurn        = sdk.MsgTypeURL(&nft.MsgBurn{})
+	TypeMsgUpdateNFT   = sdk.MsgTypeURL(&nft.MsgUpdateNFT{})
+)
 
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
@@ -35,7 +52,14 @@ func WeightedOperations(
 	bk nft.BankKeeper,
 	k keeper.Keeper,
 ) simulation.WeightedOperations {
-	var weightMsgSend int
+	var (
+		weightMsgSend        int
+		weightMsgCreateClass int
+		weightMsgUpdateClass int
+		weightMsgMint        int
+		weightMsgBurn        int
+		weightMsgUpdateNFT   int
+	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
 		func(_ *rand.Rand) {
@@ -43,10 +67,61 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClass, &weightMsgCreateClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClass = WeightCreateClass
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateClass, &weightMsgUpdateClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateClass = WeightUpdateClass
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = WeightMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = WeightBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateNFT, &weightMsgUpdateNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateNFT = WeightUpdateNFT
+		},
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
-			SimulateMsgSend(codec.NewProtoCodec(registry), ak, bk, k),
+			SimulateMsgSend(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateClass,
+			SimulateMsgCreateClass(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateClass,
+			SimulateMsgUpdateClass(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateNFT,
+			SimulateMsgUpdateNFT(protoCdc, ak, bk, k),
 		),
 	}
 }
@@ -116,6 +191,193 @@ func SimulateMsgSend(
 	}
 }
 
+// SimulateMsgCreateClass generates a MsgCreateClass with random values.
+func SimulateMsgCreateClass(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+
+		classID := simtypes.RandStringOfLength(r, 10)
+		if k.HasClass(ctx, classID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgCreateClass, "class already exists"), nil, nil
+		}
+
+		msg := &nft.MsgCreateClass{
+			Creator:      creator.Address.String(),
+			Id:           classID,
+			Name:         simtypes.RandStringOfLength(r, 10),
+			Symbol:       simtypes.RandStringOfLength(r, 5),
+			Description:  simtypes.RandStringOfLength(r, 10),
+			Uri:          simtypes.RandStringOfLength(r, 10),
+			Transferable: r.Intn(2) == 0,
+			Updatable:    r.Intn(2) == 0,
+		}
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, creator, msg, TypeMsgCreateClass)
+	}
+}
+
+// SimulateMsgUpdateClass generates a MsgUpdateClass with random values.
+func SimulateMsgUpdateClass(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, creator, found := randManagedClass(ctx, r, k, accs, func(p *nft.ClassPermissions) string {
+			if !p.Updatable {
+				return ""
+			}
+			return p.Creator
+		})
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateClass, "no updatable class created by a simulation account"), nil, nil
+		}
+
+		msg := &nft.MsgUpdateClass{
+			Creator:     creator.Address.String(),
+			Id:          class.Id,
+			Name:        simtypes.RandStringOfLength(r, 10),
+			Symbol:      class.Symbol,
+			Description: simtypes.RandStringOfLength(r, 10),
+			Uri:         simtypes.RandStringOfLength(r, 10),
+		}
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, creator, msg, TypeMsgUpdateClass)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, minter, found := randManagedClass(ctx, r, k, accs, func(p *nft.ClassPermissions) string {
+			return p.MintAuthority
+		})
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, "no class mintable by a simulation account"), nil, nil
+		}
+
+		nftID := simtypes.RandStringOfLength(r, 10)
+		if k.HasNFT(ctx, class.Id, nftID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, "nft already exists"), nil, nil
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		msg := &nft.MsgMint{
+			Minter:   minter.Address.String(),
+			ClassId:  class.Id,
+			Id:       nftID,
+			Uri:      simtypes.RandStringOfLength(r, 10),
+			Receiver: receiver.Address.String(),
+		}
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, minter, msg, TypeMsgMint)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		n, found := randManagedNFT(ctx, r, k, owner.Address)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgBurn, "no nft of a managed class owned by the account"), nil, nil
+		}
+
+		msg := &nft.MsgBurn{
+			Owner:   owner.Address.String(),
+			ClassId: n.ClassId,
+			Id:      n.Id,
+		}
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, owner, msg, TypeMsgBurn)
+	}
+}
+
+// SimulateMsgUpdateNFT generates a MsgUpdateNFT with random values.
+func SimulateMsgUpdateNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, updater, found := randManagedClass(ctx, r, k, accs, func(p *nft.ClassPermissions) string {
+			if !p.Updatable {
+				return ""
+			}
+			return p.MintAuthority
+		})
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateNFT, "no updatable class mintable by a simulation account"), nil, nil
+		}
+
+		ns := k.GetNFTsOfClass(ctx, class.Id)
+		if len(ns) == 0 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateNFT, "class has no nfts"), nil, nil
+		}
+		n := ns[r.Intn(len(ns))]
+
+		msg := &nft.MsgUpdateNFT{
+			Updater: updater.Address.String(),
+			ClassId: n.ClassId,
+			Id:      n.Id,
+			Uri:     simtypes.RandStringOfLength(r, 10),
+		}
+		return genAndDeliverTx(r, app, ctx, cdc, ak, bk, updater, msg, TypeMsgUpdateNFT)
+	}
+}
+
+// genAndDeliverTx signs the msg with the account's key and delivers it with random fees.
+func genAndDeliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	signer simtypes.Account,
+	msg sdk.Msg,
+	msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           tx.NewTxConfig(cdc, tx.DefaultSignModes),
+		Cdc:             cdc,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      signer,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      nft.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
 // randNFT picks a random NFT from a class belonging to the specified owner(minter).
 func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
 	c, err := randClass(ctx, r, k)
@@ -139,9 +401,14 @@ func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddre
 	return n, nil
 }
 
-// randClass picks a random Class.
+// randClass picks a random Class whose nfts can be sent.
 func randClass(ctx sdk.Context, r *rand.Rand, k keeper.Keeper) (nft.Class, error) {
-	classes := k.GetClasses(ctx)
+	var classes []*nft.Class
+	for _, c := range k.GetClasses(ctx) {
+		if c.Permissions == nil || c.Permissions.Transferable {
+			classes = append(classes, c)
+		}
+	}
 	if len(classes) == 0 {
 		c := nft.Class{
 			Id:          simtypes.RandStringOfLength(r, 10),
@@ -158,3 +425,54 @@ func randClass(ctx sdk.Context, r *rand.Rand, k keeper.Keeper) (nft.Class, error
 	}
 	return *classes[r.Intn(len(classes))], nil
 }
+
+// randManagedClass picks a random class created through the Msg service for which the
+// address returned by signerOf belongs to one of the simulation accounts.
+func randManagedClass(
+	ctx sdk.Context,
+	r *rand.Rand,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+	signerOf func(*nft.ClassPermissions) string,
+) (nft.Class, simtypes.Account, bool) {
+	type candidate struct {
+		class  nft.Class
+		signer simtypes.Account
+	}
+
+	var candidates []candidate
+	for _, c := range k.GetClasses(ctx) {
+		if c.Permissions == nil {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(signerOf(c.Permissions))
+		if err != nil {
+			continue
+		}
+		if acc, found := simtypes.FindAccount(accs, addr); found {
+			candidates = append(candidates, candidate{class: *c, signer: acc})
+		}
+	}
+	if len(candidates) == 0 {
+		return nft.Class{}, simtypes.Account{}, false
+	}
+
+	picked := candidates[r.Intn(len(candidates))]
+	return picked.class, picked.signer, true
+}
+
+// randManagedNFT picks a random nft owned by the specified owner from a class created through
+// the Msg service.
+func randManagedNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, owner sdk.AccAddress) (nft.NFT, bool) {
+	var ns []nft.NFT
+	for _, c := range k.GetClasses(ctx) {
+		if c.Permissions == nil {
+			continue
+		}
+		ns = append(ns, k.GetNFTsOfClassByOwner(ctx, c.Id, owner)...)
+	}
+	if len(ns) == 0 {
+		return nft.NFT{}, false
+	}
+	return ns[r.Intn(len(ns))], true
+}
@@ -77,6 +77,11 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		opMsgName  string
 	}{
 		{simulation.WeightSend, simulation.TypeMsgSend, simulation.TypeMsgSend},
+		{simulation.WeightCreateClass, simulation.TypeMsgCreateClass, simulation.TypeMsgCreateClass},
+		{simulation.WeightUpdateClass, nft.ModuleName, simulation.TypeMsgUpdateClass},
+		{simulation.WeightMint, simulation.TypeMsgMint, simulation.TypeMsgMint},
+		{simulation.WeightBurn, nft.ModuleName, simulation.TypeMsgBurn},
+		{simulation.WeightUpdateNFT, nft.ModuleName, simulation.TypeMsgUpdateNFT},
 	}
 
 	for i, w := range weightedOps {
@@ -135,6 +140,66 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+func (suite *SimTestSuite) TestSimulateMsgCreateClass() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	// begin new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	op := simulation.SimulateMsgCreateClass(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgCreateClass
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(simulation.TypeMsgCreateClass, sdk.MsgTypeURL(&msg))
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMint() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	err := suite.nftKeeper.SaveClass(suite.ctx, nft.Class{
+		Id: "kitty",
+		Permissions: &nft.ClassPermissions{
+			Creator:       accounts[0].Address.String(),
+			MintAuthority: accounts[0].Address.String(),
+		},
+	})
+	suite.Require().NoError(err)
+
+	// begin new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	op := simulation.SimulateMsgMint(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgMint
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal("kitty", msg.ClassId)
+	suite.Require().Equal(accounts[0].Address.String(), msg.Minter)
+	suite.Require().Len(futureOperations, 0)
+}
+
 func TestSimTestSuite(t *testing.T) {
 	suite.Run(t, new(SimTestSuite))
 }
@@ -38,6 +38,14 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, offense := range data.DowntimeOffenses {
+		address, err := sdk.ConsAddressFromBech32(offense.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetDowntimeOffense(ctx, address, offense)
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -67,5 +75,11 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	downtimeOffenses := make([]types.DowntimeOffense, 0)
+	keeper.IterateAllDowntimeOffenses(ctx, func(offense types.DowntimeOffense) (stop bool) {
+		downtimeOffenses = append(downtimeOffenses, offense)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, downtimeOffenses)
 }
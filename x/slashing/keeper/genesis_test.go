@@ -26,11 +26,15 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 
 	keeper.SetValidatorSigningInfo(ctx, consAddr1, info1)
 	keeper.SetValidatorSigningInfo(ctx, consAddr2, info2)
+	offense := types.NewDowntimeOffense(consAddr1, int64(2), time.Now().UTC(), time.Now().UTC().Add(100000000000),
+		testutil.TestParams().SlashFractionDowntime, int64(1))
+	keeper.SetDowntimeOffense(ctx, consAddr1, offense)
 	genesisState := keeper.ExportGenesis(ctx)
 
 	require.Equal(genesisState.Params, testutil.TestParams())
 	require.Len(genesisState.SigningInfos, 2)
 	require.Equal(genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Equal([]types.DowntimeOffense{offense}, genesisState.DowntimeOffenses)
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	keeper.Tombstone(ctx, consAddr1)
@@ -54,4 +58,11 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	newInfo2, _ := keeper.GetValidatorSigningInfo(ctx, consAddr2)
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
+
+	var offenses []types.DowntimeOffense
+	keeper.IterateDowntimeOffenses(ctx, consAddr1, func(offense types.DowntimeOffense) bool {
+		offenses = append(offenses, offense)
+		return false
+	})
+	require.Equal([]types.DowntimeOffense{offense}, offenses)
 }
@@ -75,3 +75,38 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// DowntimeOffenses returns the downtime offense history of a specific validator.
+func (k Keeper) DowntimeOffenses(c context.Context, req *types.QueryDowntimeOffensesRequest) (*types.QueryDowntimeOffensesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var offenses []types.DowntimeOffense
+
+	offenseStore := prefix.NewStore(store, types.DowntimeOffensesPrefixKey(consAddr))
+	pageRes, err := query.Paginate(offenseStore, req.Pagination, func(key []byte, value []byte) error {
+		var offense types.DowntimeOffense
+		err := k.cdc.Unmarshal(value, &offense)
+		if err != nil {
+			return err
+		}
+		offenses = append(offenses, offense)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDowntimeOffensesResponse{Offenses: offenses, Pagination: pageRes}, nil
+}
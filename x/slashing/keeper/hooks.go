@@ -5,6 +5,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -90,3 +91,16 @@ func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new consensus pubkey of a
+// validator and moves its signing info to its new consensus address. The address-pubkey relation
+// of the previous consensus pubkey is kept to handle the evidence of its infractions.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, _ sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := h.k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	h.k.rotateValidatorSigningInfo(ctx, sdk.GetConsAddress(oldPubKey), sdk.GetConsAddress(newPubKey))
+
+	return nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -41,3 +42,56 @@ func (s *KeeperTestSuite) TestAfterValidatorCreatedOrRemoved() {
 	_, err = keeper.GetPubkey(ctx, addr.Bytes())
 	require.Error(err)
 }
+
+func (s *KeeperTestSuite) TestAfterConsensusPubKeyUpdate() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, oldPubKey, _ := testdata.KeyTestPubAddr()
+	_, newPubKey, _ := testdata.KeyTestPubAddr()
+	oldConsAddr, newConsAddr := sdk.GetConsAddress(oldPubKey), sdk.GetConsAddress(newPubKey)
+	valAddr := sdk.ValAddress(oldConsAddr)
+
+	require.NoError(keeper.AddPubkey(ctx, oldPubKey))
+	keeper.Hooks().AfterValidatorBonded(ctx, oldConsAddr, valAddr)
+	keeper.SetValidatorMissedBlockBitArray(ctx, oldConsAddr, 1, true)
+	keeper.SetDowntimeOffense(ctx, oldConsAddr, slashingtypes.DowntimeOffense{
+		Address:       oldConsAddr.String(),
+		Height:        1,
+		Time:          ctx.BlockTime(),
+		JailedUntil:   ctx.BlockTime(),
+		SlashFraction: keeper.SlashFractionDowntime(ctx),
+	})
+
+	require.NoError(keeper.Hooks().AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey))
+
+	// the pubkeys of both consensus addresses are known
+	pubKey, err := keeper.GetPubkey(ctx, newConsAddr.Bytes())
+	require.NoError(err)
+	require.Equal(newPubKey, pubKey)
+	pubKey, err = keeper.GetPubkey(ctx, oldConsAddr.Bytes())
+	require.NoError(err)
+	require.Equal(oldPubKey, pubKey)
+
+	// the signing info, missed blocks and downtime offenses are moved to the new consensus address
+	require.False(keeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+	info, found := keeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(found)
+	require.Equal(newConsAddr.String(), info.Address)
+
+	require.False(keeper.GetValidatorMissedBlockBitArray(ctx, oldConsAddr, 1))
+	require.True(keeper.GetValidatorMissedBlockBitArray(ctx, newConsAddr, 1))
+
+	var offenses []slashingtypes.DowntimeOffense
+	keeper.IterateDowntimeOffenses(ctx, oldConsAddr, func(offense slashingtypes.DowntimeOffense) bool {
+		offenses = append(offenses, offense)
+		return false
+	})
+	require.Empty(offenses)
+	keeper.IterateDowntimeOffenses(ctx, newConsAddr, func(offense slashingtypes.DowntimeOffense) bool {
+		offenses = append(offenses, offense)
+		return false
+	})
+	require.Len(offenses, 1)
+	require.Equal(newConsAddr.String(), offenses[0].Address)
+}
@@ -17,6 +17,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// fetch the validator public key
 	consAddr := sdk.ConsAddress(addr)
 
+	// the blocks following a consensus pubkey rotation are still signed with the previous
+	// consensus pubkey, whose signing info was moved to the new consensus address
+	if !k.HasValidatorSigningInfo(ctx, consAddr) {
+		if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil {
+			if valConsAddr, err := validator.GetConsAddr(); err == nil {
+				consAddr = valConsAddr
+			}
+		}
+	}
+
 	// don't update missed blocks when validator's jailed
 	if k.sk.IsValidatorJailed(ctx, consAddr) {
 		return
@@ -87,7 +97,14 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx), stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			// Repeat offenses within the escalation window of the previous jail are given escalated penalties.
+			params := k.GetParams(ctx)
+			blockTime := ctx.BlockHeader().Time
+			signInfo.RecentJailCount = signInfo.DecayedRecentJailCount(blockTime, params.DowntimeJailEscalationWindow) + 1
+			signInfo.LastJailTime = blockTime
+			jailDuration, slashFraction := params.DowntimeJailPenalties(signInfo.RecentJailCount)
+
+			coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -96,11 +113,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+					sdk.NewAttribute(types.AttributeKeyRecentJailCount, fmt.Sprintf("%d", signInfo.RecentJailCount)),
 				),
 			)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = blockTime.Add(jailDuration)
+			k.SetDowntimeOffense(ctx, consAddr, types.NewDowntimeOffense(consAddr, height, blockTime, signInfo.JailedUntil, slashFraction, signInfo.RecentJailCount))
+
+			// The offenses older than the maximum escalation period no longer affect the penalties and are pruned.
+			k.pruneDowntimeOffenses(ctx, consAddr, blockTime.Add(-params.DowntimeOffenseRetention()))
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -113,8 +135,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", slashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
+				"recent_jail_count", signInfo.RecentJailCount,
 			)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
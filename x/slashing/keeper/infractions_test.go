@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestHandleValidatorSignatureJailEscalation() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	params := testutil.TestParams()
+	params.DowntimeJailEscalationWindow = 24 * time.Hour
+	params.DowntimeJailDurationMultiplier = sdk.NewDec(2)
+	params.SlashFractionDowntimeMultiplier = sdk.NewDec(3)
+	params.MaxDowntimeJailEscalations = 2
+	require.NoError(keeper.SetParams(ctx, params))
+
+	power := int64(100)
+	startTime := ctx.BlockHeader().Time
+	keeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0))
+
+	// the offenses older than the maximum escalation period of 48 hours are pruned
+	testCases := []struct {
+		name            string
+		elapsed         time.Duration
+		expJailCount    int64
+		expJailDuration time.Duration
+		expSlash        sdk.Dec
+		expOffenses     int
+	}{
+		{"first offense", 0, 1, params.DowntimeJailDuration, params.SlashFractionDowntime, 1},
+		{"repeat offense within the window", time.Hour, 2, 2 * params.DowntimeJailDuration, params.SlashFractionDowntime.MulInt64(3), 2},
+		{"second repeat offense within the window", 2 * time.Hour, 3, 4 * params.DowntimeJailDuration, params.SlashFractionDowntime.MulInt64(9), 3},
+		{"escalations are capped", 3 * time.Hour, 4, 4 * params.DowntimeJailDuration, params.SlashFractionDowntime.MulInt64(9), 4},
+		{"recent jail count decays after windows without offense", 3*time.Hour + 48*time.Hour, 3, 4 * params.DowntimeJailDuration, params.SlashFractionDowntime.MulInt64(9), 2},
+		{"recent jail count decays to zero", 3*time.Hour + 200*time.Hour, 1, params.DowntimeJailDuration, params.SlashFractionDowntime, 1},
+	}
+
+	for i, tc := range testCases {
+		height := params.SignedBlocksWindow + 1 + int64(i)
+		blockTime := startTime.Add(tc.elapsed)
+		ctx := ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+
+		// the validator missed enough blocks of the window to be jailed
+		info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(found)
+		info.StartHeight = 0
+		info.MissedBlocksCounter = params.SignedBlocksWindow
+		keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+		s.stakingKeeper.EXPECT().IsValidatorJailed(ctx, consAddr).Return(false)
+		s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, consAddr).Return(stakingtypes.Validator{})
+		s.stakingKeeper.EXPECT().SlashWithInfractionReason(ctx, consAddr, gomock.Any(), power, tc.expSlash, stakingtypes.Infraction_INFRACTION_DOWNTIME).Return(sdk.NewInt(0))
+		s.stakingKeeper.EXPECT().Jail(ctx, consAddr)
+
+		keeper.HandleValidatorSignature(ctx, consAddr.Bytes(), power, false)
+
+		info, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.True(found, tc.name)
+		require.Equal(tc.expJailCount, info.RecentJailCount, tc.name)
+		require.Equal(blockTime, info.LastJailTime, tc.name)
+		require.Equal(blockTime.Add(tc.expJailDuration), info.JailedUntil, tc.name)
+
+		// the offense history lists the downtime jails within the maximum escalation period
+		res, err := s.queryClient.DowntimeOffenses(gocontext.Background(), &slashingtypes.QueryDowntimeOffensesRequest{ConsAddress: consAddr.String()})
+		require.NoError(err)
+		require.Len(res.Offenses, tc.expOffenses, tc.name)
+		for j, offense := range res.Offenses {
+			expCase := testCases[i-tc.expOffenses+1+j]
+			require.Equal(consAddr.String(), offense.Address, tc.name)
+			require.Equal(params.SignedBlocksWindow+1+int64(i-tc.expOffenses+1+j), offense.Height, tc.name)
+			require.Equal(startTime.Add(expCase.elapsed), offense.Time, tc.name)
+			require.Equal(expCase.expJailCount, offense.RecentJailCount, tc.name)
+			require.Equal(expCase.expSlash, offense.SlashFraction, tc.name)
+			require.Equal(offense.Time.Add(expCase.expJailDuration), offense.JailedUntil, tc.name)
+		}
+
+		res, err = s.queryClient.DowntimeOffenses(gocontext.Background(), &slashingtypes.QueryDowntimeOffensesRequest{
+			ConsAddress: consAddr.String(),
+			Pagination:  &query.PageRequest{Limit: 2},
+		})
+		require.NoError(err)
+		if tc.expOffenses > 2 {
+			require.Len(res.Offenses, 2, tc.name)
+			require.NotNil(res.Pagination.NextKey, tc.name)
+		} else {
+			require.Len(res.Offenses, tc.expOffenses, tc.name)
+			require.Nil(res.Pagination.NextKey, tc.name)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 
@@ -76,10 +77,29 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.De
 }
 
 // SlashWithInfractionReason attempts to slash a validator. The slash is delegated to the staking
-// module to make the necessary validator changes. It specifies an intraction reason.
-func (k Keeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64, infraction stakingtypes.Infraction) {
+// module to make the necessary validator changes. It specifies an intraction reason and returns
+// the amount of tokens burned.
+func (k Keeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64, infraction stakingtypes.Infraction) math.Int {
 	coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
+	k.emitSlashEvent(ctx, consAddr, power, infraction, coinsBurned)
 
+	return coinsBurned
+}
+
+// SlashWithBounty attempts to slash a validator for double signing, paying the
+// DoubleSignEvidenceBounty share of the tokens slashed from the validator to the
+// recipient. The slash is delegated to the staking module to make the necessary
+// validator changes. It returns the amount of tokens paid as bounty.
+func (k Keeper) SlashWithBounty(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64, recipient sdk.AccAddress) math.Int {
+	slashed, bounty := k.sk.SlashWithBounty(
+		ctx, consAddr, distributionHeight, power, fraction, k.DoubleSignEvidenceBounty(ctx), recipient,
+	)
+	k.emitSlashEvent(ctx, consAddr, power, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN, slashed.Sub(bounty))
+
+	return bounty
+}
+
+func (k Keeper) emitSlashEvent(ctx sdk.Context, consAddr sdk.ConsAddress, power int64, infraction stakingtypes.Infraction, coinsBurned math.Int) {
 	reasonAttr := sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueUnspecified)
 	switch infraction {
 	case stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN:
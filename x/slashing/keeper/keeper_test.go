@@ -6,6 +6,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtime "github.com/cometbft/cometbft/types/time"
 
@@ -118,6 +119,32 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 	s.slashingKeeper.Jail(s.ctx, consAddr)
 }
 
+func (s *KeeperTestSuite) TestSlashWithBounty() {
+	recipient := sdk.AccAddress("recipient")
+	s.stakingKeeper.EXPECT().SlashWithBounty(s.ctx,
+		consAddr,
+		s.ctx.BlockHeight(),
+		int64(10),
+		s.slashingKeeper.SlashFractionDoubleSign(s.ctx),
+		s.slashingKeeper.DoubleSignEvidenceBounty(s.ctx),
+		recipient,
+	).Return(sdk.NewInt(100), sdk.NewInt(10))
+
+	bounty := s.slashingKeeper.SlashWithBounty(
+		s.ctx,
+		consAddr,
+		s.slashingKeeper.SlashFractionDoubleSign(s.ctx),
+		int64(10),
+		s.ctx.BlockHeight(),
+		recipient,
+	)
+	s.Require().Equal(sdk.NewInt(10), bounty)
+
+	events := s.ctx.EventManager().Events()
+	s.Require().Equal(slashingtypes.EventTypeSlash, events[len(events)-1].Type)
+	s.Require().Contains(events[len(events)-1].Attributes, abci.EventAttribute{Key: slashingtypes.AttributeKeyBurnedCoins, Value: "90"})
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -21,6 +21,15 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 	slashFractionDowntime, err := sdk.NewDecFromStr("0.0089")
 	require.NoError(err)
 
+	jailDurationMultiplier, err := sdk.NewDecFromStr("2")
+	require.NoError(err)
+
+	slashFractionDowntimeMultiplier, err := sdk.NewDecFromStr("1.5")
+	require.NoError(err)
+
+	doubleSignEvidenceBounty, err := sdk.NewDecFromStr("0.1")
+	require.NoError(err)
+
 	invalidVal, err := sdk.NewDecFromStr("-1")
 	require.NoError(err)
 
@@ -113,16 +122,60 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid downtime jail escalation window",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(34800000000000),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeJailEscalationWindow:    time.Duration(0),
+					DowntimeJailDurationMultiplier:  jailDurationMultiplier,
+					SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+					MaxDowntimeJailEscalations:      int64(3),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "downtime jail escalation window must be positive",
+		},
+		{
+			name: "set invalid double sign evidence bounty",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(34800000000000),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeJailEscalationWindow:    time.Hour,
+					DowntimeJailDurationMultiplier:  jailDurationMultiplier,
+					SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+					MaxDowntimeJailEscalations:      int64(3),
+					DoubleSignEvidenceBounty:        sdk.NewDecWithPrec(11, 1),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "double sign evidence bounty too large",
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: slashingtypes.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(34800000000000),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(34800000000000),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeJailEscalationWindow:    time.Hour,
+					DowntimeJailDurationMultiplier:  jailDurationMultiplier,
+					SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+					MaxDowntimeJailEscalations:      int64(3),
+					DoubleSignEvidenceBounty:        doubleSignEvidenceBounty,
 				},
 			},
 			expectErr: false,
@@ -37,6 +37,11 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return k.GetParams(ctx).SlashFractionDowntime
 }
 
+// DoubleSignEvidenceBounty - share of the tokens slashed for double sign paid to the evidence submitter
+func (k Keeper) DoubleSignEvidenceBounty(ctx sdk.Context) (res sdk.Dec) {
+	return k.GetParams(ctx).DoubleSignEvidenceBounty
+}
+
 // GetParams returns the current x/slashing module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -20,6 +20,15 @@ func (s *KeeperTestSuite) TestParams() {
 	slashFractionDowntime, err := sdk.NewDecFromStr("0.0089")
 	require.NoError(err)
 
+	jailDurationMultiplier, err := sdk.NewDecFromStr("2")
+	require.NoError(err)
+
+	slashFractionDowntimeMultiplier, err := sdk.NewDecFromStr("1.5")
+	require.NoError(err)
+
+	doubleSignEvidenceBounty, err := sdk.NewDecFromStr("0.1")
+	require.NoError(err)
+
 	invalidVal, err := sdk.NewDecFromStr("-1")
 	require.NoError(err)
 
@@ -89,14 +98,100 @@ func (s *KeeperTestSuite) TestParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid downtime jail escalation window",
+			input: types.Params{
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(34800000000000),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeJailEscalationWindow:    time.Duration(0),
+				DowntimeJailDurationMultiplier:  jailDurationMultiplier,
+				SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+				MaxDowntimeJailEscalations:      int64(3),
+			},
+			expectErr: true,
+			expErrMsg: "downtime jail escalation window must be positive",
+		},
+		{
+			name: "set invalid downtime jail duration multiplier",
+			input: types.Params{
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(34800000000000),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeJailEscalationWindow:    time.Hour,
+				DowntimeJailDurationMultiplier:  slashFractionDowntime,
+				SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+				MaxDowntimeJailEscalations:      int64(3),
+			},
+			expectErr: true,
+			expErrMsg: "downtime jail duration multiplier cannot be less than one",
+		},
+		{
+			name: "set invalid downtime slash fraction multiplier",
+			input: types.Params{
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(34800000000000),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeJailEscalationWindow:    time.Hour,
+				DowntimeJailDurationMultiplier:  jailDurationMultiplier,
+				SlashFractionDowntimeMultiplier: sdk.NewDec(101),
+				MaxDowntimeJailEscalations:      int64(3),
+			},
+			expectErr: true,
+			expErrMsg: "downtime slash fraction multiplier too large",
+		},
+		{
+			name: "set invalid max downtime jail escalations",
+			input: types.Params{
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(34800000000000),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeJailEscalationWindow:    time.Hour,
+				DowntimeJailDurationMultiplier:  jailDurationMultiplier,
+				SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+				MaxDowntimeJailEscalations:      int64(-1),
+			},
+			expectErr: true,
+			expErrMsg: "max downtime jail escalations cannot be negative",
+		},
+		{
+			name: "set invalid double sign evidence bounty",
+			input: types.Params{
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(34800000000000),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeJailEscalationWindow:    time.Hour,
+				DowntimeJailDurationMultiplier:  jailDurationMultiplier,
+				SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+				MaxDowntimeJailEscalations:      int64(3),
+				DoubleSignEvidenceBounty:        sdk.NewDecWithPrec(11, 1),
+			},
+			expectErr: true,
+			expErrMsg: "double sign evidence bounty too large",
+		},
 		{
 			name: "set all valid params",
 			input: types.Params{
-				SignedBlocksWindow:      int64(750),
-				MinSignedPerWindow:      minSignedPerWindow,
-				DowntimeJailDuration:    time.Duration(34800000000000),
-				SlashFractionDoubleSign: slashFractionDoubleSign,
-				SlashFractionDowntime:   slashFractionDowntime,
+				SignedBlocksWindow:              int64(750),
+				MinSignedPerWindow:              minSignedPerWindow,
+				DowntimeJailDuration:            time.Duration(34800000000000),
+				SlashFractionDoubleSign:         slashFractionDoubleSign,
+				SlashFractionDowntime:           slashFractionDowntime,
+				DowntimeJailEscalationWindow:    time.Hour,
+				DowntimeJailDurationMultiplier:  jailDurationMultiplier,
+				SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+				MaxDowntimeJailEscalations:      int64(3),
+				DoubleSignEvidenceBounty:        doubleSignEvidenceBounty,
 			},
 			expectErr: false,
 		},
@@ -122,6 +217,7 @@ func (s *KeeperTestSuite) TestParams() {
 			require.Equal(keeper.DowntimeJailDuration(ctx), expected.DowntimeJailDuration)
 			require.Equal(keeper.SlashFractionDoubleSign(ctx), expected.SlashFractionDoubleSign)
 			require.Equal(keeper.SlashFractionDowntime(ctx), expected.SlashFractionDowntime)
+			require.Equal(keeper.DoubleSignEvidenceBounty(ctx), expected.DoubleSignEvidenceBounty)
 		})
 	}
 }
@@ -156,3 +156,86 @@ func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.C
 		store.Delete(iter.Key())
 	}
 }
+
+// rotateValidatorSigningInfo moves the signing info, the missed block bit array and the downtime
+// offense history of a validator from its previous consensus address to its new one.
+func (k Keeper) rotateValidatorSigningInfo(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	signInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		// the validator was never bonded
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	signInfo.Address = newConsAddr.String()
+	k.SetValidatorSigningInfo(ctx, newConsAddr, signInfo)
+	store.Delete(types.ValidatorSigningInfoKey(oldConsAddr))
+
+	k.IterateValidatorMissedBlockBitArray(ctx, oldConsAddr, func(index int64, missed bool) (stop bool) {
+		k.SetValidatorMissedBlockBitArray(ctx, newConsAddr, index, missed)
+		return false
+	})
+	k.clearValidatorMissedBlockBitArray(ctx, oldConsAddr)
+
+	var offenses []types.DowntimeOffense
+	k.IterateDowntimeOffenses(ctx, oldConsAddr, func(offense types.DowntimeOffense) (stop bool) {
+		offenses = append(offenses, offense)
+		return false
+	})
+	for _, offense := range offenses {
+		store.Delete(types.DowntimeOffenseKey(oldConsAddr, offense.Height))
+
+		offense.Address = newConsAddr.String()
+		k.SetDowntimeOffense(ctx, newConsAddr, offense)
+	}
+}
+
+// SetDowntimeOffense records a downtime offense in the offense history of its validator
+func (k Keeper) SetDowntimeOffense(ctx sdk.Context, consAddr sdk.ConsAddress, offense types.DowntimeOffense) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&offense)
+	store.Set(types.DowntimeOffenseKey(consAddr, offense.Height), bz)
+}
+
+// pruneDowntimeOffenses removes the downtime offenses of a validator which are older than the given
+// time, so that the offense history of a validator does not grow without bound.
+func (k Keeper) pruneDowntimeOffenses(ctx sdk.Context, consAddr sdk.ConsAddress, before time.Time) {
+	var heights []int64
+	k.IterateDowntimeOffenses(ctx, consAddr, func(offense types.DowntimeOffense) (stop bool) {
+		if offense.Time.Before(before) {
+			heights = append(heights, offense.Height)
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, height := range heights {
+		store.Delete(types.DowntimeOffenseKey(consAddr, height))
+	}
+}
+
+// IterateDowntimeOffenses iterates over the downtime offense history of a validator, ordered by height
+func (k Keeper) IterateDowntimeOffenses(ctx sdk.Context,
+	address sdk.ConsAddress, handler func(offense types.DowntimeOffense) (stop bool),
+) {
+	k.iterateDowntimeOffenses(ctx, types.DowntimeOffensesPrefixKey(address), handler)
+}
+
+// IterateAllDowntimeOffenses iterates over the downtime offense history of all validators
+func (k Keeper) IterateAllDowntimeOffenses(ctx sdk.Context, handler func(offense types.DowntimeOffense) (stop bool)) {
+	k.iterateDowntimeOffenses(ctx, types.DowntimeOffenseKeyPrefix, handler)
+}
+
+func (k Keeper) iterateDowntimeOffenses(ctx sdk.Context, prefix []byte, handler func(offense types.DowntimeOffense) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var offense types.DowntimeOffense
+		k.cdc.MustUnmarshal(iter.Value(), &offense)
+		if handler(offense) {
+			break
+		}
+	}
+}
@@ -18,7 +18,9 @@ var ParamsKey = []byte{0x00}
 // and managed by the x/params modules and stores them directly into the x/slashing
 // module state.
 func Migrate(ctx sdk.Context, store sdk.KVStore, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
-	var currParams types.Params
+	// the parameters which are not managed by the x/params module default to
+	// their default values
+	currParams := types.DefaultParams()
 	legacySubspace.GetParamSet(ctx, &currParams)
 
 	if err := currParams.Validate(); err != nil {
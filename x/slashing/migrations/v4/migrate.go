@@ -0,0 +1,35 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+var ParamsKey = []byte{0x00}
+
+// Migrate migrates the x/slashing module state from the consensus version 3 to
+// version 4. Specifically, it sets the new downtime jail escalation parameters
+// to their default values, which do not escalate the penalties of repeat
+// offenses.
+func Migrate(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &params); err != nil {
+		return err
+	}
+
+	defaultParams := types.DefaultParams()
+	params.DowntimeJailEscalationWindow = defaultParams.DowntimeJailEscalationWindow
+	params.DowntimeJailDurationMultiplier = defaultParams.DowntimeJailDurationMultiplier
+	params.SlashFractionDowntimeMultiplier = defaultParams.SlashFractionDowntimeMultiplier
+	params.MaxDowntimeJailEscalations = defaultParams.MaxDowntimeJailEscalations
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
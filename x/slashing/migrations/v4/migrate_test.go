@@ -0,0 +1,44 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	v4 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(slashing.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// v3 params don't have the downtime jail escalation parameters
+	oldParams := types.Params{
+		SignedBlocksWindow:      200,
+		MinSignedPerWindow:      types.DefaultMinSignedPerWindow,
+		DowntimeJailDuration:    time.Hour,
+		SlashFractionDoubleSign: types.DefaultSlashFractionDoubleSign,
+		SlashFractionDowntime:   types.DefaultSlashFractionDowntime,
+	}
+	store.Set(v4.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v4.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v4.ParamsKey), &res))
+	require.Equal(t, int64(200), res.SignedBlocksWindow)
+	require.Equal(t, time.Hour, res.DowntimeJailDuration)
+	require.Equal(t, types.DefaultDowntimeJailEscalationWindow, res.DowntimeJailEscalationWindow)
+	require.Equal(t, types.DefaultDowntimeJailDurationMultiplier, res.DowntimeJailDurationMultiplier)
+	require.Equal(t, types.DefaultSlashFractionDowntimeMultiplier, res.SlashFractionDowntimeMultiplier)
+	require.Equal(t, types.DefaultMaxDowntimeJailEscalations, res.MaxDowntimeJailEscalations)
+}
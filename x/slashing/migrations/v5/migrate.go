@@ -0,0 +1,31 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+var ParamsKey = []byte{0x00}
+
+// Migrate migrates the x/slashing module state from the consensus version 4 to
+// version 5. Specifically, it sets the new double sign evidence bounty
+// parameter to its default value, which pays no bounty to the submitters of
+// double sign evidence.
+func Migrate(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &params); err != nil {
+		return err
+	}
+
+	params.DoubleSignEvidenceBounty = types.DefaultParams().DoubleSignEvidenceBounty
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	v5 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(slashing.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// v4 params don't have the double sign evidence bounty parameter
+	oldParams := types.Params{
+		SignedBlocksWindow:              200,
+		MinSignedPerWindow:              types.DefaultMinSignedPerWindow,
+		DowntimeJailDuration:            time.Hour,
+		SlashFractionDoubleSign:         types.DefaultSlashFractionDoubleSign,
+		SlashFractionDowntime:           types.DefaultSlashFractionDowntime,
+		DowntimeJailEscalationWindow:    types.DefaultDowntimeJailEscalationWindow,
+		DowntimeJailDurationMultiplier:  sdk.NewDec(2),
+		SlashFractionDowntimeMultiplier: types.DefaultSlashFractionDowntimeMultiplier,
+		MaxDowntimeJailEscalations:      types.DefaultMaxDowntimeJailEscalations,
+	}
+	store.Set(v5.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v5.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v5.ParamsKey), &res))
+	require.Equal(t, int64(200), res.SignedBlocksWindow)
+	require.Equal(t, sdk.NewDec(2), res.DowntimeJailDurationMultiplier)
+	require.Equal(t, types.DefaultDoubleSignEvidenceBounty, res.DoubleSignEvidenceBounty)
+}
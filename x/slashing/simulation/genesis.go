@@ -17,11 +17,16 @@ import (
 
 // Simulation parameter constants
 const (
-	SignedBlocksWindow      = "signed_blocks_window"
-	MinSignedPerWindow      = "min_signed_per_window"
-	DowntimeJailDuration    = "downtime_jail_duration"
-	SlashFractionDoubleSign = "slash_fraction_double_sign"
-	SlashFractionDowntime   = "slash_fraction_downtime"
+	SignedBlocksWindow              = "signed_blocks_window"
+	MinSignedPerWindow              = "min_signed_per_window"
+	DowntimeJailDuration            = "downtime_jail_duration"
+	SlashFractionDoubleSign         = "slash_fraction_double_sign"
+	SlashFractionDowntime           = "slash_fraction_downtime"
+	DowntimeJailEscalationWindow    = "downtime_jail_escalation_window"
+	DowntimeJailDurationMultiplier  = "downtime_jail_duration_multiplier"
+	SlashFractionDowntimeMultiplier = "slash_fraction_downtime_multiplier"
+	MaxDowntimeJailEscalations      = "max_downtime_jail_escalations"
+	DoubleSignEvidenceBounty        = "double_sign_evidence_bounty"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +54,31 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeJailEscalationWindow randomized DowntimeJailEscalationWindow
+func GenDowntimeJailEscalationWindow(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60*60, 60*60*24*30)) * time.Second
+}
+
+// GenDowntimeJailDurationMultiplier randomized DowntimeJailDurationMultiplier
+func GenDowntimeJailDurationMultiplier(r *rand.Rand) math.LegacyDec {
+	return math.LegacyOneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(20)), 1))
+}
+
+// GenSlashFractionDowntimeMultiplier randomized SlashFractionDowntimeMultiplier
+func GenSlashFractionDowntimeMultiplier(r *rand.Rand) math.LegacyDec {
+	return math.LegacyOneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(20)), 1))
+}
+
+// GenMaxDowntimeJailEscalations randomized MaxDowntimeJailEscalations
+func GenMaxDowntimeJailEscalations(r *rand.Rand) int64 {
+	return int64(r.Intn(10))
+}
+
+// GenDoubleSignEvidenceBounty randomized DoubleSignEvidenceBounty
+func GenDoubleSignEvidenceBounty(r *rand.Rand) math.LegacyDec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,12 +111,44 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeJailEscalationWindow time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailEscalationWindow, &downtimeJailEscalationWindow, simState.Rand,
+		func(r *rand.Rand) { downtimeJailEscalationWindow = GenDowntimeJailEscalationWindow(r) },
+	)
+
+	var downtimeJailDurationMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailDurationMultiplier, &downtimeJailDurationMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeJailDurationMultiplier = GenDowntimeJailDurationMultiplier(r) },
+	)
+
+	var slashFractionDowntimeMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionDowntimeMultiplier, &slashFractionDowntimeMultiplier, simState.Rand,
+		func(r *rand.Rand) { slashFractionDowntimeMultiplier = GenSlashFractionDowntimeMultiplier(r) },
+	)
+
+	var maxDowntimeJailEscalations int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxDowntimeJailEscalations, &maxDowntimeJailEscalations, simState.Rand,
+		func(r *rand.Rand) { maxDowntimeJailEscalations = GenMaxDowntimeJailEscalations(r) },
+	)
+
+	var doubleSignEvidenceBounty sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DoubleSignEvidenceBounty, &doubleSignEvidenceBounty, simState.Rand,
+		func(r *rand.Rand) { doubleSignEvidenceBounty = GenDoubleSignEvidenceBounty(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, downtimeJailEscalationWindow,
+		downtimeJailDurationMultiplier, slashFractionDowntimeMultiplier, maxDowntimeJailEscalations,
+		doubleSignEvidenceBounty,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.DowntimeOffense{})
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
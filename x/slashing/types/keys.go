@@ -27,12 +27,15 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes><height_Bytes>: DowntimeOffense
 
 var (
 	ParamsKey                             = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	DowntimeOffenseKeyPrefix              = []byte{0x04} // Prefix for downtime offense history
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -62,6 +65,16 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	return append(ValidatorMissedBlockBitArrayPrefixKey(v), b...)
 }
 
+// DowntimeOffensesPrefixKey - stored by *Consensus* address (not operator address)
+func DowntimeOffensesPrefixKey(v sdk.ConsAddress) []byte {
+	return append(DowntimeOffenseKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// DowntimeOffenseKey - stored by *Consensus* address (not operator address) and height
+func DowntimeOffenseKey(v sdk.ConsAddress, height int64) []byte {
+	return append(DowntimeOffensesPrefixKey(v), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 // AddrPubkeyRelationKey gets pubkey relation key used to get the pubkey from the address
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
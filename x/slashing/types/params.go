@@ -11,27 +11,45 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow           = int64(100)
+	DefaultDowntimeJailDuration         = 60 * 10 * time.Second
+	DefaultDowntimeJailEscalationWindow = 60 * 60 * 24 * 7 * time.Second
+	DefaultMaxDowntimeJailEscalations   = int64(5)
 )
 
 var (
-	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
-	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
-	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+	DefaultMinSignedPerWindow              = sdk.NewDecWithPrec(5, 1)
+	DefaultSlashFractionDoubleSign         = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
+	DefaultSlashFractionDowntime           = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+	DefaultDowntimeJailDurationMultiplier  = math.LegacyOneDec()
+	DefaultSlashFractionDowntimeMultiplier = math.LegacyOneDec()
+	DefaultDoubleSignEvidenceBounty        = math.LegacyZeroDec()
+)
+
+// bounds of the escalated downtime penalties, which keep them from overflowing
+var (
+	maxDuration           = time.Duration(1<<63 - 1)
+	maxDowntimeMultiplier = math.LegacyNewDec(100)
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, downtimeJailEscalationWindow time.Duration,
+	downtimeJailDurationMultiplier, slashFractionDowntimeMultiplier sdk.Dec, maxDowntimeJailEscalations int64,
+	doubleSignEvidenceBounty sdk.Dec,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:              signedBlocksWindow,
+		MinSignedPerWindow:              minSignedPerWindow,
+		DowntimeJailDuration:            downtimeJailDuration,
+		SlashFractionDoubleSign:         slashFractionDoubleSign,
+		SlashFractionDowntime:           slashFractionDowntime,
+		DowntimeJailEscalationWindow:    downtimeJailEscalationWindow,
+		DowntimeJailDurationMultiplier:  downtimeJailDurationMultiplier,
+		SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+		MaxDowntimeJailEscalations:      maxDowntimeJailEscalations,
+		DoubleSignEvidenceBounty:        doubleSignEvidenceBounty,
 	}
 }
 
@@ -43,9 +61,54 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultDowntimeJailEscalationWindow,
+		DefaultDowntimeJailDurationMultiplier,
+		DefaultSlashFractionDowntimeMultiplier,
+		DefaultMaxDowntimeJailEscalations,
+		DefaultDoubleSignEvidenceBounty,
 	)
 }
 
+// DowntimeJailPenalties returns the jail duration and slash fraction of a downtime offense given the
+// recent jail count of the validator, this offense included. The base jail duration and slash
+// fraction are multiplied by their multiplier for each repeat offense, up to
+// MaxDowntimeJailEscalations times. The slash fraction is capped at one.
+func (p Params) DowntimeJailPenalties(recentJailCount int64) (time.Duration, sdk.Dec) {
+	escalations := recentJailCount - 1
+	if escalations < 0 {
+		escalations = 0
+	}
+	if escalations > p.MaxDowntimeJailEscalations {
+		escalations = p.MaxDowntimeJailEscalations
+	}
+
+	// the penalties are capped at every escalation so that they neither overflow a time.Duration
+	// nor a sdk.Dec
+	maxJailDuration := sdk.NewDec(int64(maxDuration))
+	jailDuration := sdk.NewDec(int64(p.DowntimeJailDuration))
+	slashFraction := p.SlashFractionDowntime
+	for i := int64(0); i < escalations; i++ {
+		jailDuration = sdk.MinDec(jailDuration.Mul(p.DowntimeJailDurationMultiplier), maxJailDuration)
+		slashFraction = sdk.MinDec(slashFraction.Mul(p.SlashFractionDowntimeMultiplier), math.LegacyOneDec())
+	}
+
+	return time.Duration(jailDuration.TruncateInt64()), slashFraction
+}
+
+// DowntimeOffenseRetention returns how long the downtime offenses of a validator affect its
+// penalties, i.e. MaxDowntimeJailEscalations escalation windows. It saturates at the maximum
+// time.Duration instead of overflowing.
+func (p Params) DowntimeOffenseRetention() time.Duration {
+	if p.MaxDowntimeJailEscalations <= 0 || p.DowntimeJailEscalationWindow <= 0 {
+		return 0
+	}
+	if p.DowntimeJailEscalationWindow > maxDuration/time.Duration(p.MaxDowntimeJailEscalations) {
+		return maxDuration
+	}
+
+	return p.DowntimeJailEscalationWindow * time.Duration(p.MaxDowntimeJailEscalations)
+}
+
 // Validate validates the params
 func (p Params) Validate() error {
 	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
@@ -63,6 +126,21 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimeJailEscalationWindow(p.DowntimeJailEscalationWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailDurationMultiplier(p.DowntimeJailDurationMultiplier); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDowntimeMultiplier(p.SlashFractionDowntimeMultiplier); err != nil {
+		return err
+	}
+	if err := validateMaxDowntimeJailEscalations(p.MaxDowntimeJailEscalations); err != nil {
+		return err
+	}
+	if err := validateDoubleSignEvidenceBounty(p.DoubleSignEvidenceBounty); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -148,3 +226,86 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeJailEscalationWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("downtime jail escalation window must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailDurationMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("downtime jail duration multiplier cannot be nil: %s", v)
+	}
+	if v.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("downtime jail duration multiplier cannot be less than one: %s", v)
+	}
+	if v.GT(maxDowntimeMultiplier) {
+		return fmt.Errorf("downtime jail duration multiplier too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDowntimeMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("downtime slash fraction multiplier cannot be nil: %s", v)
+	}
+	if v.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("downtime slash fraction multiplier cannot be less than one: %s", v)
+	}
+	if v.GT(maxDowntimeMultiplier) {
+		return fmt.Errorf("downtime slash fraction multiplier too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeJailEscalations(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max downtime jail escalations cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateDoubleSignEvidenceBounty(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("double sign evidence bounty cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("double sign evidence bounty cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("double sign evidence bounty too large: %s", v)
+	}
+
+	return nil
+}
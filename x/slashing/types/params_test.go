@@ -0,0 +1,30 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDowntimeOffenseRetention(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, 5*DefaultDowntimeJailEscalationWindow, params.DowntimeOffenseRetention())
+
+	params.MaxDowntimeJailEscalations = 0
+	require.Equal(t, time.Duration(0), params.DowntimeOffenseRetention())
+
+	// the retention saturates instead of overflowing with large params
+	params.MaxDowntimeJailEscalations = math.MaxInt64
+	require.NoError(t, params.Validate())
+	require.Equal(t, time.Duration(math.MaxInt64), params.DowntimeOffenseRetention())
+
+	params.DowntimeJailEscalationWindow = time.Duration(math.MaxInt64)
+	params.MaxDowntimeJailEscalations = 2
+	require.Equal(t, time.Duration(math.MaxInt64), params.DowntimeOffenseRetention())
+
+	// and the pruning cutoff stays in the past
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.True(t, now.Add(-params.DowntimeOffenseRetention()).Before(now))
+}
@@ -309,6 +309,116 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDowntimeOffensesRequest is the request type for the Query/DowntimeOffenses RPC
+// method
+type QueryDowntimeOffensesRequest struct {
+	// cons_address is the address to query the downtime offenses of
+	ConsAddress string             `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDowntimeOffensesRequest) Reset()         { *m = QueryDowntimeOffensesRequest{} }
+func (m *QueryDowntimeOffensesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeOffensesRequest) ProtoMessage()    {}
+func (*QueryDowntimeOffensesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeOffensesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeOffensesRequest.Merge(m, src)
+}
+func (m *QueryDowntimeOffensesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeOffensesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeOffensesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeOffensesRequest proto.InternalMessageInfo
+
+func (m *QueryDowntimeOffensesRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryDowntimeOffensesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDowntimeOffensesResponse is the response type for the Query/DowntimeOffenses RPC
+// method
+type QueryDowntimeOffensesResponse struct {
+	// offenses are the downtime offenses of the validator, ordered by height
+	Offenses   []DowntimeOffense   `protobuf:"bytes,1,rep,name=offenses,proto3" json:"offenses"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDowntimeOffensesResponse) Reset()         { *m = QueryDowntimeOffensesResponse{} }
+func (m *QueryDowntimeOffensesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeOffensesResponse) ProtoMessage()    {}
+func (*QueryDowntimeOffensesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeOffensesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeOffensesResponse.Merge(m, src)
+}
+func (m *QueryDowntimeOffensesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeOffensesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeOffensesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeOffensesResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimeOffensesResponse) GetOffenses() []DowntimeOffense {
+	if m != nil {
+		return m.Offenses
+	}
+	return nil
+}
+
+func (m *QueryDowntimeOffensesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +426,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryDowntimeOffensesRequest)(nil), "cosmos.slashing.v1beta1.QueryDowntimeOffensesRequest")
+	proto.RegisterType((*QueryDowntimeOffensesResponse)(nil), "cosmos.slashing.v1beta1.QueryDowntimeOffensesResponse")
 }
 
 func init() {
@@ -323,43 +435,49 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x16, 0x3a, 0x29, 0xa2, 0x63, 0xa0, 0x69, 0x90, 0x8d, 0xae, 0x90, 0x96,
-	0x6a, 0x76, 0x4c, 0x44, 0x3c, 0x88, 0x07, 0x73, 0xb0, 0x08, 0x1e, 0x34, 0x85, 0x82, 0x5e, 0xc2,
-	0x6c, 0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0x3b, 0x9b, 0x60, 0x11, 0x3d, 0x78, 0xf6, 0x20, 0xf8,
-	0x19, 0x04, 0x8f, 0x2a, 0x7e, 0x88, 0x1e, 0x8b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0x77, 0x3f, 0x81,
-	0x64, 0x66, 0x92, 0x4c, 0x89, 0xab, 0x29, 0x5e, 0x92, 0xe5, 0xbd, 0xf7, 0x7f, 0xff, 0xdf, 0x7b,
-	0x79, 0x1b, 0x78, 0xb9, 0x2d, 0x55, 0x57, 0x2a, 0xac, 0x22, 0xa2, 0xf6, 0xb8, 0x60, 0xb8, 0x5f,
-	0x0b, 0x69, 0x4a, 0x6a, 0x78, 0xbf, 0x47, 0x93, 0x83, 0x20, 0x4e, 0x64, 0x2a, 0xd1, 0xaa, 0x29,
-	0x0a, 0xc6, 0x45, 0x81, 0x2d, 0x2a, 0x6d, 0x5a, 0x75, 0x48, 0x14, 0x35, 0x8a, 0x89, 0x3e, 0x26,
-	0x8c, 0x0b, 0x92, 0x72, 0x29, 0x4c, 0x93, 0x52, 0x81, 0x49, 0x26, 0xf5, 0x23, 0x1e, 0x3d, 0xd9,
-	0xe8, 0x05, 0x26, 0x25, 0x8b, 0x28, 0x26, 0x31, 0xc7, 0x44, 0x08, 0x99, 0x6a, 0x89, 0xb2, 0xd9,
-	0x4a, 0x16, 0xdd, 0x84, 0xc4, 0xd4, 0xad, 0x99, 0xba, 0x96, 0x69, 0x6f, 0x69, 0x4d, 0xea, 0x1c,
-	0xe9, 0x72, 0x21, 0xb1, 0xfe, 0x34, 0x21, 0xbf, 0x00, 0xd1, 0xc3, 0x11, 0xeb, 0x03, 0x92, 0x90,
-	0xae, 0x6a, 0xd2, 0xfd, 0x1e, 0x55, 0xa9, 0xff, 0x08, 0x9e, 0x3f, 0x16, 0x55, 0xb1, 0x14, 0x8a,
-	0xa2, 0x06, 0x5c, 0x8a, 0x75, 0xa4, 0x08, 0x2e, 0x82, 0x8d, 0x7c, 0xbd, 0x1c, 0x64, 0x2c, 0x23,
-	0x30, 0xc2, 0xc6, 0xf2, 0xe1, 0xb7, 0x72, 0xee, 0xdd, 0xcf, 0xf7, 0x9b, 0xa0, 0x69, 0x95, 0xfe,
-	0x0e, 0x5c, 0xd5, 0xad, 0xb7, 0x39, 0x13, 0x5c, 0xb0, 0x7b, 0x62, 0x57, 0x5a, 0x57, 0x74, 0x0b,
-	0xae, 0xb4, 0xa5, 0x50, 0x2d, 0xd2, 0xe9, 0x24, 0x54, 0x19, 0x93, 0xe5, 0x46, 0xf1, 0xf3, 0xa7,
-	0x6a, 0xc1, 0xfa, 0xdc, 0x31, 0x99, 0xed, 0x34, 0xe1, 0x82, 0x35, 0xf3, 0xa3, 0x6a, 0x1b, 0xf2,
-	0x5f, 0xc0, 0xe2, 0x6c, 0x5f, 0xcb, 0x1d, 0xc2, 0xb3, 0x7d, 0x12, 0xb5, 0x94, 0x49, 0xb5, 0xb8,
-	0xd8, 0x95, 0x76, 0x82, 0x6a, 0xe6, 0x04, 0x3b, 0x24, 0xe2, 0x1d, 0x92, 0xca, 0xc4, 0x69, 0xe8,
-	0xce, 0x73, 0xa6, 0x4f, 0x22, 0x27, 0xe5, 0x87, 0xb3, 0xfe, 0xe3, 0x75, 0xa2, 0xbb, 0x10, 0x4e,
-	0x4f, 0xc0, 0x3a, 0x57, 0xc6, 0xce, 0xa3, 0x7b, 0x09, 0xcc, 0x85, 0x4d, 0xb7, 0xc7, 0xa8, 0xd5,
-	0x36, 0x1d, 0xa5, 0xff, 0x11, 0xc0, 0xb5, 0x3f, 0x98, 0xd8, 0x29, 0xef, 0xc3, 0x53, 0x76, 0xb2,
-	0xc5, 0xff, 0x9a, 0x4c, 0x77, 0x41, 0x5b, 0xc7, 0x98, 0x17, 0x34, 0xf3, 0xfa, 0x3f, 0x99, 0x0d,
-	0x8a, 0x0b, 0x5d, 0xff, 0xb5, 0x08, 0x4f, 0x6b, 0x68, 0xf4, 0x0a, 0xc0, 0x25, 0x73, 0x18, 0xe8,
-	0x4a, 0x26, 0xdd, 0xec, 0x35, 0x96, 0xae, 0xce, 0x57, 0x6c, 0xbc, 0xfd, 0xf5, 0x97, 0x5f, 0x7e,
-	0xbc, 0x59, 0xb8, 0x84, 0xca, 0x38, 0xeb, 0x85, 0x31, 0x97, 0x88, 0x3e, 0x00, 0x98, 0x77, 0x56,
-	0x80, 0xae, 0xfd, 0xdd, 0x66, 0xf6, 0x60, 0x4b, 0xb5, 0x13, 0x28, 0x2c, 0xdd, 0x6d, 0x4d, 0x77,
-	0x13, 0xdd, 0xc8, 0xa4, 0x73, 0xaf, 0x54, 0xe1, 0x67, 0xee, 0x1b, 0xf1, 0x1c, 0xbd, 0x05, 0x70,
-	0xc5, 0xfd, 0xf1, 0xd1, 0xfc, 0x08, 0x93, 0x75, 0xd6, 0x4f, 0x22, 0xb1, 0xd8, 0x81, 0xc6, 0xde,
-	0x40, 0x95, 0xf9, 0xb0, 0x1b, 0x5b, 0x87, 0x03, 0x0f, 0x1c, 0x0d, 0x3c, 0xf0, 0x7d, 0xe0, 0x81,
-	0xd7, 0x43, 0x2f, 0x77, 0x34, 0xf4, 0x72, 0x5f, 0x87, 0x5e, 0xee, 0x71, 0x95, 0xf1, 0x74, 0xaf,
-	0x17, 0x06, 0x6d, 0xd9, 0x1d, 0xf7, 0x32, 0x5f, 0x55, 0xd5, 0x79, 0x82, 0x9f, 0x4e, 0x1b, 0xa7,
-	0x07, 0x31, 0x55, 0xe1, 0x92, 0xfe, 0x9b, 0xba, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xae,
-	0x17, 0x98, 0x9c, 0x05, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x6b, 0x13, 0x4f,
+	0x14, 0xc7, 0x33, 0xfd, 0xfd, 0x5a, 0xec, 0xb4, 0x48, 0x1d, 0x0b, 0x6d, 0x43, 0xdd, 0xe8, 0x0a,
+	0x69, 0xa8, 0x66, 0xd7, 0x44, 0xaa, 0x07, 0xf1, 0x60, 0x90, 0x86, 0x82, 0x10, 0x4d, 0xa1, 0xa0,
+	0x97, 0x30, 0x9b, 0x4c, 0xb6, 0x83, 0xc9, 0xcc, 0x76, 0x67, 0x13, 0x2d, 0xa2, 0x07, 0xcf, 0x1e,
+	0x04, 0xff, 0x04, 0x11, 0x3c, 0xaa, 0x78, 0xf1, 0x2f, 0xb0, 0xc7, 0x52, 0x2f, 0x9e, 0x44, 0x12,
+	0xc1, 0x7f, 0x43, 0x32, 0x33, 0x49, 0x36, 0x4d, 0xb7, 0x26, 0xb5, 0x97, 0x64, 0x79, 0xf3, 0xde,
+	0xfb, 0x7e, 0xde, 0x37, 0x6f, 0x36, 0xf0, 0x72, 0x99, 0x8b, 0x3a, 0x17, 0xb6, 0xa8, 0x61, 0xb1,
+	0x4d, 0x99, 0x6b, 0x37, 0x33, 0x0e, 0x09, 0x70, 0xc6, 0xde, 0x69, 0x10, 0x7f, 0xd7, 0xf2, 0x7c,
+	0x1e, 0x70, 0xb4, 0xa0, 0x92, 0xac, 0x6e, 0x92, 0xa5, 0x93, 0xe2, 0xab, 0xba, 0xda, 0xc1, 0x82,
+	0xa8, 0x8a, 0x5e, 0xbd, 0x87, 0x5d, 0xca, 0x70, 0x40, 0x39, 0x53, 0x4d, 0xe2, 0xf3, 0x2e, 0x77,
+	0xb9, 0x7c, 0xb4, 0x3b, 0x4f, 0x3a, 0xba, 0xec, 0x72, 0xee, 0xd6, 0x88, 0x8d, 0x3d, 0x6a, 0x63,
+	0xc6, 0x78, 0x20, 0x4b, 0x84, 0x3e, 0x4d, 0x46, 0xd1, 0xf5, 0x48, 0x54, 0xde, 0x92, 0xca, 0x2b,
+	0xa9, 0xf6, 0x9a, 0x56, 0x1d, 0x9d, 0xc3, 0x75, 0xca, 0xb8, 0x2d, 0x3f, 0x55, 0xc8, 0x9c, 0x87,
+	0xe8, 0x41, 0x87, 0xf5, 0x3e, 0xf6, 0x71, 0x5d, 0x14, 0xc9, 0x4e, 0x83, 0x88, 0xc0, 0x7c, 0x08,
+	0xcf, 0x0f, 0x44, 0x85, 0xc7, 0x99, 0x20, 0x28, 0x07, 0xa7, 0x3c, 0x19, 0x59, 0x04, 0x17, 0x41,
+	0x6a, 0x26, 0x9b, 0xb0, 0x22, 0xcc, 0xb0, 0x54, 0x61, 0x6e, 0x7a, 0xef, 0x47, 0x22, 0xf6, 0xfe,
+	0xf7, 0x87, 0x55, 0x50, 0xd4, 0x95, 0xe6, 0x16, 0x5c, 0x90, 0xad, 0x37, 0xa9, 0xcb, 0x28, 0x73,
+	0x37, 0x58, 0x95, 0x6b, 0x55, 0x74, 0x0b, 0xce, 0x96, 0x39, 0x13, 0x25, 0x5c, 0xa9, 0xf8, 0x44,
+	0x28, 0x91, 0xe9, 0xdc, 0xe2, 0xc1, 0xe7, 0xf4, 0xbc, 0xd6, 0xb9, 0xa3, 0x4e, 0x36, 0x03, 0x9f,
+	0x32, 0xb7, 0x38, 0xd3, 0xc9, 0xd6, 0x21, 0xf3, 0x05, 0x5c, 0x1c, 0xee, 0xab, 0xb9, 0x1d, 0x38,
+	0xd7, 0xc4, 0xb5, 0x92, 0x50, 0x47, 0x25, 0xca, 0xaa, 0x5c, 0x4f, 0x90, 0x8e, 0x9c, 0x60, 0x0b,
+	0xd7, 0x68, 0x05, 0x07, 0xdc, 0x0f, 0x35, 0x0c, 0xcf, 0x73, 0xb6, 0x89, 0x6b, 0xa1, 0x23, 0xd3,
+	0x19, 0xd6, 0xef, 0xda, 0x89, 0xd6, 0x21, 0xec, 0xaf, 0x80, 0x56, 0x4e, 0x76, 0x95, 0x3b, 0xfb,
+	0x62, 0xa9, 0x0d, 0xeb, 0xbb, 0xe7, 0x12, 0x5d, 0x5b, 0x0c, 0x55, 0x9a, 0x9f, 0x00, 0x5c, 0x3a,
+	0x42, 0x44, 0x4f, 0x79, 0x0f, 0xfe, 0xaf, 0x27, 0xfb, 0xef, 0x9f, 0x26, 0x93, 0x5d, 0x50, 0x7e,
+	0x80, 0x79, 0x42, 0x32, 0xaf, 0xfc, 0x95, 0x59, 0xa1, 0x0c, 0x40, 0xbf, 0x05, 0x70, 0x59, 0x42,
+	0xdf, 0xe5, 0x4f, 0x58, 0x40, 0xeb, 0xa4, 0x50, 0xad, 0x12, 0x26, 0x88, 0x38, 0x8d, 0x9f, 0x1d,
+	0xad, 0x1f, 0x81, 0x79, 0x12, 0x6b, 0xbf, 0x00, 0x78, 0x21, 0x82, 0x52, 0xdb, 0x5b, 0x80, 0x67,
+	0xb8, 0x8e, 0x69, 0x8b, 0x53, 0x91, 0x16, 0x1f, 0x6a, 0x12, 0x76, 0xb7, 0xd7, 0xe4, 0xd4, 0x1c,
+	0xce, 0x7e, 0x9d, 0x84, 0x93, 0x92, 0x1d, 0xbd, 0x02, 0x70, 0x4a, 0x5d, 0x3d, 0x74, 0x25, 0x12,
+	0x6e, 0xf8, 0xbe, 0xc7, 0xaf, 0x8e, 0x96, 0xac, 0xb4, 0xcd, 0x95, 0x97, 0xdf, 0x7e, 0xbd, 0x99,
+	0xb8, 0x84, 0x12, 0x76, 0xd4, 0x2b, 0x49, 0xdd, 0x75, 0xf4, 0x11, 0xc0, 0x99, 0xd0, 0x92, 0xa1,
+	0x6b, 0xc7, 0xcb, 0x0c, 0xbf, 0x12, 0xe2, 0x99, 0x31, 0x2a, 0x34, 0xdd, 0x6d, 0x49, 0x77, 0x13,
+	0xad, 0x45, 0xd2, 0x85, 0xdf, 0x03, 0xc2, 0x7e, 0x16, 0x5e, 0xbe, 0xe7, 0xe8, 0x1d, 0x80, 0xb3,
+	0xa1, 0xb6, 0x02, 0x8d, 0x8e, 0xd0, 0xb3, 0x33, 0x3b, 0x4e, 0x89, 0xc6, 0xb6, 0x24, 0x76, 0x0a,
+	0x25, 0x47, 0xc3, 0x46, 0x07, 0x00, 0xce, 0x1d, 0xde, 0x55, 0xb4, 0x76, 0xbc, 0x70, 0xc4, 0x0d,
+	0x8c, 0xdf, 0x18, 0xb7, 0x4c, 0x33, 0x17, 0x24, 0xf3, 0x06, 0xca, 0x9f, 0xc8, 0x6a, 0xbb, 0xa2,
+	0xfb, 0x96, 0xba, 0x57, 0x22, 0x97, 0xdf, 0x6b, 0x19, 0x60, 0xbf, 0x65, 0x80, 0x9f, 0x2d, 0x03,
+	0xbc, 0x6e, 0x1b, 0xb1, 0xfd, 0xb6, 0x11, 0xfb, 0xde, 0x36, 0x62, 0x8f, 0xd2, 0x2e, 0x0d, 0xb6,
+	0x1b, 0x8e, 0x55, 0xe6, 0xf5, 0xae, 0x98, 0xfa, 0x4a, 0x8b, 0xca, 0x63, 0xfb, 0x69, 0x5f, 0x39,
+	0xd8, 0xf5, 0x88, 0x70, 0xa6, 0xe4, 0xbf, 0xdb, 0xf5, 0x3f, 0x03, 0x00, 0xdf, 0x7e, 0x7c, 0x2f,
+	0xd3, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +498,8 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// DowntimeOffenses queries the downtime offense history of given cons address
+	DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +537,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error) {
+	out := new(QueryDowntimeOffensesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/DowntimeOffenses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +554,8 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// DowntimeOffenses queries the downtime offense history of given cons address
+	DowntimeOffenses(context.Context, *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +571,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) DowntimeOffenses(ctx context.Context, req *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeOffenses not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +633,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DowntimeOffenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeOffensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeOffenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/DowntimeOffenses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeOffenses(ctx, req.(*QueryDowntimeOffensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +667,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "DowntimeOffenses",
+			Handler:    _Query_DowntimeOffenses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,6 +879,97 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDowntimeOffensesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeOffensesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeOffensesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimeOffensesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeOffensesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeOffensesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offenses) > 0 {
+		for iNdEx := len(m.Offenses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offenses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -810,6 +1057,42 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDowntimeOffensesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimeOffensesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offenses) > 0 {
+		for _, e := range m.Offenses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1320,6 +1603,244 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDowntimeOffensesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeOffensesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeOffensesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimeOffensesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeOffensesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeOffensesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offenses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offenses = append(m.Offenses, DowntimeOffense{})
+			if err := m.Offenses[len(m.Offenses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -33,9 +33,42 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Recent Jail Count:     %d
+  Last Jail Time:        %v`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.RecentJailCount, i.LastJailTime)
+}
+
+// NewDowntimeOffense creates a new DowntimeOffense instance
+//
+//nolint:interfacer
+func NewDowntimeOffense(
+	consAddr sdk.ConsAddress, height int64, offenseTime, jailedUntil time.Time,
+	slashFraction sdk.Dec, recentJailCount int64,
+) DowntimeOffense {
+	return DowntimeOffense{
+		Address:         consAddr.String(),
+		Height:          height,
+		Time:            offenseTime,
+		JailedUntil:     jailedUntil,
+		SlashFraction:   slashFraction,
+		RecentJailCount: recentJailCount,
+	}
+}
+
+// DecayedRecentJailCount returns the recent jail count of the validator at the given time, decayed
+// by one for each escalation window elapsed since its last jail.
+func (i ValidatorSigningInfo) DecayedRecentJailCount(blockTime time.Time, escalationWindow time.Duration) int64 {
+	if i.RecentJailCount <= 0 || escalationWindow <= 0 || !blockTime.After(i.LastJailTime) {
+		return i.RecentJailCount
+	}
+
+	decay := int64(blockTime.Sub(i.LastJailTime) / escalationWindow)
+	if decay >= i.RecentJailCount {
+		return 0
+	}
+	return i.RecentJailCount - decay
 }
 
 // unmarshal a validator signing info from a store value
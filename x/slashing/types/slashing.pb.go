@@ -49,6 +49,11 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Number of recent downtime jails of the validator, used to escalate the penalties of repeat
+	// offenses. It decays by one for each `downtime_jail_escalation_window` elapsed since the last jail.
+	RecentJailCount int64 `protobuf:"varint,7,opt,name=recent_jail_count,json=recentJailCount,proto3" json:"recent_jail_count,omitempty"`
+	// Timestamp of the last downtime jail of the validator.
+	LastJailTime time.Time `protobuf:"bytes,8,opt,name=last_jail_time,json=lastJailTime,proto3,stdtime" json:"last_jail_time"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -125,6 +130,104 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetRecentJailCount() int64 {
+	if m != nil {
+		return m.RecentJailCount
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetLastJailTime() time.Time {
+	if m != nil {
+		return m.LastJailTime
+	}
+	return time.Time{}
+}
+
+// DowntimeOffense records a downtime jail of a validator and the penalties it was given.
+type DowntimeOffense struct {
+	// address is the validator consensus address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height at which the validator was jailed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time at which the validator was jailed.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// jailed_until is the time until which the validator was jailed.
+	JailedUntil time.Time `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// slash_fraction is the fraction of the validator stake which was slashed.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// recent_jail_count is the number of recent downtime jails of the validator, this one included.
+	RecentJailCount int64 `protobuf:"varint,6,opt,name=recent_jail_count,json=recentJailCount,proto3" json:"recent_jail_count,omitempty"`
+}
+
+func (m *DowntimeOffense) Reset()         { *m = DowntimeOffense{} }
+func (m *DowntimeOffense) String() string { return proto.CompactTextString(m) }
+func (*DowntimeOffense) ProtoMessage()    {}
+func (*DowntimeOffense) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *DowntimeOffense) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeOffense) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeOffense.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeOffense) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeOffense.Merge(m, src)
+}
+func (m *DowntimeOffense) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeOffense) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeOffense.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeOffense proto.InternalMessageInfo
+
+func (m *DowntimeOffense) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DowntimeOffense) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DowntimeOffense) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeOffense) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeOffense) GetRecentJailCount() int64 {
+	if m != nil {
+		return m.RecentJailCount
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -132,13 +235,27 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// downtime_jail_escalation_window is the window within which a downtime jail counts as a repeat
+	// offense of the previous one. The recent jail count of a validator decays by one for each
+	// window elapsed since its last jail.
+	DowntimeJailEscalationWindow time.Duration `protobuf:"bytes,6,opt,name=downtime_jail_escalation_window,json=downtimeJailEscalationWindow,proto3,stdduration" json:"downtime_jail_escalation_window"`
+	// downtime_jail_duration_multiplier multiplies the downtime jail duration for each repeat offense.
+	DowntimeJailDurationMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=downtime_jail_duration_multiplier,json=downtimeJailDurationMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_duration_multiplier"`
+	// slash_fraction_downtime_multiplier multiplies the downtime slash fraction for each repeat offense.
+	SlashFractionDowntimeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=slash_fraction_downtime_multiplier,json=slashFractionDowntimeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime_multiplier"`
+	// max_downtime_jail_escalations is the maximum number of times the penalties of repeat offenses
+	// are multiplied.
+	MaxDowntimeJailEscalations int64 `protobuf:"varint,9,opt,name=max_downtime_jail_escalations,json=maxDowntimeJailEscalations,proto3" json:"max_downtime_jail_escalations,omitempty"`
+	// double_sign_evidence_bounty is the share of the tokens slashed for a double sign paid to the
+	// submitter of the evidence.
+	DoubleSignEvidenceBounty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=double_sign_evidence_bounty,json=doubleSignEvidenceBounty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"double_sign_evidence_bounty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -181,8 +298,23 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeJailEscalationWindow() time.Duration {
+	if m != nil {
+		return m.DowntimeJailEscalationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDowntimeJailEscalations() int64 {
+	if m != nil {
+		return m.MaxDowntimeJailEscalations
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*DowntimeOffense)(nil), "cosmos.slashing.v1beta1.DowntimeOffense")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -191,46 +323,61 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3f, 0x4f, 0xdb, 0x4e,
-	0x18, 0xce, 0x01, 0x3f, 0x7e, 0xf4, 0x42, 0x87, 0x5e, 0x43, 0x31, 0x51, 0x65, 0x07, 0x06, 0x14,
-	0x21, 0x61, 0x97, 0xb0, 0xb1, 0x35, 0x45, 0x55, 0xff, 0x49, 0x45, 0xa1, 0x7f, 0xa4, 0x0e, 0xb5,
-	0xce, 0xbe, 0xb3, 0x73, 0xc5, 0xbe, 0x8b, 0x7c, 0xe7, 0x42, 0xf7, 0x4e, 0x9d, 0x18, 0x19, 0x19,
-	0x19, 0x19, 0x3a, 0xf6, 0x03, 0x30, 0xa2, 0x4e, 0x55, 0x07, 0x5a, 0x85, 0x81, 0x7e, 0x8c, 0xca,
-	0x77, 0x76, 0x88, 0x40, 0xea, 0xc0, 0x92, 0xd8, 0xcf, 0xf3, 0xbc, 0xcf, 0x7b, 0xcf, 0xfb, 0x9e,
-	0xe1, 0x72, 0x28, 0x64, 0x2a, 0xa4, 0x27, 0x13, 0x2c, 0xfb, 0x8c, 0xc7, 0xde, 0xc7, 0xb5, 0x80,
-	0x2a, 0xbc, 0x36, 0x02, 0xdc, 0x41, 0x26, 0x94, 0x40, 0xf3, 0x46, 0xe7, 0x8e, 0xe0, 0x52, 0xd7,
-	0x6c, 0xc4, 0x22, 0x16, 0x5a, 0xe3, 0x15, 0x4f, 0x46, 0xde, 0xb4, 0x63, 0x21, 0xe2, 0x84, 0x7a,
-	0xfa, 0x2d, 0xc8, 0x23, 0x8f, 0xe4, 0x19, 0x56, 0x4c, 0xf0, 0x92, 0x77, 0xae, 0xf2, 0x8a, 0xa5,
-	0x54, 0x2a, 0x9c, 0x0e, 0x4a, 0xc1, 0x82, 0xe9, 0xe7, 0x1b, 0xe7, 0xb2, 0xb9, 0xa1, 0xee, 0xe0,
-	0x94, 0x71, 0xe1, 0xe9, 0x5f, 0x03, 0x2d, 0x7d, 0x9b, 0x80, 0x8d, 0x37, 0x38, 0x61, 0x04, 0x2b,
-	0x91, 0x6d, 0xb3, 0x98, 0x33, 0x1e, 0x3f, 0xe5, 0x91, 0x40, 0x1d, 0xf8, 0x3f, 0x26, 0x24, 0xa3,
-	0x52, 0x5a, 0xa0, 0x05, 0xda, 0xb7, 0xba, 0xd6, 0xf7, 0xaf, 0xab, 0x8d, 0xd2, 0xee, 0xa1, 0x61,
-	0xb6, 0x55, 0xc6, 0x78, 0xdc, 0xab, 0x84, 0x68, 0x11, 0xce, 0x4a, 0x85, 0x33, 0xe5, 0xf7, 0x29,
-	0x8b, 0xfb, 0xca, 0x9a, 0x68, 0x81, 0xf6, 0x64, 0xaf, 0xae, 0xb1, 0x27, 0x1a, 0x2a, 0x24, 0x8c,
-	0x13, 0xba, 0xe7, 0x8b, 0x28, 0x92, 0x54, 0x59, 0x93, 0x46, 0xa2, 0xb1, 0x97, 0x1a, 0x42, 0x2f,
-	0xe0, 0xec, 0x07, 0xcc, 0x12, 0x4a, 0xfc, 0x9c, 0x2b, 0x96, 0x58, 0x53, 0x2d, 0xd0, 0xae, 0x77,
-	0x9a, 0xae, 0x09, 0xee, 0x56, 0xc1, 0xdd, 0x57, 0x55, 0xf0, 0xee, 0xed, 0x93, 0x33, 0xa7, 0xb6,
-	0xff, 0xcb, 0x01, 0x47, 0x17, 0xc7, 0x2b, 0xa0, 0x57, 0x37, 0xe5, 0xaf, 0x8b, 0x6a, 0x64, 0x43,
-	0xa8, 0x44, 0x1a, 0x48, 0x25, 0x38, 0x25, 0xd6, 0x7f, 0x2d, 0xd0, 0x9e, 0xe9, 0x8d, 0x21, 0xa8,
-	0x03, 0xe7, 0x52, 0x26, 0x25, 0x25, 0x7e, 0x90, 0x88, 0x70, 0x47, 0xfa, 0xa1, 0xc8, 0xb9, 0xa2,
-	0x99, 0x35, 0xad, 0x4f, 0x76, 0xd7, 0x90, 0x5d, 0xcd, 0x3d, 0x32, 0xd4, 0xc6, 0xcc, 0xc1, 0xa1,
-	0x53, 0xfb, 0x73, 0xe8, 0x80, 0xa5, 0xcf, 0x53, 0x70, 0x7a, 0x0b, 0x67, 0x38, 0x95, 0xe8, 0x01,
-	0x6c, 0x48, 0x16, 0xf3, 0x4b, 0xa3, 0x5d, 0xc6, 0x89, 0xd8, 0xd5, 0xd3, 0x9b, 0xec, 0x21, 0xc3,
-	0x19, 0x9f, 0xb7, 0x9a, 0x41, 0x51, 0xd1, 0x9a, 0xfb, 0x65, 0xd5, 0x80, 0x66, 0x55, 0x49, 0x31,
-	0xb7, 0xd9, 0xee, 0x7a, 0x91, 0xea, 0xe7, 0x99, 0xb3, 0x1c, 0x33, 0xd5, 0xcf, 0x03, 0x37, 0x14,
-	0x69, 0xb9, 0xce, 0xf2, 0x6f, 0x55, 0x92, 0x1d, 0x4f, 0x7d, 0x1a, 0x50, 0xe9, 0x6e, 0xd2, 0xd0,
-	0x64, 0x47, 0x29, 0xe3, 0xdb, 0xda, 0x70, 0x8b, 0x66, 0x65, 0x9f, 0xf7, 0xf0, 0x1e, 0x11, 0xbb,
-	0xbc, 0xb8, 0x28, 0x7e, 0x31, 0x1a, 0xbf, 0xba, 0x52, 0x7a, 0xfa, 0xf5, 0xce, 0xc2, 0xb5, 0xd1,
-	0x6e, 0x96, 0x02, 0x33, 0xd9, 0x83, 0xd1, 0x64, 0x1b, 0x95, 0xcf, 0x33, 0xcc, 0x92, 0x4a, 0x84,
-	0x06, 0xb0, 0xa9, 0x2f, 0xb7, 0x1f, 0x65, 0x38, 0x2c, 0x10, 0x9f, 0x88, 0x3c, 0x48, 0xa8, 0x4e,
-	0xa6, 0xd7, 0x77, 0xc3, 0x30, 0xf3, 0xda, 0xf6, 0x71, 0xe9, 0xba, 0xa9, 0x4d, 0x8b, 0x70, 0x68,
-	0x07, 0xce, 0x5f, 0xeb, 0x68, 0x0e, 0xa6, 0x37, 0x7c, 0xc3, 0x76, 0x73, 0x57, 0xda, 0x19, 0xc7,
-	0x8d, 0xc5, 0x2f, 0x17, 0xc7, 0x2b, 0xf7, 0xc7, 0xca, 0xf6, 0x2e, 0xbf, 0x79, 0xb3, 0xfb, 0xee,
-	0xf3, 0xa3, 0xa1, 0x0d, 0x4e, 0x86, 0x36, 0x38, 0x1d, 0xda, 0xe0, 0xf7, 0xd0, 0x06, 0xfb, 0xe7,
-	0x76, 0xed, 0xf4, 0xdc, 0xae, 0xfd, 0x38, 0xb7, 0x6b, 0xef, 0x56, 0xff, 0x79, 0x88, 0x31, 0x37,
-	0x7d, 0x9e, 0x60, 0x5a, 0xaf, 0x61, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x46, 0x11,
-	0xae, 0x61, 0x04, 0x00, 0x00,
+	// 850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3d, 0x6f, 0x1b, 0x47,
+	0x10, 0xe5, 0x85, 0x34, 0x45, 0xad, 0x68, 0x1b, 0xde, 0xd0, 0xd6, 0x99, 0x71, 0xee, 0x28, 0x15,
+	0x06, 0x21, 0x80, 0x64, 0x2c, 0x77, 0x02, 0x52, 0x88, 0xa1, 0x83, 0x7c, 0x42, 0x06, 0x95, 0x0f,
+	0x20, 0x45, 0x0e, 0x7b, 0x77, 0x7b, 0xc7, 0x8d, 0xee, 0x76, 0x89, 0xdb, 0xa5, 0x45, 0x37, 0x41,
+	0xaa, 0x14, 0xa9, 0x5c, 0xba, 0x74, 0xe9, 0x2e, 0x2e, 0xdc, 0xe4, 0x1f, 0xb8, 0x34, 0x5c, 0x05,
+	0x29, 0x9c, 0x80, 0x2a, 0x94, 0x9f, 0x11, 0xec, 0xc7, 0x51, 0xa4, 0x44, 0x05, 0x20, 0xe1, 0x46,
+	0x12, 0x67, 0xde, 0xbc, 0x99, 0x37, 0x6f, 0x96, 0x10, 0xb8, 0x1b, 0x30, 0x9e, 0x32, 0xde, 0xe1,
+	0x09, 0xe2, 0x03, 0x42, 0xe3, 0xce, 0xa3, 0x7b, 0x3e, 0x16, 0xe8, 0xde, 0x34, 0xd0, 0x1e, 0x66,
+	0x4c, 0x30, 0xb8, 0xa9, 0x71, 0xed, 0x69, 0xd8, 0xe0, 0xea, 0xb5, 0x98, 0xc5, 0x4c, 0x61, 0x3a,
+	0xf2, 0x2f, 0x0d, 0xaf, 0x3b, 0x31, 0x63, 0x71, 0x82, 0x3b, 0xea, 0x93, 0x3f, 0x8a, 0x3a, 0xe1,
+	0x28, 0x43, 0x82, 0x30, 0x6a, 0xf2, 0xee, 0xf9, 0xbc, 0x20, 0x29, 0xe6, 0x02, 0xa5, 0x43, 0x03,
+	0xb8, 0xad, 0xfb, 0x79, 0x9a, 0xd9, 0x34, 0xd7, 0xa9, 0x1b, 0x28, 0x25, 0x94, 0x75, 0xd4, 0x4f,
+	0x1d, 0xda, 0xfe, 0xa3, 0x08, 0x6a, 0xdf, 0xa1, 0x84, 0x84, 0x48, 0xb0, 0xec, 0x90, 0xc4, 0x94,
+	0xd0, 0xf8, 0x73, 0x1a, 0x31, 0xb8, 0x0b, 0xd6, 0x50, 0x18, 0x66, 0x98, 0x73, 0xdb, 0x6a, 0x58,
+	0xcd, 0xf5, 0xae, 0xfd, 0xe6, 0x65, 0xab, 0x66, 0xe8, 0xf6, 0x75, 0xe6, 0x50, 0x64, 0x84, 0xc6,
+	0xfd, 0x1c, 0x08, 0xb7, 0x40, 0x95, 0x0b, 0x94, 0x09, 0x6f, 0x80, 0x49, 0x3c, 0x10, 0xf6, 0x7b,
+	0x0d, 0xab, 0x59, 0xec, 0x6f, 0xa8, 0xd8, 0x67, 0x2a, 0x24, 0x21, 0x84, 0x86, 0x78, 0xec, 0xb1,
+	0x28, 0xe2, 0x58, 0xd8, 0x45, 0x0d, 0x51, 0xb1, 0x03, 0x15, 0x82, 0x5f, 0x81, 0xea, 0x4f, 0x88,
+	0x24, 0x38, 0xf4, 0x46, 0x54, 0x90, 0xc4, 0x2e, 0x35, 0xac, 0xe6, 0xc6, 0x6e, 0xbd, 0xad, 0x85,
+	0xb7, 0x73, 0xe1, 0xed, 0x6f, 0x72, 0xe1, 0xdd, 0xab, 0xaf, 0xde, 0xba, 0x85, 0x27, 0x7f, 0xbb,
+	0xd6, 0xf3, 0xd3, 0x17, 0x3b, 0x56, 0x7f, 0x43, 0x97, 0x7f, 0x2b, 0xab, 0xa1, 0x03, 0x80, 0x60,
+	0xa9, 0xcf, 0x05, 0xa3, 0x38, 0xb4, 0xaf, 0x34, 0xac, 0x66, 0xa5, 0x3f, 0x13, 0x81, 0xbb, 0xe0,
+	0x66, 0x4a, 0x38, 0xc7, 0xa1, 0xe7, 0x27, 0x2c, 0x38, 0xe2, 0x5e, 0xc0, 0x46, 0x54, 0xe0, 0xcc,
+	0x2e, 0xab, 0xc9, 0xde, 0xd7, 0xc9, 0xae, 0xca, 0x7d, 0xa2, 0x53, 0x70, 0x07, 0xdc, 0xc8, 0x70,
+	0x80, 0xa9, 0xf0, 0x64, 0x27, 0x5d, 0x61, 0xaf, 0x29, 0xfc, 0x75, 0x9d, 0xf8, 0x02, 0x91, 0x44,
+	0xa1, 0xe1, 0x01, 0xb8, 0x96, 0x20, 0x6e, 0x90, 0xd2, 0x2b, 0xbb, 0xb2, 0xac, 0x9e, 0xaa, 0x24,
+	0x90, 0x8c, 0x12, 0xb1, 0x57, 0x79, 0xfa, 0xcc, 0x2d, 0xfc, 0xfb, 0xcc, 0xb5, 0xb6, 0x7f, 0x2d,
+	0x82, 0xeb, 0x3d, 0x76, 0x4c, 0x25, 0xeb, 0x41, 0x14, 0x61, 0xca, 0xf1, 0x4a, 0xb6, 0xdd, 0x02,
+	0xe5, 0x39, 0xc3, 0xcc, 0x27, 0xf8, 0x31, 0x28, 0xa9, 0x81, 0x8b, 0xcb, 0x0e, 0xac, 0xca, 0xde,
+	0xb1, 0x8f, 0x03, 0x70, 0x4d, 0xbd, 0x20, 0x2f, 0xca, 0x50, 0x20, 0xdf, 0x83, 0xf2, 0x72, 0xbd,
+	0xbb, 0x2f, 0x6b, 0xfe, 0x7a, 0xeb, 0xde, 0x8d, 0x89, 0x18, 0x8c, 0xfc, 0x76, 0xc0, 0x52, 0x73,
+	0xf4, 0xe6, 0x57, 0x8b, 0x87, 0x47, 0x1d, 0xf1, 0x78, 0x88, 0x79, 0xbb, 0x87, 0x83, 0x37, 0x2f,
+	0x5b, 0xc0, 0x6c, 0xa3, 0x87, 0x03, 0xdd, 0xe7, 0xaa, 0x22, 0xfe, 0xd4, 0xf0, 0x2e, 0x76, 0xb7,
+	0xbc, 0xd0, 0xdd, 0xbd, 0x92, 0x32, 0xe2, 0xf7, 0x0a, 0x28, 0x3f, 0x44, 0x19, 0x4a, 0x39, 0xfc,
+	0x08, 0xd4, 0x38, 0x89, 0xe9, 0xd9, 0x39, 0x1d, 0x13, 0x1a, 0xb2, 0x63, 0x65, 0x46, 0xb1, 0x0f,
+	0x75, 0x4e, 0x5f, 0xd3, 0xf7, 0x2a, 0x03, 0x23, 0x79, 0x80, 0xd4, 0x33, 0x55, 0x43, 0x9c, 0xe5,
+	0x25, 0xd2, 0x8c, 0x6a, 0xf7, 0xfe, 0x72, 0xfa, 0xb4, 0x22, 0x98, 0x12, 0x7a, 0xa8, 0x08, 0x1f,
+	0xe2, 0xcc, 0xf4, 0xf9, 0x11, 0xdc, 0x0a, 0xcd, 0xb1, 0x68, 0x61, 0xf9, 0x17, 0x8b, 0xf1, 0xf7,
+	0xf6, 0x05, 0x63, 0x7a, 0x06, 0xa0, 0x7d, 0x79, 0x3a, 0xf5, 0xa5, 0x96, 0xf3, 0xc8, 0x3d, 0xe4,
+	0x20, 0x38, 0x04, 0xf5, 0x79, 0x83, 0xbc, 0x90, 0x8d, 0xfc, 0x04, 0x2b, 0x65, 0x76, 0x69, 0x75,
+	0x31, 0x9b, 0x73, 0xf6, 0xf4, 0x14, 0xa9, 0x14, 0x07, 0x8f, 0xc0, 0xe6, 0x85, 0x8e, 0x7a, 0x30,
+	0xfb, 0xca, 0xea, 0xed, 0x6e, 0x9e, 0x6b, 0xa7, 0x19, 0x21, 0x03, 0xee, 0xfc, 0xfa, 0x30, 0x0f,
+	0x50, 0xa2, 0xa4, 0xe7, 0x86, 0x95, 0x97, 0xdc, 0xe3, 0x9d, 0xd9, 0x3d, 0x3e, 0x98, 0xd2, 0x19,
+	0xbf, 0x7e, 0x06, 0x5b, 0x8b, 0xfd, 0xf2, 0xd2, 0x51, 0x22, 0xc8, 0x30, 0x21, 0x38, 0xb3, 0xd7,
+	0x56, 0xd7, 0xe9, 0x2c, 0x72, 0xf1, 0xeb, 0x29, 0x35, 0xfc, 0xc5, 0x02, 0xdb, 0x97, 0xac, 0x77,
+	0x76, 0x82, 0xca, 0xea, 0x13, 0xb8, 0x0b, 0x37, 0x3d, 0x33, 0xc2, 0x3e, 0xf8, 0x30, 0x45, 0x63,
+	0xef, 0xb2, 0xbd, 0x73, 0x7b, 0x5d, 0xbd, 0xaa, 0x7a, 0x8a, 0xc6, 0xbd, 0x85, 0xab, 0xe4, 0x30,
+	0x03, 0x1f, 0xcc, 0x9c, 0xa1, 0x87, 0x1f, 0x91, 0x10, 0xd3, 0x00, 0x7b, 0xbe, 0x7c, 0xbe, 0x8f,
+	0x6d, 0xb0, 0xfa, 0xf4, 0x76, 0x38, 0xbd, 0xc4, 0x07, 0x86, 0xb5, 0xab, 0x48, 0xf7, 0xb6, 0x7e,
+	0x3b, 0x7d, 0xb1, 0x73, 0x67, 0xa6, 0x72, 0x7c, 0xf6, 0x4f, 0x82, 0xfe, 0x9a, 0xe8, 0x7e, 0xf9,
+	0x7c, 0xe2, 0x58, 0xaf, 0x26, 0x8e, 0xf5, 0x7a, 0xe2, 0x58, 0xff, 0x4c, 0x1c, 0xeb, 0xc9, 0x89,
+	0x53, 0x78, 0x7d, 0xe2, 0x14, 0xfe, 0x3c, 0x71, 0x0a, 0x3f, 0xb4, 0xfe, 0x77, 0x8e, 0x19, 0x36,
+	0x35, 0x92, 0x5f, 0x56, 0x97, 0x76, 0xff, 0xbf, 0x01, 0x00, 0x05, 0x2a, 0x11, 0x2f, 0x92, 0x08,
+	0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -270,6 +417,51 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.RecentJailCount != that1.RecentJailCount {
+		return false
+	}
+	if !this.LastJailTime.Equal(that1.LastJailTime) {
+		return false
+	}
+	return true
+}
+func (this *DowntimeOffense) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimeOffense)
+	if !ok {
+		that2, ok := that.(DowntimeOffense)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.JailedUntil.Equal(that1.JailedUntil) {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.RecentJailCount != that1.RecentJailCount {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -306,6 +498,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.DowntimeJailEscalationWindow != that1.DowntimeJailEscalationWindow {
+		return false
+	}
+	if !this.DowntimeJailDurationMultiplier.Equal(that1.DowntimeJailDurationMultiplier) {
+		return false
+	}
+	if !this.SlashFractionDowntimeMultiplier.Equal(that1.SlashFractionDowntimeMultiplier) {
+		return false
+	}
+	if this.MaxDowntimeJailEscalations != that1.MaxDowntimeJailEscalations {
+		return false
+	}
+	if !this.DoubleSignEvidenceBounty.Equal(that1.DoubleSignEvidenceBounty) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -328,6 +535,19 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastJailTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastJailTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.RecentJailCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.RecentJailCount))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -343,12 +563,12 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if m.IndexOffset != 0 {
@@ -371,6 +591,72 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimeOffense) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeOffense) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeOffense) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecentJailCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.RecentJailCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -391,6 +677,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DoubleSignEvidenceBounty.Size()
+		i -= size
+		if _, err := m.DoubleSignEvidenceBounty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MaxDowntimeJailEscalations != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxDowntimeJailEscalations))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.SlashFractionDowntimeMultiplier.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntimeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DowntimeJailDurationMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeJailDurationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailEscalationWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailEscalationWindow):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -411,12 +740,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n6
+	i = encodeVarintSlashing(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -472,6 +801,36 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.RecentJailCount != 0 {
+		n += 1 + sovSlashing(uint64(m.RecentJailCount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastJailTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *DowntimeOffense) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.RecentJailCount != 0 {
+		n += 1 + sovSlashing(uint64(m.RecentJailCount))
+	}
 	return n
 }
 
@@ -492,6 +851,17 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailEscalationWindow)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailDurationMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntimeMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.MaxDowntimeJailEscalations != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxDowntimeJailEscalations))
+	}
+	l = m.DoubleSignEvidenceBounty.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -672,16 +1042,288 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSlashing(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentJailCount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSlashing
+			m.RecentJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecentJailCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJailTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastJailTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeOffense) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeOffense: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeOffense: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentJailCount", wireType)
+			}
+			m.RecentJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecentJailCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -873,6 +1515,157 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailEscalationWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailEscalationWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDurationMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailDurationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntimeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeJailEscalations", wireType)
+			}
+			m.MaxDowntimeJailEscalations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDowntimeJailEscalations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSignEvidenceBounty", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DoubleSignEvidenceBounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
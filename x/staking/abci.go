@@ -23,5 +23,15 @@ func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k *keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	// apply the announced commission changes that have taken effect
+	if err := k.ApplyMatureCommissionChanges(ctx); err != nil {
+		panic(err)
+	}
+
+	// remove the consensus pubkey rotations whose unbonding period has passed
+	if err := k.RemoveMatureConsPubKeyRotations(ctx); err != nil {
+		panic(err)
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -36,6 +36,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorDelegations(),
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
+		GetCmdQueryValidatorPendingCommissionChange(),
+		GetCmdQueryPendingCommissionChanges(),
+		GetCmdQueryTokenizeShareRecordByID(),
+		GetCmdQueryTokenizeShareRecordByDenom(),
+		GetCmdQueryTokenizeShareRecordsOwned(),
+		GetCmdQueryAllTokenizeShareRecords(),
+		GetCmdQueryTotalLiquidStaked(),
+		GetCmdQueryValidatorLiquidShares(),
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
@@ -744,3 +752,350 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryValidatorPendingCommissionChange implements the query pending
+// commission change of a validator command.
+func GetCmdQueryValidatorPendingCommissionChange() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "pending-commission-change [validator-addr]",
+		Short: "Query the pending commission change of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the commission change announced by a validator which has not taken effect yet.
+
+Example:
+$ %s query staking pending-commission-change %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorPendingCommissionChangeRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorPendingCommissionChange(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Change)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingCommissionChanges implements the query all pending
+// commission changes command.
+func GetCmdQueryPendingCommissionChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-commission-changes",
+		Short: "Query for all pending commission changes",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the commission changes announced by all validators which have not taken effect yet.
+
+Example:
+$ %s query staking pending-commission-changes
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingCommissionChanges(cmd.Context(), &types.QueryPendingCommissionChangesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending commission changes")
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordByID implements the query tokenize share
+// record by id command.
+func GetCmdQueryTokenizeShareRecordByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-id [id]",
+		Short: "Query a tokenize share record by id",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a tokenize share record by its id.
+
+Example:
+$ %s query staking tokenize-share-record-by-id 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordById(cmd.Context(), &types.QueryTokenizeShareRecordByIdRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordByDenom implements the query tokenize share
+// record by share token denom command.
+func GetCmdQueryTokenizeShareRecordByDenom() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-denom [denom]",
+		Short: "Query a tokenize share record by share token denom",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share record of a share token denom.
+
+Example:
+$ %s query staking tokenize-share-record-by-denom %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecordByDenom(cmd.Context(), &types.QueryTokenizeShareRecordByDenomRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the query tokenize share
+// records by owner command.
+func GetCmdQueryTokenizeShareRecordsOwned() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner]",
+		Short: "Query the tokenize share records of an owner",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records owned by an address.
+
+Example:
+$ %s query staking tokenize-share-records-owned %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{
+				Owner: owner.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllTokenizeShareRecords implements the query all tokenize share
+// records command.
+func GetCmdQueryAllTokenizeShareRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-tokenize-share-records",
+		Short: "Query for all tokenize share records",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the tokenize share records.
+
+Example:
+$ %s query staking all-tokenize-share-records
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllTokenizeShareRecords(cmd.Context(), &types.QueryAllTokenizeShareRecordsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenize share records")
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the query total liquid staked
+// tokens command.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Short: "Query the total amount of liquid staked tokens",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount of tokens delegated by tokenize share records.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorLiquidShares implements the query liquid shares of a
+// validator command.
+func GetCmdQueryValidatorLiquidShares() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "liquid-shares [validator-addr]",
+		Short: "Query the liquid shares of a validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegator shares of a validator held by tokenize share records.
+
+Example:
+$ %s query staking liquid-shares %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorLiquidShares(cmd.Context(), &types.QueryValidatorLiquidSharesRequest{
+				ValidatorAddr: addr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
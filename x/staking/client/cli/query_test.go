@@ -84,6 +84,140 @@ func (s *CLITestSuite) TestGetCmdQueryValidators() {
 	}
 }
 
+func (s *CLITestSuite) TestGetCmdQueryValidatorPendingCommissionChange() {
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"with invalid address",
+			[]string{"somethinginvalidaddress", fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			true,
+		},
+		{
+			"happy case",
+			[]string{sdk.ValAddress(s.addrs[0]).String(), fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorPendingCommissionChange()
+			clientCtx := s.clientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				var result types.PendingCommissionChange
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &result))
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestGetCmdQueryPendingCommissionChanges() {
+	testCases := []struct {
+		name string
+		args []string
+	}{
+		{
+			"with pagination",
+			[]string{
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+				fmt.Sprintf("--%s=1", flags.FlagLimit),
+			},
+		},
+		{
+			"without pagination",
+			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryPendingCommissionChanges()
+			clientCtx := s.clientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var result types.QueryPendingCommissionChangesResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &result))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestGetCmdQueryAllTokenizeShareRecords() {
+	testCases := []struct {
+		name string
+		args []string
+	}{
+		{
+			"with pagination",
+			[]string{
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+				fmt.Sprintf("--%s=1", flags.FlagLimit),
+			},
+		},
+		{
+			"without pagination",
+			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryAllTokenizeShareRecords()
+			clientCtx := s.clientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var result types.QueryAllTokenizeShareRecordsResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &result))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestGetCmdQueryValidatorLiquidShares() {
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"with invalid address",
+			[]string{"somethinginvalidaddress", fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			true,
+		},
+		{
+			"happy case",
+			[]string{sdk.ValAddress(s.addrs[0]).String(), fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorLiquidShares()
+			clientCtx := s.clientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				var result types.QueryValidatorLiquidSharesResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &result))
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestGetCmdQueryDelegation() {
 	testCases := []struct {
 		name     string
@@ -45,6 +45,18 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStatePendingCommissionChanges(data.PendingCommissionChanges); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateConsPubKeyRotations(data.ConsPubKeyRotations); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -85,3 +97,66 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStatePendingCommissionChanges(changes []types.PendingCommissionChange) error {
+	valMap := make(map[string]bool, len(changes))
+
+	for _, change := range changes {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := valMap[change.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate pending commission change in genesis state: validator %s", change.ValidatorAddress)
+		}
+
+		valMap[change.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastRecordID uint64) error {
+	idMap := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := idMap[record.Id]; ok {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > lastRecordID {
+			return fmt.Errorf("tokenize share record id %d exceeds the last tokenize share record id %d", record.Id, lastRecordID)
+		}
+
+		idMap[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateConsPubKeyRotations(rotations []types.ConsPubKeyRotation) error {
+	consAddrMap := make(map[string]bool, len(rotations))
+
+	for _, rotation := range rotations {
+		if err := rotation.Validate(); err != nil {
+			return err
+		}
+
+		consAddr, err := rotation.GetOldConsAddr()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := consAddrMap[consAddr.String()]; ok {
+			return fmt.Errorf("duplicate consensus pubkey rotation in genesis state: consensus address %s", consAddr)
+		}
+
+		consAddrMap[consAddr.String()] = true
+	}
+
+	return nil
+}
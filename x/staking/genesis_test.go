@@ -2,6 +2,7 @@ package staking_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
@@ -20,6 +21,15 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = math.OneInt()
 	genValidators1[0].DelegatorShares = math.LegacyOneDec()
 
+	announceTime := time.Unix(1000, 0).UTC()
+	pendingChange := types.NewPendingCommissionChange(sdk.ValAddress(pk.Address()), math.LegacyNewDecWithPrec(1, 1), announceTime, announceTime.Add(time.Hour))
+
+	record := types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))
+
+	newPk := ed25519.GenPrivKey().PubKey()
+	rotation, err := types.NewConsPubKeyRotation(sdk.ValAddress(pk.Address()), pk, newPk, 1, announceTime)
+	assert.NoError(t, err)
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -40,6 +50,53 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate pending commission changes
+		{"pending commission change", func(data *types.GenesisState) {
+			data.PendingCommissionChanges = []types.PendingCommissionChange{pendingChange}
+		}, false},
+		{"duplicate pending commission change", func(data *types.GenesisState) {
+			data.PendingCommissionChanges = []types.PendingCommissionChange{pendingChange, pendingChange}
+		}, true},
+		{"pending commission change rate too large", func(data *types.GenesisState) {
+			change := pendingChange
+			change.Rate = math.LegacyNewDec(2)
+			data.PendingCommissionChanges = []types.PendingCommissionChange{change}
+		}, true},
+		{"pending commission change effective before announced", func(data *types.GenesisState) {
+			change := pendingChange
+			change.EffectiveTime = change.AnnounceTime.Add(-time.Hour)
+			data.PendingCommissionChanges = []types.PendingCommissionChange{change}
+		}, true},
+		// validate tokenize share records
+		{"tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id exceeds last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+		}, true},
+		{"tokenize share record with invalid module account", func(data *types.GenesisState) {
+			invalidRecord := record
+			invalidRecord.ModuleAccount = "tokenizeshare_2"
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{invalidRecord}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		// validate consensus pubkey rotations
+		{"consensus pubkey rotation", func(data *types.GenesisState) {
+			data.ConsPubKeyRotations = []types.ConsPubKeyRotation{rotation}
+		}, false},
+		{"duplicate consensus pubkey rotation", func(data *types.GenesisState) {
+			data.ConsPubKeyRotations = []types.ConsPubKeyRotation{rotation, rotation}
+		}, true},
+		{"consensus pubkey rotation to the same pubkey", func(data *types.GenesisState) {
+			sameRotation := rotation
+			sameRotation.NewConsPubkey = rotation.OldConsPubkey
+			data.ConsPubKeyRotations = []types.ConsPubKeyRotation{sameRotation}
+		}, true},
 	}
 
 	for _, tt := range tests {
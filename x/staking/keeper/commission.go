@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// CommissionChangeDelay - The delay between the announcement of a commission
+// rate change and the time it takes effect
+func (k Keeper) CommissionChangeDelay(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).CommissionChangeDelay
+}
+
+// GetPendingCommissionChange returns the pending commission change of a validator.
+func (k Keeper) GetPendingCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress) (change types.PendingCommissionChange, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetPendingCommissionChangeKey(valAddr))
+	if bz == nil {
+		return change, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &change)
+	return change, true
+}
+
+// SetPendingCommissionChange sets the pending commission change of a validator.
+func (k Keeper) SetPendingCommissionChange(ctx sdk.Context, change types.PendingCommissionChange) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&change)
+	store.Set(types.GetPendingCommissionChangeKey(change.GetValidatorAddr()), bz)
+}
+
+// RemovePendingCommissionChange removes the pending commission change of a
+// validator along with its entry in the commission change queue.
+func (k Keeper) RemovePendingCommissionChange(ctx sdk.Context, valAddr sdk.ValAddress) {
+	change, found := k.GetPendingCommissionChange(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	k.DeleteCommissionChangeQueue(ctx, change)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingCommissionChangeKey(valAddr))
+}
+
+// IteratePendingCommissionChanges iterates through all the pending commission
+// changes.
+func (k Keeper) IteratePendingCommissionChanges(ctx sdk.Context, cb func(change types.PendingCommissionChange) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingCommissionChangeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.PendingCommissionChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+
+		if cb(change) {
+			break
+		}
+	}
+}
+
+// GetAllPendingCommissionChanges returns all the pending commission changes.
+func (k Keeper) GetAllPendingCommissionChanges(ctx sdk.Context) (changes []types.PendingCommissionChange) {
+	k.IteratePendingCommissionChanges(ctx, func(change types.PendingCommissionChange) bool {
+		changes = append(changes, change)
+		return false
+	})
+
+	return changes
+}
+
+// GetCommissionChangeQueueTimeSlice gets a specific commission change queue
+// timeslice. A timeslice is a slice of validator addresses corresponding to
+// commission changes that take effect at a certain time.
+func (k Keeper) GetCommissionChangeQueueTimeSlice(ctx sdk.Context, timestamp time.Time) []string {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetCommissionChangeTimeKey(timestamp))
+	if bz == nil {
+		return []string{}
+	}
+
+	addrs := types.ValAddresses{}
+	k.cdc.MustUnmarshal(bz, &addrs)
+
+	return addrs.Addresses
+}
+
+// SetCommissionChangeQueueTimeSlice sets a specific commission change queue
+// timeslice.
+func (k Keeper) SetCommissionChangeQueueTimeSlice(ctx sdk.Context, timestamp time.Time, addrs []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	if len(addrs) == 0 {
+		store.Delete(types.GetCommissionChangeTimeKey(timestamp))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&types.ValAddresses{Addresses: addrs})
+	store.Set(types.GetCommissionChangeTimeKey(timestamp), bz)
+}
+
+// InsertCommissionChangeQueue inserts a pending commission change into the
+// appropriate timeslice in the commission change queue.
+func (k Keeper) InsertCommissionChangeQueue(ctx sdk.Context, change types.PendingCommissionChange) {
+	addrs := k.GetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime)
+	addrs = append(addrs, change.ValidatorAddress)
+	k.SetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime, addrs)
+}
+
+// DeleteCommissionChangeQueue removes a pending commission change from its
+// timeslice in the commission change queue.
+func (k Keeper) DeleteCommissionChangeQueue(ctx sdk.Context, change types.PendingCommissionChange) {
+	addrs := k.GetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime)
+	newAddrs := []string{}
+
+	deletingAddr := change.GetValidatorAddr()
+	for _, addr := range addrs {
+		storedAddr, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+		if !storedAddr.Equals(deletingAddr) {
+			newAddrs = append(newAddrs, storedAddr.String())
+		}
+	}
+
+	k.SetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime, newAddrs)
+}
+
+// CommissionChangeQueueIterator returns all the commission change queue
+// timeslices from time 0 until endTime.
+func (k Keeper) CommissionChangeQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.CommissionChangeQueueKey,
+		sdk.InclusiveEndBytes(types.GetCommissionChangeTimeKey(endTime)))
+}
+
+// AnnounceCommissionChange validates a commission rate change of a validator
+// and schedules it to take effect once the commission change delay has passed.
+// Any commission change previously announced by the validator is replaced.
+func (k Keeper) AnnounceCommissionChange(ctx sdk.Context, validator types.Validator, newRate sdk.Dec) (types.PendingCommissionChange, error) {
+	if _, err := k.UpdateValidatorCommission(ctx, validator, newRate); err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	valAddr := validator.GetOperator()
+	k.RemovePendingCommissionChange(ctx, valAddr)
+
+	blockTime := ctx.BlockHeader().Time
+	change := types.NewPendingCommissionChange(valAddr, newRate, blockTime, blockTime.Add(k.CommissionChangeDelay(ctx)))
+
+	k.SetPendingCommissionChange(ctx, change)
+	k.InsertCommissionChangeQueue(ctx, change)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAnnounceCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, change.EffectiveTime.Format(time.RFC3339)),
+		),
+	)
+
+	return change, nil
+}
+
+// ApplyMatureCommissionChanges applies all the pending commission changes
+// whose effective time has passed and removes them from the queue.
+func (k Keeper) ApplyMatureCommissionChanges(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockHeader().Time
+
+	// collect all the mature timeslices first, as applying the changes
+	// modifies the store
+	var matureAddrs []string
+
+	iterator := k.CommissionChangeQueueIterator(ctx, blockTime)
+	for ; iterator.Valid(); iterator.Next() {
+		addrs := types.ValAddresses{}
+		k.cdc.MustUnmarshal(iterator.Value(), &addrs)
+
+		matureAddrs = append(matureAddrs, addrs.Addresses...)
+
+		store.Delete(iterator.Key())
+	}
+	iterator.Close()
+
+	for _, addr := range matureAddrs {
+		valAddr, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			return err
+		}
+
+		change, found := k.GetPendingCommissionChange(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		store.Delete(types.GetPendingCommissionChangeKey(valAddr))
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		if err := k.applyCommissionChange(ctx, validator, change); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyCommissionChange sets the commission rate of a validator to the rate of
+// a mature commission change.
+func (k Keeper) applyCommissionChange(ctx sdk.Context, validator types.Validator, change types.PendingCommissionChange) error {
+	// the minimum commission rate may have been raised since the change was
+	// announced, in which case the validator commission is set to the minimum
+	rate := change.Rate
+	if minRate := k.MinCommissionRate(ctx); rate.LT(minRate) {
+		rate = minRate
+	}
+
+	// call the before-modification hook since we're about to update the commission
+	if err := k.Hooks().BeforeValidatorModified(ctx, validator.GetOperator()); err != nil {
+		return err
+	}
+
+	validator.Commission.Rate = rate
+	validator.Commission.UpdateTime = ctx.BlockHeader().Time
+	k.SetValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, rate.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestAnnounceCommissionChange() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	commission := stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1))
+	val := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	val, _ = val.SetInitialCommission(commission)
+	keeper.SetValidator(ctx, val)
+
+	// the rate change exceeds the max change rate
+	_, err := keeper.AnnounceCommissionChange(ctx, val, sdk.NewDecWithPrec(3, 1))
+	require.Error(err)
+	_, found := keeper.GetPendingCommissionChange(ctx, val.GetOperator())
+	require.False(found)
+
+	change, err := keeper.AnnounceCommissionChange(ctx, val, sdk.NewDecWithPrec(2, 1))
+	require.NoError(err)
+	require.Equal(sdk.NewDecWithPrec(2, 1), change.Rate)
+	require.Equal(ctx.BlockHeader().Time, change.AnnounceTime)
+	require.Equal(ctx.BlockHeader().Time.Add(stakingtypes.DefaultCommissionChangeDelay), change.EffectiveTime)
+
+	res, found := keeper.GetPendingCommissionChange(ctx, val.GetOperator())
+	require.True(found)
+	require.Equal(change, res)
+	require.Equal([]string{val.OperatorAddress}, keeper.GetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime))
+
+	// the validator commission is unchanged until the change takes effect
+	val, found = keeper.GetValidator(ctx, val.GetOperator())
+	require.True(found)
+	require.Equal(sdk.NewDecWithPrec(1, 1), val.Commission.Rate)
+
+	// a new announcement replaces the pending change
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	newChange, err := keeper.AnnounceCommissionChange(ctx, val, sdk.NewDecWithPrec(15, 2))
+	require.NoError(err)
+	require.Empty(keeper.GetCommissionChangeQueueTimeSlice(ctx, change.EffectiveTime))
+	require.Equal([]string{val.OperatorAddress}, keeper.GetCommissionChangeQueueTimeSlice(ctx, newChange.EffectiveTime))
+	require.Len(keeper.GetAllPendingCommissionChanges(ctx), 1)
+
+	keeper.RemovePendingCommissionChange(ctx, val.GetOperator())
+	_, found = keeper.GetPendingCommissionChange(ctx, val.GetOperator())
+	require.False(found)
+	require.Empty(keeper.GetCommissionChangeQueueTimeSlice(ctx, newChange.EffectiveTime))
+}
+
+func (s *KeeperTestSuite) TestApplyMatureCommissionChanges() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	commission := stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1))
+	val1 := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	val1, _ = val1.SetInitialCommission(commission)
+	val2 := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[1].Address().Bytes()), PKs[1])
+	val2, _ = val2.SetInitialCommission(commission)
+	keeper.SetValidator(ctx, val1)
+	keeper.SetValidator(ctx, val2)
+
+	change1, err := keeper.AnnounceCommissionChange(ctx, val1, sdk.NewDecWithPrec(2, 1))
+	require.NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	change2, err := keeper.AnnounceCommissionChange(ctx, val2, sdk.NewDecWithPrec(5, 2))
+	require.NoError(err)
+
+	// no change has taken effect yet
+	require.NoError(keeper.ApplyMatureCommissionChanges(ctx))
+	require.Len(keeper.GetAllPendingCommissionChanges(ctx), 2)
+
+	// only the first change has taken effect
+	ctx = ctx.WithBlockTime(change1.EffectiveTime)
+	require.NoError(keeper.ApplyMatureCommissionChanges(ctx))
+
+	val1, found := keeper.GetValidator(ctx, val1.GetOperator())
+	require.True(found)
+	require.Equal(change1.Rate, val1.Commission.Rate)
+	require.Equal(change1.EffectiveTime, val1.Commission.UpdateTime)
+	_, found = keeper.GetPendingCommissionChange(ctx, val1.GetOperator())
+	require.False(found)
+
+	val2, found = keeper.GetValidator(ctx, val2.GetOperator())
+	require.True(found)
+	require.Equal(sdk.NewDecWithPrec(1, 1), val2.Commission.Rate)
+
+	// the minimum commission rate is raised before the second change takes effect
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(8, 2)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockTime(change2.EffectiveTime.Add(time.Minute))
+	require.NoError(keeper.ApplyMatureCommissionChanges(ctx))
+
+	val2, found = keeper.GetValidator(ctx, val2.GetOperator())
+	require.True(found)
+	require.Equal(sdk.NewDecWithPrec(8, 2), val2.Commission.Rate)
+	require.Empty(keeper.GetAllPendingCommissionChanges(ctx))
+}
+
+func (s *KeeperTestSuite) TestEditValidatorCommission() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	commission := stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1))
+	val := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	val, _ = val.SetInitialCommission(commission)
+	keeper.SetValidator(ctx, val)
+
+	newRate := sdk.NewDecWithPrec(2, 1)
+	msg := stakingtypes.NewMsgEditValidator(val.GetOperator(), stakingtypes.Description{Moniker: "moniker"}, &newRate, nil, nil)
+
+	// the commission change is announced with the commission change delay
+	_, err := msgServer.EditValidator(ctx, msg)
+	require.NoError(err)
+
+	val, found := keeper.GetValidator(ctx, val.GetOperator())
+	require.True(found)
+	require.Equal(sdk.NewDecWithPrec(1, 1), val.Commission.Rate)
+
+	res, err := s.queryClient.ValidatorPendingCommissionChange(ctx, &stakingtypes.QueryValidatorPendingCommissionChangeRequest{ValidatorAddr: val.OperatorAddress})
+	require.NoError(err)
+	require.Equal(newRate, res.Change.Rate)
+
+	// the commission change takes effect immediately without a commission change delay
+	params := keeper.GetParams(ctx)
+	params.CommissionChangeDelay = 0
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, err = msgServer.EditValidator(ctx, msg)
+	require.NoError(err)
+
+	val, found = keeper.GetValidator(ctx, val.GetOperator())
+	require.True(found)
+	require.Equal(newRate, val.Commission.Rate)
+
+	changes, err := s.queryClient.PendingCommissionChanges(ctx, &stakingtypes.QueryPendingCommissionChangesRequest{})
+	require.NoError(err)
+	require.Empty(changes.Changes)
+}
@@ -0,0 +1,361 @@
+package keeper
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// KeyRotationFee - The fee burned for each consensus pubkey rotation
+func (k Keeper) KeyRotationFee(ctx sdk.Context) sdk.Coin {
+	return k.GetParams(ctx).KeyRotationFee
+}
+
+// MaxConsPubKeyRotations - The maximum number of consensus pubkey rotations of
+// a validator within an unbonding period
+func (k Keeper) MaxConsPubKeyRotations(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxConsPubKeyRotations
+}
+
+// GetConsPubKeyRotation returns the consensus pubkey rotation of a validator at
+// a given height.
+func (k Keeper) GetConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress, height int64) (rotation types.ConsPubKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetConsPubKeyRotationKey(valAddr, height))
+	if bz == nil {
+		return rotation, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return rotation, true
+}
+
+// SetConsPubKeyRotation sets a consensus pubkey rotation along with the index
+// mapping the previous consensus address of the validator to the validator.
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) error {
+	oldConsAddr, err := rotation.GetOldConsAddr()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	valAddr := rotation.GetValidatorAddr()
+
+	bz := k.cdc.MustMarshal(&rotation)
+	store.Set(types.GetConsPubKeyRotationKey(valAddr, rotation.Height), bz)
+	store.Set(types.GetValidatorByRotatedConsAddrKey(oldConsAddr), valAddr)
+
+	return nil
+}
+
+// RemoveConsPubKeyRotation removes a consensus pubkey rotation along with the
+// index mapping the previous consensus address of the validator to the
+// validator.
+func (k Keeper) RemoveConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) error {
+	oldConsAddr, err := rotation.GetOldConsAddr()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	valAddr := rotation.GetValidatorAddr()
+
+	store.Delete(types.GetConsPubKeyRotationKey(valAddr, rotation.Height))
+	store.Delete(types.GetConsPubKeyRotationByHeightIndexKey(rotation.Height, valAddr))
+	store.Delete(types.GetValidatorByRotatedConsAddrKey(oldConsAddr))
+
+	return nil
+}
+
+// GetValidatorConsPubKeyRotations returns the consensus pubkey rotations of a
+// validator within the unbonding period, ordered by height.
+func (k Keeper) GetValidatorConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) (rotations []types.ConsPubKeyRotation) {
+	k.iterateConsPubKeyRotations(ctx, types.GetConsPubKeyRotationsKey(valAddr), func(rotation types.ConsPubKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+
+	return rotations
+}
+
+// IterateConsPubKeyRotations iterates through all the consensus pubkey
+// rotations within the unbonding period.
+func (k Keeper) IterateConsPubKeyRotations(ctx sdk.Context, cb func(rotation types.ConsPubKeyRotation) (stop bool)) {
+	k.iterateConsPubKeyRotations(ctx, types.ConsPubKeyRotationKey, cb)
+}
+
+func (k Keeper) iterateConsPubKeyRotations(ctx sdk.Context, prefix []byte, cb func(rotation types.ConsPubKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.ConsPubKeyRotation
+		k.cdc.MustUnmarshal(iterator.Value(), &rotation)
+
+		if cb(rotation) {
+			break
+		}
+	}
+}
+
+// GetAllConsPubKeyRotations returns all the consensus pubkey rotations within
+// the unbonding period.
+func (k Keeper) GetAllConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+
+	return rotations
+}
+
+// GetBlockConsPubKeyRotations returns the consensus pubkey rotations which
+// occurred at the current height.
+func (k Keeper) GetBlockConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	height := ctx.BlockHeight()
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetConsPubKeyRotationsByHeightIndexKey(height))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotation, found := k.GetConsPubKeyRotation(ctx, iterator.Value(), height)
+		if !found {
+			panic("consensus pubkey rotation in the height index but not found")
+		}
+
+		rotations = append(rotations, rotation)
+	}
+
+	return rotations
+}
+
+// getValidatorByRotatedConsAddr returns the operator address of the validator
+// which rotated its consensus pubkey away from the given consensus address
+// within the unbonding period.
+func (k Keeper) getValidatorByRotatedConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.ValAddress {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.GetValidatorByRotatedConsAddrKey(consAddr))
+}
+
+// GetConsPubKeyRotationQueueTimeSlice gets a specific consensus pubkey
+// rotation queue timeslice. A timeslice is a slice of validator addresses
+// corresponding to consensus pubkey rotations completing at a certain time.
+func (k Keeper) GetConsPubKeyRotationQueueTimeSlice(ctx sdk.Context, timestamp time.Time) []string {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetConsPubKeyRotationTimeKey(timestamp))
+	if bz == nil {
+		return []string{}
+	}
+
+	addrs := types.ValAddresses{}
+	k.cdc.MustUnmarshal(bz, &addrs)
+
+	return addrs.Addresses
+}
+
+// SetConsPubKeyRotationQueueTimeSlice sets a specific consensus pubkey
+// rotation queue timeslice.
+func (k Keeper) SetConsPubKeyRotationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, addrs []string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.ValAddresses{Addresses: addrs})
+	store.Set(types.GetConsPubKeyRotationTimeKey(timestamp), bz)
+}
+
+// InsertConsPubKeyRotationQueue inserts a consensus pubkey rotation into the
+// appropriate timeslice in the consensus pubkey rotation queue.
+func (k Keeper) InsertConsPubKeyRotationQueue(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	addrs := k.GetConsPubKeyRotationQueueTimeSlice(ctx, rotation.CompletionTime)
+	addrs = append(addrs, rotation.ValidatorAddress)
+	k.SetConsPubKeyRotationQueueTimeSlice(ctx, rotation.CompletionTime, addrs)
+}
+
+// ConsPubKeyRotationQueueIterator returns all the consensus pubkey rotation
+// queue timeslices from time 0 until endTime.
+func (k Keeper) ConsPubKeyRotationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.ConsPubKeyRotationQueueKey,
+		sdk.InclusiveEndBytes(types.GetConsPubKeyRotationTimeKey(endTime)))
+}
+
+// RotateConsPubKey rotates the consensus pubkey of a validator. The key
+// rotation fee is paid by the validator operator and burned. The previous
+// consensus address of the validator keeps being mapped to the validator until
+// the unbonding period has passed, so that the infractions committed with the
+// previous consensus pubkey can still be handled, and the rotation is applied
+// to the CometBFT validator set by ApplyAndReturnValidatorSetUpdates.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	valAddr := validator.GetOperator()
+
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPubKey)); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	height := ctx.BlockHeight()
+	if _, found := k.GetConsPubKeyRotation(ctx, valAddr, height); found {
+		return types.ErrConsPubKeyRotatedInBlock
+	}
+
+	// the rotations are removed once their unbonding period has passed
+	maxRotations := k.MaxConsPubKeyRotations(ctx)
+	if uint32(len(k.GetValidatorConsPubKeyRotations(ctx, valAddr))) >= maxRotations {
+		return sdkerrors.Wrapf(
+			types.ErrExceedingMaxConsPubKeyRotations, "maximum of %d rotations within the unbonding period", maxRotations,
+		)
+	}
+
+	fee := sdk.NewCoins(k.KeyRotationFee(ctx))
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.ModuleName, fee); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee); err != nil {
+			return err
+		}
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	rotation, err := types.NewConsPubKeyRotation(valAddr, oldPubKey, newPubKey, height, completionTime)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SetConsPubKeyRotation(ctx, rotation); err != nil {
+		return err
+	}
+	k.InsertConsPubKeyRotationQueue(ctx, rotation)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConsPubKeyRotationByHeightIndexKey(height, valAddr), valAddr)
+
+	// update the validator and its consensus address index to the new pubkey
+	oldConsAddr := sdk.GetConsAddress(oldPubKey)
+	store.Delete(types.GetValidatorByConsAddrKey(oldConsAddr))
+
+	validator.ConsensusPubkey = rotation.NewConsPubkey
+	k.SetValidator(ctx, validator)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	if err := k.Hooks().AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.GetConsAddress(newPubKey).String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return nil
+}
+
+// RemoveMatureConsPubKeyRotations removes all the consensus pubkey rotations
+// whose unbonding period has passed, after which their previous consensus
+// address is no longer mapped to their validator.
+func (k Keeper) RemoveMatureConsPubKeyRotations(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockHeader().Time
+
+	// collect all the mature timeslices first, as removing the rotations
+	// modifies the store
+	var matureAddrs []string
+
+	iterator := k.ConsPubKeyRotationQueueIterator(ctx, blockTime)
+	for ; iterator.Valid(); iterator.Next() {
+		addrs := types.ValAddresses{}
+		k.cdc.MustUnmarshal(iterator.Value(), &addrs)
+
+		matureAddrs = append(matureAddrs, addrs.Addresses...)
+
+		store.Delete(iterator.Key())
+	}
+	iterator.Close()
+
+	for _, addr := range matureAddrs {
+		valAddr, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			return err
+		}
+
+		for _, rotation := range k.GetValidatorConsPubKeyRotations(ctx, valAddr) {
+			if rotation.CompletionTime.After(blockTime) {
+				continue
+			}
+
+			if err := k.RemoveConsPubKeyRotation(ctx, rotation); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyConsPubKeyRotationUpdates replaces, in the validator updates, the
+// consensus pubkeys of the validators of the last validator set which rotated
+// their consensus pubkey at the current height. CometBFT only knows these
+// validators by their previous consensus pubkey, which is removed from the
+// validator set and replaced by the new consensus pubkey if the validator is
+// still bonded.
+func (k Keeper) applyConsPubKeyRotationUpdates(
+	ctx sdk.Context, updates []abci.ValidatorUpdate, rotations []types.ConsPubKeyRotation,
+) ([]abci.ValidatorUpdate, error) {
+	powerReduction := k.PowerReduction(ctx)
+
+	for _, rotation := range rotations {
+		oldPk, err := rotation.OldConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		oldTmPk, err := cryptocodec.ToTmProtoPublicKey(oldPk)
+		if err != nil {
+			return nil, err
+		}
+
+		newPk, err := rotation.NewConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		newTmPk, err := cryptocodec.ToTmProtoPublicKey(newPk)
+		if err != nil {
+			return nil, err
+		}
+
+		// drop the updates of the new pubkey, which CometBFT doesn't know yet
+		filtered := updates[:0]
+		for _, update := range updates {
+			if !update.PubKey.Equal(newTmPk) {
+				filtered = append(filtered, update)
+			}
+		}
+		updates = append(filtered, abci.ValidatorUpdate{PubKey: oldTmPk, Power: 0})
+
+		validator := k.mustGetValidator(ctx, rotation.GetValidatorAddr())
+		if validator.IsBonded() {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: newTmPk, Power: validator.ConsensusPower(powerReduction)})
+		}
+	}
+
+	return updates, nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestRotateConsPubKey() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.MaxConsPubKeyRotations = 2
+	keeper.SetParams(ctx, params)
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	val := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, val)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, val))
+
+	otherVal := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[1].Address().Bytes()), PKs[1])
+	keeper.SetValidator(ctx, otherVal)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, otherVal))
+
+	// the new pubkey is already used by another validator
+	require.ErrorIs(keeper.RotateConsPubKey(ctx, val, PKs[1]), stakingtypes.ErrValidatorPubKeyExists)
+
+	fee := sdk.NewCoins(stakingtypes.DefaultKeyRotationFee)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.ModuleName, fee).Times(2)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, fee).Times(2)
+
+	require.NoError(keeper.RotateConsPubKey(ctx, val, PKs[2]))
+
+	rotation, found := keeper.GetConsPubKeyRotation(ctx, valAddr, ctx.BlockHeight())
+	require.True(found)
+	require.Equal(val.OperatorAddress, rotation.ValidatorAddress)
+	require.Equal(ctx.BlockHeader().Time.Add(stakingtypes.DefaultUnbondingTime), rotation.CompletionTime)
+	require.Equal([]string{val.OperatorAddress}, keeper.GetConsPubKeyRotationQueueTimeSlice(ctx, rotation.CompletionTime))
+	require.Equal([]stakingtypes.ConsPubKeyRotation{rotation}, keeper.GetBlockConsPubKeyRotations(ctx))
+
+	// the validator is found by both its previous and its new consensus address
+	val, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.True(found)
+	require.Equal(valAddr, val.GetOperator())
+	val, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[2]))
+	require.True(found)
+	consPk, err := val.ConsPubKey()
+	require.NoError(err)
+	require.True(PKs[2].Equals(consPk))
+
+	// only one rotation per block
+	require.ErrorIs(keeper.RotateConsPubKey(ctx, val, PKs[3]), stakingtypes.ErrConsPubKeyRotatedInBlock)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(keeper.RotateConsPubKey(ctx, val, PKs[3]))
+	require.Len(keeper.GetValidatorConsPubKeyRotations(ctx, valAddr), 2)
+
+	// the rotations within the unbonding period exceed the maximum
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.ErrorIs(keeper.RotateConsPubKey(ctx, val, PKs[4]), stakingtypes.ErrExceedingMaxConsPubKeyRotations)
+}
+
+func (s *KeeperTestSuite) TestRotateConsPubKeyValidatorSetUpdates() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	val := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	val, _ = val.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	keeper.SetValidator(ctx, val)
+	keeper.SetValidatorByPowerIndex(ctx, val)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, val))
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	s.applyValidatorSetUpdates(ctx, keeper, 1)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.ModuleName, gomock.Any())
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any())
+	val, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.NoError(keeper.RotateConsPubKey(ctx, val, PKs[1]))
+
+	// the previous pubkey is removed from the validator set and replaced by
+	// the new pubkey with the same power
+	oldTmPk, err := cryptocodec.ToTmProtoPublicKey(PKs[0])
+	require.NoError(err)
+	newTmPk, err := cryptocodec.ToTmProtoPublicKey(PKs[1])
+	require.NoError(err)
+
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 2)
+	require.Equal(abci.ValidatorUpdate{PubKey: oldTmPk, Power: 0}, updates[0])
+	require.Equal(abci.ValidatorUpdate{PubKey: newTmPk, Power: 10}, updates[1])
+
+	// the rotation is only applied once
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	s.applyValidatorSetUpdates(ctx, keeper, 0)
+}
+
+func (s *KeeperTestSuite) TestRemoveMatureConsPubKeyRotations() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	val := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, val)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, val))
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.ModuleName, gomock.Any())
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any())
+	require.NoError(keeper.RotateConsPubKey(ctx, val, PKs[1]))
+
+	rotation, found := keeper.GetConsPubKeyRotation(ctx, valAddr, ctx.BlockHeight())
+	require.True(found)
+
+	// the unbonding period has not passed yet
+	require.NoError(keeper.RemoveMatureConsPubKeyRotations(ctx))
+	require.Len(keeper.GetAllConsPubKeyRotations(ctx), 1)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.True(found)
+
+	ctx = ctx.WithBlockTime(rotation.CompletionTime)
+	require.NoError(keeper.RemoveMatureConsPubKeyRotations(ctx))
+	require.Empty(keeper.GetAllConsPubKeyRotations(ctx))
+	require.Empty(keeper.GetConsPubKeyRotationQueueTimeSlice(ctx, rotation.CompletionTime))
+	require.Empty(keeper.GetBlockConsPubKeyRotations(ctx))
+
+	// the previous consensus address is no longer mapped to the validator
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.False(found)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[1]))
+	require.True(found)
+}
@@ -100,6 +100,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, change := range data.PendingCommissionChanges {
+		k.SetPendingCommissionChange(ctx, change)
+		k.InsertCommissionChangeQueue(ctx, change)
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+	k.RefreshLiquidStaking(ctx)
+
+	for _, rotation := range data.ConsPubKeyRotations {
+		if err := k.SetConsPubKeyRotation(ctx, rotation); err != nil {
+			panic(err)
+		}
+		k.InsertConsPubKeyRotationQueue(ctx, rotation)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -194,13 +214,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    k.GetParams(ctx),
+		LastTotalPower:            k.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                k.GetAllValidators(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		PendingCommissionChanges:  k.GetAllPendingCommissionChanges(ctx),
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
+		ConsPubKeyRotations:       k.GetAllConsPubKeyRotations(ctx),
 	}
 }
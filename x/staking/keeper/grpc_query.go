@@ -464,6 +464,170 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// ValidatorPendingCommissionChange queries the pending commission change of a validator
+func (k Querier) ValidatorPendingCommissionChange(c context.Context, req *types.QueryValidatorPendingCommissionChangeRequest) (*types.QueryValidatorPendingCommissionChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	change, found := k.GetPendingCommissionChange(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pending commission change for validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorPendingCommissionChangeResponse{Change: change}, nil
+}
+
+// PendingCommissionChanges queries the pending commission changes of all validators
+func (k Querier) PendingCommissionChanges(c context.Context, req *types.QueryPendingCommissionChangesRequest) (*types.QueryPendingCommissionChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	changeStore := prefix.NewStore(store, types.PendingCommissionChangeKey)
+
+	var changes []types.PendingCommissionChange
+	pageRes, err := query.Paginate(changeStore, req.Pagination, func(key []byte, value []byte) error {
+		var change types.PendingCommissionChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingCommissionChangesResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// TokenizeShareRecordById queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecordById(c context.Context, req *types.QueryTokenizeShareRecordByIdRequest) (*types.QueryTokenizeShareRecordByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByIdResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordByDenom queries the tokenize share record of a share token denom
+func (k Querier) TokenizeShareRecordByDenom(c context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByDenomResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records of an owner
+func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records := k.GetTokenizeShareRecordsByOwner(ctx, owner)
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records}, nil
+}
+
+// AllTokenizeShareRecords queries all the tokenize share records
+func (k Querier) AllTokenizeShareRecords(c context.Context, req *types.QueryAllTokenizeShareRecordsRequest) (*types.QueryAllTokenizeShareRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	recordStore := prefix.NewStore(store, types.TokenizeShareRecordPrefix)
+
+	var records []types.TokenizeShareRecord
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTokenizeShareRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TotalLiquidStaked queries the total amount of liquid staked tokens
+func (k Querier) TotalLiquidStaked(c context.Context, req *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTotalLiquidStakedResponse{Tokens: k.GetTotalLiquidStakedTokens(ctx)}, nil
+}
+
+// ValidatorLiquidShares queries the liquid shares of a validator
+func (k Querier) ValidatorLiquidShares(c context.Context, req *types.QueryValidatorLiquidSharesRequest) (*types.QueryValidatorLiquidSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorLiquidSharesResponse{LiquidShares: k.GetValidatorLiquidShares(ctx, valAddr)}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
 	if err != nil {
@@ -0,0 +1,355 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ValidatorBondFactor - The maximum ratio of the liquid shares of a validator
+// to the shares of its validator bond, -1 if disabled
+func (k Keeper) ValidatorBondFactor(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValidatorBondFactor
+}
+
+// GlobalLiquidStakingCap - The maximum ratio of the liquid staked tokens to
+// the total bonded tokens
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).GlobalLiquidStakingCap
+}
+
+// ValidatorLiquidStakingCap - The maximum ratio of the liquid shares of a
+// validator to its delegator shares
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValidatorLiquidStakingCap
+}
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by its id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return record, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "id %d", id)
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, nil
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordIDsByOwnerPrefix(owner))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of a share
+// token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "denom %s", denom)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	if _, err := k.GetTokenizeShareRecord(ctx, record.Id); err == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tokenize share record %d already exists", record.Id)
+	}
+
+	k.setTokenizeShareRecord(ctx, record)
+
+	store := ctx.KVStore(k.storeKey)
+	idBz := sdk.Uint64ToBigEndian(record.Id)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.GetOwnerAddr(), record.Id), idBz)
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), idBz)
+
+	return nil
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.GetOwnerAddr(), id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), bz)
+}
+
+// GetTotalLiquidStakedTokens returns the total amount of tokens delegated by
+// tokenize share records.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
+
+// SetTotalLiquidStakedTokens sets the total amount of tokens delegated by
+// tokenize share records.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator held by
+// tokenize share records.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+
+	var shares sdk.Dec
+	if err := shares.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return shares
+}
+
+// SetValidatorLiquidShares sets the delegator shares of a validator held by
+// tokenize share records.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz, err := shares.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// GetValidatorBondShares returns the shares of the self-delegation of a
+// validator, which acts as its validator bond.
+func (k Keeper) GetValidatorBondShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	if !found {
+		return math.LegacyZeroDec()
+	}
+
+	return delegation.Shares
+}
+
+// SafelyIncreaseTotalLiquidStakedTokens increases the total liquid staked
+// tokens, returning an error if the global liquid staking cap is exceeded.
+func (k Keeper) SafelyIncreaseTotalLiquidStakedTokens(ctx sdk.Context, amount math.Int) error {
+	updatedTotal := k.GetTotalLiquidStakedTokens(ctx).Add(amount)
+
+	totalBonded := k.TotalBondedTokens(ctx)
+	if !totalBonded.IsPositive() {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	liquidStakePercent := sdk.NewDecFromInt(updatedTotal).QuoInt(totalBonded)
+	if liquidStakePercent.GT(k.GlobalLiquidStakingCap(ctx)) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, updatedTotal)
+	return nil
+}
+
+// DecreaseTotalLiquidStakedTokens decreases the total liquid staked tokens.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, amount math.Int) {
+	total := k.GetTotalLiquidStakedTokens(ctx)
+	if amount.GT(total) {
+		amount = total
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, total.Sub(amount))
+}
+
+// SafelyIncreaseValidatorLiquidShares increases the liquid shares of a
+// validator, returning an error if the validator liquid staking cap or the
+// validator bond requirement is exceeded.
+func (k Keeper) SafelyIncreaseValidatorLiquidShares(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
+	valAddr := validator.GetOperator()
+	updatedShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+
+	if factor := k.ValidatorBondFactor(ctx); !factor.Equal(sdk.NewDec(-1)) {
+		maxLiquidShares := k.GetValidatorBondShares(ctx, valAddr).Mul(factor)
+		if updatedShares.GT(maxLiquidShares) {
+			return types.ErrInsufficientValidatorBondShares
+		}
+	}
+
+	if !validator.DelegatorShares.IsPositive() ||
+		updatedShares.Quo(validator.DelegatorShares).GT(k.ValidatorLiquidStakingCap(ctx)) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, updatedShares)
+	return nil
+}
+
+// DecreaseValidatorLiquidShares decreases the liquid shares of a validator.
+func (k Keeper) DecreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+	if shares.GT(liquidShares) {
+		shares = liquidShares
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, liquidShares.Sub(shares))
+}
+
+// SafelyDecreaseValidatorBond checks that the validator bond requirement still
+// holds once the given shares are removed from the self-delegation of a
+// validator.
+func (k Keeper) SafelyDecreaseValidatorBond(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	factor := k.ValidatorBondFactor(ctx)
+	if factor.Equal(sdk.NewDec(-1)) {
+		return nil
+	}
+
+	remainingShares := k.GetValidatorBondShares(ctx, valAddr).Sub(shares)
+	if k.GetValidatorLiquidShares(ctx, valAddr).GT(remainingShares.Mul(factor)) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+
+	return nil
+}
+
+// RefreshLiquidStaking recomputes the liquid shares of the validators and the
+// total liquid staked tokens from the delegations of the tokenize share
+// records.
+func (k Keeper) RefreshLiquidStaking(ctx sdk.Context) {
+	k.removeAllValidatorLiquidShares(ctx)
+
+	totalLiquidStaked := math.ZeroInt()
+	liquidShares := make(map[string]sdk.Dec)
+	var valAddrs []sdk.ValAddress
+
+	for _, record := range k.GetAllTokenizeShareRecords(ctx) {
+		valAddr := record.GetValidatorAddr()
+
+		delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if !found {
+			continue
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		totalLiquidStaked = totalLiquidStaked.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+		shares, ok := liquidShares[valAddr.String()]
+		if !ok {
+			shares = math.LegacyZeroDec()
+			valAddrs = append(valAddrs, valAddr)
+		}
+		liquidShares[valAddr.String()] = shares.Add(delegation.Shares)
+	}
+
+	for _, valAddr := range valAddrs {
+		k.SetValidatorLiquidShares(ctx, valAddr, liquidShares[valAddr.String()])
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked)
+}
+
+// removeAllValidatorLiquidShares removes the liquid shares of all the
+// validators, so that no stale value is kept for the validators without any
+// tokenize share record.
+func (k Keeper) removeAllValidatorLiquidShares(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidSharesPrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestTokenizeShareRecords() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+
+	record1 := stakingtypes.NewTokenizeShareRecord(1, addrs[0], valAddrs[0])
+	record2 := stakingtypes.NewTokenizeShareRecord(2, addrs[0], valAddrs[1])
+	record3 := stakingtypes.NewTokenizeShareRecord(3, addrs[1], valAddrs[0])
+
+	_, err := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	for _, record := range []stakingtypes.TokenizeShareRecord{record1, record2, record3} {
+		require.NoError(keeper.AddTokenizeShareRecord(ctx, record))
+	}
+	require.Error(keeper.AddTokenizeShareRecord(ctx, record1))
+
+	res, err := keeper.GetTokenizeShareRecord(ctx, 2)
+	require.NoError(err)
+	require.Equal(record2, res)
+
+	res, err = keeper.GetTokenizeShareRecordByDenom(ctx, record3.GetShareTokenDenom())
+	require.NoError(err)
+	require.Equal(record3, res)
+
+	require.Equal([]stakingtypes.TokenizeShareRecord{record1, record2}, keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[0]))
+	require.Len(keeper.GetAllTokenizeShareRecords(ctx), 3)
+
+	require.NoError(keeper.DeleteTokenizeShareRecord(ctx, 1))
+	_, err = keeper.GetTokenizeShareRecordByDenom(ctx, record1.GetShareTokenDenom())
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+	require.Equal([]stakingtypes.TokenizeShareRecord{record2}, keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[0]))
+	require.ErrorIs(keeper.DeleteTokenizeShareRecord(ctx, 1), stakingtypes.ErrTokenizeShareRecordNotExists)
+}
+
+func (s *KeeperTestSuite) TestLiquidStakingCaps() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(1)
+	valAddr := valAddrs[0]
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(1000))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(sdk.AccAddress(valAddr), valAddr, math.LegacyNewDec(100)))
+
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)).AnyTimes()
+
+	params := keeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(5, 1)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(3, 1)
+	params.ValidatorBondFactor = sdk.NewDec(2)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// global liquid staking cap
+	require.ErrorIs(keeper.SafelyIncreaseTotalLiquidStakedTokens(ctx, math.NewInt(501)), stakingtypes.ErrGlobalLiquidStakingCapExceeded)
+	require.NoError(keeper.SafelyIncreaseTotalLiquidStakedTokens(ctx, math.NewInt(500)))
+	require.Equal(math.NewInt(500), keeper.GetTotalLiquidStakedTokens(ctx))
+	keeper.DecreaseTotalLiquidStakedTokens(ctx, math.NewInt(600))
+	require.Equal(math.ZeroInt(), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	// validator bond requirement
+	require.ErrorIs(keeper.SafelyIncreaseValidatorLiquidShares(ctx, validator, math.LegacyNewDec(201)), stakingtypes.ErrInsufficientValidatorBondShares)
+
+	// validator liquid staking cap
+	params.ValidatorBondFactor = stakingtypes.DefaultValidatorBondFactor
+	require.NoError(keeper.SetParams(ctx, params))
+	require.ErrorIs(keeper.SafelyIncreaseValidatorLiquidShares(ctx, validator, math.LegacyNewDec(301)), stakingtypes.ErrValidatorLiquidStakingCapExceeded)
+	require.NoError(keeper.SafelyIncreaseValidatorLiquidShares(ctx, validator, math.LegacyNewDec(300)))
+	require.Equal(math.LegacyNewDec(300), keeper.GetValidatorLiquidShares(ctx, valAddr))
+
+	// the validator bond cannot be decreased below the liquid shares
+	params.ValidatorBondFactor = sdk.NewDec(10)
+	require.NoError(keeper.SetParams(ctx, params))
+	require.NoError(keeper.SafelyDecreaseValidatorBond(ctx, valAddr, math.LegacyNewDec(70)))
+	require.ErrorIs(keeper.SafelyDecreaseValidatorBond(ctx, valAddr, math.LegacyNewDec(71)), stakingtypes.ErrInsufficientValidatorBondShares)
+
+	keeper.DecreaseValidatorLiquidShares(ctx, valAddr, math.LegacyNewDec(400))
+	require.Equal(math.LegacyZeroDec(), keeper.GetValidatorLiquidShares(ctx, valAddr))
+}
+
+func (s *KeeperTestSuite) TestTokenizeAndRedeemShares() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+	delAddr, owner, valAddr := addrs[0], addrs[1], valAddrs[1]
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(1000))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr, valAddr, math.LegacyNewDec(1000)))
+
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(nil).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)).AnyTimes()
+
+	// the validator bond cannot be tokenized
+	_, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(sdk.AccAddress(valAddr), valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), owner))
+	require.ErrorIs(err, stakingtypes.ErrValidatorBondNotAllowedForTokenizeShare)
+
+	record := stakingtypes.NewTokenizeShareRecord(1, owner, valAddr)
+	stake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+	shareTokens := sdk.NewCoins(sdk.NewInt64Coin(record.GetShareTokenDenom(), 400))
+
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, stake).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), delAddr, record.GetModuleAddress(), stake).Return(nil)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), record.GetModuleAddress(), stakingtypes.NotBondedPoolName, stake).Return(nil)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, shareTokens).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delAddr, shareTokens).Return(nil)
+
+	res, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(delAddr, valAddr, stake[0], owner))
+	require.NoError(err)
+	require.Equal(shareTokens[0], res.Amount)
+
+	resRecord, err := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(err)
+	require.Equal(record, resRecord)
+	require.Equal(uint64(1), keeper.GetLastTokenizeShareRecordID(ctx))
+
+	delegation, found := keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(found)
+	require.Equal(math.LegacyNewDec(600), delegation.Shares)
+	delegation, found = keeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(found)
+	require.Equal(math.LegacyNewDec(400), delegation.Shares)
+	require.Equal(math.NewInt(400), keeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(math.LegacyNewDec(400), keeper.GetValidatorLiquidShares(ctx, valAddr))
+
+	// redeeming all the share tokens removes the record
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), delAddr, record.GetShareTokenDenom()).Return(shareTokens[0])
+	s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), record.GetModuleAddress()).Return(sdk.NewCoins())
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.ModuleName, shareTokens).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, shareTokens).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, stake).Return(nil)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, stake).Return(nil)
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(delAddr, shareTokens[0]))
+	require.NoError(err)
+	require.Equal(stake[0], redeemRes.Amount)
+
+	_, err = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	delegation, found = keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(found)
+	require.Equal(math.LegacyNewDec(1000), delegation.Shares)
+	_, found = keeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(found)
+	require.Equal(math.ZeroInt(), keeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(math.LegacyZeroDec(), keeper.GetValidatorLiquidShares(ctx, valAddr))
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesWithRedelegation() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	delAddr, owner, srcValAddr, valAddr := addrs[0], addrs[1], valAddrs[1], valAddrs[2]
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(1000))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr, valAddr, math.LegacyNewDec(1000)))
+
+	// the delegation is receiving a redelegation from another validator
+	keeper.SetRedelegation(ctx, stakingtypes.NewRedelegation(
+		delAddr, srcValAddr, valAddr, 0, ctx.BlockTime().Add(time.Hour), math.NewInt(1000), math.LegacyNewDec(1000), 1,
+	))
+
+	_, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), owner))
+	require.ErrorIs(err, stakingtypes.ErrRedelegationInProgress)
+
+	_, err = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+}
+
+func (s *KeeperTestSuite) TestRefreshLiquidStaking() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(1000))
+	keeper.SetValidator(ctx, validator)
+
+	record := stakingtypes.NewTokenizeShareRecord(1, addrs[0], valAddrs[0])
+	require.NoError(keeper.AddTokenizeShareRecord(ctx, record))
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(record.GetModuleAddress(), valAddrs[0], math.LegacyNewDec(300)))
+
+	// the liquid shares of a validator without any record are stale
+	keeper.SetValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(100))
+	keeper.SetValidatorLiquidShares(ctx, valAddrs[1], math.LegacyNewDec(200))
+
+	keeper.RefreshLiquidStaking(ctx)
+	require.Equal(math.LegacyNewDec(300), keeper.GetValidatorLiquidShares(ctx, valAddrs[0]))
+	require.Equal(math.LegacyZeroDec(), keeper.GetValidatorLiquidShares(ctx, valAddrs[1]))
+	require.Equal(math.NewInt(300), keeper.GetTotalLiquidStakedTokens(ctx))
+}
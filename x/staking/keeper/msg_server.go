@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -162,7 +163,13 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		return nil, types.ErrProbonoCommissionChange
 	}
 
-	if msg.CommissionRate != nil {
+	if msg.CommissionRate != nil && k.CommissionChangeDelay(ctx) > 0 {
+		// the commission change only takes effect once the commission change
+		// delay has passed
+		if _, err := k.AnnounceCommissionChange(ctx, validator, *msg.CommissionRate); err != nil {
+			return nil, err
+		}
+	} else if msg.CommissionRate != nil {
 		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
 		if err != nil {
 			return nil, err
@@ -174,6 +181,7 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		}
 
 		validator.Commission = commission
+		k.RemovePendingCommissionChange(ctx, valAddr)
 	}
 
 	if msg.MinSelfDelegation != nil {
@@ -295,6 +303,13 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	// the validator bond must keep backing the liquid shares of the validator
+	if delegatorAddress.Equals(sdk.AccAddress(valSrcAddr)) {
+		if err := k.SafelyDecreaseValidatorBond(ctx, valSrcAddr, shares); err != nil {
+			return nil, err
+		}
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -354,6 +369,13 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		)
 	}
 
+	// the validator bond must keep backing the liquid shares of the validator
+	if delegatorAddress.Equals(sdk.AccAddress(addr)) {
+		if err := k.SafelyDecreaseValidatorBond(ctx, addr, shares); err != nil {
+			return nil, err
+		}
+	}
+
 	completionTime, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
 	if err != nil {
 		return nil, err
@@ -544,3 +566,295 @@ func (k msgServer) CreateValidatorByGov(goCtx context.Context, req *types.MsgCre
 
 	return &types.MsgCreateValidatorByGovResponse{}, nil
 }
+
+// TokenizeShares defines a method for converting a delegation into share
+// tokens held by the delegator
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	shareOwner, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	// the validator bond cannot be tokenized as it backs the liquid shares
+	if delegatorAddress.Equals(sdk.AccAddress(valAddr)) {
+		return nil, types.ErrValidatorBondNotAllowedForTokenizeShare
+	}
+
+	// a delegation receiving a redelegation cannot be tokenized, as it would
+	// escape the slashing of the redelegation for the infractions of its
+	// source validator
+	if k.HasReceivingRedelegation(ctx, delegatorAddress, valAddr) {
+		return nil, types.ErrRedelegationInProgress
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	// only the delegations of vested coins can be tokenized, as the share
+	// tokens are freely transferable
+	acc := k.authKeeper.GetAccount(ctx, delegatorAddress)
+	if vestingAcc, ok := acc.(vestingexported.VestingAccount); ok {
+		delegatedFree := vestingAcc.GetDelegatedFree().AmountOf(bondDenom)
+		if msg.Amount.Amount.GT(delegatedFree) {
+			return nil, types.ErrExceedingFreeVestingDelegations
+		}
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+
+	record := types.NewTokenizeShareRecord(recordID, shareOwner, valAddr)
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	// move the delegation to the module account of the record
+	returnAmount, err := k.Unbond(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, returnCoins); err != nil {
+		return nil, err
+	}
+
+	recordAddress := record.GetModuleAddress()
+	if err := k.bankKeeper.SendCoins(ctx, delegatorAddress, recordAddress, returnCoins); err != nil {
+		return nil, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	newShares, err := k.Keeper.Delegate(ctx, recordAddress, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// the liquid staking caps and the validator bond requirement are checked
+	// against the delegation of the record
+	if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, returnAmount); err != nil {
+		return nil, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	if err := k.SafelyIncreaseValidatorLiquidShares(ctx, validator, newShares); err != nil {
+		return nil, err
+	}
+
+	// mint the share tokens to the delegator
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenizedShares, shareToken.String()),
+		),
+	)
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount: shareToken,
+	}, nil
+}
+
+// RedeemTokensForShares defines a method for converting share tokens back into
+// a delegation of the holder
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delegatorAddress, msg.Amount.Denom)
+	if balance.Amount.LT(msg.Amount.Amount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, msg.Amount)
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr := record.GetValidatorAddr()
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	recordAddress := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, recordAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	// the last share tokens of a record redeem the remaining fractional shares
+	// of its delegation as well
+	shares := sdk.NewDecFromInt(msg.Amount.Amount)
+	if msg.Amount.Amount.Equal(delegation.Shares.TruncateInt()) {
+		shares = delegation.Shares
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+	if !tokens.IsPositive() {
+		return nil, types.ErrTinyRedemptionAmount
+	}
+
+	k.DecreaseTotalLiquidStakedTokens(ctx, tokens)
+	k.DecreaseValidatorLiquidShares(ctx, valAddr, shares)
+
+	returnAmount, err := k.Unbond(ctx, recordAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	// once the delegation of the record is fully redeemed, the rewards left in
+	// its module account are sent to the record owner and the record is removed
+	if _, found := k.GetDelegation(ctx, recordAddress, valAddr); !found {
+		rewards := k.bankKeeper.SpendableCoins(ctx, recordAddress)
+		if !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddress, record.GetOwnerAddr(), rewards); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	// burn the redeemed share tokens
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	// delegate the unbonded tokens on behalf of the share token holder
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, sdk.NewCoins(returnCoin)); err != nil {
+		return nil, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	if _, err := k.Keeper.Delegate(ctx, delegatorAddress, returnAmount, types.Unbonded, validator, true); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returnCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenizedShares, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: returnCoin,
+	}, nil
+}
+
+// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator
+func (k msgServer) RotateConsPubKey(goCtx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Validator != nil {
+		pkType := pk.Type()
+		hasKeyType := false
+		for _, keyType := range cp.Validator.PubKeyTypes {
+			if pkType == keyType {
+				hasKeyType = true
+				break
+			}
+		}
+		if !hasKeyType {
+			return nil, sdkerrors.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	if err := k.Keeper.RotateConsPubKey(ctx, validator, pk); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
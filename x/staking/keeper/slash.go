@@ -31,12 +31,39 @@ import (
 //	Infraction was committed at the current height or at a past height,
 //	not at a height in the future
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) math.Int {
+	slashed, _ := k.slash(ctx, consAddr, infractionHeight, power, slashFactor, math.LegacyZeroDec(), nil)
+	return slashed
+}
+
+// SlashWithBounty slashes a validator as Slash does, except that the
+// bountyFraction share of the tokens slashed from the validator is sent to the
+// recipient rather than burned. It returns the amount of tokens slashed from
+// the validator and the bounty paid out of them.
+//
+// CONTRACT:
+//
+//	bountyFraction is between zero and one
+func (k Keeper) SlashWithBounty(
+	ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor, bountyFraction sdk.Dec,
+	recipient sdk.AccAddress,
+) (slashed, bounty math.Int) {
+	return k.slash(ctx, consAddr, infractionHeight, power, slashFactor, bountyFraction, recipient)
+}
+
+func (k Keeper) slash(
+	ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor, bountyFraction sdk.Dec,
+	recipient sdk.AccAddress,
+) (math.Int, math.Int) {
 	logger := k.Logger(ctx)
 
 	if slashFactor.IsNegative() {
 		panic(fmt.Errorf("attempted to slash with a negative slash factor: %v", slashFactor))
 	}
 
+	if bountyFraction.IsNegative() || bountyFraction.GT(math.LegacyOneDec()) {
+		panic(fmt.Errorf("attempted to slash with an invalid bounty fraction: %v", bountyFraction))
+	}
+
 	// Amount of slashing = slash slashFactor * power at time of infraction
 	amount := k.TokensFromConsensusPower(ctx, power)
 	slashAmountDec := sdk.NewDecFromInt(amount).Mul(slashFactor)
@@ -54,7 +81,7 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 			"WARNING: ignored attempt to slash a nonexistent validator; we recommend you investigate immediately",
 			"validator", consAddr.String(),
 		)
-		return sdk.NewInt(0)
+		return sdk.NewInt(0), sdk.NewInt(0)
 	}
 
 	// should not be slashing an unbonded validator
@@ -130,17 +157,37 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		}
 	}
 
+	// the tokens backing the liquid shares of the validator are slashed as well
+	liquidShares := k.GetValidatorLiquidShares(ctx, operatorAddress)
+	liquidTokensBefore := validator.TokensFromShares(liquidShares).TruncateInt()
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 
+	if liquidShares.IsPositive() {
+		liquidTokensAfter := validator.TokensFromShares(liquidShares).TruncateInt()
+		k.DecreaseTotalLiquidStakedTokens(ctx, liquidTokensBefore.Sub(liquidTokensAfter))
+	}
+
+	// The bounty is paid out of the pool holding the slashed tokens, and the
+	// rest of them is burned.
+	bounty := sdk.NewDecFromInt(tokensToBurn).Mul(bountyFraction).TruncateInt()
+	burned := tokensToBurn.Sub(bounty)
+
 	switch validator.GetStatus() {
 	case types.Bonded:
-		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
+		if err := k.payBounty(ctx, types.BondedPoolName, recipient, bounty); err != nil {
+			panic(err)
+		}
+		if err := k.burnBondedTokens(ctx, burned); err != nil {
 			panic(err)
 		}
 	case types.Unbonding, types.Unbonded:
-		if err := k.burnNotBondedTokens(ctx, tokensToBurn); err != nil {
+		if err := k.payBounty(ctx, types.NotBondedPoolName, recipient, bounty); err != nil {
+			panic(err)
+		}
+		if err := k.burnNotBondedTokens(ctx, burned); err != nil {
 			panic(err)
 		}
 	default:
@@ -151,9 +198,22 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		"validator slashed by slash factor",
 		"validator", validator.GetOperator().String(),
 		"slash_factor", slashFactor.String(),
-		"burned", tokensToBurn,
+		"burned", burned,
+		"bounty", bounty,
 	)
-	return tokensToBurn
+	return tokensToBurn, bounty
+}
+
+// payBounty sends the bounty share of slashed tokens from the pool holding them
+// to the recipient.
+func (k Keeper) payBounty(ctx sdk.Context, poolName string, recipient sdk.AccAddress, amt math.Int) error {
+	if !amt.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, poolName, recipient, coins)
 }
 
 // SlashWithInfractionReason implementation doesn't require the infraction (types.Infraction) to work but is required by Interchain Security.
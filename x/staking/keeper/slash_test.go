@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"github.com/golang/mock/gomock"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // tests Jail, Unjail
@@ -48,3 +51,35 @@ func (s *KeeperTestSuite) TestSlashAtFutureHeight() {
 	fraction := sdk.NewDecWithPrec(5, 1)
 	require.Panics(func() { keeper.Slash(ctx, consAddr, 1, 10, fraction) })
 }
+
+// tests SlashWithBounty pays the bounty share of the slashed tokens and burns the rest
+func (s *KeeperTestSuite) TestSlashWithBounty() {
+	ctx, keeper := s.ctx.WithBlockHeight(10), s.stakingKeeper
+	require := s.Require()
+
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	validator = validator.UpdateStatus(stakingtypes.Bonded)
+	keeper.SetValidator(ctx, validator)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	recipient := sdk.AccAddress(PKs[1].Address())
+	bondDenom := keeper.BondDenom(ctx)
+	bounty := sdk.NewCoins(sdk.NewCoin(bondDenom, keeper.TokensFromConsensusPower(ctx, 1).QuoRaw(2)))
+	burned := sdk.NewCoins(sdk.NewCoin(bondDenom, keeper.TokensFromConsensusPower(ctx, 9).QuoRaw(2)))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.BondedPoolName, recipient, bounty).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.BondedPoolName, burned).Return(nil)
+
+	slashed, paid := keeper.SlashWithBounty(ctx, consAddr, 10, 10, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1), recipient)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 5), slashed)
+	require.Equal(bounty.AmountOf(bondDenom), paid)
+
+	validator, found := keeper.GetValidator(ctx, validator.GetOperator())
+	require.True(found)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 5), validator.GetTokens())
+
+	require.Panics(func() {
+		keeper.SlashWithBounty(ctx, consAddr, 10, 10, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(11, 1), recipient)
+	})
+}
@@ -122,6 +122,15 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Retrieve the consensus pubkey rotations of the last validator set at
+	// this height, as CometBFT knows these validators by their previous pubkey.
+	var rotations []types.ConsPubKeyRotation
+	for _, rotation := range k.GetBlockConsPubKeyRotations(ctx) {
+		if _, found := last[rotation.ValidatorAddress]; found {
+			rotations = append(rotations, rotation)
+		}
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -200,6 +209,11 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
+	updates, err = k.applyConsPubKeyRotationUpdates(ctx, updates, rotations)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
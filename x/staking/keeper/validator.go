@@ -39,7 +39,12 @@ func (k Keeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress
 
 	opAddr := store.Get(types.GetValidatorByConsAddrKey(consAddr))
 	if opAddr == nil {
-		return validator, false
+		// the consensus address may have been rotated away from by its
+		// validator within the unbonding period
+		opAddr = k.getValidatorByRotatedConsAddr(ctx, consAddr)
+		if opAddr == nil {
+			return validator, false
+		}
 	}
 
 	return k.GetValidator(ctx, opAddr)
@@ -181,6 +186,7 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	k.RemovePendingCommissionChange(ctx, address)
 
 	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()); err != nil {
 		k.Logger(ctx).Error("error in after validator removed hook", "error", err)
@@ -36,19 +36,32 @@ func TestMigrateJSON(t *testing.T) {
 
 	// Make sure about new param MinCommissionRate.
 	expected := `{
+	"cons_pub_key_rotations": [],
 	"delegations": [],
 	"exported": false,
+	"last_tokenize_share_record_id": "0",
 	"last_total_power": "0",
 	"last_validator_powers": [],
 	"params": {
 		"bond_denom": "stake",
+		"commission_change_delay": "86400s",
+		"global_liquid_staking_cap": "1.000000000000000000",
 		"historical_entries": 10000,
+		"key_rotation_fee": {
+			"amount": "1000000",
+			"denom": "stake"
+		},
+		"max_cons_pub_key_rotations": 1,
 		"max_entries": 7,
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
-		"unbonding_time": "1814400s"
+		"unbonding_time": "1814400s",
+		"validator_bond_factor": "-1.000000000000000000",
+		"validator_liquid_staking_cap": "1.000000000000000000"
 	},
+	"pending_commission_changes": [],
 	"redelegations": [],
+	"tokenize_share_records": [],
 	"unbonding_delegations": [],
 	"validators": []
 }`
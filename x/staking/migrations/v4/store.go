@@ -51,7 +51,9 @@ func migrateProbonoValidators(ctx sdk.Context, store storetypes.KVStore, cdc cod
 
 // migrateParams will set the params to store from legacySubspace
 func migrateParams(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, legacySubspace exported.Subspace) error {
-	var legacyParams types.Params
+	// params missing from the legacy subspace are set by later migrations, but
+	// must be valid in the meantime
+	legacyParams := types.DefaultParams()
 	legacySubspace.GetParamSet(ctx, &legacyParams)
 
 	if err := legacyParams.Validate(); err != nil {
@@ -0,0 +1,28 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. Specifically,
+// it sets the new commission change delay parameter to its default value.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(types.ParamsKey), &params); err != nil {
+		return err
+	}
+
+	// the params are validated by the v7 migration, as the liquid staking
+	// and key rotation params are not set yet
+	params.CommissionChangeDelay = types.DefaultCommissionChangeDelay
+
+	bz := cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v5 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// v4 params don't have the commission change delay parameter
+	oldParams := types.Params{
+		UnbondingTime:     time.Hour,
+		MaxValidators:     50,
+		MaxEntries:        types.DefaultMaxEntries,
+		HistoricalEntries: types.DefaultHistoricalEntries,
+		BondDenom:         sdk.DefaultBondDenom,
+		MinCommissionRate: sdk.NewDecWithPrec(5, 2),
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+	require.Equal(t, time.Hour, res.UnbondingTime)
+	require.Equal(t, uint32(50), res.MaxValidators)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), res.MinCommissionRate)
+	require.Equal(t, types.DefaultCommissionChangeDelay, res.CommissionChangeDelay)
+}
@@ -0,0 +1,30 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. Specifically,
+// it sets the new liquid staking parameters to their default values.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(types.ParamsKey), &params); err != nil {
+		return err
+	}
+
+	// the params are validated by the v7 migration, as the key rotation
+	// params are not set yet
+	params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+
+	bz := cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
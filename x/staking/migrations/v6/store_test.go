@@ -0,0 +1,46 @@
+package v6_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// v5 params don't have the liquid staking parameters
+	oldParams := types.Params{
+		UnbondingTime:         time.Hour,
+		MaxValidators:         50,
+		MaxEntries:            types.DefaultMaxEntries,
+		HistoricalEntries:     types.DefaultHistoricalEntries,
+		BondDenom:             sdk.DefaultBondDenom,
+		MinCommissionRate:     sdk.NewDecWithPrec(5, 2),
+		CommissionChangeDelay: time.Minute,
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+	require.Equal(t, time.Hour, res.UnbondingTime)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), res.MinCommissionRate)
+	require.Equal(t, time.Minute, res.CommissionChangeDelay)
+	require.Equal(t, types.DefaultValidatorBondFactor, res.ValidatorBondFactor)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, res.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, res.ValidatorLiquidStakingCap)
+}
@@ -0,0 +1,31 @@
+package v7
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. Specifically,
+// it sets the new consensus pubkey rotation parameters to their default values.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(types.ParamsKey), &params); err != nil {
+		return err
+	}
+
+	params.KeyRotationFee = types.DefaultKeyRotationFee
+	params.MaxConsPubKeyRotations = types.DefaultMaxConsPubKeyRotations
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package v7_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// v6 params don't have the consensus pubkey rotation parameters
+	oldParams := types.Params{
+		UnbondingTime:             time.Hour,
+		MaxValidators:             50,
+		MaxEntries:                types.DefaultMaxEntries,
+		HistoricalEntries:         types.DefaultHistoricalEntries,
+		BondDenom:                 sdk.DefaultBondDenom,
+		MinCommissionRate:         sdk.NewDecWithPrec(5, 2),
+		CommissionChangeDelay:     time.Minute,
+		ValidatorBondFactor:       sdk.NewDec(250),
+		GlobalLiquidStakingCap:    sdk.NewDecWithPrec(25, 2),
+		ValidatorLiquidStakingCap: sdk.NewDecWithPrec(5, 1),
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+	require.Equal(t, time.Hour, res.UnbondingTime)
+	require.Equal(t, sdk.NewDec(250), res.ValidatorBondFactor)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), res.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultKeyRotationFee, res.KeyRotationFee)
+	require.Equal(t, types.DefaultMaxConsPubKeyRotations, res.MaxConsPubKeyRotations)
+}
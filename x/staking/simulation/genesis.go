@@ -16,9 +16,10 @@ import (
 
 // Simulation parameter constants
 const (
-	unbondingTime     = "unbonding_time"
-	maxValidators     = "max_validators"
-	historicalEntries = "historical_entries"
+	unbondingTime         = "unbonding_time"
+	maxValidators         = "max_validators"
+	historicalEntries     = "historical_entries"
+	commissionChangeDelay = "commission_change_delay"
 )
 
 // genUnbondingTime returns randomized UnbondingTime
@@ -36,6 +37,11 @@ func getHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// genCommissionChangeDelay returns randomized CommissionChangeDelay of up to one day.
+func genCommissionChangeDelay(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(60*60*24+1)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -44,6 +50,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		maxVals           uint32
 		histEntries       uint32
 		minCommissionRate sdk.Dec
+		commissionDelay   time.Duration
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -61,10 +68,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { histEntries = getHistEntries(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, commissionChangeDelay, &commissionDelay, simState.Rand,
+		func(r *rand.Rand) { commissionDelay = genCommissionChangeDelay(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate)
+	params := types.NewParams(
+		simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate, commissionDelay,
+		types.DefaultValidatorBondFactor, types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap,
+		types.DefaultKeyRotationFee, types.DefaultMaxConsPubKeyRotations,
+	)
 
 	// validators & delegations
 	var (
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"time"
 
 	"cosmossdk.io/math"
@@ -102,3 +103,50 @@ func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time) error
 
 	return nil
 }
+
+// NewPendingCommissionChange returns an initialized pending commission change.
+func NewPendingCommissionChange(valAddr sdk.ValAddress, rate sdk.Dec, announceTime, effectiveTime time.Time) PendingCommissionChange {
+	return PendingCommissionChange{
+		ValidatorAddress: valAddr.String(),
+		Rate:             rate,
+		AnnounceTime:     announceTime,
+		EffectiveTime:    effectiveTime,
+	}
+}
+
+// String implements the Stringer interface for a PendingCommissionChange object.
+func (c PendingCommissionChange) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// GetValidatorAddr returns the operator address of the validator announcing
+// the commission change.
+func (c PendingCommissionChange) GetValidatorAddr() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(c.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate performs basic sanity validation checks of a pending commission
+// change.
+func (c PendingCommissionChange) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(c.ValidatorAddress); err != nil {
+		return err
+	}
+
+	switch {
+	case c.Rate.IsNil() || c.Rate.IsNegative():
+		return ErrCommissionNegative
+
+	case c.Rate.GT(math.LegacyOneDec()):
+		return ErrCommissionHuge
+
+	case c.EffectiveTime.Before(c.AnnounceTime):
+		return fmt.Errorf("commission change effective time %s is before its announce time %s", c.EffectiveTime, c.AnnounceTime)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*ConsPubKeyRotation)(nil)
+
+// NewConsPubKeyRotation returns an initialized consensus pubkey rotation.
+//
+//nolint:interfacer
+func NewConsPubKeyRotation(
+	valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, height int64, completionTime time.Time,
+) (ConsPubKeyRotation, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotation{}, err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotation{}, err
+	}
+
+	return ConsPubKeyRotation{
+		ValidatorAddress: valAddr.String(),
+		OldConsPubkey:    oldPkAny,
+		NewConsPubkey:    newPkAny,
+		Height:           height,
+		CompletionTime:   completionTime,
+	}, nil
+}
+
+// GetValidatorAddr returns the operator address of the validator rotating its
+// consensus pubkey.
+func (r ConsPubKeyRotation) GetValidatorAddr() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(r.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// OldConsPubKey returns the consensus pubkey of the validator before the
+// rotation.
+func (r ConsPubKeyRotation) OldConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := r.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// NewConsPubKey returns the consensus pubkey of the validator after the
+// rotation.
+func (r ConsPubKeyRotation) NewConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := r.NewConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// GetOldConsAddr returns the consensus address of the validator before the
+// rotation.
+func (r ConsPubKeyRotation) GetOldConsAddr() (sdk.ConsAddress, error) {
+	pk, err := r.OldConsPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ConsAddress(pk.Address()), nil
+}
+
+// Validate performs basic sanity validation checks of a consensus pubkey
+// rotation.
+func (r ConsPubKeyRotation) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+		return err
+	}
+
+	oldPk, err := r.OldConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	newPk, err := r.NewConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	if oldPk.Equals(newPk) {
+		return errors.New("consensus pubkey rotation to the same pubkey")
+	}
+
+	if r.Height < 0 {
+		return errors.New("consensus pubkey rotation height cannot be negative")
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r ConsPubKeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+
+	return unpacker.UnpackAny(r.NewConsPubkey, &pk)
+}
@@ -10,6 +10,11 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeAnnounceCommissionChange  = "announce_commission_change"
+	EventTypeCommissionChange          = "commission_change"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_shares"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -22,4 +27,10 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyEffectiveTime     = "effective_time"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyTokenizedShares   = "tokenized_shares"
+	AttributeKeyOldConsAddress    = "old_consensus_address"
+	AttributeKeyNewConsAddress    = "new_consensus_address"
 )
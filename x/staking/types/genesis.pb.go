@@ -45,6 +45,15 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// pending_commission_changes defines the commission changes pending at genesis.
+	PendingCommissionChanges []PendingCommissionChange `protobuf:"bytes,9,rep,name=pending_commission_changes,json=pendingCommissionChanges,proto3" json:"pending_commission_changes"`
+	// tokenize_share_records defines the tokenize share records at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,10,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,11,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// cons_pub_key_rotations defines the consensus public key rotations within
+	// the unbonding period at genesis.
+	ConsPubKeyRotations []ConsPubKeyRotation `protobuf:"bytes,12,rep,name=cons_pub_key_rotations,json=consPubKeyRotations,proto3" json:"cons_pub_key_rotations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +138,34 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetPendingCommissionChanges() []PendingCommissionChange {
+	if m != nil {
+		return m.PendingCommissionChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConsPubKeyRotations() []ConsPubKeyRotation {
+	if m != nil {
+		return m.ConsPubKeyRotations
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +217,47 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0xf2, 0xaf, 0x4c, 0xd1, 0xe8, 0x58, 0xc8, 0xd2, 0xc4, 0x6d, 0x25, 0xc4, 0x34,
+	0x28, 0xbb, 0x01, 0x6e, 0x9e, 0xa4, 0x18, 0x0d, 0x91, 0x44, 0xb2, 0x80, 0x07, 0x13, 0xb3, 0x99,
+	0xee, 0x4e, 0xb6, 0x63, 0xdb, 0x99, 0xcd, 0xbc, 0x53, 0x04, 0x3f, 0x81, 0x47, 0x3f, 0x02, 0x47,
+	0x8f, 0x1c, 0xfc, 0x10, 0x1c, 0x89, 0x27, 0xe3, 0x81, 0x18, 0x38, 0xe8, 0xc7, 0x30, 0x9d, 0xd9,
+	0xd6, 0x25, 0xed, 0xf6, 0xd2, 0x76, 0xfa, 0x3e, 0xcf, 0xef, 0x79, 0x3b, 0xdd, 0xf7, 0x45, 0xab,
+	0xa1, 0x80, 0xae, 0x00, 0x0f, 0x14, 0x69, 0x33, 0x1e, 0x7b, 0xc7, 0x1b, 0x4d, 0xaa, 0xc8, 0x86,
+	0x17, 0x53, 0x4e, 0x81, 0x81, 0x9b, 0x48, 0xa1, 0x04, 0x5e, 0x32, 0x2a, 0x37, 0x55, 0xb9, 0xa9,
+	0xaa, 0x52, 0x8e, 0x45, 0x2c, 0xb4, 0xc4, 0xeb, 0x7f, 0x32, 0xea, 0x4a, 0x1e, 0x73, 0xe0, 0x36,
+	0xaa, 0x65, 0xa3, 0x0a, 0x8c, 0x3d, 0x0d, 0x30, 0xa5, 0x07, 0xa4, 0xcb, 0xb8, 0xf0, 0xf4, 0xab,
+	0xf9, 0x6a, 0xe5, 0xbc, 0x88, 0x16, 0x5e, 0x9b, 0x9e, 0x0e, 0x14, 0x51, 0x14, 0x6f, 0xa3, 0xd9,
+	0x84, 0x48, 0xd2, 0x05, 0xdb, 0xaa, 0x59, 0xf5, 0xd2, 0xa6, 0xe3, 0x8e, 0xef, 0xd1, 0xdd, 0xd7,
+	0xaa, 0xc6, 0xfc, 0xc5, 0x55, 0xb5, 0xf0, 0xed, 0xcf, 0xf9, 0x9a, 0xe5, 0xa7, 0x46, 0xfc, 0x01,
+	0xdd, 0xef, 0x10, 0x50, 0x81, 0x12, 0x8a, 0x74, 0x82, 0x44, 0x7c, 0xa2, 0xd2, 0xbe, 0x53, 0xb3,
+	0xea, 0x0b, 0x8d, 0xad, 0xbe, 0xf8, 0xd7, 0x55, 0xf5, 0x49, 0xcc, 0x54, 0xab, 0xd7, 0x74, 0x43,
+	0xd1, 0x4d, 0x3b, 0x4c, 0xdf, 0xd6, 0x21, 0x6a, 0x7b, 0xea, 0x34, 0xa1, 0xe0, 0xee, 0x72, 0x65,
+	0xb0, 0xf7, 0xfa, 0xb0, 0xc3, 0x3e, 0x6b, 0xbf, 0x8f, 0xc2, 0x0c, 0x2d, 0x6a, 0xfc, 0x31, 0xe9,
+	0xb0, 0x88, 0x28, 0x21, 0x4d, 0x04, 0xd8, 0x53, 0xb5, 0xa9, 0x7a, 0x69, 0x73, 0x2d, 0xaf, 0xe1,
+	0x3d, 0x02, 0xea, 0xdd, 0xc0, 0xa3, 0x51, 0xd9, 0xe6, 0x1f, 0x76, 0x46, 0xca, 0x80, 0xf7, 0x10,
+	0x1a, 0xa6, 0x80, 0x3d, 0xad, 0xf9, 0x8f, 0xf3, 0xf8, 0x43, 0x73, 0x16, 0x9b, 0xf1, 0xe3, 0xb7,
+	0xa8, 0x14, 0xd1, 0x0e, 0x8d, 0x89, 0x62, 0x82, 0x83, 0x3d, 0xa3, 0x71, 0x2b, 0x79, 0xb8, 0x97,
+	0x43, 0x69, 0x96, 0x97, 0x25, 0xe0, 0x36, 0x5a, 0xec, 0xf1, 0xa6, 0xe0, 0x11, 0xe3, 0x71, 0x90,
+	0x45, 0xcf, 0x6a, 0xf4, 0xd3, 0x3c, 0xf4, 0xd1, 0xc0, 0x34, 0x3e, 0xa3, 0xdc, 0x1b, 0xad, 0x03,
+	0x3e, 0x42, 0x77, 0x25, 0xcd, 0x86, 0xcc, 0xe9, 0x90, 0xd5, 0xbc, 0x10, 0x9f, 0x46, 0x63, 0xe9,
+	0xb7, 0x29, 0xb8, 0x82, 0x8a, 0xf4, 0x24, 0x11, 0x52, 0xd1, 0xc8, 0x2e, 0xd6, 0xac, 0x7a, 0xd1,
+	0x1f, 0x9e, 0xf1, 0x09, 0xaa, 0x24, 0xd4, 0xfc, 0xba, 0x50, 0x74, 0xbb, 0x0c, 0x80, 0x09, 0x1e,
+	0x84, 0x2d, 0xc2, 0x63, 0x0a, 0xf6, 0xbc, 0xce, 0xf7, 0x72, 0x9f, 0x4f, 0xe3, 0xdc, 0x19, 0x1a,
+	0x77, 0xb4, 0x2f, 0xdb, 0x8a, 0x9d, 0x8c, 0xd7, 0x00, 0xee, 0xa0, 0x25, 0x25, 0xda, 0x94, 0xb3,
+	0xcf, 0x34, 0x80, 0x16, 0x91, 0x34, 0x90, 0x34, 0x14, 0x32, 0x02, 0x1b, 0x4d, 0xbe, 0xda, 0xc3,
+	0xd4, 0x75, 0xd0, 0x37, 0xf9, 0xda, 0x73, 0xeb, 0x6a, 0xd5, 0x68, 0x1d, 0xf0, 0x0b, 0xf4, 0x28,
+	0x1d, 0x98, 0x31, 0x91, 0x01, 0x8b, 0xec, 0x52, 0xcd, 0xaa, 0x4f, 0xfb, 0xcb, 0x66, 0x10, 0x46,
+	0x00, 0xbb, 0x11, 0xfe, 0x88, 0x96, 0x42, 0xc1, 0x21, 0x48, 0x7a, 0xcd, 0xa0, 0x4d, 0x4f, 0x03,
+	0x29, 0x54, 0xfa, 0x2f, 0x2d, 0x4c, 0x1e, 0x8a, 0x1d, 0xc1, 0x61, 0xbf, 0xd7, 0x7c, 0x43, 0x4f,
+	0xfd, 0xd4, 0x72, 0x6b, 0x28, 0xc2, 0x91, 0x32, 0xac, 0xb4, 0x10, 0x1e, 0x1d, 0x25, 0xbc, 0x89,
+	0xe6, 0x48, 0x14, 0x49, 0x0a, 0x66, 0x71, 0xcc, 0x37, 0xec, 0x1f, 0xdf, 0xd7, 0xcb, 0x69, 0xea,
+	0xb6, 0xa9, 0x1c, 0x28, 0xc9, 0x78, 0xec, 0x0f, 0x84, 0xb8, 0x8c, 0x66, 0xfe, 0x6f, 0x87, 0x29,
+	0xdf, 0x1c, 0x9e, 0x17, 0xbf, 0x9c, 0x55, 0x0b, 0x7f, 0xcf, 0xaa, 0x85, 0xc6, 0xab, 0x8b, 0x6b,
+	0xc7, 0xba, 0xbc, 0x76, 0xac, 0xdf, 0xd7, 0x8e, 0xf5, 0xf5, 0xc6, 0x29, 0x5c, 0xde, 0x38, 0x85,
+	0x9f, 0x37, 0x4e, 0xe1, 0xfd, 0xb3, 0x89, 0x0b, 0xe4, 0x64, 0xb8, 0x22, 0xf5, 0x2a, 0x69, 0xce,
+	0xea, 0x5d, 0xb7, 0xf5, 0x6f, 0x00, 0x75, 0xbe, 0x0c, 0x87, 0x95, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +280,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsPubKeyRotations) > 0 {
+		for iNdEx := len(m.ConsPubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PendingCommissionChanges) > 0 {
+		for iNdEx := len(m.PendingCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +519,27 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.PendingCommissionChanges) > 0 {
+		for _, e := range m.PendingCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.ConsPubKeyRotations) > 0 {
+		for _, e := range m.ConsPubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +850,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommissionChanges = append(m.PendingCommissionChanges, PendingCommissionChange{})
+			if err := m.PendingCommissionChanges[len(m.PendingCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubKeyRotations = append(m.ConsPubKeyRotations, ConsPubKeyRotation{})
+			if err := m.ConsPubKeyRotations[len(m.ConsPubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -112,3 +113,12 @@ func (h MultiStakingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) e
 	}
 	return nil
 }
+
+func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	for i := range h {
+		if err := h[i].AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
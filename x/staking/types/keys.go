@@ -31,9 +31,14 @@ var (
 	LastValidatorPowerKey = []byte{0x11} // prefix for each key to a validator index, for bonded validators
 	LastTotalPowerKey     = []byte{0x12} // prefix for the total power
 
-	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
-	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
-	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorsKey              = []byte{0x21} // prefix for each key to a validator
+	ValidatorsByConsAddrKey    = []byte{0x22} // prefix for each key to a validator index, by pubkey
+	ValidatorsByPowerIndexKey  = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	PendingCommissionChangeKey = []byte{0x24} // prefix for each key to a pending commission change, by validator operator
+
+	ConsPubKeyRotationKey              = []byte{0x25} // prefix for each key to a consensus pubkey rotation, by validator operator and height
+	ValidatorsByRotatedConsAddrKey     = []byte{0x26} // prefix for each key to a validator index, by rotated consensus address
+	ConsPubKeyRotationByHeightIndexKey = []byte{0x27} // prefix for each key to a consensus pubkey rotation index, by height and validator operator
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -46,14 +51,23 @@ var (
 	UnbondingIndexKey = []byte{0x38} // prefix for an index for looking up unbonding operations by their IDs
 	UnbondingTypeKey  = []byte{0x39} // prefix for an index containing the type of unbonding operations
 
-	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
-	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
-	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+	UnbondingQueueKey          = []byte{0x41} // prefix for the timestamps in unbonding queue
+	RedelegationQueueKey       = []byte{0x42} // prefix for the timestamps in redelegations queue
+	ValidatorQueueKey          = []byte{0x43} // prefix for the timestamps in validator queue
+	CommissionChangeQueueKey   = []byte{0x44} // prefix for the timestamps in commission change queue
+	ConsPubKeyRotationQueueKey = []byte{0x45} // prefix for the timestamps in consensus pubkey rotation queue
 
 	HistoricalInfoKey   = []byte{0x50} // prefix for the historical info
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	TokenizeShareRecordPrefix          = []byte{0x81} // key for tokenize share record with given id
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x82} // key for tokenize share record id by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x83} // key for tokenize share record id by denom
+	LastTokenizeShareRecordIDKey       = []byte{0x84} // key for last tokenize share record id
+	TotalLiquidStakedTokensKey         = []byte{0x85} // key for the total liquid staked tokens
+	ValidatorLiquidSharesPrefix        = []byte{0x86} // prefix for the liquid shares of each validator
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -257,6 +271,60 @@ func GetUnbondingDelegationTimeKey(timestamp time.Time) []byte {
 	return append(UnbondingQueueKey, bz...)
 }
 
+// GetPendingCommissionChangeKey creates the key for the pending commission
+// change of a validator
+// VALUE: staking/PendingCommissionChange
+func GetPendingCommissionChangeKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingCommissionChangeKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetCommissionChangeTimeKey creates the prefix for all commission changes
+// taking effect at a given time
+func GetCommissionChangeTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(CommissionChangeQueueKey, bz...)
+}
+
+// GetConsPubKeyRotationsKey creates the prefix for the consensus pubkey
+// rotations of a validator
+func GetConsPubKeyRotationsKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetConsPubKeyRotationKey creates the key for the consensus pubkey rotation
+// of a validator at a given height
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetConsPubKeyRotationsKey(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetValidatorByRotatedConsAddrKey creates the key for the validator with a
+// rotated consensus address
+// VALUE: validator operator address ([]byte)
+func GetValidatorByRotatedConsAddrKey(addr sdk.ConsAddress) []byte {
+	return append(ValidatorsByRotatedConsAddrKey, address.MustLengthPrefix(addr)...)
+}
+
+// GetConsPubKeyRotationsByHeightIndexKey creates the prefix for the index of
+// the consensus pubkey rotations at a given height
+func GetConsPubKeyRotationsByHeightIndexKey(height int64) []byte {
+	return append(ConsPubKeyRotationByHeightIndexKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetConsPubKeyRotationByHeightIndexKey creates the index key of the consensus
+// pubkey rotation of a validator at a given height
+// VALUE: validator operator address ([]byte)
+func GetConsPubKeyRotationByHeightIndexKey(height int64, valAddr sdk.ValAddress) []byte {
+	return append(GetConsPubKeyRotationsByHeightIndexKey(height), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetConsPubKeyRotationTimeKey creates the prefix for all consensus pubkey
+// rotations completing at a given time
+func GetConsPubKeyRotationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ConsPubKeyRotationQueueKey, bz...)
+}
+
 // GetREDKey returns a key prefix for indexing a redelegation from a delegator
 // and source validator to a destination validator.
 func GetREDKey(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) []byte {
@@ -377,3 +445,32 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix of the ids of
+// the tokenize share records of an owner
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key of the id of a
+// tokenize share record of an owner
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key of the id of the tokenize
+// share record of a share token denom
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the liquid shares of a
+// validator
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesPrefix, address.MustLengthPrefix(valAddr)...)
+}
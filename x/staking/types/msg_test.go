@@ -208,6 +208,80 @@ func TestMsgUndelegate(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgTokenizeShares
+func TestMsgTokenizeShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		owner         sdk.AccAddress
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), sdk.AccAddress(valAddr1), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr1), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr1), false},
+		{"empty owner", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(emptyAddr), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgTokenizeShares(tc.delegatorAddr, tc.validatorAddr, tc.amount, tc.owner)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgRedeemTokensForShares
+func TestMsgRedeemTokensForShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), sdk.NewInt64Coin("share", 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), sdk.NewInt64Coin("share", 0), false},
+		{"nil amount", sdk.AccAddress(valAddr1), sdk.Coin{}, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), sdk.NewInt64Coin("share", 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgRedeemTokensForShares(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		pubkey        cryptotypes.PubKey
+		expectPass    bool
+	}{
+		{"regular", valAddr1, pk2, true},
+		{"empty validator", emptyAddr, pk2, false},
+		{"empty pubkey", valAddr1, nil, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := types.NewMsgRotateConsPubKey(tc.validatorAddr, tc.pubkey)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 func TestMsgUpdateParams(t *testing.T) {
 	msg := types.MsgUpdateParams{
 		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -30,20 +30,56 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultCommissionChangeDelay is the default delay of one day between the
+	// announcement of a commission rate change and the time it takes effect.
+	DefaultCommissionChangeDelay time.Duration = time.Hour * 24
+
+	// DefaultMaxConsPubKeyRotations is the default maximum of one consensus
+	// public key rotation per validator within an unbonding period.
+	DefaultMaxConsPubKeyRotations uint32 = 1
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = sdk.ZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultValidatorBondFactor is set to -1, which disables the validator
+	// bond requirement
+	DefaultValidatorBondFactor = sdk.NewDec(-1)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, which disables the global
+	// liquid staking cap
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, which disables the
+	// validator liquid staking cap
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+
+	// DefaultKeyRotationFee is set to 1000000 of the default bond denom
+	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+)
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minCommissionRate sdk.Dec, commissionChangeDelay time.Duration,
+	validatorBondFactor, globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec,
+	keyRotationFee sdk.Coin, maxConsPubKeyRotations uint32,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		CommissionChangeDelay:     commissionChangeDelay,
+		ValidatorBondFactor:       validatorBondFactor,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		KeyRotationFee:            keyRotationFee,
+		MaxConsPubKeyRotations:    maxConsPubKeyRotations,
 	}
 }
 
@@ -56,6 +92,12 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultCommissionChangeDelay,
+		DefaultValidatorBondFactor,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultKeyRotationFee,
+		DefaultMaxConsPubKeyRotations,
 	)
 }
 
@@ -107,6 +149,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCommissionChangeDelay(p.CommissionChangeDelay); err != nil {
+		return err
+	}
+
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -206,3 +268,64 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateCommissionChangeDelay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("commission change delay cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil: %s", v)
+	}
+	if v.IsNegative() && !v.Equal(sdk.NewDec(-1)) {
+		return fmt.Errorf("invalid validator bond factor: %s, must be -1 or non-negative", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -35,4 +36,41 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate commission change delay
+	params = types.DefaultParams()
+	params.CommissionChangeDelay = 0
+	require.NoError(t, params.Validate())
+
+	params.CommissionChangeDelay = -1
+	require.Error(t, params.Validate())
+
+	// validate validator bond factor
+	params = types.DefaultParams()
+	params.ValidatorBondFactor = math.LegacyNewDec(250)
+	require.NoError(t, params.Validate())
+
+	params.ValidatorBondFactor = math.LegacyNewDecWithPrec(-5, 1)
+	require.Error(t, params.Validate())
+
+	// validate liquid staking caps
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = math.LegacyZeroDec()
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	// validate key rotation fee
+	params = types.DefaultParams()
+	params.KeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+	require.NoError(t, params.Validate())
+
+	params.KeyRotationFee = sdk.Coin{Denom: "", Amount: math.OneInt()}
+	require.Error(t, params.Validate())
 }
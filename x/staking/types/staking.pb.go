@@ -256,6 +256,201 @@ func (m *Commission) GetUpdateTime() time.Time {
 	return time.Time{}
 }
 
+// PendingCommissionChange defines a commission rate change announced by a
+// validator, which takes effect at its effective time.
+type PendingCommissionChange struct {
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rate is the commission rate which takes effect at the effective time.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// announce_time is the time at which the commission change was announced.
+	AnnounceTime time.Time `protobuf:"bytes,3,opt,name=announce_time,json=announceTime,proto3,stdtime" json:"announce_time"`
+	// effective_time is the time at which the commission change takes effect.
+	EffectiveTime time.Time `protobuf:"bytes,4,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingCommissionChange) Reset()      { *m = PendingCommissionChange{} }
+func (*PendingCommissionChange) ProtoMessage() {}
+func (*PendingCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{3}
+}
+func (m *PendingCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommissionChange.Merge(m, src)
+}
+func (m *PendingCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCommissionChange proto.InternalMessageInfo
+
+func (m *PendingCommissionChange) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *PendingCommissionChange) GetAnnounceTime() time.Time {
+	if m != nil {
+		return m.AnnounceTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingCommissionChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// TokenizeShareRecord defines a record of a delegation tokenized into
+// transferable share tokens. The tokenized delegation is held by the module
+// account of the record, whose rewards are claimed by the record owner.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account receiving the rewards of the
+	// tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized
+	// delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the tokenized
+	// delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{4}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// ConsPubKeyRotation defines a rotation of the consensus public key of a
+// validator. The previous consensus address of the validator keeps being mapped
+// to the validator until the completion time of the rotation, after the
+// unbonding period, so that its infractions can still be handled.
+type ConsPubKeyRotation struct {
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key of the validator before the rotation.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the rotation.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the height at which the rotation occurred.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// completion_time is the time at which the previous consensus address stops
+	// being mapped to the validator.
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *ConsPubKeyRotation) Reset()         { *m = ConsPubKeyRotation{} }
+func (m *ConsPubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotation) ProtoMessage()    {}
+func (*ConsPubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{5}
+}
+func (m *ConsPubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotation.Merge(m, src)
+}
+func (m *ConsPubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotation proto.InternalMessageInfo
+
 // Description defines a validator description.
 type Description struct {
 	// moniker defines a human-readable name for the validator.
@@ -273,7 +468,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{3}
+	return fileDescriptor_64c30c6cf92913c9, []int{6}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,7 +578,7 @@ type Validator struct {
 func (m *Validator) Reset()      { *m = Validator{} }
 func (*Validator) ProtoMessage() {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{4}
+	return fileDescriptor_64c30c6cf92913c9, []int{7}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +615,7 @@ type ValAddresses struct {
 func (m *ValAddresses) Reset()      { *m = ValAddresses{} }
 func (*ValAddresses) ProtoMessage() {}
 func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{5}
+	return fileDescriptor_64c30c6cf92913c9, []int{8}
 }
 func (m *ValAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +662,7 @@ type DVPair struct {
 func (m *DVPair) Reset()      { *m = DVPair{} }
 func (*DVPair) ProtoMessage() {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{6}
+	return fileDescriptor_64c30c6cf92913c9, []int{9}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +700,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{7}
+	return fileDescriptor_64c30c6cf92913c9, []int{10}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +749,7 @@ type DVVTriplet struct {
 func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
 func (*DVVTriplet) ProtoMessage() {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{8}
+	return fileDescriptor_64c30c6cf92913c9, []int{11}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,7 +787,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{9}
+	return fileDescriptor_64c30c6cf92913c9, []int{12}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,7 +838,7 @@ type Delegation struct {
 func (m *Delegation) Reset()      { *m = Delegation{} }
 func (*Delegation) ProtoMessage() {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{10}
+	return fileDescriptor_64c30c6cf92913c9, []int{13}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +881,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{11}
+	return fileDescriptor_64c30c6cf92913c9, []int{14}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +929,7 @@ type UnbondingDelegationEntry struct {
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{12}
+	return fileDescriptor_64c30c6cf92913c9, []int{15}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -810,7 +1005,7 @@ type RedelegationEntry struct {
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{13}
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,7 +1078,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{14}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,12 +1121,28 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// commission_change_delay is the delay between the announcement of a commission rate change by a
+	// validator and the time it takes effect. Commission rate changes take effect immediately if zero.
+	CommissionChangeDelay time.Duration `protobuf:"bytes,7,opt,name=commission_change_delay,json=commissionChangeDelay,proto3,stdduration" json:"commission_change_delay"`
+	// validator_bond_factor is the maximum ratio of the liquid shares of a validator to the shares of its
+	// self-delegation, which acts as the validator bond. The validator bond requirement is disabled if -1.
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor"`
+	// global_liquid_staking_cap is the maximum ratio of the liquid staked tokens to the total bonded tokens.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum ratio of the liquid shares of a validator to its delegator
+	// shares.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap"`
+	// key_rotation_fee is the fee burned for each rotation of the consensus public key of a validator.
+	KeyRotationFee types2.Coin `protobuf:"bytes,11,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// max_cons_pub_key_rotations is the maximum number of rotations of the consensus public key of a validator
+	// within an unbonding period.
+	MaxConsPubKeyRotations uint32 `protobuf:"varint,12,opt,name=max_cons_pub_key_rotations,json=maxConsPubKeyRotations,proto3" json:"max_cons_pub_key_rotations,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{15}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -995,6 +1206,27 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetCommissionChangeDelay() time.Duration {
+	if m != nil {
+		return m.CommissionChangeDelay
+	}
+	return 0
+}
+
+func (m *Params) GetKeyRotationFee() types2.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types2.Coin{}
+}
+
+func (m *Params) GetMaxConsPubKeyRotations() uint32 {
+	if m != nil {
+		return m.MaxConsPubKeyRotations
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1005,7 +1237,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1060,7 +1292,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1340,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1162,7 +1394,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,7 +1433,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,6 +1475,9 @@ func init() {
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
 	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
+	proto.RegisterType((*PendingCommissionChange)(nil), "cosmos.staking.v1beta1.PendingCommissionChange")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*ConsPubKeyRotation)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotation")
 	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
 	proto.RegisterType((*Validator)(nil), "cosmos.staking.v1beta1.Validator")
 	proto.RegisterType((*ValAddresses)(nil), "cosmos.staking.v1beta1.ValAddresses")
@@ -1268,127 +1503,148 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1918 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0x63, 0x47,
-	0x19, 0xf7, 0xb3, 0x5d, 0x27, 0xf9, 0x9c, 0xc4, 0xce, 0xec, 0x76, 0xd7, 0xeb, 0x85, 0xd8, 0x75,
-	0x4b, 0x9b, 0xae, 0xba, 0x0e, 0x1b, 0x24, 0x0e, 0xa1, 0x02, 0xad, 0xe3, 0x6c, 0xd7, 0x65, 0x9b,
-	0x58, 0xcf, 0x49, 0x4a, 0x41, 0xe8, 0x69, 0xfc, 0xde, 0xc4, 0x19, 0x62, 0xcf, 0xb3, 0xde, 0x8c,
-	0xb7, 0xb1, 0xc4, 0x01, 0x71, 0x5a, 0xe5, 0x80, 0x2a, 0x71, 0xa0, 0x97, 0x95, 0x56, 0x82, 0x03,
-	0x87, 0x22, 0xf5, 0x50, 0x71, 0xe0, 0x82, 0x38, 0x20, 0x15, 0x2e, 0xac, 0x7a, 0x42, 0x08, 0x05,
-	0xb4, 0x7b, 0x28, 0xe2, 0x84, 0xb8, 0x83, 0xd0, 0xcc, 0x9b, 0xf7, 0xc7, 0x76, 0xb2, 0x9b, 0xac,
-	0x42, 0x55, 0xa9, 0x17, 0xfb, 0xcd, 0xcc, 0xf7, 0xfd, 0x66, 0xbe, 0xdf, 0xf7, 0x67, 0xfe, 0xc0,
-	0x4b, 0xb6, 0xcb, 0x7b, 0x2e, 0x5f, 0xe6, 0x02, 0xef, 0x53, 0xd6, 0x59, 0xbe, 0x7b, 0xa3, 0x4d,
-	0x04, 0xbe, 0x11, 0xb4, 0xab, 0x7d, 0xcf, 0x15, 0x2e, 0xba, 0xe4, 0x4b, 0x55, 0x83, 0x5e, 0x2d,
-	0x55, 0xbc, 0xd8, 0x71, 0x3b, 0xae, 0x12, 0x59, 0x96, 0x5f, 0xbe, 0x74, 0xf1, 0x4a, 0xc7, 0x75,
-	0x3b, 0x5d, 0xb2, 0xac, 0x5a, 0xed, 0xc1, 0xee, 0x32, 0x66, 0x43, 0x3d, 0xb4, 0x38, 0x3e, 0xe4,
-	0x0c, 0x3c, 0x2c, 0xa8, 0xcb, 0xf4, 0x78, 0x69, 0x7c, 0x5c, 0xd0, 0x1e, 0xe1, 0x02, 0xf7, 0xfa,
-	0x01, 0xb6, 0xbf, 0x12, 0xcb, 0x9f, 0x54, 0x2f, 0x4b, 0x63, 0x6b, 0x53, 0xda, 0x98, 0x93, 0xd0,
-	0x0e, 0xdb, 0xa5, 0x01, 0xf6, 0x02, 0xee, 0x51, 0xe6, 0x2e, 0xab, 0x5f, 0xdd, 0xf5, 0x25, 0x41,
-	0x98, 0x43, 0xbc, 0x1e, 0x65, 0x62, 0x59, 0x0c, 0xfb, 0x84, 0xfb, 0xbf, 0x7a, 0xf4, 0x6a, 0x6c,
-	0x14, 0xb7, 0x6d, 0x1a, 0x1f, 0xac, 0xfc, 0xd4, 0x80, 0xf9, 0xdb, 0x94, 0x0b, 0xd7, 0xa3, 0x36,
-	0xee, 0x36, 0xd8, 0xae, 0x8b, 0xbe, 0x01, 0x99, 0x3d, 0x82, 0x1d, 0xe2, 0x15, 0x8c, 0xb2, 0xb1,
-	0x94, 0x5d, 0x29, 0x54, 0x23, 0x80, 0xaa, 0xaf, 0x7b, 0x5b, 0x8d, 0xd7, 0x66, 0x3e, 0x3e, 0x2a,
-	0x25, 0x7e, 0xf9, 0xe9, 0x87, 0xd7, 0x0c, 0x53, 0xab, 0xa0, 0x3a, 0x64, 0xee, 0xe2, 0x2e, 0x27,
-	0xa2, 0x90, 0x2c, 0xa7, 0x96, 0xb2, 0x2b, 0x2f, 0x54, 0x8f, 0xe7, 0xbc, 0xba, 0x83, 0xbb, 0xd4,
-	0xc1, 0xc2, 0x1d, 0x45, 0xf1, 0x75, 0x2b, 0x1f, 0x24, 0x21, 0xb7, 0xe6, 0xf6, 0x7a, 0x94, 0x73,
-	0xea, 0x32, 0x13, 0x0b, 0xc2, 0x51, 0x13, 0xd2, 0x1e, 0x16, 0x44, 0x2d, 0x6a, 0xa6, 0xf6, 0xba,
-	0x54, 0xfa, 0xcb, 0x51, 0xe9, 0xe5, 0x0e, 0x15, 0x7b, 0x83, 0x76, 0xd5, 0x76, 0x7b, 0x9a, 0x46,
-	0xfd, 0x77, 0x9d, 0x3b, 0xfb, 0xda, 0xd2, 0x3a, 0xb1, 0x3f, 0xf9, 0xe8, 0x3a, 0xe8, 0x85, 0xd4,
-	0x89, 0x6d, 0x2a, 0x24, 0xf4, 0x36, 0x4c, 0xf7, 0xf0, 0x81, 0xa5, 0x50, 0x93, 0xe7, 0x80, 0x3a,
-	0xd5, 0xc3, 0x07, 0x72, 0xad, 0xc8, 0x81, 0x9c, 0x04, 0xb6, 0xf7, 0x30, 0xeb, 0x10, 0x1f, 0x3f,
-	0x75, 0x0e, 0xf8, 0x73, 0x3d, 0x7c, 0xb0, 0xa6, 0x30, 0xe5, 0x2c, 0xab, 0xd3, 0xef, 0x3f, 0x28,
-	0x25, 0xfe, 0xf1, 0xa0, 0x64, 0x54, 0x7e, 0x6f, 0x00, 0x44, 0x74, 0x21, 0x0c, 0x79, 0x3b, 0x6c,
-	0xa9, 0xe9, 0xb9, 0x76, 0xe5, 0x2b, 0x27, 0x79, 0x63, 0x8c, 0xec, 0xda, 0x9c, 0x5c, 0xe8, 0xc3,
-	0xa3, 0x92, 0xe1, 0xfb, 0x25, 0x67, 0x8f, 0x39, 0xe3, 0x4d, 0xc8, 0x0e, 0xfa, 0x0e, 0x16, 0xc4,
-	0x92, 0x91, 0xad, 0xd8, 0xcb, 0xae, 0x14, 0xab, 0x7e, 0xd8, 0x57, 0x83, 0xb0, 0xaf, 0x6e, 0x05,
-	0x61, 0xef, 0x03, 0xbe, 0xf7, 0xb7, 0x00, 0x10, 0x7c, 0x6d, 0x39, 0x1e, 0xb3, 0xe3, 0x03, 0x03,
-	0xb2, 0x75, 0xc2, 0x6d, 0x8f, 0xf6, 0x65, 0x32, 0xa1, 0x02, 0x4c, 0xf5, 0x5c, 0x46, 0xf7, 0x75,
-	0x28, 0xce, 0x98, 0x41, 0x13, 0x15, 0x61, 0x9a, 0x3a, 0x84, 0x09, 0x2a, 0x86, 0xbe, 0xeb, 0xcc,
-	0xb0, 0x2d, 0xb5, 0xde, 0x25, 0x6d, 0x4e, 0x03, 0xd6, 0xcd, 0xa0, 0x89, 0x5e, 0x85, 0x3c, 0x27,
-	0xf6, 0xc0, 0xa3, 0x62, 0x68, 0xd9, 0x2e, 0x13, 0xd8, 0x16, 0x85, 0xb4, 0x12, 0xc9, 0x05, 0xfd,
-	0x6b, 0x7e, 0xb7, 0x04, 0x71, 0x88, 0xc0, 0xb4, 0xcb, 0x0b, 0xcf, 0xf9, 0x20, 0xba, 0x19, 0x5b,
-	0xee, 0x6f, 0xa6, 0x61, 0x26, 0x0c, 0x63, 0xb4, 0x06, 0x79, 0xb7, 0x4f, 0x3c, 0xf9, 0x6d, 0x61,
-	0xc7, 0xf1, 0x08, 0xe7, 0x3a, 0x56, 0x0b, 0x9f, 0x7c, 0x74, 0xfd, 0xa2, 0x26, 0xfe, 0xa6, 0x3f,
-	0xd2, 0x12, 0x1e, 0x65, 0x1d, 0x33, 0x17, 0x68, 0xe8, 0x6e, 0xf4, 0x8e, 0x74, 0x1d, 0xe3, 0x84,
-	0xf1, 0x01, 0xb7, 0xfa, 0x83, 0xf6, 0x3e, 0x19, 0x6a, 0x72, 0x2f, 0x4e, 0x90, 0x7b, 0x93, 0x0d,
-	0x6b, 0x85, 0x3f, 0x46, 0xd0, 0xb6, 0x37, 0xec, 0x0b, 0xb7, 0xda, 0x1c, 0xb4, 0xbf, 0x4d, 0x86,
-	0xd2, 0x65, 0x1a, 0xa7, 0xa9, 0x60, 0xd0, 0x25, 0xc8, 0xfc, 0x00, 0xd3, 0x2e, 0x71, 0x14, 0x2b,
-	0xd3, 0xa6, 0x6e, 0xa1, 0x55, 0xc8, 0x70, 0x81, 0xc5, 0x80, 0x2b, 0x2a, 0xe6, 0x57, 0x2a, 0x27,
-	0xc5, 0x48, 0xcd, 0x65, 0x4e, 0x4b, 0x49, 0x9a, 0x5a, 0x03, 0x6d, 0x41, 0x46, 0xb8, 0xfb, 0x84,
-	0x69, 0x92, 0xce, 0x14, 0xdf, 0x0d, 0x26, 0x62, 0xf1, 0xdd, 0x60, 0xc2, 0xd4, 0x58, 0xa8, 0x03,
-	0x79, 0x87, 0x74, 0x49, 0x47, 0x51, 0xc9, 0xf7, 0xb0, 0x47, 0x78, 0x21, 0x73, 0x0e, 0xf9, 0x93,
-	0x0b, 0x51, 0x5b, 0x0a, 0x14, 0x35, 0x21, 0xeb, 0x44, 0xe1, 0x56, 0x98, 0x52, 0x44, 0xbf, 0x78,
-	0x92, 0xfd, 0xb1, 0xc8, 0x8c, 0xd7, 0xac, 0x38, 0x84, 0x8c, 0xb0, 0x01, 0x6b, 0xbb, 0xcc, 0xa1,
-	0xac, 0x63, 0xed, 0x11, 0xda, 0xd9, 0x13, 0x85, 0xe9, 0xb2, 0xb1, 0x94, 0x32, 0x73, 0x61, 0xff,
-	0x6d, 0xd5, 0x8d, 0x9a, 0x30, 0x1f, 0x89, 0xaa, 0x2c, 0x9a, 0x39, 0x6b, 0x16, 0xcd, 0x85, 0x00,
-	0x52, 0x04, 0xbd, 0x05, 0x10, 0xe5, 0x69, 0x01, 0x14, 0x5a, 0xe5, 0xe9, 0x19, 0x1f, 0x37, 0x26,
-	0x06, 0x80, 0xba, 0x70, 0xa1, 0x47, 0x99, 0xc5, 0x49, 0x77, 0xd7, 0xd2, 0xcc, 0x49, 0xdc, 0xec,
-	0x39, 0x78, 0x7a, 0xa1, 0x47, 0x59, 0x8b, 0x74, 0x77, 0xeb, 0x21, 0x2c, 0xb2, 0x61, 0x5e, 0xd6,
-	0xcc, 0xd8, 0x44, 0xb3, 0xe7, 0x30, 0x91, 0x2c, 0x99, 0xb1, 0x49, 0x0a, 0x30, 0xd5, 0xf7, 0xdc,
-	0xb6, 0xcb, 0xdc, 0xc2, 0x9c, 0x4a, 0x82, 0xa0, 0x89, 0x5e, 0x87, 0xab, 0x91, 0x37, 0x5c, 0x66,
-	0xed, 0xb9, 0x5d, 0xc7, 0xf2, 0xc8, 0xae, 0x65, 0xbb, 0x03, 0x26, 0x0a, 0xf3, 0xca, 0x87, 0x97,
-	0x43, 0x91, 0x4d, 0x76, 0xdb, 0xed, 0x3a, 0x26, 0xd9, 0x5d, 0x93, 0xc3, 0xe8, 0x45, 0x88, 0x5c,
-	0x61, 0x51, 0x87, 0x17, 0x72, 0xe5, 0xd4, 0x52, 0xda, 0x9c, 0x0d, 0x3b, 0x1b, 0x0e, 0x5f, 0x9d,
-	0xbd, 0xf7, 0xa0, 0x94, 0xd0, 0xc5, 0x23, 0x51, 0x69, 0xc2, 0xec, 0x0e, 0xee, 0xea, 0xbc, 0x27,
-	0x1c, 0x7d, 0x1d, 0x66, 0x70, 0xd0, 0x28, 0x18, 0xe5, 0xd4, 0x13, 0xeb, 0x46, 0x24, 0xea, 0x97,
-	0xa3, 0x1f, 0xfd, 0xb5, 0x6c, 0x54, 0x7e, 0x61, 0x40, 0xa6, 0xbe, 0xd3, 0xc4, 0xd4, 0x43, 0xeb,
-	0xb0, 0x10, 0x65, 0xd0, 0x69, 0x8b, 0x51, 0x94, 0x74, 0x41, 0x35, 0x5a, 0x87, 0x85, 0xbb, 0x41,
-	0x7d, 0x0b, 0x61, 0x92, 0x4f, 0x83, 0x09, 0x55, 0x74, 0xff, 0x98, 0xe1, 0x6f, 0xc2, 0x94, 0xbf,
-	0x4a, 0x8e, 0xbe, 0x05, 0xcf, 0xf5, 0xe5, 0x87, 0xb2, 0x37, 0xbb, 0xb2, 0x78, 0x62, 0xe6, 0x29,
-	0xf9, 0x78, 0x9c, 0xfa, 0x7a, 0x95, 0xff, 0x18, 0x00, 0xf5, 0x9d, 0x9d, 0x2d, 0x8f, 0xf6, 0xbb,
-	0x44, 0x9c, 0x97, 0xd9, 0x77, 0xe0, 0xf9, 0xc8, 0x6c, 0xee, 0xd9, 0xa7, 0x36, 0xfd, 0x42, 0xa8,
-	0xd6, 0xf2, 0xec, 0x63, 0xd1, 0x1c, 0x2e, 0x42, 0xb4, 0xd4, 0xa9, 0xd1, 0xea, 0x5c, 0x1c, 0xcf,
-	0xe5, 0x77, 0x20, 0x1b, 0x99, 0xcf, 0x51, 0x03, 0xa6, 0x85, 0xfe, 0xd6, 0x94, 0x56, 0x4e, 0xa6,
-	0x34, 0x50, 0x8b, 0xd3, 0x1a, 0xaa, 0x57, 0xfe, 0x2b, 0x99, 0x8d, 0x12, 0xe7, 0x73, 0x15, 0x50,
-	0x72, 0xdb, 0xd1, 0xdb, 0xc2, 0x79, 0x1c, 0xab, 0x34, 0xd6, 0x18, 0xb5, 0xf7, 0x92, 0x70, 0x61,
-	0x3b, 0x48, 0xdf, 0xcf, 0x2d, 0x13, 0xdb, 0x30, 0x45, 0x98, 0xf0, 0xa8, 0xa2, 0x42, 0x3a, 0xfc,
-	0xab, 0x27, 0x39, 0xfc, 0x18, 0x5b, 0xd6, 0x99, 0xf0, 0x86, 0x71, 0xf7, 0x07, 0x58, 0x63, 0x54,
-	0xfc, 0x2e, 0x05, 0x85, 0x93, 0xd4, 0xd1, 0x2b, 0x90, 0xb3, 0x3d, 0xa2, 0x3a, 0x82, 0x0d, 0xcf,
-	0x50, 0xc5, 0x72, 0x3e, 0xe8, 0xd6, 0xfb, 0x9d, 0x09, 0xf2, 0x14, 0x29, 0xa3, 0x4b, 0x8a, 0x3e,
-	0xdb, 0xb1, 0x71, 0x3e, 0x42, 0x50, 0x3b, 0x1e, 0x81, 0x1c, 0x65, 0x54, 0x50, 0xdc, 0xb5, 0xda,
-	0xb8, 0x8b, 0x99, 0xfd, 0x2c, 0x07, 0xed, 0xc9, 0x5d, 0x63, 0x5e, 0x83, 0xd6, 0x7c, 0x4c, 0xb4,
-	0x03, 0x53, 0x01, 0x7c, 0xfa, 0x1c, 0xe0, 0x03, 0x30, 0xf4, 0x02, 0xcc, 0xc6, 0xb7, 0x0d, 0x75,
-	0x88, 0x4a, 0x9b, 0xd9, 0xd8, 0xae, 0xf1, 0xb4, 0x7d, 0x29, 0xf3, 0xc4, 0x7d, 0x29, 0x76, 0x56,
-	0xfd, 0x6d, 0x0a, 0x16, 0x4c, 0xe2, 0x7c, 0x01, 0x9d, 0xf7, 0x3d, 0x00, 0x3f, 0xc1, 0x65, 0xf1,
-	0x7d, 0x06, 0xff, 0x4d, 0x16, 0x8c, 0x19, 0x1f, 0xaf, 0xce, 0xc5, 0x67, 0xe9, 0xc1, 0x3f, 0x25,
-	0x61, 0x36, 0xee, 0xc1, 0x2f, 0xc0, 0x6e, 0x87, 0x36, 0xa2, 0xf2, 0x96, 0x56, 0xe5, 0xed, 0xd5,
-	0x93, 0xca, 0xdb, 0x44, 0x6c, 0x9f, 0xa2, 0xae, 0xfd, 0x2c, 0x05, 0x99, 0x26, 0xf6, 0x70, 0x8f,
-	0xa3, 0xcd, 0x89, 0xc3, 0xb8, 0x7f, 0x61, 0xbe, 0x32, 0x11, 0xde, 0x75, 0xfd, 0xd2, 0xe3, 0x47,
-	0xf7, 0xfb, 0x27, 0x9d, 0xc5, 0xbf, 0xe2, 0x1f, 0x67, 0x43, 0xa3, 0x7c, 0x3a, 0xe7, 0xd4, 0x81,
-	0x34, 0xbc, 0x33, 0x72, 0x54, 0x82, 0xac, 0x14, 0x8b, 0x6a, 0xb8, 0x94, 0x81, 0x1e, 0x3e, 0x58,
-	0xf7, 0x7b, 0xd0, 0x75, 0x40, 0x7b, 0xe1, 0xf3, 0x8c, 0x15, 0x91, 0x21, 0xe5, 0x16, 0xa2, 0x91,
-	0x40, 0xfc, 0xcb, 0x00, 0x72, 0x15, 0x96, 0x43, 0x98, 0xdb, 0xd3, 0x37, 0xd7, 0x19, 0xd9, 0x53,
-	0x97, 0x1d, 0xe8, 0x87, 0xfe, 0x91, 0x7e, 0xec, 0x75, 0x40, 0x5f, 0xae, 0xee, 0x9c, 0x2d, 0x29,
-	0xfe, 0x7d, 0x54, 0x2a, 0x0e, 0x71, 0xaf, 0xbb, 0x5a, 0x39, 0x06, 0xb2, 0xa2, 0x8e, 0xf8, 0xa3,
-	0xaf, 0x0a, 0xab, 0x4b, 0x41, 0x2c, 0x1f, 0x7e, 0xfa, 0xe1, 0xb5, 0xab, 0x31, 0xcc, 0x83, 0xf0,
-	0xdd, 0xce, 0x77, 0x47, 0xe5, 0x57, 0x06, 0xa0, 0x68, 0xa3, 0x31, 0x09, 0xef, 0xcb, 0xcb, 0xac,
-	0xbc, 0xe0, 0xc4, 0xee, 0x07, 0xc6, 0x93, 0x2f, 0x38, 0x91, 0xfe, 0xc8, 0x05, 0x27, 0x96, 0x40,
-	0xdf, 0x8c, 0xca, 0x7a, 0x52, 0x7b, 0x5b, 0x63, 0xb5, 0x31, 0x27, 0xb1, 0x9b, 0x12, 0x1d, 0x81,
-	0x08, 0x94, 0xc2, 0xdc, 0x4c, 0x54, 0x8e, 0x0c, 0xb8, 0x32, 0x11, 0x81, 0xe1, 0xb2, 0x6d, 0x40,
-	0x5e, 0x6c, 0x50, 0x79, 0x71, 0xa8, 0x97, 0xff, 0x6c, 0x01, 0xbd, 0xe0, 0x4d, 0x94, 0xf2, 0xff,
-	0xd3, 0x1e, 0xb5, 0x9a, 0x56, 0xc5, 0xe7, 0x0f, 0x06, 0x5c, 0x8c, 0xaf, 0x28, 0xb4, 0xad, 0x05,
-	0xb3, 0xf1, 0xb5, 0x68, 0xab, 0x5e, 0x3a, 0x8d, 0x55, 0x71, 0x83, 0x46, 0x40, 0xa4, 0x2d, 0x41,
-	0xa4, 0xfb, 0xaf, 0x88, 0x37, 0x4e, 0xcd, 0x52, 0xb0, 0xb0, 0x63, 0xd3, 0x3f, 0xad, 0x9c, 0xf5,
-	0x93, 0x24, 0xa4, 0x9b, 0xae, 0xdb, 0x45, 0x3f, 0x36, 0x60, 0x81, 0xb9, 0xc2, 0x92, 0xf9, 0x41,
-	0x1c, 0x4b, 0xbf, 0x64, 0xf8, 0x15, 0x74, 0xe7, 0x6c, 0xec, 0xfd, 0xf3, 0xa8, 0x34, 0x09, 0x35,
-	0x4a, 0xa9, 0x7e, 0x49, 0x63, 0xae, 0xa8, 0x29, 0xa1, 0x2d, 0xff, 0xb1, 0xe3, 0x5d, 0x98, 0x1b,
-	0x9d, 0xdf, 0x2f, 0xbb, 0xe6, 0x99, 0xe7, 0x9f, 0x7b, 0xea, 0xdc, 0xb3, 0xed, 0xd8, 0xc4, 0xab,
-	0xd3, 0xd2, 0xb1, 0xff, 0x92, 0xce, 0x7d, 0x07, 0xf2, 0x61, 0x49, 0xda, 0x56, 0xef, 0x72, 0xf2,
-	0x7c, 0x3a, 0xe5, 0x3f, 0xd1, 0x05, 0x37, 0x89, 0x72, 0xfc, 0x15, 0x18, 0xb7, 0x6d, 0x5a, 0x1d,
-	0xd3, 0x19, 0x61, 0x5c, 0xeb, 0x5e, 0xfb, 0xb5, 0x01, 0x10, 0xbd, 0x1b, 0xa1, 0xd7, 0xe0, 0x72,
-	0x6d, 0x73, 0xa3, 0x6e, 0xb5, 0xb6, 0x6e, 0x6e, 0x6d, 0xb7, 0xac, 0xed, 0x8d, 0x56, 0x73, 0x7d,
-	0xad, 0x71, 0xab, 0xb1, 0x5e, 0xcf, 0x27, 0x8a, 0xb9, 0xc3, 0xfb, 0xe5, 0xec, 0x36, 0xe3, 0x7d,
-	0x62, 0xd3, 0x5d, 0x4a, 0x1c, 0xf4, 0x32, 0x5c, 0x1c, 0x95, 0x96, 0xad, 0xf5, 0x7a, 0xde, 0x28,
-	0xce, 0x1e, 0xde, 0x2f, 0x4f, 0xfb, 0x47, 0x52, 0xe2, 0xa0, 0x25, 0x78, 0x7e, 0x52, 0xae, 0xb1,
-	0xf1, 0x46, 0x3e, 0x59, 0x9c, 0x3b, 0xbc, 0x5f, 0x9e, 0x09, 0xcf, 0xae, 0xa8, 0x02, 0x28, 0x2e,
-	0xa9, 0xf1, 0x52, 0x45, 0x38, 0xbc, 0x5f, 0xce, 0xf8, 0x6e, 0x29, 0xa6, 0xef, 0xfd, 0x7c, 0x31,
-	0x71, 0xed, 0xfb, 0x00, 0x0d, 0xb6, 0xeb, 0x61, 0x5b, 0x05, 0x64, 0x11, 0x2e, 0x35, 0x36, 0x6e,
-	0x99, 0x37, 0xd7, 0xb6, 0x1a, 0x9b, 0x1b, 0xa3, 0xcb, 0x1e, 0x1b, 0xab, 0x6f, 0x6e, 0xd7, 0xee,
-	0xac, 0x5b, 0xad, 0xc6, 0x1b, 0x1b, 0x79, 0x03, 0x5d, 0x86, 0x0b, 0x23, 0x63, 0x6f, 0x6f, 0x6c,
-	0x35, 0xde, 0x5a, 0xcf, 0x27, 0x6b, 0xb7, 0x3e, 0x7e, 0xb4, 0x68, 0x3c, 0x7c, 0xb4, 0x68, 0xfc,
-	0xfd, 0xd1, 0xa2, 0xf1, 0xde, 0xe3, 0xc5, 0xc4, 0xc3, 0xc7, 0x8b, 0x89, 0x3f, 0x3f, 0x5e, 0x4c,
-	0x7c, 0xf7, 0xb5, 0x27, 0x3a, 0x3c, 0xaa, 0x94, 0xca, 0xf5, 0xed, 0x8c, 0xda, 0x97, 0xbe, 0xf6,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xc1, 0x35, 0x21, 0x00, 0x19, 0x00, 0x00,
+	// 2250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x6c, 0x5c, 0x47,
+	0x19, 0xf7, 0xdb, 0xdd, 0xda, 0xde, 0x6f, 0xbd, 0x7f, 0x3c, 0x49, 0x93, 0xcd, 0xb6, 0xd8, 0xee,
+	0xf6, 0x5f, 0x1a, 0x35, 0x6b, 0x12, 0x24, 0x0e, 0xa6, 0x02, 0xc5, 0x5e, 0xa7, 0xd9, 0x92, 0x3a,
+	0xd6, 0x5b, 0xdb, 0xa5, 0x20, 0xf4, 0x98, 0x7d, 0x6f, 0x76, 0xfd, 0xf0, 0xdb, 0x99, 0xe5, 0xcd,
+	0xdb, 0x38, 0x8b, 0x40, 0x02, 0x4e, 0x51, 0x0e, 0xa8, 0x12, 0x97, 0x5c, 0x22, 0x55, 0x82, 0x03,
+	0x87, 0x22, 0xf5, 0x50, 0x71, 0xe0, 0x82, 0x38, 0x20, 0x15, 0x2e, 0x44, 0x3d, 0x21, 0x84, 0x0c,
+	0x4a, 0x0e, 0x45, 0x5c, 0x40, 0xdc, 0x41, 0x68, 0xfe, 0xbc, 0x3f, 0xbb, 0xb6, 0x13, 0x6f, 0xba,
+	0xa0, 0x4a, 0xbd, 0xd8, 0x3b, 0x33, 0xdf, 0xf7, 0x9b, 0xf9, 0x7e, 0xdf, 0x37, 0xdf, 0x37, 0x33,
+	0x0f, 0x5e, 0xb0, 0x19, 0xef, 0x32, 0xbe, 0xcc, 0x03, 0xbc, 0xe7, 0xd2, 0xce, 0xf2, 0xcd, 0x4b,
+	0x2d, 0x12, 0xe0, 0x4b, 0x61, 0xbb, 0xd6, 0xf3, 0x59, 0xc0, 0xd0, 0x19, 0x25, 0x55, 0x0b, 0x7b,
+	0xb5, 0x54, 0xe5, 0x74, 0x87, 0x75, 0x98, 0x14, 0x59, 0x16, 0xbf, 0x94, 0x74, 0xe5, 0x5c, 0x87,
+	0xb1, 0x8e, 0x47, 0x96, 0x65, 0xab, 0xd5, 0x6f, 0x2f, 0x63, 0x3a, 0xd0, 0x43, 0x0b, 0xa3, 0x43,
+	0x4e, 0xdf, 0xc7, 0x81, 0xcb, 0xa8, 0x1e, 0x5f, 0x1c, 0x1d, 0x0f, 0xdc, 0x2e, 0xe1, 0x01, 0xee,
+	0xf6, 0x42, 0x6c, 0xb5, 0x12, 0x4b, 0x4d, 0xaa, 0x97, 0xa5, 0xb1, 0xb5, 0x29, 0x2d, 0xcc, 0x49,
+	0x64, 0x87, 0xcd, 0xdc, 0x10, 0x7b, 0x1e, 0x77, 0x5d, 0xca, 0x96, 0xe5, 0x5f, 0xdd, 0xf5, 0x6c,
+	0x40, 0xa8, 0x43, 0xfc, 0xae, 0x4b, 0x83, 0xe5, 0x60, 0xd0, 0x23, 0x5c, 0xfd, 0xd5, 0xa3, 0xcf,
+	0x24, 0x46, 0x71, 0xcb, 0x76, 0x93, 0x83, 0xd5, 0x9f, 0x18, 0x50, 0xb8, 0xe6, 0xf2, 0x80, 0xf9,
+	0xae, 0x8d, 0xbd, 0x06, 0x6d, 0x33, 0xf4, 0x25, 0x98, 0xde, 0x25, 0xd8, 0x21, 0x7e, 0xd9, 0x58,
+	0x32, 0xce, 0xe7, 0x2e, 0x97, 0x6b, 0x31, 0x40, 0x4d, 0xe9, 0x5e, 0x93, 0xe3, 0xab, 0xd9, 0x0f,
+	0x0f, 0x16, 0xa7, 0x7e, 0xfe, 0xf1, 0xfb, 0x17, 0x0c, 0x53, 0xab, 0xa0, 0x3a, 0x4c, 0xdf, 0xc4,
+	0x1e, 0x27, 0x41, 0x39, 0xb5, 0x94, 0x3e, 0x9f, 0xbb, 0xfc, 0x5c, 0xed, 0x68, 0xce, 0x6b, 0x3b,
+	0xd8, 0x73, 0x1d, 0x1c, 0xb0, 0x61, 0x14, 0xa5, 0x5b, 0x7d, 0x2f, 0x05, 0xc5, 0x35, 0xd6, 0xed,
+	0xba, 0x9c, 0xbb, 0x8c, 0x9a, 0x38, 0x20, 0x1c, 0x6d, 0x42, 0xc6, 0xc7, 0x01, 0x91, 0x8b, 0xca,
+	0xae, 0xbe, 0x26, 0x94, 0xfe, 0x74, 0xb0, 0xf8, 0x52, 0xc7, 0x0d, 0x76, 0xfb, 0xad, 0x9a, 0xcd,
+	0xba, 0x9a, 0x46, 0xfd, 0xef, 0x22, 0x77, 0xf6, 0xb4, 0xa5, 0x75, 0x62, 0x7f, 0xf4, 0xc1, 0x45,
+	0xd0, 0x0b, 0xa9, 0x13, 0xdb, 0x94, 0x48, 0xe8, 0x2d, 0x98, 0xed, 0xe2, 0x5b, 0x96, 0x44, 0x4d,
+	0x4d, 0x00, 0x75, 0xa6, 0x8b, 0x6f, 0x89, 0xb5, 0x22, 0x07, 0x8a, 0x02, 0xd8, 0xde, 0xc5, 0xb4,
+	0x43, 0x14, 0x7e, 0x7a, 0x02, 0xf8, 0xf9, 0x2e, 0xbe, 0xb5, 0x26, 0x31, 0xc5, 0x2c, 0x2b, 0xb3,
+	0x77, 0xdf, 0x5d, 0x9c, 0xfa, 0xdb, 0xbb, 0x8b, 0x46, 0xf5, 0xb7, 0x06, 0x40, 0x4c, 0x17, 0xc2,
+	0x50, 0xb2, 0xa3, 0x96, 0x9c, 0x9e, 0x6b, 0x57, 0xbe, 0x7c, 0x9c, 0x37, 0x46, 0xc8, 0x5e, 0xcd,
+	0x8b, 0x85, 0xde, 0x3f, 0x58, 0x34, 0x94, 0x5f, 0x8a, 0xf6, 0x88, 0x33, 0xde, 0x80, 0x5c, 0xbf,
+	0xe7, 0xe0, 0x80, 0x58, 0x22, 0xb2, 0x25, 0x7b, 0xb9, 0xcb, 0x95, 0x9a, 0x0a, 0xfb, 0x5a, 0x18,
+	0xf6, 0xb5, 0xad, 0x30, 0xec, 0x15, 0xe0, 0x3b, 0x7f, 0x09, 0x01, 0x41, 0x69, 0x8b, 0xf1, 0x84,
+	0x1d, 0x07, 0x29, 0x38, 0xbb, 0x49, 0xa8, 0xe3, 0xd2, 0x4e, 0xbc, 0x20, 0x65, 0x31, 0x5a, 0x87,
+	0xf9, 0x9b, 0x61, 0xc8, 0x58, 0xd8, 0x71, 0x7c, 0xc2, 0xb9, 0x8e, 0x85, 0xf2, 0x47, 0x1f, 0x5c,
+	0x3c, 0xad, 0x0d, 0xbb, 0xa2, 0x46, 0x9a, 0x81, 0xef, 0xd2, 0x8e, 0x59, 0x8a, 0x54, 0x74, 0x7f,
+	0x14, 0x45, 0xa9, 0x89, 0x45, 0xd1, 0x06, 0xe4, 0x31, 0xa5, 0xac, 0x4f, 0x6d, 0x4d, 0x46, 0x7a,
+	0x5c, 0x32, 0xe6, 0x42, 0x7d, 0x21, 0x81, 0x36, 0xa1, 0x40, 0xda, 0x6d, 0x62, 0x07, 0xee, 0x4d,
+	0x0d, 0x98, 0x19, 0x17, 0x30, 0x1f, 0x01, 0x8c, 0x10, 0xfc, 0x2b, 0x03, 0x4e, 0x6d, 0xb1, 0x3d,
+	0x42, 0xdd, 0xef, 0x92, 0xe6, 0x2e, 0xf6, 0x89, 0x49, 0x6c, 0xe6, 0x3b, 0xa8, 0x00, 0x29, 0xd7,
+	0x91, 0x6c, 0x66, 0xcc, 0x94, 0xeb, 0xa0, 0x1a, 0x3c, 0xc5, 0xf6, 0x29, 0xf1, 0xcb, 0xa9, 0xc7,
+	0x10, 0xac, 0xc4, 0xd0, 0x8b, 0x50, 0xe8, 0x32, 0xa7, 0xef, 0x11, 0x0b, 0xdb, 0x36, 0xeb, 0xd3,
+	0x40, 0xc5, 0xbb, 0x99, 0x57, 0xbd, 0x57, 0x54, 0x27, 0xfa, 0x22, 0x64, 0x23, 0x87, 0x94, 0x33,
+	0x8f, 0x81, 0x8e, 0x45, 0x57, 0x32, 0x72, 0xf1, 0x3f, 0x4c, 0x03, 0x5a, 0x63, 0x94, 0x6f, 0xf6,
+	0x5b, 0x5f, 0x25, 0x03, 0x93, 0x05, 0x32, 0xe3, 0x4e, 0x2a, 0x30, 0x76, 0xa0, 0xc8, 0x3c, 0xc7,
+	0xb2, 0x19, 0xe5, 0x56, 0xaf, 0xdf, 0xda, 0x23, 0x03, 0x1d, 0xd5, 0xa7, 0x0f, 0xf1, 0x7e, 0x85,
+	0x0e, 0x56, 0xcb, 0xbf, 0x8f, 0xa1, 0x6d, 0x7f, 0xd0, 0x0b, 0x58, 0x4d, 0xaf, 0x2b, 0xcf, 0x3c,
+	0x47, 0x2f, 0x73, 0x8f, 0x0c, 0x04, 0x2e, 0x25, 0xfb, 0x43, 0xb8, 0xe9, 0x27, 0xc3, 0xa5, 0x64,
+	0x3f, 0x81, 0x7b, 0x46, 0x64, 0x69, 0xb7, 0xb3, 0x1b, 0x48, 0x22, 0xd3, 0xa6, 0x6e, 0x21, 0x13,
+	0xc4, 0x66, 0xed, 0x79, 0x44, 0x90, 0xa3, 0xe2, 0xe7, 0xa9, 0x71, 0xe3, 0xa7, 0x10, 0x23, 0xa8,
+	0x00, 0xba, 0xad, 0x02, 0x68, 0xaa, 0xfa, 0x9e, 0x01, 0xb9, 0x3a, 0xe1, 0xb6, 0xef, 0xf6, 0x24,
+	0xf9, 0x65, 0x98, 0xe9, 0x32, 0xea, 0xee, 0xe9, 0x62, 0x91, 0x35, 0xc3, 0x26, 0xaa, 0xc0, 0xac,
+	0xeb, 0x10, 0x1a, 0xb8, 0x81, 0x22, 0x32, 0x6b, 0x46, 0x6d, 0xa1, 0xb5, 0x4f, 0x5a, 0xdc, 0x0d,
+	0xf3, 0xa2, 0x19, 0x36, 0xd1, 0x2b, 0x50, 0xe2, 0xc4, 0xee, 0xfb, 0x6e, 0x30, 0x10, 0x94, 0x05,
+	0xd8, 0x56, 0xf6, 0x65, 0xcd, 0x62, 0xd8, 0xbf, 0xa6, 0xba, 0x05, 0x88, 0x43, 0x02, 0xec, 0x7a,
+	0x5c, 0x1a, 0x98, 0x35, 0xc3, 0x66, 0x32, 0xde, 0x67, 0x21, 0x1b, 0x15, 0x1a, 0xb4, 0x06, 0x25,
+	0xd6, 0x23, 0xfe, 0x58, 0x81, 0x52, 0x0c, 0x35, 0xc2, 0x38, 0x79, 0x5b, 0x24, 0x57, 0xca, 0x09,
+	0xe5, 0xfd, 0x4f, 0x18, 0x28, 0xc5, 0x08, 0x27, 0x76, 0xe9, 0xb7, 0xb1, 0xeb, 0x11, 0x47, 0xb2,
+	0x32, 0x6b, 0xea, 0x16, 0x5a, 0x81, 0x69, 0x1e, 0xe0, 0xa0, 0xcf, 0x25, 0x15, 0x85, 0xcb, 0xd5,
+	0xe3, 0xb2, 0xf8, 0x2a, 0xa3, 0x4e, 0x53, 0x4a, 0x9a, 0x5a, 0x03, 0x6d, 0xc1, 0x74, 0x20, 0x36,
+	0xbc, 0x26, 0x69, 0xac, 0x8c, 0xd7, 0xa0, 0x41, 0x22, 0xe3, 0x35, 0x68, 0x60, 0x6a, 0x2c, 0xd4,
+	0x81, 0x92, 0x43, 0x3c, 0xd2, 0x91, 0x54, 0x72, 0x91, 0x48, 0x78, 0x79, 0x7a, 0x02, 0x19, 0xb5,
+	0x18, 0xa1, 0xca, 0xec, 0x24, 0xd2, 0x75, 0xce, 0x89, 0xc3, 0xad, 0x3c, 0x23, 0x89, 0x7e, 0xfe,
+	0x38, 0xfb, 0x13, 0x91, 0x99, 0x3c, 0x55, 0x24, 0x21, 0x44, 0x84, 0xf5, 0x69, 0x8b, 0xc9, 0x22,
+	0x63, 0xe9, 0x1d, 0x34, 0x2b, 0x77, 0x50, 0x31, 0xea, 0xbf, 0xa6, 0xb6, 0xd2, 0x26, 0x14, 0x62,
+	0x51, 0xb9, 0x93, 0xb2, 0x63, 0x67, 0xe2, 0x08, 0x40, 0xe6, 0xf6, 0x37, 0x01, 0xe2, 0x4a, 0x5a,
+	0x06, 0x89, 0x56, 0x7d, 0x7c, 0x4d, 0x4e, 0x1a, 0x93, 0x00, 0x40, 0x1e, 0x9c, 0xea, 0xba, 0xd4,
+	0xe2, 0xc4, 0x6b, 0x5b, 0x9a, 0x39, 0x81, 0x9b, 0x9b, 0x80, 0xa7, 0xe7, 0xbb, 0x2e, 0x6d, 0x12,
+	0xaf, 0x5d, 0x8f, 0x60, 0x91, 0x0d, 0x05, 0x71, 0xaa, 0x49, 0x4c, 0x34, 0x37, 0x81, 0x89, 0xc4,
+	0xa1, 0x26, 0x31, 0x49, 0x19, 0x66, 0x7a, 0x3e, 0x6b, 0x31, 0xca, 0xca, 0x79, 0xb9, 0x09, 0xc2,
+	0x26, 0x7a, 0x0d, 0x9e, 0x89, 0xbd, 0xc1, 0xa8, 0xb5, 0x2b, 0xd2, 0xb5, 0x4f, 0xda, 0x96, 0x2a,
+	0x38, 0x05, 0xe9, 0xc3, 0xb3, 0x91, 0xc8, 0x0d, 0x7a, 0x8d, 0x79, 0x8e, 0x49, 0xda, 0x6b, 0x62,
+	0x18, 0x3d, 0x0f, 0xb1, 0x2b, 0x2c, 0xd7, 0xe1, 0xe5, 0xe2, 0x52, 0xfa, 0x7c, 0xc6, 0x9c, 0x8b,
+	0x3a, 0x1b, 0x0e, 0x5f, 0x99, 0x13, 0x79, 0xee, 0x6e, 0x98, 0xeb, 0x36, 0x61, 0x6e, 0x07, 0x7b,
+	0x7a, 0xdf, 0x13, 0x2e, 0xaa, 0x17, 0x0e, 0x1b, 0x65, 0x63, 0x29, 0xfd, 0xe8, 0xea, 0x15, 0x89,
+	0xaa, 0x74, 0xf4, 0x83, 0x3f, 0x2f, 0x19, 0xd5, 0x9f, 0x19, 0x30, 0x5d, 0xdf, 0xd9, 0xc4, 0xae,
+	0x2f, 0xaa, 0x56, 0xbc, 0x83, 0x4e, 0x5c, 0xb5, 0x22, 0x15, 0xdd, 0x7f, 0x74, 0xf1, 0x4b, 0x8d,
+	0x5b, 0xfc, 0x46, 0x0c, 0x7f, 0x03, 0x66, 0xd4, 0x2a, 0x39, 0xfa, 0x0a, 0x3c, 0xd5, 0x13, 0x3f,
+	0xa4, 0xbd, 0xb9, 0xcb, 0x0b, 0xc7, 0xee, 0x3c, 0x29, 0x9f, 0x8c, 0x53, 0xa5, 0x57, 0xfd, 0xb7,
+	0x01, 0x50, 0xdf, 0xd9, 0xd9, 0xf2, 0x5d, 0x51, 0x50, 0x26, 0x65, 0xf6, 0x75, 0x78, 0x3a, 0x36,
+	0x9b, 0xfb, 0xf6, 0x89, 0x4d, 0x3f, 0x15, 0xa9, 0x35, 0x7d, 0xfb, 0x48, 0x34, 0x87, 0x07, 0x11,
+	0x5a, 0xfa, 0xc4, 0x68, 0x75, 0x1e, 0x1c, 0xcd, 0xe5, 0xd7, 0x20, 0x17, 0x9b, 0xcf, 0x51, 0x03,
+	0x66, 0x03, 0xfd, 0x5b, 0x53, 0x5a, 0x3d, 0x9e, 0xd2, 0x50, 0x2d, 0x49, 0x6b, 0xa4, 0x5e, 0xfd,
+	0x8f, 0x60, 0x36, 0xde, 0x38, 0x9f, 0xaa, 0x80, 0x12, 0x65, 0x47, 0x97, 0x85, 0x49, 0x5c, 0x7c,
+	0x34, 0xd6, 0x08, 0xb5, 0xb7, 0x53, 0x70, 0x6a, 0x3b, 0xdc, 0xbe, 0x9f, 0x5a, 0x26, 0xb6, 0x61,
+	0x86, 0xd0, 0xc0, 0x77, 0x25, 0x15, 0xc2, 0xe1, 0x9f, 0x3f, 0xce, 0xe1, 0x47, 0xd8, 0xb2, 0x4e,
+	0x03, 0x7f, 0x90, 0x74, 0x7f, 0x88, 0x35, 0x42, 0xc5, 0x6f, 0xd2, 0x50, 0x3e, 0x4e, 0x1d, 0xbd,
+	0x0c, 0x45, 0xdb, 0x27, 0xb2, 0x23, 0x2c, 0x78, 0x86, 0x4c, 0x96, 0x85, 0xb0, 0xfb, 0xda, 0xb1,
+	0x47, 0xc7, 0xd4, 0x27, 0x3c, 0x3a, 0x22, 0x02, 0x45, 0x97, 0xba, 0x81, 0x8b, 0x3d, 0xab, 0x85,
+	0x3d, 0x4c, 0xed, 0x27, 0xb9, 0x0a, 0x1f, 0xae, 0x1a, 0x05, 0x0d, 0xba, 0xaa, 0x30, 0xd1, 0x0e,
+	0xcc, 0x84, 0xf0, 0x99, 0x09, 0xc0, 0x87, 0x60, 0xe8, 0x39, 0x98, 0x4b, 0x96, 0x0d, 0x79, 0x88,
+	0xca, 0x98, 0xb9, 0x44, 0xd5, 0x78, 0x5c, 0x5d, 0x9a, 0x7e, 0x64, 0x5d, 0x4a, 0x9c, 0x55, 0x7f,
+	0x9d, 0x86, 0x79, 0x93, 0x38, 0x9f, 0x41, 0xe7, 0x7d, 0x03, 0x40, 0x6d, 0x70, 0x91, 0x7c, 0xcb,
+	0x99, 0x09, 0x24, 0x8c, 0xac, 0xc2, 0xab, 0xf3, 0xe0, 0xff, 0xe9, 0xc1, 0x3f, 0xa4, 0x60, 0x2e,
+	0xe9, 0xc1, 0xcf, 0x40, 0xb5, 0x43, 0x1b, 0x71, 0x7a, 0xcb, 0xc8, 0xf4, 0xf6, 0xca, 0x71, 0xe9,
+	0xed, 0x50, 0x6c, 0x9f, 0x20, 0xaf, 0xfd, 0x63, 0x06, 0xa6, 0x37, 0xb1, 0x8f, 0xbb, 0x1c, 0xdd,
+	0x38, 0x74, 0x18, 0x57, 0x4f, 0x5a, 0xe7, 0x0e, 0x85, 0x77, 0x5d, 0xbf, 0xc5, 0xaa, 0xe8, 0xbe,
+	0x7b, 0xdc, 0x59, 0xfc, 0x45, 0x75, 0x9c, 0x8d, 0x8c, 0x52, 0x74, 0xe6, 0xe5, 0x81, 0x34, 0xba,
+	0x33, 0x72, 0xb4, 0x08, 0x39, 0x21, 0x16, 0xe7, 0x70, 0x21, 0x03, 0x5d, 0x7c, 0x6b, 0x5d, 0xf5,
+	0xa0, 0x8b, 0x80, 0x76, 0xa3, 0x07, 0x54, 0x2b, 0x26, 0x43, 0xc8, 0xcd, 0xc7, 0x23, 0xa1, 0xf8,
+	0xe7, 0x00, 0xc4, 0x2a, 0x2c, 0x87, 0x50, 0xd6, 0xd5, 0x37, 0xd7, 0xac, 0xe8, 0xa9, 0x8b, 0x0e,
+	0xf4, 0x3d, 0x75, 0xa4, 0x1f, 0x79, 0xbf, 0xd3, 0x97, 0xab, 0xeb, 0xe3, 0x6d, 0x8a, 0x7f, 0x1d,
+	0x2c, 0x56, 0x06, 0xb8, 0xeb, 0xad, 0x54, 0x8f, 0x80, 0xac, 0xca, 0x23, 0xfe, 0xf0, 0xbb, 0x1f,
+	0xfa, 0x16, 0x9c, 0x4d, 0x88, 0xe9, 0xf7, 0x4b, 0x87, 0x78, 0x78, 0x50, 0x9e, 0x19, 0x93, 0xed,
+	0xa7, 0xed, 0x91, 0x17, 0xbc, 0xba, 0x80, 0x41, 0xbd, 0x64, 0xf4, 0x49, 0x22, 0xda, 0xd8, 0x16,
+	0xcf, 0x41, 0xb3, 0x13, 0xd8, 0xf6, 0x71, 0x84, 0x8a, 0x9b, 0xf0, 0x55, 0x09, 0x8c, 0xf6, 0xe1,
+	0x5c, 0xc7, 0x63, 0x2d, 0xec, 0x59, 0x9e, 0xfb, 0x9d, 0xbe, 0xeb, 0x58, 0x3a, 0x30, 0x2d, 0x1b,
+	0xf7, 0xca, 0xd9, 0x09, 0xcc, 0x7a, 0x46, 0xc1, 0x5f, 0x97, 0xe8, 0x4d, 0x05, 0xbe, 0x86, 0x7b,
+	0xe8, 0xfb, 0xf0, 0x6c, 0x6c, 0xea, 0x11, 0x73, 0xc3, 0x04, 0xe6, 0x3e, 0x17, 0xcd, 0x70, 0x68,
+	0xfa, 0x0d, 0x28, 0xed, 0x91, 0x81, 0xe5, 0xeb, 0x77, 0x32, 0xab, 0x4d, 0x48, 0x39, 0xa7, 0x9d,
+	0xa8, 0x11, 0xc4, 0x27, 0x86, 0xc4, 0x75, 0xd3, 0x1d, 0xba, 0x68, 0x16, 0xf6, 0xe2, 0x47, 0xb6,
+	0xab, 0x84, 0xa0, 0x15, 0xa8, 0xc8, 0x47, 0x6d, 0xfd, 0x90, 0x65, 0x25, 0xc1, 0xb9, 0xbc, 0x0a,
+	0xe6, 0xcd, 0x33, 0xe2, 0x85, 0xfa, 0xd0, 0x13, 0x1d, 0x5f, 0x39, 0x1f, 0xe6, 0xc8, 0x3b, 0x1f,
+	0xbf, 0x7f, 0xe1, 0x99, 0x84, 0x5d, 0xb7, 0xa2, 0x2f, 0x36, 0x6a, 0x9b, 0x57, 0x7f, 0x61, 0x00,
+	0x8a, 0x0f, 0x30, 0x26, 0xe1, 0x3d, 0x46, 0xb9, 0xbc, 0x38, 0x27, 0xee, 0x9d, 0xc6, 0xa3, 0x2f,
+	0xce, 0xb1, 0xfe, 0xd0, 0xc5, 0x39, 0x91, 0x98, 0xbf, 0x1c, 0x1f, 0x17, 0x52, 0x63, 0x50, 0x12,
+	0x2a, 0x45, 0x39, 0x7f, 0xaa, 0x7a, 0x60, 0xc0, 0xb9, 0x43, 0x99, 0x2d, 0x5a, 0xb6, 0x0d, 0xc8,
+	0x4f, 0x0c, 0xca, 0xec, 0x30, 0xd0, 0xcb, 0x7f, 0xb2, 0x44, 0x39, 0xef, 0x8f, 0x8e, 0xfe, 0xaf,
+	0xce, 0x3e, 0xfa, 0xd5, 0xf5, 0x77, 0x06, 0x9c, 0x4e, 0xae, 0x28, 0xb2, 0xad, 0x09, 0x73, 0xc9,
+	0xb5, 0x68, 0xab, 0x5e, 0x38, 0x89, 0x55, 0x49, 0x83, 0x86, 0x40, 0x84, 0x2d, 0x61, 0x06, 0x55,
+	0xdf, 0x8f, 0x2e, 0x9d, 0x98, 0xa5, 0x70, 0x61, 0x47, 0x96, 0x95, 0x8c, 0x74, 0xd6, 0x8f, 0x53,
+	0x90, 0xd9, 0x64, 0xcc, 0x43, 0x3f, 0x32, 0x60, 0x9e, 0xb2, 0x40, 0x26, 0x20, 0xe2, 0x58, 0xfa,
+	0x85, 0x4c, 0x55, 0xe6, 0x9d, 0xf1, 0xd8, 0xfb, 0xfb, 0xc1, 0xe2, 0x61, 0xa8, 0x61, 0x4a, 0xf5,
+	0x37, 0x14, 0xca, 0x82, 0x55, 0x29, 0x24, 0x5f, 0xe0, 0x39, 0xda, 0x87, 0xfc, 0xf0, 0xfc, 0xaa,
+	0x9c, 0x9b, 0x63, 0xcf, 0x9f, 0x7f, 0xec, 0xdc, 0x73, 0xad, 0xc4, 0xc4, 0x2b, 0xb3, 0xc2, 0xb1,
+	0xff, 0x14, 0xce, 0x7d, 0x1b, 0x4a, 0x51, 0xa9, 0xdb, 0x96, 0x5f, 0x64, 0xc4, 0xbd, 0x67, 0x46,
+	0x7d, 0x9c, 0x09, 0x6f, 0xa8, 0x4b, 0xc9, 0xef, 0x7f, 0xe2, 0x03, 0x62, 0x6d, 0x44, 0x67, 0x88,
+	0x71, 0xad, 0x7b, 0xe1, 0x97, 0x06, 0x40, 0xfc, 0x1e, 0x89, 0x5e, 0x85, 0xb3, 0xab, 0x37, 0x36,
+	0xea, 0x56, 0x73, 0xeb, 0xca, 0xd6, 0x76, 0xd3, 0xda, 0xde, 0x68, 0x6e, 0xae, 0xaf, 0x35, 0xae,
+	0x36, 0xd6, 0xeb, 0xa5, 0xa9, 0x4a, 0xf1, 0xce, 0xbd, 0xa5, 0xdc, 0x36, 0xe5, 0x3d, 0x62, 0xbb,
+	0x6d, 0x97, 0x38, 0xe8, 0x25, 0x38, 0x3d, 0x2c, 0x2d, 0x5a, 0xeb, 0xf5, 0x92, 0x51, 0x99, 0xbb,
+	0x73, 0x6f, 0x69, 0x56, 0x5d, 0x75, 0x88, 0x83, 0xce, 0xc3, 0xd3, 0x87, 0xe5, 0x1a, 0x1b, 0xaf,
+	0x97, 0x52, 0x95, 0xfc, 0x9d, 0x7b, 0x4b, 0xd9, 0xe8, 0x4e, 0x84, 0xaa, 0x80, 0x92, 0x92, 0x1a,
+	0x2f, 0x5d, 0x81, 0x3b, 0xf7, 0x96, 0xa6, 0x95, 0x5b, 0x2a, 0x99, 0xdb, 0x3f, 0x5d, 0x98, 0xba,
+	0xf0, 0x4d, 0x80, 0x06, 0x6d, 0xfb, 0xd8, 0x96, 0x01, 0x59, 0x81, 0x33, 0x8d, 0x8d, 0xab, 0xe6,
+	0x95, 0xb5, 0xad, 0xc6, 0x8d, 0x8d, 0xe1, 0x65, 0x8f, 0x8c, 0xd5, 0x6f, 0x6c, 0xaf, 0x5e, 0x5f,
+	0xb7, 0x9a, 0x8d, 0xd7, 0x37, 0x4a, 0x06, 0x3a, 0x0b, 0xa7, 0x86, 0xc6, 0xde, 0xda, 0xd8, 0x6a,
+	0xbc, 0xb9, 0x5e, 0x4a, 0xad, 0x5e, 0xfd, 0xf0, 0xc1, 0x82, 0x71, 0xff, 0xc1, 0x82, 0xf1, 0xd7,
+	0x07, 0x0b, 0xc6, 0x3b, 0x0f, 0x17, 0xa6, 0xee, 0x3f, 0x5c, 0x98, 0xfa, 0xe3, 0xc3, 0x85, 0xa9,
+	0xaf, 0xbf, 0xfa, 0x48, 0x87, 0xc7, 0x99, 0x52, 0xba, 0xbe, 0x35, 0x2d, 0x2b, 0xf0, 0x17, 0xfe,
+	0x3b, 0x00, 0x71, 0xd1, 0xed, 0x0e, 0xfa, 0x1e, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1397,692 +1653,786 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 10946 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6b, 0x70, 0x24, 0xc7,
-		0x79, 0x18, 0xf6, 0x81, 0x7d, 0x7c, 0xbb, 0xd8, 0x1d, 0x34, 0x70, 0x77, 0xb8, 0x3d, 0x12, 0x00,
-		0x87, 0x22, 0xef, 0x41, 0x12, 0x47, 0x1e, 0x79, 0x47, 0x12, 0x27, 0x8a, 0xda, 0x5d, 0xec, 0xe1,
-		0xf6, 0x0e, 0x2f, 0xcd, 0x2e, 0x8e, 0x0f, 0x45, 0x1a, 0x0d, 0x66, 0x1b, 0xc0, 0xf0, 0x76, 0x67,
-		0x56, 0x33, 0xb3, 0x77, 0x00, 0xcb, 0x95, 0xa2, 0xad, 0xd8, 0x91, 0x2f, 0x8e, 0x22, 0x47, 0x29,
-		0x8b, 0x96, 0x75, 0x8a, 0x64, 0xd9, 0x92, 0xec, 0xc8, 0x89, 0x6c, 0x29, 0xb2, 0x6c, 0x57, 0x1c,
-		0x3b, 0x15, 0x27, 0xb2, 0x2b, 0x95, 0xc8, 0xae, 0x54, 0xe2, 0x72, 0x39, 0x8c, 0x4d, 0xb9, 0x22,
-		0x45, 0x92, 0x63, 0x5b, 0xa1, 0x2b, 0x49, 0xa9, 0xec, 0xa4, 0xfa, 0x35, 0x8f, 0x7d, 0x60, 0x17,
-		0x27, 0x92, 0x91, 0x4b, 0x7f, 0xee, 0xb6, 0xbb, 0xbf, 0xef, 0xeb, 0xee, 0xaf, 0xbf, 0xfe, 0x5e,
-		0xdd, 0xd3, 0x80, 0x3f, 0x2e, 0xc1, 0xfc, 0x8e, 0x65, 0xed, 0x34, 0xf1, 0xd9, 0xb6, 0x6d, 0xb9,
-		0xd6, 0x56, 0x67, 0xfb, 0x6c, 0x03, 0x3b, 0xba, 0x6d, 0xb4, 0x5d, 0xcb, 0x5e, 0xa0, 0x75, 0x28,
-		0xcf, 0x20, 0x16, 0x04, 0x84, 0xbc, 0x0a, 0x93, 0x97, 0x8c, 0x26, 0x5e, 0xf2, 0x00, 0x6b, 0xd8,
-		0x45, 0x4f, 0x40, 0x7c, 0xdb, 0x68, 0xe2, 0x99, 0xc8, 0x7c, 0xec, 0x54, 0xe6, 0xdc, 0x5b, 0x16,
-		0xba, 0x90, 0x16, 0xc2, 0x18, 0x1b, 0xa4, 0x5a, 0xa1, 0x18, 0xf2, 0x5f, 0xc7, 0x61, 0xaa, 0x4f,
-		0x2b, 0x42, 0x10, 0x37, 0xb5, 0x16, 0xa1, 0x18, 0x39, 0x95, 0x56, 0xe8, 0x6f, 0x34, 0x03, 0xc9,
-		0xb6, 0xa6, 0x5f, 0xd7, 0x76, 0xf0, 0x4c, 0x94, 0x56, 0x8b, 0x22, 0x9a, 0x05, 0x68, 0xe0, 0x36,
-		0x36, 0x1b, 0xd8, 0xd4, 0xf7, 0x67, 0x62, 0xf3, 0xb1, 0x53, 0x69, 0x25, 0x50, 0x83, 0x1e, 0x80,
-		0xc9, 0x76, 0x67, 0xab, 0x69, 0xe8, 0x6a, 0x00, 0x0c, 0xe6, 0x63, 0xa7, 0xc6, 0x15, 0x89, 0x35,
-		0x2c, 0xf9, 0xc0, 0x27, 0x21, 0x7f, 0x13, 0x6b, 0xd7, 0x83, 0xa0, 0x19, 0x0a, 0x9a, 0x23, 0xd5,
-		0x01, 0xc0, 0x32, 0x64, 0x5b, 0xd8, 0x71, 0xb4, 0x1d, 0xac, 0xba, 0xfb, 0x6d, 0x3c, 0x13, 0xa7,
-		0xb3, 0x9f, 0xef, 0x99, 0x7d, 0xf7, 0xcc, 0x33, 0x1c, 0xab, 0xbe, 0xdf, 0xc6, 0xa8, 0x08, 0x69,
-		0x6c, 0x76, 0x5a, 0x8c, 0xc2, 0xf8, 0x00, 0xfe, 0x55, 0xcc, 0x4e, 0xab, 0x9b, 0x4a, 0x8a, 0xa0,
-		0x71, 0x12, 0x49, 0x07, 0xdb, 0x37, 0x0c, 0x1d, 0xcf, 0x24, 0x28, 0x81, 0x93, 0x3d, 0x04, 0x6a,
-		0xac, 0xbd, 0x9b, 0x86, 0xc0, 0x43, 0x65, 0x48, 0xe3, 0x3d, 0x17, 0x9b, 0x8e, 0x61, 0x99, 0x33,
-		0x49, 0x4a, 0xe4, 0xbe, 0x3e, 0xab, 0x88, 0x9b, 0x8d, 0x6e, 0x12, 0x3e, 0x1e, 0xba, 0x00, 0x49,
-		0xab, 0xed, 0x1a, 0x96, 0xe9, 0xcc, 0xa4, 0xe6, 0x23, 0xa7, 0x32, 0xe7, 0xee, 0xea, 0x2b, 0x08,
-		0xeb, 0x0c, 0x46, 0x11, 0xc0, 0xa8, 0x0a, 0x92, 0x63, 0x75, 0x6c, 0x1d, 0xab, 0xba, 0xd5, 0xc0,
-		0xaa, 0x61, 0x6e, 0x5b, 0x33, 0x69, 0x4a, 0x60, 0xae, 0x77, 0x22, 0x14, 0xb0, 0x6c, 0x35, 0x70,
-		0xd5, 0xdc, 0xb6, 0x94, 0x9c, 0x13, 0x2a, 0xa3, 0xa3, 0x90, 0x70, 0xf6, 0x4d, 0x57, 0xdb, 0x9b,
-		0xc9, 0x52, 0x09, 0xe1, 0x25, 0x22, 0x3a, 0xb8, 0x61, 0x90, 0xee, 0x66, 0x26, 0x98, 0xe8, 0xf0,
-		0xa2, 0xfc, 0x2b, 0x09, 0xc8, 0x8f, 0x22, 0x7c, 0x17, 0x61, 0x7c, 0x9b, 0xcc, 0x7f, 0x26, 0x7a,
-		0x18, 0xee, 0x30, 0x9c, 0x30, 0x7b, 0x13, 0x77, 0xc8, 0xde, 0x22, 0x64, 0x4c, 0xec, 0xb8, 0xb8,
-		0xc1, 0x64, 0x25, 0x36, 0xa2, 0xb4, 0x01, 0x43, 0xea, 0x15, 0xb6, 0xf8, 0x1d, 0x09, 0xdb, 0xb3,
-		0x90, 0xf7, 0x86, 0xa4, 0xda, 0x9a, 0xb9, 0x23, 0xa4, 0xf6, 0xec, 0xb0, 0x91, 0x2c, 0x54, 0x04,
-		0x9e, 0x42, 0xd0, 0x94, 0x1c, 0x0e, 0x95, 0xd1, 0x12, 0x80, 0x65, 0x62, 0x6b, 0x5b, 0x6d, 0x60,
-		0xbd, 0x39, 0x93, 0x1a, 0xc0, 0xa5, 0x75, 0x02, 0xd2, 0xc3, 0x25, 0x8b, 0xd5, 0xea, 0x4d, 0xf4,
-		0xa4, 0x2f, 0x84, 0xc9, 0x01, 0x32, 0xb4, 0xca, 0xb6, 0x5f, 0x8f, 0x1c, 0x6e, 0x42, 0xce, 0xc6,
-		0x64, 0x47, 0xe0, 0x06, 0x9f, 0x59, 0x9a, 0x0e, 0x62, 0x61, 0xe8, 0xcc, 0x14, 0x8e, 0xc6, 0x26,
-		0x36, 0x61, 0x07, 0x8b, 0xe8, 0x5e, 0xf0, 0x2a, 0x54, 0x2a, 0x56, 0x40, 0xf5, 0x53, 0x56, 0x54,
-		0xae, 0x69, 0x2d, 0x5c, 0x78, 0x11, 0x72, 0x61, 0xf6, 0xa0, 0x69, 0x18, 0x77, 0x5c, 0xcd, 0x76,
-		0xa9, 0x14, 0x8e, 0x2b, 0xac, 0x80, 0x24, 0x88, 0x61, 0xb3, 0x41, 0xf5, 0xdf, 0xb8, 0x42, 0x7e,
-		0xa2, 0xb7, 0xfb, 0x13, 0x8e, 0xd1, 0x09, 0xdf, 0xdf, 0xbb, 0xa2, 0x21, 0xca, 0xdd, 0xf3, 0x2e,
-		0x3c, 0x0e, 0x13, 0xa1, 0x09, 0x8c, 0xda, 0xb5, 0xfc, 0x03, 0x70, 0xa4, 0x2f, 0x69, 0xf4, 0x2c,
-		0x4c, 0x77, 0x4c, 0xc3, 0x74, 0xb1, 0xdd, 0xb6, 0x31, 0x91, 0x58, 0xd6, 0xd5, 0xcc, 0xd7, 0x92,
-		0x03, 0x64, 0x6e, 0x33, 0x08, 0xcd, 0xa8, 0x28, 0x53, 0x9d, 0xde, 0xca, 0x33, 0xe9, 0xd4, 0xd7,
-		0x93, 0xd2, 0x4b, 0x2f, 0xbd, 0xf4, 0x52, 0x54, 0xfe, 0xcd, 0x04, 0x4c, 0xf7, 0xdb, 0x33, 0x7d,
-		0xb7, 0xef, 0x51, 0x48, 0x98, 0x9d, 0xd6, 0x16, 0xb6, 0x29, 0x93, 0xc6, 0x15, 0x5e, 0x42, 0x45,
-		0x18, 0x6f, 0x6a, 0x5b, 0xb8, 0x39, 0x13, 0x9f, 0x8f, 0x9c, 0xca, 0x9d, 0x7b, 0x60, 0xa4, 0x5d,
-		0xb9, 0xb0, 0x42, 0x50, 0x14, 0x86, 0x89, 0xde, 0x06, 0x71, 0xae, 0xbc, 0x09, 0x85, 0x33, 0xa3,
-		0x51, 0x20, 0x7b, 0x49, 0xa1, 0x78, 0xe8, 0x04, 0xa4, 0xc9, 0xff, 0x4c, 0x36, 0x12, 0x74, 0xcc,
-		0x29, 0x52, 0x41, 0xe4, 0x02, 0x15, 0x20, 0x45, 0xb7, 0x49, 0x03, 0x0b, 0xa3, 0xe7, 0x95, 0x89,
-		0x60, 0x35, 0xf0, 0xb6, 0xd6, 0x69, 0xba, 0xea, 0x0d, 0xad, 0xd9, 0xc1, 0x54, 0xe0, 0xd3, 0x4a,
-		0x96, 0x57, 0x5e, 0x23, 0x75, 0x68, 0x0e, 0x32, 0x6c, 0x57, 0x19, 0x66, 0x03, 0xef, 0x51, 0xbd,
-		0x3a, 0xae, 0xb0, 0x8d, 0x56, 0x25, 0x35, 0xa4, 0xfb, 0x17, 0x1c, 0xcb, 0x14, 0xa2, 0x49, 0xbb,
-		0x20, 0x15, 0xb4, 0xfb, 0xc7, 0xbb, 0x55, 0xfa, 0xdd, 0xfd, 0xa7, 0xd7, 0xb3, 0x97, 0x4e, 0x42,
-		0x9e, 0x42, 0x3c, 0xca, 0x97, 0x5e, 0x6b, 0xce, 0x4c, 0xce, 0x47, 0x4e, 0xa5, 0x94, 0x1c, 0xab,
-		0x5e, 0xe7, 0xb5, 0xf2, 0x17, 0xa3, 0x10, 0xa7, 0x8a, 0x25, 0x0f, 0x99, 0xfa, 0x73, 0x1b, 0x15,
-		0x75, 0x69, 0x7d, 0xb3, 0xb4, 0x52, 0x91, 0x22, 0x28, 0x07, 0x40, 0x2b, 0x2e, 0xad, 0xac, 0x17,
-		0xeb, 0x52, 0xd4, 0x2b, 0x57, 0xd7, 0xea, 0x17, 0x1e, 0x93, 0x62, 0x1e, 0xc2, 0x26, 0xab, 0x88,
-		0x07, 0x01, 0x1e, 0x3d, 0x27, 0x8d, 0x23, 0x09, 0xb2, 0x8c, 0x40, 0xf5, 0xd9, 0xca, 0xd2, 0x85,
-		0xc7, 0xa4, 0x44, 0xb8, 0xe6, 0xd1, 0x73, 0x52, 0x12, 0x4d, 0x40, 0x9a, 0xd6, 0x94, 0xd6, 0xd7,
-		0x57, 0xa4, 0x94, 0x47, 0xb3, 0x56, 0x57, 0xaa, 0x6b, 0xcb, 0x52, 0xda, 0xa3, 0xb9, 0xac, 0xac,
-		0x6f, 0x6e, 0x48, 0xe0, 0x51, 0x58, 0xad, 0xd4, 0x6a, 0xc5, 0xe5, 0x8a, 0x94, 0xf1, 0x20, 0x4a,
-		0xcf, 0xd5, 0x2b, 0x35, 0x29, 0x1b, 0x1a, 0xd6, 0xa3, 0xe7, 0xa4, 0x09, 0xaf, 0x8b, 0xca, 0xda,
-		0xe6, 0xaa, 0x94, 0x43, 0x93, 0x30, 0xc1, 0xba, 0x10, 0x83, 0xc8, 0x77, 0x55, 0x5d, 0x78, 0x4c,
-		0x92, 0xfc, 0x81, 0x30, 0x2a, 0x93, 0xa1, 0x8a, 0x0b, 0x8f, 0x49, 0x48, 0x2e, 0xc3, 0x38, 0x15,
-		0x43, 0x84, 0x20, 0xb7, 0x52, 0x2c, 0x55, 0x56, 0xd4, 0xf5, 0x8d, 0x7a, 0x75, 0x7d, 0xad, 0xb8,
-		0x22, 0x45, 0xfc, 0x3a, 0xa5, 0xf2, 0x8e, 0xcd, 0xaa, 0x52, 0x59, 0x92, 0xa2, 0xc1, 0xba, 0x8d,
-		0x4a, 0xb1, 0x5e, 0x59, 0x92, 0x62, 0xb2, 0x0e, 0xd3, 0xfd, 0x14, 0x6a, 0xdf, 0x2d, 0x14, 0x90,
-		0x85, 0xe8, 0x00, 0x59, 0xa0, 0xb4, 0xba, 0x65, 0x41, 0xfe, 0x6a, 0x14, 0xa6, 0xfa, 0x18, 0x95,
-		0xbe, 0x9d, 0x3c, 0x0d, 0xe3, 0x4c, 0x96, 0x99, 0x99, 0x3d, 0xdd, 0xd7, 0x3a, 0x51, 0xc9, 0xee,
-		0x31, 0xb5, 0x14, 0x2f, 0xe8, 0x84, 0xc4, 0x06, 0x38, 0x21, 0x84, 0x44, 0x8f, 0xc0, 0xbe, 0xab,
-		0x47, 0xf9, 0x33, 0xfb, 0x78, 0x61, 0x14, 0xfb, 0x48, 0xeb, 0x0e, 0x67, 0x04, 0xc6, 0xfb, 0x18,
-		0x81, 0x8b, 0x30, 0xd9, 0x43, 0x68, 0x64, 0x65, 0xfc, 0xbe, 0x08, 0xcc, 0x0c, 0x62, 0xce, 0x10,
-		0x95, 0x18, 0x0d, 0xa9, 0xc4, 0x8b, 0xdd, 0x1c, 0xbc, 0x67, 0xf0, 0x22, 0xf4, 0xac, 0xf5, 0xa7,
-		0x23, 0x70, 0xb4, 0xbf, 0xb3, 0xd9, 0x77, 0x0c, 0x6f, 0x83, 0x44, 0x0b, 0xbb, 0xbb, 0x96, 0x70,
-		0xab, 0xee, 0xef, 0x63, 0xac, 0x49, 0x73, 0xf7, 0x62, 0x73, 0xac, 0xa0, 0xb5, 0x8f, 0x0d, 0xf2,
-		0x18, 0xd9, 0x68, 0x7a, 0x46, 0xfa, 0xa3, 0x51, 0x38, 0xd2, 0x97, 0x78, 0xdf, 0x81, 0xde, 0x0d,
-		0x60, 0x98, 0xed, 0x8e, 0xcb, 0x5c, 0x27, 0xa6, 0x89, 0xd3, 0xb4, 0x86, 0x2a, 0x2f, 0xa2, 0x65,
-		0x3b, 0xae, 0xd7, 0x1e, 0xa3, 0xed, 0xc0, 0xaa, 0x28, 0xc0, 0x13, 0xfe, 0x40, 0xe3, 0x74, 0xa0,
-		0xb3, 0x03, 0x66, 0xda, 0x23, 0x98, 0x0f, 0x83, 0xa4, 0x37, 0x0d, 0x6c, 0xba, 0xaa, 0xe3, 0xda,
-		0x58, 0x6b, 0x19, 0xe6, 0x0e, 0x35, 0x35, 0xa9, 0xc5, 0xf1, 0x6d, 0xad, 0xe9, 0x60, 0x25, 0xcf,
-		0x9a, 0x6b, 0xa2, 0x95, 0x60, 0x50, 0x01, 0xb2, 0x03, 0x18, 0x89, 0x10, 0x06, 0x6b, 0xf6, 0x30,
-		0xe4, 0x1f, 0x4f, 0x43, 0x26, 0xe0, 0x9a, 0xa3, 0x7b, 0x20, 0xfb, 0x82, 0x76, 0x43, 0x53, 0x45,
-		0xb8, 0xc5, 0x38, 0x91, 0x21, 0x75, 0x1b, 0x3c, 0xe4, 0x7a, 0x18, 0xa6, 0x29, 0x88, 0xd5, 0x71,
-		0xb1, 0xad, 0xea, 0x4d, 0xcd, 0x71, 0x28, 0xd3, 0x52, 0x14, 0x14, 0x91, 0xb6, 0x75, 0xd2, 0x54,
-		0x16, 0x2d, 0xe8, 0x3c, 0x4c, 0x51, 0x8c, 0x56, 0xa7, 0xe9, 0x1a, 0xed, 0x26, 0x56, 0x49, 0x00,
-		0xe8, 0x50, 0x93, 0xe3, 0x8d, 0x6c, 0x92, 0x40, 0xac, 0x72, 0x00, 0x32, 0x22, 0x07, 0x2d, 0xc1,
-		0xdd, 0x14, 0x6d, 0x07, 0x9b, 0xd8, 0xd6, 0x5c, 0xac, 0xe2, 0xf7, 0x76, 0xb4, 0xa6, 0xa3, 0x6a,
-		0x66, 0x43, 0xdd, 0xd5, 0x9c, 0xdd, 0x99, 0x69, 0x42, 0xa0, 0x14, 0x9d, 0x89, 0x28, 0xc7, 0x09,
-		0xe0, 0x32, 0x87, 0xab, 0x50, 0xb0, 0xa2, 0xd9, 0xb8, 0xac, 0x39, 0xbb, 0x68, 0x11, 0x8e, 0x52,
-		0x2a, 0x8e, 0x6b, 0x1b, 0xe6, 0x8e, 0xaa, 0xef, 0x62, 0xfd, 0xba, 0xda, 0x71, 0xb7, 0x9f, 0x98,
-		0x39, 0x11, 0xec, 0x9f, 0x8e, 0xb0, 0x46, 0x61, 0xca, 0x04, 0x64, 0xd3, 0xdd, 0x7e, 0x02, 0xd5,
-		0x20, 0x4b, 0x16, 0xa3, 0x65, 0xbc, 0x88, 0xd5, 0x6d, 0xcb, 0xa6, 0x36, 0x34, 0xd7, 0x47, 0x35,
-		0x05, 0x38, 0xb8, 0xb0, 0xce, 0x11, 0x56, 0xad, 0x06, 0x5e, 0x1c, 0xaf, 0x6d, 0x54, 0x2a, 0x4b,
-		0x4a, 0x46, 0x50, 0xb9, 0x64, 0xd9, 0x44, 0xa0, 0x76, 0x2c, 0x8f, 0xc1, 0x19, 0x26, 0x50, 0x3b,
-		0x96, 0x60, 0xef, 0x79, 0x98, 0xd2, 0x75, 0x36, 0x67, 0x43, 0x57, 0x79, 0x98, 0xe6, 0xcc, 0x48,
-		0x21, 0x66, 0xe9, 0xfa, 0x32, 0x03, 0xe0, 0x32, 0xee, 0xa0, 0x27, 0xe1, 0x88, 0xcf, 0xac, 0x20,
-		0xe2, 0x64, 0xcf, 0x2c, 0xbb, 0x51, 0xcf, 0xc3, 0x54, 0x7b, 0xbf, 0x17, 0x11, 0x85, 0x7a, 0x6c,
-		0xef, 0x77, 0xa3, 0x3d, 0x0e, 0xd3, 0xed, 0xdd, 0x76, 0x2f, 0xde, 0x99, 0x20, 0x1e, 0x6a, 0xef,
-		0xb6, 0xbb, 0x11, 0xef, 0xa3, 0x31, 0xbb, 0x8d, 0x75, 0xcd, 0xc5, 0x8d, 0x99, 0x63, 0x41, 0xf0,
-		0x40, 0x03, 0x5a, 0x00, 0x49, 0xd7, 0x55, 0x6c, 0x6a, 0x5b, 0x4d, 0xac, 0x6a, 0x36, 0x36, 0x35,
-		0x67, 0x66, 0x8e, 0x02, 0xc7, 0x5d, 0xbb, 0x83, 0x95, 0x9c, 0xae, 0x57, 0x68, 0x63, 0x91, 0xb6,
-		0xa1, 0x33, 0x30, 0x69, 0x6d, 0xbd, 0xa0, 0x33, 0x89, 0x54, 0xdb, 0x36, 0xde, 0x36, 0xf6, 0x66,
-		0xde, 0x42, 0xd9, 0x9b, 0x27, 0x0d, 0x54, 0x1e, 0x37, 0x68, 0x35, 0x3a, 0x0d, 0x92, 0xee, 0xec,
-		0x6a, 0x76, 0x9b, 0xaa, 0x64, 0xa7, 0xad, 0xe9, 0x78, 0xe6, 0x3e, 0x06, 0xca, 0xea, 0xd7, 0x44,
-		0x35, 0xd9, 0x11, 0xce, 0x4d, 0x63, 0xdb, 0x15, 0x14, 0x4f, 0xb2, 0x1d, 0x41, 0xeb, 0x38, 0xb5,
-		0x53, 0x20, 0x11, 0x4e, 0x84, 0x3a, 0x3e, 0x45, 0xc1, 0x72, 0xed, 0xdd, 0x76, 0xb0, 0xdf, 0x7b,
-		0x61, 0x82, 0x40, 0xfa, 0x9d, 0x9e, 0x66, 0x8e, 0x5b, 0x7b, 0x37, 0xd0, 0xe3, 0x63, 0x70, 0x94,
-		0x00, 0xb5, 0xb0, 0xab, 0x35, 0x34, 0x57, 0x0b, 0x40, 0x3f, 0x48, 0xa1, 0x09, 0xdb, 0x57, 0x79,
-		0x63, 0x68, 0x9c, 0x76, 0x67, 0x6b, 0xdf, 0x13, 0xac, 0x87, 0xd8, 0x38, 0x49, 0x9d, 0x10, 0xad,
-		0x37, 0xcc, 0x39, 0x97, 0x17, 0x21, 0x1b, 0x94, 0x7b, 0x94, 0x06, 0x26, 0xf9, 0x52, 0x84, 0x38,
-		0x41, 0xe5, 0xf5, 0x25, 0xe2, 0xbe, 0x3c, 0x5f, 0x91, 0xa2, 0xc4, 0x8d, 0x5a, 0xa9, 0xd6, 0x2b,
-		0xaa, 0xb2, 0xb9, 0x56, 0xaf, 0xae, 0x56, 0xa4, 0x58, 0xc0, 0xb1, 0xbf, 0x12, 0x4f, 0xdd, 0x2f,
-		0x9d, 0x94, 0x7f, 0x2d, 0x06, 0xb9, 0x70, 0xa4, 0x86, 0xde, 0x0a, 0xc7, 0x44, 0xc2, 0xc5, 0xc1,
-		0xae, 0x7a, 0xd3, 0xb0, 0xe9, 0x86, 0x6c, 0x69, 0xcc, 0x38, 0x7a, 0xf2, 0x33, 0xcd, 0xa1, 0x6a,
-		0xd8, 0x7d, 0xc6, 0xb0, 0xc9, 0x76, 0x6b, 0x69, 0x2e, 0x5a, 0x81, 0x39, 0xd3, 0x52, 0x1d, 0x57,
-		0x33, 0x1b, 0x9a, 0xdd, 0x50, 0xfd, 0x54, 0x97, 0xaa, 0xe9, 0x3a, 0x76, 0x1c, 0x8b, 0x19, 0x42,
-		0x8f, 0xca, 0x5d, 0xa6, 0x55, 0xe3, 0xc0, 0xbe, 0x85, 0x28, 0x72, 0xd0, 0x2e, 0xf1, 0x8d, 0x0d,
-		0x12, 0xdf, 0x13, 0x90, 0x6e, 0x69, 0x6d, 0x15, 0x9b, 0xae, 0xbd, 0x4f, 0xfd, 0xf3, 0x94, 0x92,
-		0x6a, 0x69, 0xed, 0x0a, 0x29, 0xa3, 0x6b, 0x70, 0xbf, 0x0f, 0xaa, 0x36, 0xf1, 0x8e, 0xa6, 0xef,
-		0xab, 0xd4, 0x19, 0xa7, 0x69, 0x03, 0x55, 0xb7, 0xcc, 0xed, 0xa6, 0xa1, 0xbb, 0x0e, 0xd5, 0x0f,
-		0x4c, 0xc7, 0xc9, 0x3e, 0xc6, 0x0a, 0x45, 0xb8, 0xe2, 0x58, 0x26, 0xf5, 0xc1, 0xcb, 0x02, 0xfa,
-		0x4d, 0x09, 0xbf, 0xae, 0xc4, 0x53, 0x71, 0x69, 0xfc, 0x4a, 0x3c, 0x35, 0x2e, 0x25, 0xae, 0xc4,
-		0x53, 0x09, 0x29, 0x79, 0x25, 0x9e, 0x4a, 0x49, 0xe9, 0x2b, 0xf1, 0x54, 0x5a, 0x02, 0xf9, 0x97,
-		0x53, 0x90, 0x0d, 0x46, 0x06, 0x24, 0xd0, 0xd2, 0xa9, 0x6d, 0x8c, 0x50, 0xed, 0x79, 0xef, 0x81,
-		0x71, 0xc4, 0x42, 0x99, 0x18, 0xcd, 0xc5, 0x04, 0x73, 0xc3, 0x15, 0x86, 0x49, 0x1c, 0x16, 0x22,
-		0xd6, 0x98, 0xb9, 0x3d, 0x29, 0x85, 0x97, 0xd0, 0x32, 0x24, 0x5e, 0x70, 0x28, 0xed, 0x04, 0xa5,
-		0xfd, 0x96, 0x83, 0x69, 0x5f, 0xa9, 0x51, 0xe2, 0xe9, 0x2b, 0x35, 0x75, 0x6d, 0x5d, 0x59, 0x2d,
-		0xae, 0x28, 0x1c, 0x1d, 0x1d, 0x87, 0x78, 0x53, 0x7b, 0x71, 0x3f, 0x6c, 0x5e, 0x69, 0x15, 0x5a,
-		0x80, 0x7c, 0xc7, 0xbc, 0x81, 0x6d, 0x63, 0xdb, 0x20, 0x4b, 0x45, 0xa0, 0xf2, 0x41, 0xa8, 0x9c,
-		0xdf, 0xba, 0x42, 0xe0, 0x47, 0x14, 0x8f, 0xe3, 0x10, 0xbf, 0x89, 0xb5, 0xeb, 0x61, 0x23, 0x48,
-		0xab, 0xd0, 0x29, 0xc8, 0x36, 0xf0, 0x56, 0x67, 0x47, 0xb5, 0x71, 0x43, 0xd3, 0xdd, 0xb0, 0xea,
-		0xcf, 0xd0, 0x26, 0x85, 0xb6, 0xa0, 0xab, 0x90, 0x26, 0x6b, 0x64, 0xd2, 0x35, 0x9e, 0xa4, 0x2c,
-		0x78, 0xe8, 0x60, 0x16, 0xf0, 0x25, 0x16, 0x48, 0x8a, 0x8f, 0x8f, 0x2e, 0x41, 0xc2, 0xd5, 0xec,
-		0x1d, 0xec, 0x52, 0xcd, 0x9f, 0xeb, 0x93, 0xfc, 0xe8, 0x43, 0xa9, 0x4e, 0x31, 0x68, 0x4c, 0xcb,
-		0xb1, 0xdf, 0x40, 0x2d, 0x73, 0x16, 0xc6, 0xa9, 0x78, 0x20, 0x00, 0x2e, 0x20, 0xd2, 0x18, 0x4a,
-		0x41, 0xbc, 0xbc, 0xae, 0x10, 0x4d, 0x23, 0x41, 0x96, 0xd5, 0xaa, 0x1b, 0xd5, 0x4a, 0xb9, 0x22,
-		0x45, 0xe5, 0xf3, 0x90, 0x60, 0x6b, 0x4e, 0xb4, 0x90, 0xb7, 0xea, 0xd2, 0x18, 0x2f, 0x72, 0x1a,
-		0x11, 0xd1, 0xba, 0xb9, 0x5a, 0xaa, 0x28, 0x52, 0x54, 0xde, 0x84, 0x7c, 0x17, 0x9f, 0xd0, 0x11,
-		0x98, 0x54, 0x2a, 0xf5, 0xca, 0x1a, 0x89, 0xb3, 0xd4, 0xcd, 0xb5, 0xab, 0x6b, 0xeb, 0xcf, 0xac,
-		0x49, 0x63, 0xe1, 0x6a, 0xa1, 0xd2, 0x22, 0x68, 0x1a, 0x24, 0xbf, 0xba, 0xb6, 0xbe, 0xa9, 0xd0,
-		0xd1, 0xfc, 0x58, 0x14, 0xa4, 0x6e, 0xae, 0xa1, 0x63, 0x30, 0x55, 0x2f, 0x2a, 0xcb, 0x95, 0xba,
-		0xca, 0x62, 0x47, 0x8f, 0xf4, 0x34, 0x48, 0xc1, 0x86, 0x4b, 0x55, 0x1a, 0x1a, 0xcf, 0xc1, 0x89,
-		0x60, 0x6d, 0xe5, 0xd9, 0x7a, 0x65, 0xad, 0x46, 0x3b, 0x2f, 0xae, 0x2d, 0x13, 0xfd, 0xda, 0x45,
-		0x4f, 0x44, 0xab, 0x31, 0x32, 0xd4, 0x30, 0xbd, 0xca, 0xca, 0x92, 0x14, 0xef, 0xae, 0x5e, 0x5f,
-		0xab, 0xac, 0x5f, 0x92, 0xc6, 0xbb, 0x7b, 0xa7, 0x11, 0x6c, 0x02, 0x15, 0xe0, 0x68, 0x77, 0xad,
-		0x5a, 0x59, 0xab, 0x2b, 0xcf, 0x49, 0xc9, 0xee, 0x8e, 0x6b, 0x15, 0xe5, 0x5a, 0xb5, 0x5c, 0x91,
-		0x52, 0xe8, 0x28, 0xa0, 0xf0, 0x88, 0xea, 0x97, 0xd7, 0x97, 0xa4, 0x74, 0x8f, 0x46, 0x91, 0x1d,
-		0xc8, 0x06, 0xc3, 0xc8, 0x37, 0x27, 0x97, 0xf4, 0x72, 0x14, 0x32, 0x81, 0xb0, 0x90, 0xf8, 0xf3,
-		0x5a, 0xb3, 0x69, 0xdd, 0x54, 0xb5, 0xa6, 0xa1, 0x39, 0x5c, 0xdf, 0x00, 0xad, 0x2a, 0x92, 0x9a,
-		0x51, 0xf7, 0xf7, 0xe8, 0x1a, 0x3e, 0xf1, 0xbd, 0xa8, 0xe1, 0xc7, 0xa5, 0x84, 0xfc, 0xb1, 0x08,
-		0x48, 0xdd, 0xf1, 0x5e, 0xd7, 0xf4, 0x23, 0x83, 0xa6, 0xff, 0xa6, 0xac, 0xdd, 0x47, 0x23, 0x90,
-		0x0b, 0x07, 0x79, 0x5d, 0xc3, 0xbb, 0xe7, 0xff, 0xeb, 0xf0, 0xfe, 0x28, 0x0a, 0x13, 0xa1, 0xd0,
-		0x6e, 0xd4, 0xd1, 0xbd, 0x17, 0x26, 0x8d, 0x06, 0x6e, 0xb5, 0x2d, 0x17, 0x9b, 0xfa, 0xbe, 0xda,
-		0xc4, 0x37, 0x70, 0x73, 0x46, 0xa6, 0x4a, 0xf9, 0xec, 0xc1, 0xc1, 0xe3, 0x42, 0xd5, 0xc7, 0x5b,
-		0x21, 0x68, 0x8b, 0x53, 0xd5, 0xa5, 0xca, 0xea, 0xc6, 0x7a, 0xbd, 0xb2, 0x56, 0x7e, 0x4e, 0x68,
-		0x17, 0x45, 0x32, 0xba, 0xc0, 0xde, 0x40, 0xa5, 0xbd, 0x01, 0x52, 0xf7, 0xa0, 0x88, 0xae, 0xe8,
-		0x33, 0x2c, 0x69, 0x0c, 0x4d, 0x41, 0x7e, 0x6d, 0x5d, 0xad, 0x55, 0x97, 0x2a, 0x6a, 0xe5, 0xd2,
-		0xa5, 0x4a, 0xb9, 0x5e, 0x63, 0xe9, 0x40, 0x0f, 0xba, 0x2e, 0x45, 0x83, 0x2c, 0xfe, 0x48, 0x0c,
-		0xa6, 0xfa, 0x8c, 0x04, 0x15, 0x79, 0x20, 0xcf, 0x72, 0x0b, 0x0f, 0x8d, 0x32, 0xfa, 0x05, 0xe2,
-		0x4a, 0x6f, 0x68, 0xb6, 0xcb, 0xe3, 0xfe, 0xd3, 0x40, 0xb8, 0x64, 0xba, 0xc4, 0xb2, 0xdb, 0x3c,
-		0xcd, 0xca, 0xa2, 0xfb, 0xbc, 0x5f, 0xcf, 0x32, 0xad, 0x0f, 0x02, 0x6a, 0x5b, 0x8e, 0xe1, 0x1a,
-		0x37, 0xb0, 0x6a, 0x98, 0x22, 0x27, 0x4b, 0xa2, 0xfd, 0xb8, 0x22, 0x89, 0x96, 0xaa, 0xe9, 0x7a,
-		0xd0, 0x26, 0xde, 0xd1, 0xba, 0xa0, 0x89, 0xe7, 0x11, 0x53, 0x24, 0xd1, 0xe2, 0x41, 0xdf, 0x03,
-		0xd9, 0x86, 0xd5, 0x21, 0x21, 0x10, 0x83, 0x23, 0xda, 0x22, 0xa2, 0x64, 0x58, 0x9d, 0x07, 0xc2,
-		0x83, 0x5b, 0x3f, 0x19, 0x9c, 0x55, 0x32, 0xac, 0x8e, 0x81, 0x9c, 0x84, 0xbc, 0xb6, 0xb3, 0x63,
-		0x13, 0xe2, 0x82, 0x10, 0x0b, 0xd7, 0x73, 0x5e, 0x35, 0x05, 0x2c, 0x5c, 0x81, 0x94, 0xe0, 0x03,
-		0xf1, 0x60, 0x09, 0x27, 0xd4, 0x36, 0xcb, 0x41, 0x45, 0x4f, 0xa5, 0x95, 0x94, 0x29, 0x1a, 0xef,
-		0x81, 0xac, 0xe1, 0xa8, 0xfe, 0xd9, 0x56, 0x74, 0x3e, 0x7a, 0x2a, 0xa5, 0x64, 0x0c, 0xc7, 0x3b,
-		0x17, 0x90, 0x3f, 0x1d, 0x85, 0x5c, 0xf8, 0xd4, 0x0e, 0x2d, 0x41, 0xaa, 0x69, 0xe9, 0x1a, 0x15,
-		0x2d, 0x76, 0x64, 0x7c, 0x6a, 0xc8, 0x41, 0xdf, 0xc2, 0x0a, 0x87, 0x57, 0x3c, 0xcc, 0xc2, 0xbf,
-		0x8f, 0x40, 0x4a, 0x54, 0xa3, 0xa3, 0x10, 0x6f, 0x6b, 0xee, 0x2e, 0x25, 0x37, 0x5e, 0x8a, 0x4a,
-		0x11, 0x85, 0x96, 0x49, 0xbd, 0xd3, 0xd6, 0x4c, 0x2a, 0x02, 0xbc, 0x9e, 0x94, 0xc9, 0xba, 0x36,
-		0xb1, 0xd6, 0xa0, 0xb9, 0x00, 0xab, 0xd5, 0xc2, 0xa6, 0xeb, 0x88, 0x75, 0xe5, 0xf5, 0x65, 0x5e,
-		0x8d, 0x1e, 0x80, 0x49, 0xd7, 0xd6, 0x8c, 0x66, 0x08, 0x36, 0x4e, 0x61, 0x25, 0xd1, 0xe0, 0x01,
-		0x2f, 0xc2, 0x71, 0x41, 0xb7, 0x81, 0x5d, 0x4d, 0xdf, 0xc5, 0x0d, 0x1f, 0x29, 0x41, 0x73, 0x7e,
-		0xc7, 0x38, 0xc0, 0x12, 0x6f, 0x17, 0xb8, 0xf2, 0x57, 0xa2, 0x30, 0x29, 0xb2, 0x17, 0x0d, 0x8f,
-		0x59, 0xab, 0x00, 0x9a, 0x69, 0x5a, 0x6e, 0x90, 0x5d, 0xbd, 0xa2, 0xdc, 0x83, 0xb7, 0x50, 0xf4,
-		0x90, 0x94, 0x00, 0x81, 0xc2, 0x37, 0x23, 0x00, 0x7e, 0xd3, 0x40, 0xbe, 0xcd, 0x41, 0x86, 0x9f,
-		0xc9, 0xd2, 0x83, 0x7d, 0x96, 0xf0, 0x02, 0x56, 0x75, 0xc9, 0x68, 0xd2, 0xb4, 0xe4, 0x16, 0xde,
-		0x31, 0x4c, 0x7e, 0x9e, 0xc2, 0x0a, 0x22, 0x2d, 0x19, 0xf7, 0x8f, 0xa7, 0x14, 0x48, 0x39, 0xb8,
-		0xa5, 0x99, 0xae, 0xa1, 0xf3, 0x13, 0x92, 0x0b, 0x87, 0x1a, 0xfc, 0x42, 0x8d, 0x63, 0x2b, 0x1e,
-		0x1d, 0xf9, 0x14, 0xa4, 0x44, 0x2d, 0x71, 0xfc, 0xd6, 0xd6, 0xd7, 0x2a, 0xd2, 0x18, 0x4a, 0x42,
-		0xac, 0x56, 0xa9, 0x4b, 0x11, 0x12, 0x76, 0x16, 0x57, 0xaa, 0xc5, 0x9a, 0x14, 0x2d, 0xfd, 0x6d,
-		0x98, 0xd2, 0xad, 0x56, 0x77, 0x87, 0x25, 0xa9, 0x2b, 0xe5, 0xe7, 0x5c, 0x8e, 0x3c, 0xff, 0x10,
-		0x07, 0xda, 0xb1, 0x9a, 0x9a, 0xb9, 0xb3, 0x60, 0xd9, 0x3b, 0xfe, 0xb5, 0x08, 0x12, 0x1d, 0x38,
-		0x81, 0xcb, 0x11, 0xed, 0xad, 0xff, 0x1d, 0x89, 0xfc, 0x74, 0x34, 0xb6, 0xbc, 0x51, 0xfa, 0xf9,
-		0x68, 0x61, 0x99, 0x21, 0x6e, 0x88, 0xe9, 0x28, 0x78, 0xbb, 0x89, 0x75, 0x32, 0x78, 0xf8, 0xd3,
-		0x07, 0x60, 0x7a, 0xc7, 0xda, 0xb1, 0x28, 0xa5, 0xb3, 0xe4, 0x17, 0xbf, 0x57, 0x91, 0xf6, 0x6a,
-		0x0b, 0x43, 0x2f, 0x61, 0x2c, 0xae, 0xc1, 0x14, 0x07, 0x56, 0xe9, 0xf1, 0x2d, 0x4b, 0x2e, 0xa0,
-		0x03, 0x33, 0xdb, 0x33, 0xbf, 0xf8, 0x27, 0xd4, 0x2b, 0x51, 0x26, 0x39, 0x2a, 0x69, 0x63, 0xf9,
-		0x87, 0x45, 0x05, 0x8e, 0x84, 0xe8, 0x31, 0x1d, 0x81, 0xed, 0x21, 0x14, 0x7f, 0x8b, 0x53, 0x9c,
-		0x0a, 0x50, 0xac, 0x71, 0xd4, 0xc5, 0x32, 0x4c, 0x1c, 0x86, 0xd6, 0xbf, 0xe1, 0xb4, 0xb2, 0x38,
-		0x48, 0x64, 0x19, 0xf2, 0x94, 0x88, 0xde, 0x71, 0x5c, 0xab, 0x45, 0x15, 0xf0, 0xc1, 0x64, 0xfe,
-		0xed, 0x9f, 0xb0, 0x4d, 0x9b, 0x23, 0x68, 0x65, 0x0f, 0x6b, 0x71, 0x11, 0xe8, 0x89, 0x75, 0x03,
-		0xeb, 0xcd, 0x21, 0x14, 0xbe, 0xcc, 0x07, 0xe2, 0xc1, 0x2f, 0x5e, 0x83, 0x69, 0xf2, 0x9b, 0xea,
-		0xc7, 0xe0, 0x48, 0x86, 0xa7, 0xc1, 0x67, 0x7e, 0xf7, 0x7d, 0x4c, 0x2f, 0x4c, 0x79, 0x04, 0x02,
-		0x63, 0x0a, 0xac, 0xe2, 0x0e, 0x76, 0x5d, 0x6c, 0x3b, 0xaa, 0xd6, 0xec, 0x37, 0xbc, 0x40, 0x1e,
-		0x71, 0xe6, 0x27, 0xbf, 0x15, 0x5e, 0xc5, 0x65, 0x86, 0x59, 0x6c, 0x36, 0x17, 0x37, 0xe1, 0x58,
-		0x1f, 0xa9, 0x18, 0x81, 0xe6, 0x47, 0x38, 0xcd, 0xe9, 0x1e, 0xc9, 0x20, 0x64, 0x37, 0x40, 0xd4,
-		0x7b, 0x6b, 0x39, 0x02, 0xcd, 0x9f, 0xe2, 0x34, 0x11, 0xc7, 0x15, 0x4b, 0x4a, 0x28, 0x5e, 0x81,
-		0xc9, 0x1b, 0xd8, 0xde, 0xb2, 0x1c, 0x9e, 0xbb, 0x1d, 0x81, 0xdc, 0x47, 0x39, 0xb9, 0x3c, 0x47,
-		0xa4, 0xc9, 0x5c, 0x42, 0xeb, 0x49, 0x48, 0x6d, 0x6b, 0x3a, 0x1e, 0x81, 0xc4, 0x6d, 0x4e, 0x22,
-		0x49, 0xe0, 0x09, 0x6a, 0x11, 0xb2, 0x3b, 0x16, 0x37, 0x91, 0xc3, 0xd1, 0x3f, 0xc6, 0xd1, 0x33,
-		0x02, 0x87, 0x93, 0x68, 0x5b, 0xed, 0x4e, 0x93, 0xd8, 0xcf, 0xe1, 0x24, 0xfe, 0xb1, 0x20, 0x21,
-		0x70, 0x38, 0x89, 0x43, 0xb0, 0xf5, 0xe3, 0x82, 0x84, 0x13, 0xe0, 0xe7, 0xd3, 0x90, 0xb1, 0xcc,
-		0xe6, 0xbe, 0x65, 0x8e, 0x32, 0x88, 0x4f, 0x70, 0x0a, 0xc0, 0x51, 0x08, 0x81, 0x8b, 0x90, 0x1e,
-		0x75, 0x21, 0x7e, 0xf6, 0x5b, 0x62, 0x7b, 0x88, 0x15, 0x58, 0x86, 0xbc, 0x50, 0x50, 0x86, 0x65,
-		0x8e, 0x40, 0xe2, 0x53, 0x9c, 0x44, 0x2e, 0x80, 0xc6, 0xa7, 0xe1, 0x62, 0xc7, 0xdd, 0xc1, 0xa3,
-		0x10, 0xf9, 0xb4, 0x98, 0x06, 0x47, 0xe1, 0xac, 0xdc, 0xc2, 0xa6, 0xbe, 0x3b, 0x1a, 0x85, 0xcf,
-		0x08, 0x56, 0x0a, 0x1c, 0x42, 0xa2, 0x0c, 0x13, 0x2d, 0xcd, 0x76, 0x76, 0xb5, 0xe6, 0x48, 0xcb,
-		0xf1, 0x73, 0x9c, 0x46, 0xd6, 0x43, 0xe2, 0x1c, 0xe9, 0x98, 0x87, 0x21, 0xf3, 0xf3, 0x82, 0x23,
-		0x01, 0x34, 0xbe, 0xf5, 0x1c, 0x97, 0x26, 0xba, 0x0f, 0x43, 0xed, 0x9f, 0x88, 0xad, 0xc7, 0x70,
-		0x57, 0x83, 0x14, 0x2f, 0x42, 0xda, 0x31, 0x5e, 0x1c, 0x89, 0xcc, 0x67, 0xc5, 0x4a, 0x53, 0x04,
-		0x82, 0xfc, 0x1c, 0x1c, 0xef, 0x6b, 0x26, 0x46, 0x20, 0xf6, 0x0b, 0x9c, 0xd8, 0xd1, 0x3e, 0xa6,
-		0x82, 0xab, 0x84, 0xc3, 0x92, 0xfc, 0xa7, 0x42, 0x25, 0xe0, 0x2e, 0x5a, 0x1b, 0x24, 0x68, 0x71,
-		0xb4, 0xed, 0xc3, 0x71, 0xed, 0x9f, 0x09, 0xae, 0x31, 0xdc, 0x10, 0xd7, 0xea, 0x70, 0x94, 0x53,
-		0x3c, 0xdc, 0xba, 0x7e, 0x4e, 0x28, 0x56, 0x86, 0xbd, 0x19, 0x5e, 0xdd, 0x77, 0x42, 0xc1, 0x63,
-		0xa7, 0xf0, 0x8e, 0x1d, 0xb5, 0xa5, 0xb5, 0x47, 0xa0, 0xfc, 0x8b, 0x9c, 0xb2, 0xd0, 0xf8, 0x9e,
-		0x7b, 0xed, 0xac, 0x6a, 0x6d, 0x42, 0xfc, 0x59, 0x98, 0x11, 0xc4, 0x3b, 0xa6, 0x8d, 0x75, 0x6b,
-		0xc7, 0x34, 0x5e, 0xc4, 0x8d, 0x11, 0x48, 0xff, 0x52, 0xd7, 0x52, 0x6d, 0x06, 0xd0, 0x09, 0xe5,
-		0x2a, 0x48, 0x9e, 0xaf, 0xa2, 0x1a, 0xad, 0xb6, 0x65, 0xbb, 0x43, 0x28, 0x7e, 0x5e, 0xac, 0x94,
-		0x87, 0x57, 0xa5, 0x68, 0x8b, 0x15, 0x60, 0xb7, 0x3f, 0x46, 0x15, 0xc9, 0x2f, 0x70, 0x42, 0x13,
-		0x3e, 0x16, 0x57, 0x1c, 0xba, 0xd5, 0x6a, 0x6b, 0xf6, 0x28, 0xfa, 0xef, 0x9f, 0x0b, 0xc5, 0xc1,
-		0x51, 0xb8, 0xe2, 0x20, 0x1e, 0x1d, 0xb1, 0xf6, 0x23, 0x50, 0xf8, 0xa2, 0x50, 0x1c, 0x02, 0x87,
-		0x93, 0x10, 0x0e, 0xc3, 0x08, 0x24, 0x7e, 0x59, 0x90, 0x10, 0x38, 0x84, 0xc4, 0x3b, 0x7c, 0x43,
-		0x6b, 0xe3, 0x1d, 0xc3, 0x71, 0x6d, 0xe6, 0x92, 0x1f, 0x4c, 0xea, 0x4b, 0xdf, 0x0a, 0x3b, 0x61,
-		0x4a, 0x00, 0x95, 0x68, 0x22, 0x7e, 0xf4, 0x41, 0x43, 0xb6, 0xe1, 0x03, 0xfb, 0x15, 0xa1, 0x89,
-		0x02, 0x68, 0x64, 0x6c, 0x01, 0x0f, 0x91, 0xb0, 0x5d, 0x27, 0x81, 0xca, 0x08, 0xe4, 0x7e, 0xb5,
-		0x6b, 0x70, 0x35, 0x81, 0x4b, 0x68, 0x06, 0xfc, 0x9f, 0x8e, 0x79, 0x1d, 0xef, 0x8f, 0x24, 0x9d,
-		0xbf, 0xd6, 0xe5, 0xff, 0x6c, 0x32, 0x4c, 0xa6, 0x43, 0xf2, 0x5d, 0xfe, 0x14, 0x1a, 0x76, 0xd7,
-		0x6f, 0xe6, 0x07, 0x5f, 0xe3, 0xf3, 0x0d, 0xbb, 0x53, 0x8b, 0x2b, 0x44, 0xc8, 0xc3, 0x4e, 0xcf,
-		0x70, 0x62, 0xef, 0x7b, 0xcd, 0x93, 0xf3, 0x90, 0xcf, 0xb3, 0x78, 0x09, 0x26, 0x42, 0x0e, 0xcf,
-		0x70, 0x52, 0x7f, 0x87, 0x93, 0xca, 0x06, 0xfd, 0x9d, 0xc5, 0xf3, 0x10, 0x27, 0xce, 0xcb, 0x70,
-		0xf4, 0x1f, 0xe6, 0xe8, 0x14, 0x7c, 0xf1, 0x29, 0x48, 0x09, 0xa7, 0x65, 0x38, 0xea, 0x8f, 0x70,
-		0x54, 0x0f, 0x85, 0xa0, 0x0b, 0x87, 0x65, 0x38, 0xfa, 0xdf, 0x15, 0xe8, 0x02, 0x85, 0xa0, 0x8f,
-		0xce, 0xc2, 0xdf, 0xf8, 0x7b, 0x71, 0x6e, 0x74, 0x04, 0xef, 0x2e, 0x42, 0x92, 0x7b, 0x2a, 0xc3,
-		0xb1, 0x7f, 0x94, 0x77, 0x2e, 0x30, 0x16, 0x1f, 0x87, 0xf1, 0x11, 0x19, 0xfe, 0xf7, 0x39, 0x2a,
-		0x83, 0x5f, 0x2c, 0x43, 0x26, 0xe0, 0x9d, 0x0c, 0x47, 0xff, 0x00, 0x47, 0x0f, 0x62, 0x91, 0xa1,
-		0x73, 0xef, 0x64, 0x38, 0x81, 0x7f, 0x20, 0x86, 0xce, 0x31, 0x08, 0xdb, 0x84, 0x63, 0x32, 0x1c,
-		0xfb, 0x83, 0x82, 0xeb, 0x02, 0x65, 0xf1, 0x69, 0x48, 0x7b, 0xc6, 0x66, 0x38, 0xfe, 0x8f, 0x73,
-		0x7c, 0x1f, 0x87, 0x70, 0x20, 0x60, 0xec, 0x86, 0x93, 0xf8, 0x87, 0x82, 0x03, 0x01, 0x2c, 0xb2,
-		0x8d, 0xba, 0x1d, 0x98, 0xe1, 0x94, 0x3e, 0x24, 0xb6, 0x51, 0x97, 0xff, 0x42, 0x56, 0x93, 0xea,
-		0xfc, 0xe1, 0x24, 0xfe, 0x91, 0x58, 0x4d, 0x0a, 0x4f, 0x86, 0xd1, 0xed, 0x11, 0x0c, 0xa7, 0xf1,
-		0x61, 0x31, 0x8c, 0x2e, 0x87, 0x60, 0x71, 0x03, 0x50, 0xaf, 0x37, 0x30, 0x9c, 0xde, 0xcb, 0x9c,
-		0xde, 0x64, 0x8f, 0x33, 0xb0, 0xf8, 0x0c, 0x1c, 0xed, 0xef, 0x09, 0x0c, 0xa7, 0xfa, 0x93, 0xaf,
-		0x75, 0xc5, 0x6e, 0x41, 0x47, 0x60, 0xb1, 0xee, 0x9b, 0x94, 0xa0, 0x17, 0x30, 0x9c, 0xec, 0x47,
-		0x5e, 0x0b, 0x2b, 0xee, 0xa0, 0x13, 0xb0, 0x58, 0x04, 0xf0, 0x0d, 0xf0, 0x70, 0x5a, 0x1f, 0xe5,
-		0xb4, 0x02, 0x48, 0x64, 0x6b, 0x70, 0xfb, 0x3b, 0x1c, 0xff, 0xb6, 0xd8, 0x1a, 0x1c, 0x83, 0x6c,
-		0x0d, 0x61, 0x7a, 0x87, 0x63, 0x7f, 0x4c, 0x6c, 0x0d, 0x81, 0x42, 0x24, 0x3b, 0x60, 0xdd, 0x86,
-		0x53, 0xf8, 0x84, 0x90, 0xec, 0x00, 0xd6, 0xe2, 0x1a, 0x4c, 0xf6, 0x18, 0xc4, 0xe1, 0xa4, 0x7e,
-		0x9a, 0x93, 0x92, 0xba, 0xed, 0x61, 0xd0, 0x78, 0x71, 0x63, 0x38, 0x9c, 0xda, 0x27, 0xbb, 0x8c,
-		0x17, 0xb7, 0x85, 0x8b, 0x17, 0x21, 0x65, 0x76, 0x9a, 0x4d, 0xb2, 0x79, 0xd0, 0xc1, 0xf7, 0x73,
-		0x67, 0xfe, 0xfb, 0x77, 0x38, 0x77, 0x04, 0xc2, 0xe2, 0x79, 0x18, 0xc7, 0xad, 0x2d, 0xdc, 0x18,
-		0x86, 0xf9, 0x8d, 0xef, 0x08, 0x85, 0x49, 0xa0, 0x17, 0x9f, 0x06, 0x60, 0xa9, 0x11, 0x7a, 0x70,
-		0x3e, 0x04, 0xf7, 0x9b, 0xdf, 0xe1, 0x17, 0xe2, 0x7c, 0x14, 0x9f, 0x00, 0xbb, 0x5e, 0x77, 0x30,
-		0x81, 0x6f, 0x85, 0x09, 0xd0, 0x15, 0x79, 0x12, 0x92, 0x2f, 0x38, 0x96, 0xe9, 0x6a, 0x3b, 0xc3,
-		0xb0, 0xff, 0x94, 0x63, 0x0b, 0x78, 0xc2, 0xb0, 0x96, 0x65, 0x63, 0x57, 0xdb, 0x71, 0x86, 0xe1,
-		0xfe, 0x0f, 0x8e, 0xeb, 0x21, 0x10, 0x64, 0x5d, 0x73, 0xdc, 0x51, 0xe6, 0xfd, 0x67, 0x02, 0x59,
-		0x20, 0x90, 0x41, 0x93, 0xdf, 0xd7, 0xf1, 0xfe, 0x30, 0xdc, 0x3f, 0x17, 0x83, 0xe6, 0xf0, 0x8b,
-		0x4f, 0x41, 0x9a, 0xfc, 0x64, 0xb7, 0x5c, 0x87, 0x20, 0xff, 0x05, 0x47, 0xf6, 0x31, 0x48, 0xcf,
-		0x8e, 0xdb, 0x70, 0x8d, 0xe1, 0xcc, 0xfe, 0x36, 0x5f, 0x69, 0x01, 0xbf, 0x58, 0x84, 0x8c, 0xe3,
-		0x36, 0x1a, 0x1d, 0xee, 0x9f, 0x0e, 0x41, 0xff, 0x9f, 0xdf, 0xf1, 0x52, 0x16, 0x1e, 0x0e, 0x59,
-		0xed, 0x9b, 0xd7, 0xdd, 0xb6, 0x45, 0xcf, 0x5b, 0x86, 0x51, 0x78, 0x8d, 0x53, 0x08, 0xa0, 0x2c,
-		0x96, 0x21, 0x4b, 0xe6, 0x62, 0xe3, 0x36, 0xa6, 0x87, 0x63, 0x43, 0x48, 0xfc, 0x25, 0x67, 0x40,
-		0x08, 0xa9, 0xf4, 0x9e, 0x2f, 0xbf, 0x3a, 0x1b, 0xf9, 0xca, 0xab, 0xb3, 0x91, 0x3f, 0x7a, 0x75,
-		0x36, 0xf2, 0xc1, 0xaf, 0xce, 0x8e, 0x7d, 0xe5, 0xab, 0xb3, 0x63, 0xbf, 0xff, 0xd5, 0xd9, 0xb1,
-		0xfe, 0x59, 0x62, 0x58, 0xb6, 0x96, 0x2d, 0x96, 0x1f, 0x7e, 0xfe, 0xbe, 0x1d, 0xc3, 0xdd, 0xed,
-		0x6c, 0x2d, 0xe8, 0x56, 0xeb, 0xac, 0x6e, 0x39, 0x2d, 0xcb, 0x39, 0x1b, 0xce, 0xeb, 0xd2, 0x5f,
-		0xf0, 0x57, 0x11, 0x12, 0x33, 0x87, 0xd3, 0xb9, 0x9a, 0xb9, 0x3f, 0xe8, 0x63, 0xba, 0x0b, 0x10,
-		0x2b, 0x9a, 0xfb, 0xe8, 0x38, 0x53, 0x70, 0x6a, 0xc7, 0x6e, 0xf2, 0xab, 0x96, 0x49, 0x52, 0xde,
-		0xb4, 0x9b, 0x68, 0xda, 0xbf, 0x0f, 0x1d, 0x39, 0x95, 0xe5, 0x97, 0x9c, 0x4b, 0x1f, 0x88, 0x1c,
-		0x6e, 0x26, 0xa9, 0xa2, 0xb9, 0x4f, 0x27, 0xb2, 0x11, 0x79, 0xfe, 0xc1, 0xa1, 0x79, 0xee, 0xeb,
-		0xa6, 0x75, 0xd3, 0x24, 0xc3, 0x6e, 0x6f, 0x89, 0x1c, 0xf7, 0x6c, 0x77, 0x8e, 0xfb, 0x19, 0xdc,
-		0x6c, 0x5e, 0x25, 0x70, 0x75, 0x82, 0xb2, 0x95, 0x60, 0xb7, 0xfa, 0xe1, 0x43, 0x51, 0x98, 0xed,
-		0x49, 0x67, 0x73, 0x21, 0x18, 0xc4, 0x84, 0x45, 0x48, 0x2d, 0x09, 0xd9, 0x9a, 0x81, 0xa4, 0x83,
-		0x75, 0xcb, 0x6c, 0x38, 0x94, 0x11, 0x31, 0x45, 0x14, 0x09, 0x23, 0x4c, 0xcd, 0xb4, 0x1c, 0x7e,
-		0x59, 0x99, 0x15, 0x4a, 0x3f, 0x75, 0x48, 0x46, 0x4c, 0x88, 0x9e, 0x04, 0x37, 0x1e, 0x19, 0x91,
-		0x1b, 0x62, 0x12, 0xa1, 0xcc, 0xff, 0xa8, 0x5c, 0xf9, 0x70, 0x14, 0xe6, 0xba, 0xb9, 0x42, 0x76,
-		0x96, 0xe3, 0x6a, 0xad, 0xf6, 0x20, 0xb6, 0x5c, 0x84, 0x74, 0x5d, 0xc0, 0x1c, 0x9a, 0x2f, 0xb7,
-		0x0f, 0xc9, 0x97, 0x9c, 0xd7, 0x95, 0x60, 0xcc, 0xb9, 0x11, 0x19, 0xe3, 0xcd, 0xe3, 0x8e, 0x38,
-		0xf3, 0x7f, 0x12, 0x70, 0x9c, 0x6d, 0x27, 0x95, 0x6d, 0x25, 0x56, 0xe0, 0x3c, 0xc9, 0x06, 0x9b,
-		0x86, 0x9f, 0x93, 0xc8, 0x57, 0x61, 0xaa, 0x4a, 0xb4, 0x05, 0x89, 0x82, 0xfc, 0x13, 0x9e, 0xbe,
-		0xf7, 0xb9, 0xe7, 0x43, 0x0e, 0x3f, 0x3f, 0xdf, 0x0a, 0x56, 0xc9, 0x3f, 0x18, 0x01, 0xa9, 0xa6,
-		0x6b, 0x4d, 0xcd, 0xfe, 0x6e, 0x49, 0xa1, 0xc7, 0x01, 0xd8, 0x75, 0x0f, 0xef, 0xc3, 0xbd, 0xdc,
-		0xb9, 0x99, 0x85, 0xe0, 0xe4, 0x16, 0x58, 0x4f, 0xf4, 0x06, 0x55, 0x9a, 0xc2, 0x92, 0x9f, 0x67,
-		0x9e, 0x05, 0xf0, 0x1b, 0xd0, 0x09, 0x38, 0x56, 0x2b, 0x17, 0x57, 0x8a, 0x8a, 0xb8, 0x24, 0x54,
-		0xdb, 0xa8, 0x94, 0xab, 0x97, 0xaa, 0x95, 0x25, 0x69, 0x0c, 0x1d, 0x05, 0x14, 0x6c, 0xf4, 0x2e,
-		0x35, 0x1d, 0x81, 0xc9, 0x60, 0x3d, 0xfb, 0x4a, 0x25, 0x4a, 0x3c, 0x45, 0xa3, 0xd5, 0x6e, 0x62,
-		0x7a, 0xf2, 0xa8, 0x1a, 0x82, 0x6b, 0xc3, 0x9d, 0x90, 0xdf, 0xfe, 0x4f, 0xec, 0xcb, 0x85, 0x29,
-		0x1f, 0xdd, 0xe3, 0xf9, 0xe2, 0x0a, 0x4c, 0x6a, 0xba, 0x8e, 0xdb, 0x21, 0x92, 0x43, 0x54, 0x35,
-		0x21, 0x48, 0xcf, 0x52, 0x39, 0xa6, 0x4f, 0xed, 0x71, 0x48, 0x38, 0x74, 0xf6, 0xc3, 0x48, 0xfc,
-		0x0e, 0x27, 0xc1, 0xc1, 0x17, 0x4d, 0x98, 0x24, 0x9e, 0x9f, 0x66, 0xe3, 0xc0, 0x30, 0x0e, 0xce,
-		0x33, 0xfc, 0x8b, 0xcf, 0x3f, 0x4c, 0x4f, 0x56, 0xef, 0x09, 0x2f, 0x4b, 0x1f, 0x71, 0x52, 0x24,
-		0x4e, 0xdb, 0x1f, 0x28, 0x86, 0x9c, 0xe8, 0x8f, 0x0f, 0xf8, 0xe0, 0xce, 0x7e, 0x9d, 0x77, 0x36,
-		0xdb, 0x4f, 0x06, 0x02, 0x3d, 0x4d, 0x70, 0xaa, 0xac, 0xa1, 0x54, 0x19, 0xb4, 0xa7, 0x9f, 0x7f,
-		0xa0, 0xd7, 0x3a, 0xb1, 0xff, 0x1e, 0xa2, 0x94, 0x2f, 0x06, 0xbb, 0xf1, 0xf6, 0xde, 0x1f, 0xc4,
-		0x60, 0x52, 0x6b, 0x19, 0xa6, 0x75, 0x96, 0xfe, 0xcb, 0xf7, 0xdc, 0x38, 0x2d, 0x8c, 0x70, 0x28,
-		0x79, 0x81, 0x6d, 0x85, 0xe1, 0x12, 0xf3, 0x17, 0x3f, 0xf6, 0xa9, 0x71, 0x7f, 0xbb, 0x2c, 0xae,
-		0x82, 0x24, 0x2e, 0xf1, 0x62, 0x53, 0xb7, 0x1a, 0x23, 0x65, 0x29, 0xbe, 0x2d, 0x68, 0x88, 0xfc,
-		0x56, 0x85, 0xa3, 0x2e, 0xbe, 0x15, 0x52, 0x1e, 0x99, 0x61, 0x9e, 0x89, 0x20, 0xe2, 0x61, 0x10,
-		0xbf, 0x84, 0xed, 0xcc, 0x51, 0xbc, 0xd0, 0xd7, 0x04, 0x3e, 0xdb, 0xa1, 0x6b, 0x64, 0x36, 0xcb,
-		0x90, 0x6b, 0x58, 0xa6, 0xab, 0x5a, 0x2d, 0xc3, 0xc5, 0xad, 0xb6, 0x3b, 0xd4, 0xaf, 0xfb, 0x4b,
-		0x46, 0x24, 0xa5, 0x4c, 0x10, 0xbc, 0x75, 0x81, 0x76, 0x47, 0x8b, 0xeb, 0x34, 0xae, 0x73, 0x5d,
-		0xec, 0xee, 0xb1, 0x45, 0xf4, 0x16, 0xf7, 0xcf, 0x62, 0x30, 0xcb, 0x81, 0xb7, 0x34, 0x07, 0x9f,
-		0xbd, 0xf1, 0xc8, 0x16, 0x76, 0xb5, 0x47, 0xce, 0xea, 0x96, 0x21, 0x0c, 0xf1, 0x14, 0xd7, 0xb5,
-		0xa4, 0x7d, 0x81, 0xb7, 0x17, 0xfa, 0x9e, 0x56, 0x17, 0x06, 0xeb, 0xe8, 0x42, 0xaf, 0x08, 0xc9,
-		0xcf, 0x43, 0xbc, 0x6c, 0x19, 0x26, 0x31, 0x4d, 0x0d, 0x6c, 0x5a, 0x2d, 0xae, 0x2d, 0x59, 0x01,
-		0x5d, 0x80, 0x84, 0xd6, 0xb2, 0x3a, 0xa6, 0xcb, 0x34, 0x65, 0x69, 0xf6, 0xcb, 0xaf, 0xcc, 0x8d,
-		0xfd, 0xc1, 0x2b, 0x73, 0xb1, 0xaa, 0xe9, 0xfe, 0xde, 0x17, 0x1e, 0x02, 0x4e, 0xbd, 0x6a, 0xba,
-		0x9f, 0xf9, 0xda, 0xe7, 0xce, 0x44, 0x14, 0x0e, 0xbd, 0x18, 0xff, 0xfa, 0xc7, 0xe7, 0x22, 0xf2,
-		0xb3, 0x90, 0x5c, 0xc2, 0xfa, 0x01, 0xe4, 0x1f, 0xe9, 0x22, 0x7f, 0x5c, 0x90, 0x5f, 0xc2, 0x7a,
-		0x80, 0xfc, 0x12, 0xd6, 0xbb, 0x28, 0x3f, 0x0e, 0xa9, 0xaa, 0xe9, 0xb2, 0x2f, 0x80, 0x1e, 0x80,
-		0x98, 0x61, 0xb2, 0x4b, 0xe5, 0x01, 0x0a, 0x3d, 0x03, 0x54, 0x08, 0x14, 0x41, 0x5c, 0xc2, 0xba,
-		0x87, 0xd8, 0xc0, 0x7a, 0x37, 0x62, 0x6f, 0xd7, 0x04, 0xaa, 0xb4, 0xf4, 0xfb, 0x7f, 0x3c, 0x3b,
-		0xf6, 0xd2, 0xab, 0xb3, 0x63, 0x03, 0x97, 0x5e, 0x1e, 0xbe, 0xf4, 0xde, 0x8a, 0x7f, 0x2a, 0x0e,
-		0x77, 0xd3, 0x0f, 0x43, 0xed, 0x96, 0x61, 0xba, 0x67, 0x75, 0x7b, 0xbf, 0xed, 0x5a, 0x64, 0xff,
-		0x5a, 0xdb, 0x7c, 0xc1, 0x27, 0xfd, 0xe6, 0x05, 0xd6, 0xdc, 0x7f, 0xb9, 0xe5, 0x6d, 0x18, 0xdf,
-		0x20, 0x78, 0x84, 0xc5, 0xae, 0xe5, 0x6a, 0x4d, 0xee, 0x74, 0xb0, 0x02, 0xa9, 0x65, 0x1f, 0x93,
-		0x46, 0x59, 0xad, 0x21, 0xbe, 0x23, 0x6d, 0x62, 0x6d, 0x9b, 0x7d, 0x93, 0x13, 0xa3, 0xde, 0x6a,
-		0x8a, 0x54, 0xd0, 0xcf, 0x6f, 0xa6, 0x61, 0x5c, 0xeb, 0xb0, 0x7b, 0x33, 0x31, 0xe2, 0xc6, 0xd2,
-		0x82, 0x7c, 0x15, 0x92, 0xfc, 0xf8, 0x1c, 0x49, 0x10, 0xbb, 0x8e, 0xf7, 0x69, 0x3f, 0x59, 0x85,
-		0xfc, 0x44, 0x0b, 0x30, 0x4e, 0x07, 0xcf, 0x3f, 0x36, 0x9c, 0x59, 0xe8, 0x19, 0xfd, 0x02, 0x1d,
-		0xa4, 0xc2, 0xc0, 0xe4, 0x2b, 0x90, 0x5a, 0xb2, 0x88, 0x14, 0x86, 0xa9, 0xa5, 0x19, 0x35, 0x3a,
-		0xe6, 0x76, 0x87, 0x4b, 0x85, 0xc2, 0x0a, 0xe8, 0x28, 0x24, 0xd8, 0x37, 0x5a, 0xfc, 0xee, 0x0f,
-		0x2f, 0xc9, 0x65, 0x48, 0x52, 0xda, 0xeb, 0x6d, 0x62, 0xf1, 0xbd, 0x6b, 0xeb, 0x69, 0xfe, 0xc5,
-		0x2e, 0x27, 0x1f, 0xf5, 0x07, 0x8b, 0x20, 0xde, 0xd0, 0x5c, 0x8d, 0xcf, 0x9b, 0xfe, 0x96, 0xdf,
-		0x06, 0x29, 0x4e, 0xc4, 0x41, 0xe7, 0x20, 0x66, 0xb5, 0x1d, 0x7e, 0x7b, 0xa7, 0x30, 0x68, 0x2a,
-		0xeb, 0xed, 0x52, 0x9c, 0xc8, 0x8c, 0x42, 0x80, 0x4b, 0x6b, 0x03, 0xc5, 0xe2, 0xb1, 0x90, 0x58,
-		0xb4, 0xb0, 0xbb, 0xb5, 0xed, 0xfa, 0x3f, 0xd8, 0x72, 0xf6, 0x88, 0x82, 0x27, 0x28, 0xb7, 0xa3,
-		0x30, 0x1b, 0x68, 0xbd, 0x81, 0x6d, 0xc7, 0xb0, 0x4c, 0x26, 0x4d, 0x5c, 0x52, 0x50, 0x60, 0x80,
-		0xbc, 0x7d, 0x80, 0xa8, 0x3c, 0x05, 0xb1, 0x62, 0xbb, 0x8d, 0x0a, 0x90, 0xa2, 0x65, 0xdd, 0x62,
-		0xb2, 0x12, 0x57, 0xbc, 0x32, 0x69, 0x73, 0xac, 0x6d, 0xf7, 0xa6, 0x66, 0x7b, 0x9f, 0x30, 0x8b,
-		0xb2, 0xfc, 0x24, 0xa4, 0xcb, 0x96, 0xe9, 0x60, 0xd3, 0xe9, 0x50, 0x57, 0x76, 0xab, 0x69, 0xe9,
-		0xd7, 0x39, 0x05, 0x56, 0x20, 0xcc, 0xd6, 0xda, 0x6d, 0x8a, 0x19, 0x57, 0xc8, 0x4f, 0xb6, 0x5f,
-		0x4b, 0xeb, 0x03, 0xd9, 0x73, 0xfe, 0x70, 0xec, 0xe1, 0x13, 0xf4, 0x7d, 0xd2, 0x08, 0xdc, 0xd5,
-		0xbb, 0x91, 0xae, 0xe3, 0x7d, 0xe7, 0xb0, 0xfb, 0xe8, 0x59, 0x48, 0x6f, 0xd0, 0xd7, 0x45, 0xae,
-		0xe2, 0x7d, 0x54, 0x80, 0x24, 0x6e, 0x9c, 0x3b, 0x7f, 0xfe, 0x91, 0x27, 0x99, 0x94, 0x5f, 0x1e,
-		0x53, 0x44, 0x05, 0x9a, 0x85, 0xb4, 0x83, 0xf5, 0xf6, 0xb9, 0xf3, 0x17, 0xae, 0x3f, 0xc2, 0xc4,
-		0xea, 0xf2, 0x98, 0xe2, 0x57, 0x2d, 0xa6, 0xc8, 0x8c, 0xbf, 0xfe, 0x89, 0xb9, 0x48, 0x69, 0x1c,
-		0x62, 0x4e, 0xa7, 0xf5, 0x86, 0xc9, 0xc6, 0x4f, 0x8c, 0xc3, 0x7c, 0xa0, 0x95, 0x19, 0x97, 0x1b,
-		0x5a, 0xd3, 0x68, 0x68, 0xfe, 0x9b, 0x30, 0x52, 0x60, 0xfe, 0x14, 0x62, 0x80, 0xd5, 0x38, 0x90,
-		0x8b, 0xf2, 0x2f, 0x45, 0x20, 0x7b, 0x4d, 0x50, 0xae, 0x61, 0x17, 0x5d, 0x04, 0xf0, 0x7a, 0x12,
-		0x5b, 0xe5, 0xc4, 0x42, 0x77, 0x5f, 0x0b, 0x1e, 0x8e, 0x12, 0x00, 0x47, 0x8f, 0x53, 0x01, 0x6c,
-		0x5b, 0x0e, 0xff, 0x9c, 0x75, 0x08, 0xaa, 0x07, 0x8c, 0x1e, 0x04, 0x44, 0xb5, 0x9a, 0x7a, 0xc3,
-		0x72, 0x0d, 0x73, 0x47, 0x6d, 0x5b, 0x37, 0xf9, 0x23, 0x01, 0x31, 0x45, 0xa2, 0x2d, 0xd7, 0x68,
-		0xc3, 0x06, 0xa9, 0x27, 0x83, 0x4e, 0x7b, 0x54, 0x48, 0x54, 0xa6, 0x35, 0x1a, 0x36, 0x76, 0x1c,
-		0xae, 0xb8, 0x44, 0x11, 0x5d, 0x84, 0x64, 0xbb, 0xb3, 0xa5, 0x0a, 0x2d, 0x91, 0x39, 0x77, 0x57,
-		0xbf, 0x3d, 0x2f, 0x64, 0x83, 0xef, 0xfa, 0x44, 0xbb, 0xb3, 0x45, 0x24, 0xe5, 0x1e, 0xc8, 0xf6,
-		0x19, 0x4c, 0xe6, 0x86, 0x3f, 0x0e, 0xfa, 0xa0, 0x0d, 0x9f, 0x81, 0xda, 0xb6, 0x0d, 0xcb, 0x36,
-		0xdc, 0x7d, 0x7a, 0xeb, 0x2e, 0xa6, 0x48, 0xa2, 0x61, 0x83, 0xd7, 0xcb, 0xd7, 0x21, 0x5f, 0xa3,
-		0xde, 0xba, 0x3f, 0xf2, 0xf3, 0xfe, 0xf8, 0x22, 0xc3, 0xc7, 0x37, 0x70, 0x64, 0xd1, 0x9e, 0x91,
-		0x95, 0x56, 0x07, 0x4a, 0xe6, 0xa3, 0x87, 0x93, 0xcc, 0xb0, 0x75, 0xfb, 0xe6, 0xf1, 0xd0, 0xa6,
-		0xe4, 0x5e, 0x4f, 0x40, 0x65, 0x8d, 0x2a, 0x94, 0xc3, 0x02, 0xf1, 0xc2, 0xc1, 0x46, 0xb4, 0x30,
-		0x44, 0x75, 0x16, 0x86, 0x6e, 0x1f, 0xf9, 0x49, 0x98, 0xd8, 0xd0, 0x6c, 0xb7, 0x86, 0xdd, 0xcb,
-		0x58, 0x6b, 0x60, 0x3b, 0x6c, 0x65, 0x27, 0x84, 0x95, 0x45, 0x10, 0xa7, 0xa6, 0x94, 0x59, 0x19,
-		0xfa, 0x5b, 0xde, 0x85, 0x38, 0xbd, 0x76, 0xeb, 0x59, 0x60, 0x8e, 0xc1, 0x2c, 0x30, 0xd1, 0x9f,
-		0xfb, 0x2e, 0x76, 0x44, 0xae, 0x88, 0x16, 0xd0, 0x63, 0xc2, 0x8e, 0xc6, 0x0e, 0xb6, 0xa3, 0x5c,
-		0x08, 0xb9, 0x35, 0x6d, 0x42, 0xb2, 0x44, 0xd4, 0x6f, 0x75, 0xc9, 0x1b, 0x48, 0xc4, 0x1f, 0x08,
-		0x5a, 0x85, 0x7c, 0x5b, 0xb3, 0x5d, 0xfa, 0x19, 0xde, 0x2e, 0x9d, 0x05, 0x97, 0xf3, 0xb9, 0xde,
-		0x5d, 0x17, 0x9a, 0x2c, 0xef, 0x65, 0xa2, 0x1d, 0xac, 0x94, 0xff, 0x5b, 0x1c, 0x12, 0x9c, 0x19,
-		0x4f, 0x41, 0x92, 0xb3, 0x95, 0x4b, 0xe6, 0xdd, 0x0b, 0xbd, 0xc6, 0x68, 0xc1, 0x33, 0x1a, 0x9c,
-		0x9e, 0xc0, 0x41, 0xf7, 0x43, 0x4a, 0xdf, 0xd5, 0x0c, 0x53, 0x35, 0x1a, 0xdc, 0x01, 0xcc, 0xbc,
-		0xfa, 0xca, 0x5c, 0xb2, 0x4c, 0xea, 0xaa, 0x4b, 0x4a, 0x92, 0x36, 0x56, 0x1b, 0xc4, 0xf2, 0xef,
-		0x62, 0x63, 0x67, 0xd7, 0xe5, 0xbb, 0x8b, 0x97, 0xd0, 0x13, 0x10, 0x27, 0x02, 0xc1, 0x3f, 0xd2,
-		0x2e, 0xf4, 0xf8, 0xf2, 0x5e, 0x9e, 0xa4, 0x94, 0x22, 0x1d, 0x7f, 0xf0, 0xbf, 0xce, 0x45, 0x14,
-		0x8a, 0x81, 0xca, 0x30, 0xd1, 0xd4, 0x1c, 0x57, 0xa5, 0x56, 0x8b, 0x74, 0x3f, 0x4e, 0x49, 0x1c,
-		0xef, 0x65, 0x08, 0x67, 0x2c, 0x1f, 0x7a, 0x86, 0x60, 0xb1, 0xaa, 0x06, 0x3a, 0x05, 0x12, 0x25,
-		0xa2, 0x5b, 0xad, 0x96, 0xe1, 0x32, 0x5f, 0x2a, 0x41, 0xf9, 0x9e, 0x23, 0xf5, 0x65, 0x5a, 0x4d,
-		0x3d, 0xaa, 0x13, 0x90, 0xa6, 0x9f, 0x85, 0x52, 0x10, 0x76, 0xd7, 0x3b, 0x45, 0x2a, 0x68, 0xe3,
-		0x49, 0xc8, 0xfb, 0xba, 0x91, 0x81, 0xa4, 0x18, 0x15, 0xbf, 0x9a, 0x02, 0x3e, 0x0c, 0xd3, 0x26,
-		0xde, 0xa3, 0xb7, 0xcf, 0x43, 0xd0, 0x69, 0x0a, 0x8d, 0x48, 0xdb, 0xb5, 0x30, 0xc6, 0x7d, 0x90,
-		0xd3, 0x05, 0xf3, 0x19, 0x2c, 0x50, 0xd8, 0x09, 0xaf, 0x96, 0x82, 0x1d, 0x87, 0x94, 0xd6, 0x6e,
-		0x33, 0x80, 0x0c, 0xd7, 0x8d, 0xed, 0x36, 0x6d, 0x3a, 0x03, 0x93, 0x74, 0x8e, 0x36, 0x76, 0x3a,
-		0x4d, 0x97, 0x13, 0xc9, 0x52, 0x98, 0x3c, 0x69, 0x50, 0x58, 0x3d, 0x85, 0xbd, 0x17, 0x26, 0xf0,
-		0x0d, 0xa3, 0x81, 0x4d, 0x1d, 0x33, 0xb8, 0x09, 0x0a, 0x97, 0x15, 0x95, 0x14, 0xe8, 0x34, 0x78,
-		0x3a, 0x4f, 0x15, 0xfa, 0x38, 0xc7, 0xe8, 0x89, 0xfa, 0x22, 0xab, 0x96, 0x67, 0x20, 0xbe, 0xa4,
-		0xb9, 0x1a, 0x71, 0x2a, 0xdc, 0x3d, 0x66, 0x64, 0xb2, 0x0a, 0xf9, 0x29, 0x7f, 0x3d, 0x0a, 0xf1,
-		0x6b, 0x96, 0x8b, 0xd1, 0xa3, 0x01, 0x87, 0x2f, 0xd7, 0x4f, 0x9e, 0x6b, 0xc6, 0x8e, 0x89, 0x1b,
-		0xab, 0xce, 0x4e, 0xe0, 0x0d, 0x17, 0x5f, 0x9c, 0xa2, 0x21, 0x71, 0x9a, 0x86, 0x71, 0xdb, 0xea,
-		0x98, 0x0d, 0x71, 0x4b, 0x9a, 0x16, 0x50, 0x05, 0x52, 0x9e, 0x94, 0xc4, 0x87, 0x49, 0x49, 0x9e,
-		0x48, 0x09, 0x91, 0x61, 0x5e, 0xa1, 0x24, 0xb7, 0xb8, 0xb0, 0x94, 0x20, 0xed, 0x29, 0x2f, 0x2e,
-		0x6d, 0xa3, 0x09, 0xac, 0x8f, 0x46, 0x0c, 0x89, 0xb7, 0xf6, 0x1e, 0xf3, 0x98, 0xc4, 0x49, 0x5e,
-		0x03, 0xe7, 0x5e, 0x48, 0xac, 0xf8, 0x7b, 0x32, 0x49, 0x3a, 0x2f, 0x5f, 0xac, 0xd8, 0x9b, 0x32,
-		0x77, 0x41, 0xda, 0x31, 0x76, 0x4c, 0xcd, 0xed, 0xd8, 0x98, 0x4b, 0x9e, 0x5f, 0x21, 0xff, 0x46,
-		0x04, 0x12, 0x4c, 0x92, 0x03, 0x7c, 0x8b, 0xf4, 0xe7, 0x5b, 0x74, 0x10, 0xdf, 0x62, 0x77, 0xce,
-		0xb7, 0x22, 0x80, 0x37, 0x18, 0x87, 0x3f, 0xf3, 0xd1, 0xc7, 0x5b, 0x60, 0x43, 0xac, 0x19, 0x3b,
-		0x7c, 0xa3, 0x06, 0x90, 0xe4, 0xff, 0x12, 0x21, 0x8e, 0x2b, 0x6f, 0x47, 0x45, 0x98, 0x10, 0xe3,
-		0x52, 0xb7, 0x9b, 0xda, 0x0e, 0x97, 0x9d, 0xbb, 0x07, 0x0e, 0xee, 0x52, 0x53, 0xdb, 0x51, 0x32,
-		0x7c, 0x3c, 0xa4, 0xd0, 0x7f, 0x1d, 0xa2, 0x03, 0xd6, 0x21, 0xb4, 0xf0, 0xb1, 0x3b, 0x5b, 0xf8,
-		0xd0, 0x12, 0xc5, 0xbb, 0x97, 0xe8, 0xf3, 0x51, 0x1a, 0xbc, 0xb4, 0x2d, 0x47, 0x6b, 0xbe, 0x19,
-		0x3b, 0xe2, 0x04, 0xa4, 0xdb, 0x56, 0x53, 0x65, 0x2d, 0xec, 0xeb, 0x81, 0x54, 0xdb, 0x6a, 0x2a,
-		0x3d, 0xcb, 0x3e, 0xfe, 0x3a, 0x6d, 0x97, 0xc4, 0xeb, 0xc0, 0xb5, 0x64, 0x37, 0xd7, 0x6c, 0xc8,
-		0x32, 0x56, 0x70, 0x5b, 0xf6, 0x30, 0xe1, 0x01, 0x35, 0x8e, 0x91, 0x5e, 0xdb, 0xcb, 0x86, 0xcd,
-		0x20, 0x15, 0x0e, 0x47, 0x30, 0x98, 0xea, 0xef, 0x17, 0xf5, 0x06, 0xc5, 0x52, 0xe1, 0x70, 0xf2,
-		0x4f, 0x44, 0x00, 0x56, 0x08, 0x67, 0xe9, 0x7c, 0x89, 0x15, 0x72, 0xe8, 0x10, 0xd4, 0x50, 0xcf,
-		0xb3, 0x83, 0x16, 0x8d, 0xf7, 0x9f, 0x75, 0x82, 0xe3, 0x2e, 0xc3, 0x84, 0x2f, 0x8c, 0x0e, 0x16,
-		0x83, 0x99, 0x3d, 0xc0, 0xa3, 0xae, 0x61, 0x57, 0xc9, 0xde, 0x08, 0x94, 0xe4, 0x7f, 0x15, 0x81,
-		0x34, 0x1d, 0xd3, 0x2a, 0x76, 0xb5, 0xd0, 0x1a, 0x46, 0xee, 0x7c, 0x0d, 0xef, 0x06, 0x60, 0x64,
-		0x1c, 0xe3, 0x45, 0xcc, 0x25, 0x2b, 0x4d, 0x6b, 0x6a, 0xc6, 0x8b, 0x18, 0x5d, 0xf0, 0x18, 0x1e,
-		0x3b, 0x98, 0xe1, 0xc2, 0xe3, 0xe6, 0x6c, 0x3f, 0x06, 0x49, 0xfa, 0x2c, 0xde, 0x9e, 0xc3, 0x9d,
-		0xe8, 0x84, 0xd9, 0x69, 0xd5, 0xf7, 0x1c, 0xf9, 0x05, 0x48, 0xd6, 0xf7, 0x58, 0x2e, 0xe4, 0x04,
-		0xa4, 0x6d, 0xcb, 0xe2, 0x36, 0x99, 0xf9, 0x42, 0x29, 0x52, 0x41, 0x4d, 0x90, 0x88, 0xff, 0xa3,
-		0x7e, 0xfc, 0xef, 0x27, 0x30, 0x62, 0x23, 0x25, 0x30, 0xce, 0xfc, 0xe7, 0x08, 0x64, 0x02, 0xfa,
-		0x01, 0x3d, 0x02, 0x47, 0x4a, 0x2b, 0xeb, 0xe5, 0xab, 0x6a, 0x75, 0x49, 0xbd, 0xb4, 0x52, 0x5c,
-		0xf6, 0x3f, 0x90, 0x2b, 0x1c, 0xbd, 0x75, 0x7b, 0x1e, 0x05, 0x60, 0x37, 0x4d, 0x7a, 0x18, 0x83,
-		0xce, 0xc2, 0x74, 0x18, 0xa5, 0x58, 0xaa, 0x55, 0xd6, 0xea, 0x52, 0xa4, 0x70, 0xe4, 0xd6, 0xed,
-		0xf9, 0xc9, 0x00, 0x46, 0x71, 0xcb, 0xc1, 0xa6, 0xdb, 0x8b, 0x50, 0x5e, 0x5f, 0x5d, 0xad, 0xd6,
-		0xa5, 0x68, 0x0f, 0x02, 0x57, 0xd8, 0xa7, 0x61, 0x32, 0x8c, 0xb0, 0x56, 0x5d, 0x91, 0x62, 0x05,
-		0x74, 0xeb, 0xf6, 0x7c, 0x2e, 0x00, 0xbd, 0x66, 0x34, 0x0b, 0xa9, 0xf7, 0x7f, 0x72, 0x76, 0xec,
-		0x33, 0x3f, 0x33, 0x1b, 0x21, 0x33, 0x9b, 0x08, 0xe9, 0x08, 0xf4, 0x20, 0x1c, 0xab, 0x55, 0x97,
-		0xd7, 0x2a, 0x4b, 0xea, 0x6a, 0x6d, 0xb9, 0xeb, 0x9b, 0xe7, 0x42, 0xfe, 0xd6, 0xed, 0xf9, 0x0c,
-		0x9f, 0xd2, 0x20, 0xe8, 0x0d, 0xa5, 0x72, 0x6d, 0xbd, 0x5e, 0x91, 0x22, 0x0c, 0x7a, 0xc3, 0xc6,
-		0x37, 0x2c, 0x97, 0xbd, 0xa8, 0xf9, 0x30, 0x1c, 0xef, 0x03, 0xed, 0x4d, 0x6c, 0xf2, 0xd6, 0xed,
-		0xf9, 0x89, 0x0d, 0x1b, 0xb3, 0xfd, 0x43, 0x31, 0x16, 0x60, 0xa6, 0x17, 0x63, 0x7d, 0x63, 0xbd,
-		0x56, 0x5c, 0x91, 0xe6, 0x0b, 0xd2, 0xad, 0xdb, 0xf3, 0x59, 0xa1, 0x0c, 0x09, 0xbc, 0x3f, 0xb3,
-		0x37, 0x2a, 0xda, 0xf9, 0x91, 0x64, 0x28, 0x97, 0xc7, 0xe2, 0x88, 0xb6, 0x66, 0x6b, 0xad, 0xc3,
-		0x86, 0x3b, 0x43, 0x4e, 0x63, 0xe5, 0x97, 0xa2, 0x90, 0xf7, 0x9c, 0xe9, 0x0d, 0xda, 0x03, 0x7a,
-		0x34, 0x98, 0x87, 0xc9, 0x0c, 0x34, 0x63, 0x0c, 0x5a, 0xa4, 0x69, 0xde, 0x0a, 0x29, 0xe1, 0x94,
-		0x71, 0x75, 0x31, 0xdf, 0x8b, 0x57, 0xe1, 0x10, 0x1c, 0xd5, 0xc3, 0x40, 0x4f, 0x43, 0xda, 0x53,
-		0x1e, 0xde, 0xab, 0x53, 0x83, 0xb5, 0x0d, 0xc7, 0xf7, 0x71, 0xd0, 0x93, 0x7e, 0xd8, 0x10, 0x1f,
-		0x14, 0x88, 0x5c, 0x63, 0x00, 0x1c, 0x59, 0xc0, 0xcb, 0x55, 0xbe, 0xed, 0xf8, 0xec, 0xe9, 0x8b,
-		0x1d, 0x7b, 0x2a, 0x8b, 0xa4, 0x98, 0x57, 0x92, 0x6a, 0x69, 0x7b, 0x25, 0x1a, 0x4c, 0x1d, 0x83,
-		0x24, 0x69, 0xdc, 0xe1, 0xdf, 0x84, 0xc7, 0x94, 0x44, 0x4b, 0xdb, 0x5b, 0xd6, 0x9c, 0x2b, 0xf1,
-		0x54, 0x4c, 0x8a, 0xcb, 0x3f, 0x17, 0x81, 0x5c, 0x78, 0x8e, 0xe8, 0x01, 0x40, 0x04, 0x43, 0xdb,
-		0xc1, 0x2a, 0x51, 0x31, 0x94, 0x59, 0x82, 0x6e, 0xbe, 0xa5, 0xed, 0x15, 0x77, 0xf0, 0x5a, 0xa7,
-		0x45, 0x07, 0xe0, 0xa0, 0x55, 0x90, 0x04, 0xb0, 0x58, 0x27, 0xce, 0xcc, 0xe3, 0xbd, 0x6f, 0x50,
-		0x72, 0x00, 0x66, 0xa8, 0x5e, 0x26, 0x86, 0x2a, 0xc7, 0xe8, 0x79, 0xa7, 0xe9, 0xa1, 0xa9, 0xc4,
-		0xc2, 0x53, 0x91, 0x9f, 0x86, 0x7c, 0x17, 0x3f, 0x91, 0x0c, 0x13, 0x3c, 0x2b, 0x40, 0x4f, 0x2a,
-		0x99, 0x7f, 0x9c, 0x56, 0x32, 0x2c, 0xfa, 0xa7, 0x27, 0xb7, 0x8b, 0xa9, 0x2f, 0x7d, 0x7c, 0x2e,
-		0x42, 0x13, 0xe6, 0x0f, 0xc0, 0x44, 0x88, 0xa3, 0x22, 0x53, 0x17, 0xf1, 0x33, 0x75, 0x3e, 0xf0,
-		0xf3, 0x90, 0x25, 0x8a, 0x12, 0x37, 0x38, 0xec, 0xfd, 0x90, 0x67, 0x8a, 0xbc, 0x9b, 0xd7, 0xcc,
-		0x93, 0x5a, 0x15, 0x0c, 0x97, 0x85, 0x6b, 0x15, 0x66, 0x7b, 0x46, 0x40, 0x2d, 0x6b, 0x4e, 0xe9,
-		0x1d, 0x9f, 0x79, 0x75, 0x36, 0xf2, 0xc6, 0x6c, 0xc4, 0xdf, 0x7d, 0x07, 0x9c, 0x08, 0x34, 0x6a,
-		0x5b, 0xba, 0x11, 0xca, 0x3a, 0xe4, 0x03, 0x42, 0x46, 0x1a, 0x87, 0x65, 0x0f, 0x0e, 0xcc, 0x61,
-		0x1c, 0x9c, 0x30, 0x2b, 0x1c, 0xac, 0x11, 0x86, 0x27, 0x36, 0xfa, 0xe7, 0x28, 0xff, 0x6f, 0x0a,
-		0x92, 0x0a, 0x7e, 0x6f, 0x07, 0x3b, 0x2e, 0x3a, 0x07, 0x71, 0xac, 0xef, 0x5a, 0xfd, 0x52, 0x42,
-		0x64, 0x72, 0x0b, 0x1c, 0xae, 0xa2, 0xef, 0x5a, 0x97, 0xc7, 0x14, 0x0a, 0x8b, 0xce, 0xc3, 0xf8,
-		0x76, 0xb3, 0xc3, 0xf3, 0x14, 0x5d, 0xca, 0x22, 0x88, 0x74, 0x89, 0x00, 0x5d, 0x1e, 0x53, 0x18,
-		0x34, 0xe9, 0x8a, 0xbe, 0xf3, 0x1b, 0x3b, 0xb8, 0xab, 0xaa, 0xb9, 0x4d, 0xbb, 0x22, 0xb0, 0xa8,
-		0x04, 0x60, 0x98, 0x86, 0xab, 0xd2, 0x18, 0x9e, 0x7b, 0x82, 0xf7, 0x0c, 0xc6, 0x34, 0x5c, 0x1a,
-		0xf5, 0x5f, 0x1e, 0x53, 0xd2, 0x86, 0x28, 0x90, 0xe1, 0xbe, 0xb7, 0x83, 0xed, 0x7d, 0xee, 0x00,
-		0x0e, 0x1c, 0xee, 0x3b, 0x08, 0x10, 0x19, 0x2e, 0x85, 0x46, 0x15, 0xc8, 0xd0, 0x0f, 0x5c, 0xd9,
-		0xfe, 0xe5, 0x2f, 0xcb, 0xca, 0x83, 0x90, 0x4b, 0x04, 0x94, 0x6e, 0xe9, 0xcb, 0x63, 0x0a, 0x6c,
-		0x79, 0x25, 0xa2, 0x24, 0xd9, 0xcb, 0x63, 0xee, 0x1e, 0x7f, 0x4f, 0x73, 0x6e, 0x10, 0x0d, 0xfa,
-		0xfc, 0x58, 0x7d, 0xef, 0xf2, 0x98, 0x92, 0xd4, 0xd9, 0x4f, 0x32, 0xff, 0x06, 0x6e, 0x1a, 0x37,
-		0xb0, 0x4d, 0xf0, 0xd3, 0x07, 0xcf, 0x7f, 0x89, 0x41, 0x52, 0x0a, 0xe9, 0x86, 0x28, 0x10, 0x45,
-		0x8b, 0xcd, 0x06, 0x9f, 0x06, 0xf4, 0xea, 0xe9, 0xd0, 0x3a, 0x9b, 0x0d, 0x31, 0x89, 0x14, 0xe6,
-		0xbf, 0xd1, 0x13, 0x9e, 0x87, 0x9a, 0xe9, 0x75, 0x0a, 0x43, 0x13, 0x60, 0xb9, 0x8a, 0x31, 0xe1,
-		0xa9, 0xa2, 0x35, 0xc8, 0x35, 0x0d, 0xc7, 0x55, 0x1d, 0x53, 0x6b, 0x3b, 0xbb, 0x96, 0xeb, 0xd0,
-		0xa0, 0x3f, 0x73, 0xee, 0xbe, 0x41, 0x14, 0x56, 0x0c, 0xc7, 0xad, 0x09, 0xe0, 0xcb, 0x63, 0xca,
-		0x44, 0x33, 0x58, 0x41, 0xe8, 0x59, 0xdb, 0xdb, 0xd8, 0xf6, 0x08, 0xd2, 0xe4, 0xc0, 0x01, 0xf4,
-		0xd6, 0x09, 0xb4, 0xc0, 0x27, 0xf4, 0xac, 0x60, 0x05, 0x7a, 0x27, 0x4c, 0x35, 0x2d, 0xad, 0xe1,
-		0x91, 0x53, 0xf5, 0xdd, 0x8e, 0x79, 0x9d, 0x66, 0x12, 0x32, 0xe7, 0x4e, 0x0f, 0x1c, 0xa4, 0xa5,
-		0x35, 0x04, 0x89, 0x32, 0x41, 0xb8, 0x3c, 0xa6, 0x4c, 0x36, 0xbb, 0x2b, 0xd1, 0xbb, 0x61, 0x5a,
-		0x6b, 0xb7, 0x9b, 0xfb, 0xdd, 0xd4, 0xf3, 0x94, 0xfa, 0x99, 0x41, 0xd4, 0x8b, 0x04, 0xa7, 0x9b,
-		0x3c, 0xd2, 0x7a, 0x6a, 0x51, 0x1d, 0xa4, 0xb6, 0x8d, 0xe9, 0x37, 0x31, 0x6d, 0xee, 0xaa, 0xd0,
-		0x17, 0x83, 0x32, 0xe7, 0x4e, 0x0e, 0xa2, 0xbd, 0xc1, 0xe0, 0x85, 0x67, 0x73, 0x79, 0x4c, 0xc9,
-		0xb7, 0xc3, 0x55, 0x8c, 0xaa, 0xa5, 0x63, 0xfa, 0xa0, 0x19, 0xa7, 0x3a, 0x39, 0x8c, 0x2a, 0x85,
-		0x0f, 0x53, 0x0d, 0x55, 0x95, 0x92, 0xfc, 0x4e, 0x1b, 0x7f, 0xf0, 0xe5, 0x24, 0x64, 0x02, 0x8a,
-		0x05, 0xcd, 0x40, 0x92, 0x9f, 0xf1, 0x8b, 0xbb, 0x70, 0xbc, 0x28, 0xe7, 0x20, 0x1b, 0x54, 0x26,
-		0xf2, 0x07, 0x23, 0x1e, 0x26, 0xfd, 0x52, 0x7e, 0x26, 0x9c, 0x3a, 0x4c, 0xfb, 0x59, 0xc1, 0x7b,
-		0x85, 0x15, 0x11, 0xed, 0xec, 0x34, 0x29, 0x4b, 0x2b, 0xb9, 0x11, 0x43, 0x73, 0x90, 0x69, 0x9f,
-		0x6b, 0x7b, 0x20, 0x31, 0x0a, 0x02, 0xed, 0x73, 0x6d, 0x01, 0x70, 0x0f, 0x64, 0xc9, 0x4c, 0xd5,
-		0xa0, 0xa3, 0x91, 0x56, 0x32, 0xa4, 0x8e, 0x83, 0xc8, 0xff, 0x2e, 0x0a, 0x52, 0xb7, 0x02, 0xf2,
-		0x72, 0x8a, 0x91, 0x43, 0xe7, 0x14, 0x8f, 0x77, 0x67, 0x33, 0xfd, 0x04, 0xe6, 0x0a, 0x48, 0x7e,
-		0x1e, 0x8e, 0x19, 0x82, 0xc1, 0x8e, 0x53, 0x97, 0x87, 0xa7, 0xe4, 0xf5, 0x2e, 0x97, 0xef, 0x52,
-		0xe8, 0xec, 0x45, 0x3c, 0x64, 0xdf, 0xbd, 0xc4, 0x9e, 0xbf, 0xb0, 0xd9, 0x6e, 0x68, 0x2e, 0x16,
-		0x79, 0x91, 0xc0, 0x31, 0xcc, 0xfd, 0x90, 0xd7, 0xda, 0x6d, 0xd5, 0x71, 0x35, 0x17, 0x73, 0xb3,
-		0x3e, 0xce, 0xd2, 0x83, 0x5a, 0xbb, 0x5d, 0x23, 0xb5, 0xcc, 0xac, 0xdf, 0x07, 0x39, 0xa2, 0x93,
-		0x0d, 0xad, 0xa9, 0xf2, 0x2c, 0x41, 0x82, 0x59, 0x7f, 0x5e, 0x7b, 0x99, 0x56, 0xca, 0x0d, 0x6f,
-		0xc5, 0xa9, 0x3e, 0xf6, 0xc2, 0xac, 0x48, 0x20, 0xcc, 0x42, 0xfc, 0x05, 0x03, 0xc6, 0x1f, 0xf1,
-		0xea, 0x43, 0xff, 0xec, 0xee, 0x34, 0x0d, 0xc9, 0x6e, 0xb0, 0x84, 0x47, 0x4a, 0x61, 0x05, 0xf9,
-		0x7d, 0x51, 0x98, 0xec, 0xd1, 0xdc, 0x7d, 0xd3, 0xde, 0x7e, 0x7c, 0x19, 0x3d, 0x54, 0x7c, 0x79,
-		0x35, 0x9c, 0xd6, 0x0d, 0x58, 0xbe, 0x13, 0x3d, 0x4c, 0x66, 0x7a, 0x93, 0x08, 0x34, 0x27, 0x12,
-		0xc8, 0xfc, 0x52, 0x31, 0xdf, 0x84, 0xe9, 0xad, 0xfd, 0x17, 0x35, 0xd3, 0x35, 0x4c, 0xac, 0xf6,
-		0xac, 0x5a, 0xaf, 0x29, 0x5d, 0x35, 0x9c, 0x2d, 0xbc, 0xab, 0xdd, 0x30, 0x2c, 0x31, 0xac, 0x29,
-		0x0f, 0xdf, 0x4f, 0xee, 0xca, 0x0a, 0xe4, 0xc2, 0xa6, 0x07, 0xe5, 0x20, 0xea, 0xee, 0xf1, 0xf9,
-		0x47, 0xdd, 0x3d, 0xf4, 0x30, 0xcf, 0x03, 0x45, 0x69, 0x1e, 0xa8, 0xb7, 0x23, 0x8e, 0xe7, 0x27,
-		0x81, 0x64, 0xd9, 0xdb, 0x0d, 0x9e, 0x39, 0xea, 0xa6, 0x2a, 0x9f, 0x86, 0x7c, 0x97, 0xbd, 0x19,
-		0x94, 0x15, 0x94, 0xf3, 0x30, 0x11, 0x32, 0x2e, 0xf2, 0x51, 0x98, 0xee, 0x67, 0x2b, 0xe4, 0x5d,
-		0xaf, 0x3e, 0xa4, 0xf3, 0xd1, 0x79, 0x48, 0x79, 0xc6, 0xa2, 0x4f, 0x16, 0x82, 0xce, 0x42, 0x00,
-		0x2b, 0x1e, 0x68, 0x28, 0x99, 0x1d, 0x0d, 0x25, 0xb3, 0xe5, 0xf7, 0xc0, 0xcc, 0x20, 0x43, 0xd0,
-		0x35, 0x8d, 0xb8, 0x27, 0x85, 0x47, 0x21, 0xc1, 0x5f, 0x2d, 0x8c, 0xd2, 0xe3, 0x1b, 0x5e, 0x22,
-		0xd2, 0xc9, 0x8c, 0x42, 0x8c, 0x9d, 0xea, 0xd0, 0x82, 0xac, 0xc2, 0xf1, 0x81, 0xc6, 0x60, 0xf0,
-		0x41, 0x10, 0x23, 0xc4, 0x0f, 0x82, 0x74, 0x31, 0x1c, 0x87, 0xce, 0x55, 0x5c, 0x76, 0x60, 0x25,
-		0xf9, 0xe5, 0x18, 0x1c, 0xed, 0x6f, 0x12, 0xd0, 0x3c, 0x64, 0x89, 0xdf, 0xed, 0x86, 0x5d, 0x74,
-		0x68, 0x69, 0x7b, 0x75, 0xee, 0x9f, 0xf3, 0x44, 0x7a, 0xd4, 0x4b, 0xa4, 0xa3, 0x4d, 0x98, 0x6c,
-		0x5a, 0xba, 0xd6, 0x54, 0x03, 0x12, 0xcf, 0x85, 0xfd, 0xde, 0x1e, 0x66, 0x57, 0xd8, 0x5b, 0xe6,
-		0x8d, 0x1e, 0xa1, 0xcf, 0x53, 0x1a, 0x2b, 0x9e, 0xe4, 0xa3, 0x25, 0xc8, 0xb4, 0x7c, 0x41, 0x3e,
-		0x84, 0xb0, 0x07, 0xd1, 0x02, 0x4b, 0x32, 0xde, 0xf7, 0xd8, 0x27, 0x71, 0x68, 0x15, 0x3d, 0xe8,
-		0x04, 0x25, 0x39, 0xf0, 0x04, 0xa5, 0xdf, 0x71, 0x45, 0xaa, 0xff, 0x71, 0xc5, 0xfb, 0x83, 0x4b,
-		0x13, 0x32, 0xa2, 0xbd, 0x27, 0x18, 0xa8, 0x06, 0xd3, 0x1c, 0xbf, 0x11, 0xe2, 0x7d, 0x74, 0x54,
-		0x45, 0x83, 0x04, 0xfa, 0x60, 0xb6, 0xc7, 0xee, 0x8c, 0xed, 0x42, 0x97, 0xc6, 0x03, 0xba, 0xf4,
-		0x6f, 0xd8, 0x52, 0xfc, 0xc7, 0x34, 0xa4, 0x14, 0xec, 0xb4, 0x89, 0xe1, 0x44, 0x25, 0x48, 0xe3,
-		0x3d, 0x1d, 0xb7, 0x5d, 0xff, 0x98, 0xb2, 0x5f, 0x30, 0xc0, 0xa0, 0x2b, 0x02, 0x92, 0x78, 0xe2,
-		0x1e, 0x1a, 0x7a, 0x94, 0x07, 0x5b, 0x83, 0xe3, 0x26, 0x8e, 0x1e, 0x8c, 0xb6, 0x2e, 0x88, 0x68,
-		0x2b, 0x36, 0xd0, 0xf9, 0x66, 0x58, 0x5d, 0xe1, 0xd6, 0xa3, 0x3c, 0xdc, 0x8a, 0x0f, 0xe9, 0x2c,
-		0x14, 0x6f, 0x95, 0x43, 0xf1, 0x56, 0x62, 0xc8, 0x34, 0x07, 0x04, 0x5c, 0x17, 0x44, 0xc0, 0x95,
-		0x1c, 0x32, 0xe2, 0xae, 0x88, 0xeb, 0x52, 0x38, 0xe2, 0x4a, 0x0d, 0x50, 0x20, 0x02, 0x7b, 0x60,
-		0xc8, 0xf5, 0x54, 0x20, 0xe4, 0x4a, 0x0f, 0x8c, 0x77, 0x18, 0x91, 0x3e, 0x31, 0x57, 0x39, 0x14,
-		0x73, 0xc1, 0x10, 0x1e, 0x0c, 0x08, 0xba, 0xde, 0x1e, 0x0c, 0xba, 0x32, 0x03, 0xe3, 0x36, 0xbe,
-		0xde, 0xfd, 0xa2, 0xae, 0x27, 0xbd, 0xa8, 0x2b, 0x3b, 0x30, 0x6c, 0xe4, 0x73, 0xe8, 0x0e, 0xbb,
-		0xd6, 0x7b, 0xc2, 0xae, 0x09, 0xfe, 0x67, 0x42, 0x06, 0x91, 0x18, 0x12, 0x77, 0xad, 0xf7, 0xc4,
-		0x5d, 0xb9, 0x21, 0x04, 0x87, 0x04, 0x5e, 0x7f, 0xab, 0x7f, 0xe0, 0x35, 0x38, 0x34, 0xe2, 0xc3,
-		0x1c, 0x2d, 0xf2, 0x52, 0x07, 0x44, 0x5e, 0x2c, 0x3a, 0x7a, 0x60, 0x20, 0xf9, 0x91, 0x43, 0xaf,
-		0xcd, 0x3e, 0xa1, 0x17, 0x0b, 0x92, 0x4e, 0x0d, 0x24, 0x3e, 0x42, 0xec, 0xb5, 0xd9, 0x27, 0xf6,
-		0x42, 0x43, 0xc9, 0x1e, 0x26, 0xf8, 0x1a, 0x97, 0x12, 0xf2, 0x69, 0xe2, 0xfa, 0x76, 0xe9, 0x29,
-		0xe2, 0x3f, 0x60, 0xdb, 0xb6, 0x6c, 0x71, 0xb3, 0x96, 0x16, 0xe4, 0x53, 0xc4, 0x19, 0xf7, 0x75,
-		0xd2, 0x01, 0x81, 0x1a, 0xf5, 0xd3, 0x02, 0x7a, 0x48, 0xfe, 0x52, 0xc4, 0xc7, 0xa5, 0x3e, 0x6c,
-		0xd0, 0x91, 0x4f, 0x73, 0x47, 0x3e, 0x10, 0xbe, 0x45, 0xc3, 0xe1, 0xdb, 0x1c, 0x64, 0x88, 0xff,
-		0xd5, 0x15, 0x99, 0x69, 0x6d, 0x2f, 0x32, 0x13, 0x57, 0x0a, 0x58, 0x90, 0xc7, 0xcd, 0x0a, 0x3b,
-		0xc9, 0xc9, 0x7b, 0xd7, 0x2b, 0x58, 0x4c, 0x81, 0x1e, 0x82, 0xa9, 0x00, 0xac, 0xe7, 0xd7, 0xb1,
-		0x30, 0x45, 0xf2, 0xa0, 0x8b, 0xdc, 0xc1, 0xfb, 0xd7, 0x11, 0x9f, 0x43, 0x7e, 0x48, 0xd7, 0x2f,
-		0xfa, 0x8a, 0xbc, 0x4e, 0xd1, 0x57, 0xf4, 0x8e, 0xa3, 0xaf, 0xa0, 0x9f, 0x1a, 0x0b, 0xfb, 0xa9,
-		0xff, 0x2b, 0xe2, 0xaf, 0x89, 0x17, 0x4b, 0xe9, 0x56, 0x03, 0x73, 0xcf, 0x91, 0xfe, 0x26, 0x4e,
-		0x45, 0xd3, 0xda, 0xe1, 0xfe, 0x21, 0xf9, 0x49, 0xa0, 0x3c, 0xc3, 0x91, 0xe6, 0x76, 0xc1, 0x73,
-		0x3a, 0xc7, 0x83, 0xf7, 0x7f, 0xf9, 0xa5, 0xd8, 0x84, 0x7f, 0x29, 0xd6, 0xfb, 0x76, 0x2d, 0x19,
-		0xf8, 0x76, 0x0d, 0x3d, 0x01, 0x69, 0x9a, 0x11, 0x55, 0xad, 0xb6, 0xf8, 0xa3, 0x32, 0x27, 0x06,
-		0x5f, 0x88, 0x75, 0xe8, 0x55, 0x3d, 0x76, 0x89, 0xd6, 0xf7, 0x18, 0xd2, 0x21, 0x8f, 0xe1, 0x2e,
-		0x48, 0x93, 0xd1, 0xb3, 0xc7, 0xd1, 0x81, 0x7f, 0xf8, 0x28, 0x2a, 0xe4, 0x77, 0x03, 0xea, 0x35,
-		0x12, 0xe8, 0x32, 0x24, 0xf0, 0x0d, 0xfa, 0x28, 0x1f, 0xbb, 0x68, 0x78, 0xb4, 0xd7, 0x35, 0x25,
-		0xcd, 0xa5, 0x19, 0xc2, 0xe4, 0x6f, 0xbc, 0x32, 0x27, 0x31, 0xe8, 0x07, 0xbd, 0x7b, 0xfe, 0x0a,
-		0xc7, 0x97, 0xff, 0x30, 0x4a, 0x02, 0x98, 0x90, 0x01, 0xe9, 0xcb, 0xdb, 0x7e, 0x47, 0x84, 0xa3,
-		0xf1, 0x7b, 0x16, 0x60, 0x47, 0x73, 0xd4, 0x9b, 0x9a, 0xe9, 0xe2, 0x06, 0x67, 0x7a, 0xa0, 0x06,
-		0x15, 0x20, 0x45, 0x4a, 0x1d, 0x07, 0x37, 0x78, 0x18, 0xed, 0x95, 0x03, 0xf3, 0x4c, 0x7e, 0x77,
-		0xf3, 0x0c, 0x73, 0x39, 0xd5, 0xc5, 0xe5, 0x40, 0x70, 0x91, 0x0e, 0x06, 0x17, 0xec, 0x62, 0x30,
-		0xbf, 0x9f, 0x08, 0x6c, 0x6c, 0xa2, 0x8c, 0xee, 0x85, 0x89, 0x16, 0x6e, 0xb5, 0x2d, 0xab, 0xa9,
-		0x32, 0x75, 0xc3, 0xfe, 0x0a, 0x42, 0x96, 0x57, 0x56, 0xa8, 0xd6, 0xf9, 0xe1, 0xa8, 0xbf, 0xff,
-		0xfc, 0x20, 0xf2, 0xfb, 0x8e, 0xc1, 0xf4, 0xd9, 0xe8, 0x6e, 0x17, 0x01, 0xd5, 0x82, 0xb7, 0x46,
-		0x3a, 0x54, 0x2d, 0x08, 0x81, 0x1e, 0x55, 0x7f, 0xf8, 0xb7, 0x4b, 0x58, 0xb5, 0x83, 0x9e, 0x83,
-		0x63, 0x5d, 0xba, 0xcd, 0x23, 0x1d, 0x1d, 0x55, 0xc5, 0x1d, 0x09, 0xab, 0x38, 0x41, 0xda, 0x67,
-		0x56, 0xec, 0xbb, 0xdc, 0x75, 0x55, 0xc8, 0x85, 0x3d, 0x9e, 0xbe, 0xcb, 0x4f, 0xff, 0xea, 0x8e,
-		0xab, 0x19, 0xa6, 0x1a, 0x4a, 0x07, 0x65, 0x59, 0x25, 0x4f, 0x32, 0x6d, 0xc0, 0x91, 0xbe, 0x9e,
-		0x0f, 0x7a, 0x1c, 0xd2, 0xbe, 0xd3, 0xc4, 0xb8, 0x7a, 0x40, 0xba, 0xc0, 0x87, 0x95, 0x7f, 0x3d,
-		0xe2, 0x93, 0x0c, 0x27, 0x20, 0x2a, 0x90, 0x60, 0xd7, 0xde, 0xf8, 0x65, 0x9a, 0x87, 0x46, 0xf3,
-		0x99, 0x16, 0xd8, 0x9d, 0x38, 0x85, 0x23, 0xcb, 0xef, 0x86, 0x04, 0xab, 0x41, 0x19, 0x48, 0xfa,
-		0xef, 0xe6, 0x02, 0x24, 0x8a, 0xe5, 0x72, 0x65, 0x43, 0x3c, 0x82, 0x59, 0x5a, 0x57, 0xea, 0x52,
-		0x94, 0x54, 0x2b, 0x95, 0x2b, 0x95, 0x72, 0x5d, 0x8a, 0xa1, 0x49, 0x98, 0x60, 0xbf, 0xd5, 0x4b,
-		0xeb, 0xca, 0x6a, 0xb1, 0x2e, 0xc5, 0x03, 0x55, 0xb5, 0xca, 0xda, 0x52, 0x45, 0x91, 0xc6, 0xe5,
-		0x47, 0xe0, 0xf8, 0x40, 0x2f, 0xcb, 0xcf, 0x2e, 0x44, 0x02, 0xd9, 0x05, 0xf9, 0xe5, 0x28, 0x14,
-		0x06, 0xbb, 0x4e, 0xe8, 0x4a, 0xd7, 0xc4, 0xcf, 0x1d, 0xc2, 0xef, 0xea, 0x9a, 0x3d, 0xba, 0x0f,
-		0x72, 0x36, 0xde, 0xc6, 0xae, 0xbe, 0xcb, 0x5c, 0x39, 0x66, 0x32, 0x27, 0x94, 0x09, 0x5e, 0x4b,
-		0x91, 0x1c, 0x06, 0xf6, 0x02, 0xd6, 0x5d, 0x95, 0xe9, 0x22, 0x87, 0xff, 0x61, 0xd0, 0x09, 0x56,
-		0x5b, 0x63, 0x95, 0xf2, 0x7b, 0x0e, 0xc5, 0xcb, 0x34, 0x8c, 0x2b, 0x95, 0xba, 0xf2, 0x9c, 0x14,
-		0x43, 0x08, 0x72, 0xf4, 0xa7, 0x5a, 0x5b, 0x2b, 0x6e, 0xd4, 0x2e, 0xaf, 0x13, 0x5e, 0x4e, 0x41,
-		0x5e, 0xf0, 0x52, 0x54, 0x8e, 0xcb, 0x0f, 0xc0, 0xb1, 0x01, 0x7e, 0x5f, 0x9f, 0x7b, 0x88, 0x9f,
-		0x88, 0x04, 0xa1, 0xc3, 0x31, 0xff, 0x3a, 0x24, 0x1c, 0x57, 0x73, 0x3b, 0x0e, 0x67, 0xe2, 0xe3,
-		0xa3, 0x3a, 0x82, 0x0b, 0xe2, 0x47, 0x8d, 0xa2, 0x2b, 0x9c, 0x8c, 0x7c, 0x1e, 0x72, 0xe1, 0x96,
-		0xc1, 0x3c, 0xf0, 0x85, 0x28, 0x2a, 0x3f, 0x07, 0x10, 0xc8, 0x47, 0x7a, 0x37, 0xba, 0x22, 0xc1,
-		0x1b, 0x5d, 0xe7, 0x61, 0xfc, 0x86, 0xc5, 0x74, 0x46, 0xff, 0x8d, 0x73, 0xcd, 0x72, 0x71, 0x20,
-		0xf9, 0xc0, 0xa0, 0x65, 0x03, 0x50, 0x6f, 0x4e, 0x68, 0x40, 0x17, 0x4f, 0x85, 0xbb, 0xb8, 0x67,
-		0x60, 0x76, 0xa9, 0x7f, 0x57, 0x2f, 0xc2, 0x38, 0xd5, 0x36, 0x7d, 0x3f, 0xf1, 0x79, 0x17, 0x80,
-		0xe6, 0xba, 0xb6, 0xb1, 0xd5, 0xf1, 0x3b, 0x98, 0xeb, 0xaf, 0xad, 0x8a, 0x02, 0xae, 0x74, 0x17,
-		0x57, 0x5b, 0xd3, 0x3e, 0x6a, 0x40, 0x75, 0x05, 0x08, 0xca, 0x6b, 0x90, 0x0b, 0xe3, 0xf6, 0xff,
-		0x64, 0xc9, 0xff, 0xf4, 0x3f, 0x2d, 0xdc, 0x27, 0xcf, 0xf9, 0xe2, 0xcf, 0x71, 0xd0, 0x82, 0x7c,
-		0x2b, 0x02, 0xa9, 0xfa, 0x1e, 0x97, 0xe3, 0x03, 0x2e, 0x55, 0xfa, 0xdf, 0x6d, 0x79, 0xc9, 0x42,
-		0x96, 0x8f, 0x8d, 0x79, 0x59, 0xde, 0xb7, 0x7b, 0x3b, 0x35, 0x3e, 0x6a, 0xb4, 0x2b, 0xb2, 0xdd,
-		0x5c, 0x3b, 0x5d, 0x1c, 0xed, 0x1b, 0x89, 0x69, 0x18, 0x0f, 0x7e, 0xdf, 0xc0, 0x0a, 0x72, 0x23,
-		0x70, 0x2d, 0x81, 0x99, 0x8d, 0xe0, 0xc7, 0x14, 0x91, 0x43, 0x7f, 0x4c, 0xe1, 0xf5, 0x12, 0x0d,
-		0xf6, 0x72, 0x03, 0x52, 0x42, 0x28, 0xd0, 0xdb, 0x82, 0x77, 0x4f, 0xc4, 0x19, 0xcd, 0x40, 0xe3,
-		0xc9, 0xc9, 0x07, 0xae, 0x9e, 0x9c, 0x81, 0x49, 0x7e, 0xe5, 0xce, 0x8f, 0x2b, 0xf8, 0x5f, 0x0c,
-		0xc8, 0xb3, 0x86, 0x15, 0x11, 0x54, 0xc8, 0x3f, 0x1b, 0x01, 0xa9, 0x5b, 0x2a, 0xdf, 0xcc, 0x01,
-		0x10, 0xa5, 0x48, 0xa4, 0x3f, 0xf0, 0x64, 0x37, 0x5b, 0xf9, 0x09, 0x52, 0xeb, 0x3f, 0xda, 0xfd,
-		0xbe, 0x28, 0x64, 0x02, 0x39, 0x3d, 0xf4, 0x58, 0xe8, 0x0a, 0xe8, 0xfc, 0x41, 0xf9, 0xbf, 0xc0,
-		0x1d, 0xd0, 0xd0, 0xc4, 0xa2, 0x87, 0x9f, 0xd8, 0xeb, 0x7f, 0x49, 0xbf, 0xff, 0xd7, 0x3e, 0xe3,
-		0x03, 0xbe, 0xf6, 0xf9, 0xa1, 0x08, 0xa4, 0x3c, 0xd3, 0x7d, 0xd8, 0x6c, 0xfe, 0x51, 0x48, 0x70,
-		0xeb, 0xc4, 0xd2, 0xf9, 0xbc, 0xd4, 0x37, 0x17, 0x5a, 0x80, 0x94, 0xf8, 0x3b, 0x4f, 0x3c, 0x10,
-		0xf5, 0xca, 0x67, 0x9e, 0x84, 0x4c, 0xe0, 0x60, 0x85, 0xe8, 0x89, 0xb5, 0xca, 0x33, 0xd2, 0x58,
-		0x21, 0x79, 0xeb, 0xf6, 0x7c, 0x6c, 0x0d, 0xdf, 0x24, 0x3b, 0x4c, 0xa9, 0x94, 0x2f, 0x57, 0xca,
-		0x57, 0xa5, 0x48, 0x21, 0x73, 0xeb, 0xf6, 0x7c, 0x52, 0xc1, 0x34, 0x7d, 0x75, 0xe6, 0x2a, 0xe4,
-		0xbb, 0x16, 0x26, 0xac, 0xdf, 0x11, 0xe4, 0x96, 0x36, 0x37, 0x56, 0xaa, 0xe5, 0x62, 0xbd, 0xa2,
-		0xb2, 0xeb, 0x74, 0xe8, 0x18, 0x4c, 0xad, 0x54, 0x97, 0x2f, 0xd7, 0xd5, 0xf2, 0x4a, 0xb5, 0xb2,
-		0x56, 0x57, 0x8b, 0xf5, 0x7a, 0xb1, 0x7c, 0x55, 0x8a, 0x9e, 0xfb, 0x0e, 0x40, 0xbe, 0x58, 0x2a,
-		0x57, 0x89, 0x7d, 0x36, 0xf8, 0xab, 0xeb, 0x65, 0x88, 0xd3, 0x54, 0xc0, 0x81, 0x57, 0x45, 0x0a,
-		0x07, 0xe7, 0x36, 0xd1, 0x25, 0x18, 0xa7, 0x59, 0x02, 0x74, 0xf0, 0xdd, 0x91, 0xc2, 0x90, 0x64,
-		0x27, 0x19, 0x0c, 0xdd, 0x4e, 0x07, 0x5e, 0x26, 0x29, 0x1c, 0x9c, 0xfb, 0x44, 0x0a, 0xa4, 0xfd,
-		0x28, 0x63, 0xf8, 0xe5, 0x8a, 0xc2, 0x08, 0xda, 0x11, 0xad, 0x40, 0x52, 0x04, 0x86, 0xc3, 0xae,
-		0x7b, 0x14, 0x86, 0x26, 0x27, 0x09, 0xbb, 0x58, 0x00, 0x7f, 0xf0, 0xdd, 0x95, 0xc2, 0x90, 0x4c,
-		0x2b, 0xaa, 0x7a, 0x97, 0xf0, 0x87, 0x5c, 0xe1, 0x28, 0x0c, 0x4b, 0x36, 0x12, 0xa6, 0xf9, 0xa9,
-		0x91, 0xe1, 0x37, 0x72, 0x0a, 0x23, 0x24, 0x91, 0xd1, 0x26, 0x40, 0x20, 0x5c, 0x1f, 0xe1, 0xaa,
-		0x4d, 0x61, 0x94, 0xe4, 0x30, 0x5a, 0x87, 0x94, 0x17, 0x3d, 0x0d, 0xbd, 0xf8, 0x52, 0x18, 0x9e,
-		0xa5, 0x45, 0xef, 0x86, 0x89, 0x70, 0xd4, 0x30, 0xda, 0x75, 0x96, 0xc2, 0x88, 0xe9, 0x57, 0x42,
-		0x3f, 0x1c, 0x42, 0x8c, 0x76, 0xbd, 0xa5, 0x30, 0x62, 0x36, 0x16, 0xbd, 0x00, 0x93, 0xbd, 0x2e,
-		0xfe, 0xe8, 0xb7, 0x5d, 0x0a, 0x87, 0xc8, 0xcf, 0xa2, 0x16, 0xa0, 0x3e, 0xa1, 0xc1, 0x21, 0x2e,
-		0xbf, 0x14, 0x0e, 0x93, 0xae, 0x45, 0x0d, 0xc8, 0x77, 0xfb, 0xdb, 0xa3, 0x5e, 0x86, 0x29, 0x8c,
-		0x9c, 0xba, 0x65, 0xbd, 0x84, 0xfd, 0xf4, 0x51, 0x2f, 0xc7, 0x14, 0x46, 0xce, 0xe4, 0x96, 0x8a,
-		0x03, 0xef, 0x37, 0x9e, 0x3c, 0xf0, 0x7e, 0xa3, 0x7f, 0x63, 0xd1, 0xbb, 0xd3, 0xf8, 0xd2, 0x39,
-		0x78, 0x0b, 0x7f, 0x4c, 0xc0, 0x71, 0xb5, 0xeb, 0x86, 0xb9, 0xe3, 0xbd, 0x0e, 0xc1, 0xcb, 0xfc,
-		0x72, 0xe3, 0x51, 0xfe, 0x60, 0x81, 0xa8, 0x1d, 0xf2, 0x46, 0xc4, 0xc0, 0x77, 0xaf, 0x86, 0x5d,
-		0x42, 0x1e, 0x7e, 0x75, 0xf1, 0x80, 0xf7, 0x27, 0x86, 0xbc, 0x72, 0xd1, 0xe7, 0x7d, 0x8a, 0x21,
-		0x97, 0x30, 0x0f, 0xba, 0xef, 0x29, 0x7f, 0x28, 0x02, 0xb9, 0xcb, 0x86, 0xe3, 0x5a, 0xb6, 0xa1,
-		0x6b, 0x4d, 0x6a, 0x31, 0x2e, 0x8e, 0xfa, 0x91, 0x47, 0x29, 0x4d, 0x9c, 0x11, 0xfe, 0xa8, 0x05,
-		0xbf, 0x18, 0xb2, 0x04, 0x89, 0x1b, 0x5a, 0x93, 0x7d, 0x62, 0x11, 0x7c, 0x7e, 0xa6, 0x9b, 0xe7,
-		0x01, 0x2f, 0x29, 0x48, 0x85, 0xe1, 0xca, 0x9f, 0xa5, 0x97, 0xb8, 0x5b, 0x2d, 0xc3, 0x61, 0x7f,
-		0x89, 0xdd, 0xc5, 0x0e, 0xda, 0x80, 0xb8, 0xad, 0xb9, 0x3c, 0xa8, 0x29, 0xbd, 0x95, 0x3f, 0x45,
-		0x71, 0xff, 0xf0, 0x07, 0x25, 0x16, 0x7a, 0x5f, 0xab, 0xa0, 0x94, 0xd0, 0x33, 0x90, 0x6a, 0x69,
-		0x7b, 0x2a, 0xa5, 0x1a, 0x7d, 0x1d, 0xa8, 0x26, 0x5b, 0xda, 0x1e, 0x19, 0x2b, 0xd9, 0x41, 0x84,
-		0xb0, 0xbe, 0xab, 0x99, 0x3b, 0x98, 0xd1, 0x8f, 0xbd, 0x0e, 0xf4, 0x27, 0x5a, 0xda, 0x5e, 0x99,
-		0xd2, 0x24, 0xbd, 0x2c, 0xa6, 0x5e, 0xfe, 0xf8, 0xdc, 0x18, 0xbd, 0x8b, 0xfc, 0x5b, 0x11, 0x1e,
-		0xbf, 0x52, 0x76, 0x21, 0x0d, 0x24, 0xdd, 0x2b, 0xd1, 0xee, 0x45, 0x2e, 0xff, 0xe4, 0xa0, 0xd5,
-		0xe8, 0x62, 0x76, 0x69, 0x82, 0x0c, 0xf4, 0x2b, 0xaf, 0xcc, 0x45, 0xd8, 0xba, 0xe4, 0xf5, 0xae,
-		0xc5, 0xb8, 0x02, 0x19, 0x96, 0x42, 0x53, 0xa9, 0xdf, 0x1a, 0x1d, 0xea, 0xb7, 0x4e, 0x08, 0xbf,
-		0x95, 0x11, 0x04, 0x86, 0x4d, 0xda, 0x03, 0xf3, 0xf8, 0x6c, 0x04, 0x32, 0x4b, 0x81, 0x67, 0xa6,
-		0x66, 0x20, 0xd9, 0xb2, 0x4c, 0xe3, 0x3a, 0xb6, 0xbd, 0x03, 0x19, 0x56, 0x24, 0xfe, 0x25, 0xfb,
-		0x6b, 0x45, 0xee, 0xbe, 0x78, 0x82, 0x41, 0x94, 0x09, 0xd6, 0x4d, 0xbc, 0xe5, 0x18, 0x82, 0xeb,
-		0x8a, 0x28, 0xa2, 0xd3, 0x20, 0x39, 0x58, 0xef, 0xd8, 0x86, 0xbb, 0xaf, 0xea, 0x96, 0xe9, 0x6a,
-		0xba, 0xcb, 0xd3, 0xa6, 0x79, 0x51, 0x5f, 0x66, 0xd5, 0x84, 0x48, 0x03, 0xbb, 0x9a, 0xd1, 0x64,
-		0xf7, 0xbd, 0xd2, 0x8a, 0x28, 0x06, 0x86, 0xfb, 0xab, 0xa9, 0x60, 0x5c, 0x58, 0x06, 0xc9, 0x6a,
-		0x63, 0x3b, 0xf4, 0xbd, 0x1b, 0x93, 0xd5, 0x99, 0xdf, 0xfb, 0xc2, 0x43, 0xd3, 0x9c, 0xf1, 0xfc,
-		0xf4, 0x9d, 0xbd, 0xac, 0xac, 0xe4, 0x05, 0x86, 0xf8, 0x10, 0xee, 0xb9, 0xd0, 0x31, 0x4c, 0x67,
-		0xcb, 0xff, 0xde, 0x7e, 0xba, 0x87, 0xb9, 0x45, 0x73, 0xbf, 0x34, 0xf3, 0x3b, 0x3e, 0x69, 0x3f,
-		0x6e, 0xbc, 0x8a, 0xf7, 0x83, 0x67, 0x32, 0x94, 0x0c, 0x71, 0xdf, 0x5f, 0xd0, 0x8c, 0xa6, 0xf8,
-		0xe3, 0x6e, 0x0a, 0x2f, 0xa1, 0x45, 0x2f, 0x07, 0x13, 0xa7, 0xb1, 0x90, 0x3c, 0x48, 0x46, 0x4a,
-		0x96, 0xd9, 0x08, 0xa7, 0x5b, 0x50, 0x1d, 0x12, 0xae, 0x75, 0x1d, 0x9b, 0x9c, 0x49, 0x87, 0x92,
-		0xef, 0xde, 0xc7, 0x67, 0x38, 0x2d, 0xb4, 0x03, 0x52, 0x03, 0x37, 0xf1, 0x0e, 0xfb, 0x5a, 0x6b,
-		0x57, 0xb3, 0x31, 0xfb, 0x82, 0xf3, 0xbb, 0xdd, 0x3f, 0x79, 0x8f, 0x6a, 0x8d, 0x12, 0x45, 0x1b,
-		0xe1, 0x87, 0xce, 0x92, 0xfc, 0x48, 0x7e, 0xc0, 0xfc, 0x03, 0x92, 0x19, 0xd4, 0x59, 0xa1, 0x87,
-		0xd1, 0x4e, 0x83, 0xd4, 0x31, 0xb7, 0x2c, 0x93, 0xfe, 0xbd, 0x24, 0x1e, 0x5d, 0xa5, 0xd8, 0xb1,
-		0x9d, 0x57, 0xcf, 0x8f, 0xed, 0x36, 0x20, 0xe7, 0x83, 0xd2, 0x5d, 0x94, 0x3e, 0xec, 0x2e, 0x9a,
-		0xf0, 0x08, 0x10, 0x10, 0xb4, 0x0a, 0xe0, 0xef, 0x53, 0xef, 0x68, 0x7f, 0xe8, 0x8e, 0x0f, 0x4e,
-		0x26, 0x40, 0x00, 0x35, 0x61, 0xaa, 0x65, 0x98, 0xaa, 0x83, 0x9b, 0xdb, 0x2a, 0xe7, 0x1c, 0xa1,
-		0x9b, 0x79, 0x1d, 0x56, 0x7a, 0xb2, 0x65, 0x98, 0x35, 0xdc, 0xdc, 0x5e, 0xf2, 0xc8, 0x22, 0x1d,
-		0x72, 0x44, 0x67, 0x06, 0x3a, 0xca, 0xbe, 0x0e, 0x1d, 0x11, 0x95, 0x19, 0xe8, 0x64, 0x06, 0x92,
-		0x6d, 0xdb, 0xda, 0xb2, 0x4c, 0x8b, 0xde, 0x19, 0x48, 0x29, 0xa2, 0x88, 0xde, 0x0a, 0x27, 0xfc,
-		0xd5, 0xb0, 0x4c, 0x75, 0xd7, 0x6a, 0x36, 0x54, 0x1b, 0x6f, 0xab, 0x3a, 0x7d, 0x7a, 0x29, 0x47,
-		0xd7, 0xf0, 0x98, 0x07, 0xb2, 0x6e, 0x5e, 0xb6, 0x9a, 0x0d, 0x05, 0x6f, 0x97, 0x49, 0x33, 0xba,
-		0x17, 0xfc, 0xa5, 0x50, 0x8d, 0x86, 0x33, 0x93, 0x9f, 0x8f, 0x9d, 0x8a, 0x2b, 0x59, 0xaf, 0xb2,
-		0xda, 0x70, 0x16, 0xb3, 0xef, 0xff, 0xf8, 0xdc, 0x18, 0x57, 0x1e, 0x63, 0xf2, 0x06, 0x7d, 0x2c,
-		0x84, 0xef, 0x7b, 0xec, 0xa0, 0x0b, 0x90, 0xd6, 0x44, 0x81, 0x7d, 0xa6, 0x72, 0x80, 0xde, 0xf0,
-		0x41, 0x99, 0x3a, 0x7a, 0xe9, 0x0f, 0xe7, 0x23, 0xf2, 0xcf, 0x44, 0x20, 0xb1, 0x74, 0x6d, 0x43,
-		0x33, 0x6c, 0x54, 0x81, 0x49, 0x7f, 0x07, 0x8d, 0xaa, 0x8c, 0xfc, 0x4d, 0x27, 0xb4, 0x51, 0x65,
-		0xd0, 0x37, 0xbc, 0x07, 0x92, 0xe9, 0xfe, 0xba, 0xb7, 0x6b, 0xe2, 0x57, 0x20, 0xc9, 0x46, 0xe9,
-		0xa0, 0xa7, 0x61, 0xbc, 0x4d, 0x7e, 0xf0, 0xb3, 0x88, 0xd9, 0x81, 0x3b, 0x8f, 0xc2, 0x07, 0xe5,
-		0x94, 0xe1, 0xc9, 0x7f, 0x15, 0x01, 0x58, 0xba, 0x76, 0xad, 0x6e, 0x1b, 0xed, 0x26, 0x76, 0x5f,
-		0xaf, 0x69, 0xaf, 0xc0, 0x91, 0xc0, 0xd7, 0xa2, 0xb6, 0x3e, 0xf2, 0xd4, 0xa7, 0xfc, 0xef, 0x45,
-		0x6d, 0xbd, 0x2f, 0xb5, 0x86, 0xe3, 0x7a, 0xd4, 0x62, 0x23, 0x53, 0x5b, 0x72, 0xdc, 0xfe, 0xbc,
-		0x7c, 0x16, 0x32, 0xfe, 0xf4, 0x1d, 0x54, 0x85, 0x94, 0xcb, 0x7f, 0x73, 0x96, 0xca, 0x83, 0x59,
-		0x2a, 0xd0, 0x82, 0x6c, 0xf5, 0xd0, 0xe5, 0xbf, 0x26, 0x9c, 0xf5, 0x37, 0xce, 0xf7, 0x94, 0x40,
-		0x11, 0xb3, 0xc3, 0xcd, 0xc2, 0xeb, 0xe1, 0x56, 0x71, 0x5a, 0x5d, 0xac, 0x7d, 0x7f, 0x14, 0xa6,
-		0x36, 0xc5, 0xf6, 0xfd, 0x9e, 0xe5, 0xc4, 0x26, 0x24, 0xb1, 0xe9, 0xda, 0x06, 0x16, 0x07, 0x90,
-		0x0f, 0x0f, 0x5a, 0xf0, 0x3e, 0x73, 0xa1, 0x7f, 0xb3, 0x3b, 0xb8, 0xfc, 0x82, 0x56, 0x17, 0x2b,
-		0x7e, 0x33, 0x06, 0x33, 0x83, 0xd0, 0xd1, 0x49, 0xc8, 0xeb, 0x36, 0xa6, 0x15, 0x6a, 0x28, 0x49,
-		0x9f, 0x13, 0xd5, 0xdc, 0xde, 0x29, 0x40, 0xbc, 0x48, 0x22, 0x5d, 0x04, 0xf4, 0xce, 0xdc, 0xc6,
-		0x9c, 0x4f, 0x81, 0x5a, 0x3c, 0x0c, 0x79, 0x71, 0xeb, 0x7e, 0x4b, 0x6b, 0x6a, 0xa6, 0x7e, 0x27,
-		0x8e, 0x76, 0xaf, 0xd5, 0x10, 0x57, 0xf9, 0x4b, 0x8c, 0x26, 0xba, 0x06, 0x49, 0x41, 0x3e, 0xfe,
-		0x3a, 0x90, 0x17, 0xc4, 0xd0, 0x3d, 0x90, 0x0d, 0x9a, 0x0d, 0xea, 0x44, 0xc5, 0x95, 0x4c, 0xc0,
-		0x6a, 0x0c, 0xb3, 0x4b, 0x89, 0x03, 0xed, 0x52, 0xc0, 0x57, 0xfd, 0x97, 0x31, 0x98, 0x54, 0x70,
-		0xe3, 0xfb, 0x70, 0xf1, 0xde, 0x09, 0xc0, 0x36, 0x38, 0x51, 0xbe, 0x77, 0xb0, 0x7e, 0xbd, 0x0a,
-		0x23, 0xcd, 0xe8, 0x2d, 0x39, 0xee, 0x9b, 0xb9, 0x82, 0xff, 0x21, 0x0a, 0xd9, 0xe0, 0x0a, 0x7e,
-		0x1f, 0x58, 0x3b, 0xb4, 0xe6, 0xab, 0x37, 0x76, 0x3f, 0xfe, 0xf4, 0x20, 0xf5, 0xd6, 0x23, 0xdb,
-		0x23, 0xe8, 0xb5, 0x0f, 0xc7, 0x20, 0xc1, 0x2f, 0xac, 0xad, 0xf7, 0x38, 0xe3, 0x91, 0x61, 0x5f,
-		0x29, 0x4f, 0x88, 0xaf, 0x94, 0xfb, 0xfa, 0xe2, 0xf7, 0x31, 0x77, 0x36, 0x74, 0x0b, 0x2e, 0x72,
-		0x6a, 0x82, 0x3a, 0xa4, 0xfe, 0x9d, 0x6d, 0x34, 0x07, 0x19, 0x02, 0xe6, 0xeb, 0x70, 0x02, 0x03,
-		0x2d, 0x6d, 0xaf, 0xc2, 0x6a, 0xd0, 0x43, 0x80, 0x76, 0xbd, 0xf4, 0x8c, 0xea, 0x33, 0x83, 0xc0,
-		0x4d, 0xfa, 0x2d, 0x02, 0xfc, 0x6e, 0x00, 0x32, 0x0a, 0x95, 0xbd, 0x23, 0xca, 0x1f, 0x77, 0x25,
-		0x35, 0x4b, 0xf4, 0x2d, 0xd1, 0x1f, 0x60, 0x2e, 0x7d, 0x57, 0x76, 0x80, 0x07, 0x57, 0x2b, 0x87,
-		0xdb, 0x14, 0xdf, 0x7e, 0x65, 0xae, 0xb0, 0xaf, 0xb5, 0x9a, 0x8b, 0x72, 0x1f, 0x92, 0x32, 0x75,
-		0xf1, 0xc3, 0x59, 0x85, 0xc5, 0x53, 0x42, 0x96, 0x6f, 0x7d, 0xed, 0x73, 0x67, 0x4e, 0x04, 0x68,
-		0xee, 0x79, 0x79, 0x3b, 0xb6, 0x1c, 0xf2, 0x2f, 0x44, 0x00, 0xf9, 0x86, 0xc6, 0xbb, 0xa5, 0xbe,
-		0x4a, 0xef, 0x2e, 0x8b, 0xf8, 0x20, 0x72, 0x70, 0x80, 0xe3, 0xe3, 0x87, 0x02, 0x9c, 0xc0, 0x06,
-		0x7a, 0x9b, 0xaf, 0xd6, 0xc5, 0x37, 0xe9, 0x7d, 0x5e, 0x90, 0x5d, 0x28, 0x5b, 0x46, 0x88, 0x84,
-		0x40, 0xf2, 0xf6, 0xe6, 0x98, 0xfc, 0x4a, 0x04, 0x8e, 0xf7, 0x48, 0xa0, 0x37, 0x6c, 0x1d, 0x90,
-		0x1d, 0x68, 0xa4, 0xab, 0x28, 0x4e, 0x7e, 0xef, 0x4c, 0xa0, 0x27, 0xed, 0x1e, 0x55, 0xfe, 0x06,
-		0xd9, 0x28, 0xfe, 0x96, 0xec, 0x6f, 0x47, 0x60, 0x3a, 0x38, 0x22, 0x6f, 0x6e, 0x35, 0xc8, 0x06,
-		0xc7, 0xc2, 0x67, 0xf5, 0x96, 0x51, 0x66, 0x15, 0x9c, 0x50, 0x88, 0x08, 0x99, 0x8b, 0x90, 0x74,
-		0x96, 0x45, 0x7c, 0x64, 0x64, 0x2e, 0x79, 0x27, 0x24, 0xfd, 0xb6, 0x7f, 0x9c, 0x2e, 0xd6, 0x07,
-		0xa2, 0x10, 0xdf, 0xb0, 0xac, 0x26, 0xfa, 0xa1, 0x08, 0x4c, 0x9a, 0x96, 0xab, 0x92, 0xfd, 0x81,
-		0x1b, 0x2a, 0xcf, 0x64, 0x30, 0x0d, 0x7a, 0xed, 0x70, 0xdc, 0xfb, 0xc6, 0x2b, 0x73, 0xbd, 0xa4,
-		0xfa, 0x3d, 0xfe, 0x9b, 0x37, 0x2d, 0xb7, 0x44, 0x81, 0xea, 0x2c, 0xd9, 0x71, 0x13, 0x26, 0xc2,
-		0xfd, 0x33, 0xb5, 0xab, 0x1c, 0xba, 0xff, 0x89, 0xa1, 0x7d, 0x67, 0xb7, 0x02, 0x1d, 0xb3, 0x27,
-		0x38, 0xff, 0x9c, 0x2c, 0xee, 0x73, 0x20, 0x5d, 0xeb, 0xbe, 0x33, 0x57, 0x81, 0xe4, 0x61, 0xaf,
-		0xdf, 0x05, 0x39, 0xce, 0x71, 0xcf, 0x7c, 0x31, 0x02, 0xe0, 0xe7, 0x8d, 0xd0, 0x83, 0x70, 0xac,
-		0xb4, 0xbe, 0xb6, 0xa4, 0xd6, 0xea, 0xc5, 0xfa, 0x66, 0x2d, 0xfc, 0xe0, 0xbb, 0x78, 0x25, 0xc5,
-		0x69, 0x63, 0xdd, 0xd8, 0x36, 0x70, 0x03, 0xdd, 0x0f, 0xd3, 0x61, 0x68, 0x52, 0xaa, 0x2c, 0x49,
-		0x91, 0x42, 0xf6, 0xd6, 0xed, 0xf9, 0x14, 0x73, 0x49, 0x71, 0x03, 0x9d, 0x82, 0x23, 0xbd, 0x70,
-		0xd5, 0xb5, 0x65, 0x29, 0x5a, 0x98, 0xb8, 0x75, 0x7b, 0x3e, 0xed, 0xf9, 0xae, 0x48, 0x06, 0x14,
-		0x84, 0xe4, 0xf4, 0x62, 0x05, 0xb8, 0x75, 0x7b, 0x3e, 0xc1, 0x96, 0xa5, 0x10, 0x7f, 0xff, 0x27,
-		0x67, 0xc7, 0xce, 0xbc, 0x0b, 0xa0, 0x6a, 0x6e, 0xdb, 0x1a, 0xfd, 0xab, 0xc7, 0xa8, 0x00, 0x47,
-		0xab, 0x6b, 0x97, 0x94, 0x62, 0xb9, 0x5e, 0x5d, 0x5f, 0xeb, 0x7a, 0xa7, 0x3e, 0xdc, 0xb6, 0xb4,
-		0xbe, 0x59, 0x5a, 0xa9, 0xa8, 0xb5, 0xea, 0xf2, 0x1a, 0x3b, 0x7b, 0x0e, 0xb5, 0x3d, 0xb3, 0x56,
-		0xaf, 0xae, 0x56, 0xa4, 0x68, 0xe9, 0xd2, 0xc0, 0xd3, 0x8f, 0x07, 0x0f, 0x5c, 0x70, 0x5f, 0x53,
-		0x86, 0x8e, 0x40, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x74, 0xee, 0x8e, 0x3e, 0xfb, 0x9a,
-		0x00, 0x00,
+		// 12456 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x69, 0x90, 0x5c, 0x47,
+		0x72, 0x18, 0x8c, 0xbe, 0xbb, 0xb3, 0xaf, 0x37, 0x35, 0x03, 0xa0, 0xd1, 0x20, 0x81, 0x61, 0x73,
+		0x49, 0x82, 0x20, 0x39, 0x20, 0x41, 0x02, 0x24, 0x86, 0xcb, 0xa5, 0xfa, 0x02, 0xd0, 0xe0, 0x4c,
+		0xcf, 0xec, 0xeb, 0x19, 0xf0, 0x90, 0xb4, 0x6f, 0xdf, 0x74, 0xd7, 0xcc, 0x34, 0xd1, 0xfd, 0x5e,
+		0x6f, 0xbf, 0xd7, 0x00, 0x86, 0xf1, 0x7d, 0x0a, 0xae, 0x56, 0xc7, 0x8a, 0x3a, 0xbe, 0xd5, 0x27,
+		0x85, 0x44, 0x1d, 0x90, 0xb4, 0xba, 0x76, 0x25, 0x4b, 0xb6, 0xa4, 0x95, 0x65, 0x49, 0x0e, 0xeb,
+		0x70, 0x58, 0xb6, 0x24, 0x2b, 0xec, 0x95, 0xc2, 0x87, 0xac, 0x90, 0x69, 0x9b, 0xab, 0x58, 0x72,
+		0x0f, 0x59, 0x87, 0xa9, 0xf0, 0xb1, 0x21, 0xaf, 0x23, 0xeb, 0x78, 0x47, 0x1f, 0xd3, 0x3d, 0x58,
+		0xec, 0xc6, 0x3a, 0xf4, 0x07, 0x98, 0xaa, 0xca, 0xcc, 0xaa, 0xca, 0xca, 0x97, 0x95, 0x99, 0x95,
+		0x55, 0x0d, 0x5f, 0x78, 0x1f, 0x2c, 0xee, 0x98, 0xe6, 0x4e, 0x87, 0x9e, 0xe9, 0xf5, 0x4d, 0xdb,
+		0xdc, 0x1a, 0x6c, 0x9f, 0x69, 0x51, 0xab, 0xd9, 0x6f, 0xf7, 0x6c, 0xb3, 0xbf, 0xc4, 0xea, 0x48,
+		0x96, 0x43, 0x2c, 0x49, 0x88, 0xc2, 0x2a, 0xcc, 0x5d, 0x6c, 0x77, 0x68, 0xc5, 0x01, 0x6c, 0x50,
+		0x9b, 0x3c, 0x05, 0xe1, 0xed, 0x76, 0x87, 0xe6, 0x02, 0x8b, 0xa1, 0x53, 0xc9, 0xb3, 0xef, 0x5a,
+		0x1a, 0x42, 0x5a, 0xf2, 0x63, 0xac, 0x63, 0xb5, 0xca, 0x30, 0x0a, 0xaf, 0x47, 0x60, 0x7e, 0x4c,
+		0x2b, 0x21, 0x10, 0x36, 0xf4, 0x2e, 0x52, 0x0c, 0x9c, 0x4a, 0xa8, 0xec, 0x6f, 0x92, 0x83, 0x58,
+		0x4f, 0x6f, 0x5e, 0xd3, 0x77, 0x68, 0x2e, 0xc8, 0xaa, 0x65, 0x91, 0x9c, 0x00, 0x68, 0xd1, 0x1e,
+		0x35, 0x5a, 0xd4, 0x68, 0xee, 0xe5, 0x42, 0x8b, 0xa1, 0x53, 0x09, 0xd5, 0x53, 0x43, 0x1e, 0x82,
+		0xb9, 0xde, 0x60, 0xab, 0xd3, 0x6e, 0x6a, 0x1e, 0x30, 0x58, 0x0c, 0x9d, 0x8a, 0xa8, 0x0a, 0x6f,
+		0xa8, 0xb8, 0xc0, 0x0f, 0x40, 0xf6, 0x06, 0xd5, 0xaf, 0x79, 0x41, 0x93, 0x0c, 0x34, 0x83, 0xd5,
+		0x1e, 0xc0, 0x32, 0xa4, 0xba, 0xd4, 0xb2, 0xf4, 0x1d, 0xaa, 0xd9, 0x7b, 0x3d, 0x9a, 0x0b, 0xb3,
+		0xd9, 0x2f, 0x8e, 0xcc, 0x7e, 0x78, 0xe6, 0x49, 0x81, 0xb5, 0xb1, 0xd7, 0xa3, 0xa4, 0x08, 0x09,
+		0x6a, 0x0c, 0xba, 0x9c, 0x42, 0x64, 0x02, 0xff, 0xaa, 0xc6, 0xa0, 0x3b, 0x4c, 0x25, 0x8e, 0x68,
+		0x82, 0x44, 0xcc, 0xa2, 0xfd, 0xeb, 0xed, 0x26, 0xcd, 0x45, 0x19, 0x81, 0x07, 0x46, 0x08, 0x34,
+		0x78, 0xfb, 0x30, 0x0d, 0x89, 0x47, 0xca, 0x90, 0xa0, 0x37, 0x6d, 0x6a, 0x58, 0x6d, 0xd3, 0xc8,
+		0xc5, 0x18, 0x91, 0xfb, 0xc6, 0xac, 0x22, 0xed, 0xb4, 0x86, 0x49, 0xb8, 0x78, 0xe4, 0x3c, 0xc4,
+		0xcc, 0x9e, 0xdd, 0x36, 0x0d, 0x2b, 0x17, 0x5f, 0x0c, 0x9c, 0x4a, 0x9e, 0xbd, 0x6b, 0xac, 0x20,
+		0xac, 0x71, 0x18, 0x55, 0x02, 0x93, 0x1a, 0x28, 0x96, 0x39, 0xe8, 0x37, 0xa9, 0xd6, 0x34, 0x5b,
+		0x54, 0x6b, 0x1b, 0xdb, 0x66, 0x2e, 0xc1, 0x08, 0x9c, 0x1c, 0x9d, 0x08, 0x03, 0x2c, 0x9b, 0x2d,
+		0x5a, 0x33, 0xb6, 0x4d, 0x35, 0x63, 0xf9, 0xca, 0xe4, 0x08, 0x44, 0xad, 0x3d, 0xc3, 0xd6, 0x6f,
+		0xe6, 0x52, 0x4c, 0x42, 0x44, 0x89, 0x9c, 0x85, 0x18, 0x6d, 0xb5, 0xb1, 0xbb, 0x5c, 0x66, 0x31,
+		0x70, 0x2a, 0x73, 0x36, 0x37, 0xca, 0x63, 0xde, 0xae, 0x4a, 0xc0, 0xc2, 0xaf, 0x45, 0x21, 0x3b,
+		0x8b, 0x58, 0x3e, 0x0d, 0x91, 0x6d, 0xe4, 0x4c, 0x2e, 0x78, 0x10, 0xbe, 0x71, 0x1c, 0x3f, 0xe3,
+		0xa3, 0xb7, 0xc9, 0xf8, 0x22, 0x24, 0x0d, 0x6a, 0xd9, 0xb4, 0xc5, 0xa5, 0x28, 0x34, 0xa3, 0x1c,
+		0x02, 0x47, 0x1a, 0x15, 0xc3, 0xf0, 0x6d, 0x89, 0xe1, 0x0b, 0x90, 0x75, 0x86, 0xa4, 0xf5, 0x75,
+		0x63, 0x47, 0xca, 0xf3, 0x99, 0x69, 0x23, 0x59, 0xaa, 0x4a, 0x3c, 0x15, 0xd1, 0xd4, 0x0c, 0xf5,
+		0x95, 0x49, 0x05, 0xc0, 0x34, 0xa8, 0xb9, 0xad, 0xb5, 0x68, 0xb3, 0x93, 0x8b, 0x4f, 0xe0, 0xd2,
+		0x1a, 0x82, 0x8c, 0x70, 0xc9, 0xe4, 0xb5, 0xcd, 0x0e, 0xb9, 0xe0, 0x8a, 0x67, 0x6c, 0x82, 0x74,
+		0xad, 0xf2, 0x0f, 0x73, 0x44, 0x42, 0x37, 0x21, 0xd3, 0xa7, 0xf8, 0xad, 0xd0, 0x96, 0x98, 0x59,
+		0x82, 0x0d, 0x62, 0x69, 0xea, 0xcc, 0x54, 0x81, 0xc6, 0x27, 0x96, 0xee, 0x7b, 0x8b, 0xe4, 0x5e,
+		0x70, 0x2a, 0x34, 0x26, 0x56, 0xc0, 0x34, 0x57, 0x4a, 0x56, 0xd6, 0xf5, 0x2e, 0xcd, 0xbf, 0x02,
+		0x19, 0x3f, 0x7b, 0xc8, 0x02, 0x44, 0x2c, 0x5b, 0xef, 0xdb, 0x4c, 0x0a, 0x23, 0x2a, 0x2f, 0x10,
+		0x05, 0x42, 0xd4, 0x68, 0x31, 0xcd, 0x18, 0x51, 0xf1, 0x4f, 0xf2, 0x35, 0xee, 0x84, 0x43, 0x6c,
+		0xc2, 0xf7, 0x8f, 0xae, 0xa8, 0x8f, 0xf2, 0xf0, 0xbc, 0xf3, 0x4f, 0x42, 0xda, 0x37, 0x81, 0x59,
+		0xbb, 0x2e, 0xfc, 0x41, 0x18, 0x0e, 0x8f, 0xa5, 0x4d, 0x5e, 0x80, 0x85, 0x81, 0xd1, 0x36, 0x6c,
+		0xda, 0xef, 0xf5, 0x29, 0x8a, 0x2c, 0xef, 0x2b, 0xf7, 0x56, 0x6c, 0x82, 0xd0, 0x6d, 0x7a, 0xa1,
+		0x39, 0x15, 0x75, 0x7e, 0x30, 0x5a, 0x49, 0x5e, 0x84, 0x24, 0xca, 0x87, 0xde, 0xd7, 0x19, 0x41,
+		0xfe, 0x35, 0x9e, 0x9d, 0x6d, 0xca, 0x4b, 0x15, 0x17, 0xb3, 0x14, 0xfa, 0x70, 0x20, 0xa8, 0x7a,
+		0x69, 0x91, 0x27, 0x21, 0xbe, 0x4d, 0x75, 0x7b, 0xd0, 0xa7, 0x56, 0xee, 0x2c, 0x63, 0xe5, 0xf1,
+		0xd1, 0x8f, 0x94, 0x03, 0x34, 0xa8, 0xad, 0x3a, 0xc0, 0xa4, 0x0b, 0xa9, 0xeb, 0xb4, 0xdf, 0xde,
+		0x6e, 0x37, 0xf9, 0xa0, 0x42, 0x4c, 0xf9, 0x3c, 0x35, 0xe3, 0xa0, 0xae, 0x7a, 0x50, 0x1b, 0xb6,
+		0x6e, 0xd3, 0x65, 0xd8, 0xac, 0x5f, 0xad, 0xaa, 0xb5, 0x8b, 0xb5, 0x6a, 0x85, 0x0f, 0xd3, 0x47,
+		0x3e, 0xff, 0xbd, 0x01, 0x48, 0x7a, 0x66, 0x82, 0xea, 0xd0, 0x18, 0x74, 0xb7, 0x68, 0x5f, 0xac,
+		0x97, 0x28, 0x91, 0xe3, 0x90, 0xd8, 0x1e, 0x74, 0x3a, 0x5c, 0xe8, 0xf8, 0x5e, 0x1a, 0xc7, 0x0a,
+		0x14, 0x38, 0xd4, 0x71, 0x42, 0x8d, 0x30, 0x1d, 0x87, 0x7f, 0x93, 0x3c, 0xc4, 0xa5, 0x50, 0xe6,
+		0x22, 0x8b, 0x81, 0x53, 0x71, 0xd5, 0x29, 0xf3, 0xb6, 0x1e, 0xd5, 0x6d, 0xda, 0xca, 0x45, 0x65,
+		0x1b, 0x2f, 0x5f, 0x09, 0xc7, 0xc3, 0x4a, 0xa4, 0xf0, 0x04, 0xcc, 0x8d, 0x4c, 0x85, 0x64, 0x21,
+		0x59, 0xa9, 0x96, 0x57, 0x8a, 0x6a, 0x71, 0xa3, 0xb6, 0x56, 0x57, 0x0e, 0x91, 0x0c, 0x78, 0x66,
+		0xa7, 0x04, 0x4e, 0x27, 0xe2, 0x6f, 0xc7, 0x94, 0x57, 0x5f, 0x7d, 0xf5, 0xd5, 0x60, 0xe1, 0xb7,
+		0xa3, 0xb0, 0x30, 0x4e, 0x09, 0x8e, 0xd5, 0xc7, 0xee, 0xa4, 0x43, 0xbe, 0x49, 0x17, 0x21, 0xd2,
+		0xd1, 0xb7, 0x68, 0x27, 0x17, 0x66, 0x8b, 0xf0, 0xd0, 0x4c, 0x6a, 0x76, 0x69, 0x05, 0x51, 0x54,
+		0x8e, 0x49, 0xde, 0x23, 0x58, 0x13, 0x61, 0x14, 0x4e, 0xcf, 0x46, 0x01, 0x95, 0xa3, 0x60, 0xe3,
+		0x71, 0x48, 0xe0, 0xff, 0x9c, 0xef, 0x51, 0xce, 0x77, 0xac, 0x60, 0x7c, 0xcf, 0x43, 0x9c, 0xe9,
+		0xbd, 0x16, 0x75, 0xd6, 0x44, 0x96, 0x51, 0x53, 0xb4, 0xe8, 0xb6, 0x3e, 0xe8, 0xd8, 0xda, 0x75,
+		0xbd, 0x33, 0xa0, 0x4c, 0x83, 0x25, 0xd4, 0x94, 0xa8, 0xbc, 0x8a, 0x75, 0xe4, 0x24, 0x24, 0xb9,
+		0x9a, 0x6c, 0x1b, 0x2d, 0x7a, 0x93, 0x6d, 0xa1, 0x11, 0x95, 0x6b, 0xce, 0x1a, 0xd6, 0x60, 0xf7,
+		0x2f, 0x5b, 0xa6, 0x21, 0x75, 0x0d, 0xeb, 0x02, 0x2b, 0x58, 0xf7, 0x4f, 0x0e, 0xef, 0xde, 0x77,
+		0x8f, 0x9f, 0xde, 0x88, 0x72, 0x7c, 0x00, 0xb2, 0x0c, 0xe2, 0x71, 0xf1, 0x29, 0xeb, 0x9d, 0xdc,
+		0x1c, 0x13, 0x83, 0x0c, 0xaf, 0x5e, 0x13, 0xb5, 0x85, 0x5f, 0x09, 0x42, 0x98, 0xed, 0x14, 0x59,
+		0x48, 0x6e, 0xbc, 0xb8, 0x5e, 0xd5, 0x2a, 0x6b, 0x9b, 0xa5, 0x95, 0xaa, 0x12, 0xc0, 0xa5, 0x67,
+		0x15, 0x17, 0x57, 0xd6, 0x8a, 0x1b, 0x4a, 0xd0, 0x29, 0xd7, 0xea, 0x1b, 0xe7, 0x9f, 0x50, 0x42,
+		0x0e, 0xc2, 0x26, 0xaf, 0x08, 0x7b, 0x01, 0x1e, 0x3f, 0xab, 0x44, 0x88, 0x02, 0x29, 0x4e, 0xa0,
+		0xf6, 0x42, 0xb5, 0x72, 0xfe, 0x09, 0x25, 0xea, 0xaf, 0x79, 0xfc, 0xac, 0x12, 0x23, 0x69, 0x48,
+		0xb0, 0x9a, 0xd2, 0xda, 0xda, 0x8a, 0x12, 0x77, 0x68, 0x36, 0x36, 0xd4, 0x5a, 0xfd, 0x92, 0x92,
+		0x70, 0x68, 0x5e, 0x52, 0xd7, 0x36, 0xd7, 0x15, 0x70, 0x28, 0xac, 0x56, 0x1b, 0x8d, 0xe2, 0xa5,
+		0xaa, 0x92, 0x74, 0x20, 0x4a, 0x2f, 0x6e, 0x54, 0x1b, 0x4a, 0xca, 0x37, 0xac, 0xc7, 0xcf, 0x2a,
+		0x69, 0xa7, 0x8b, 0x6a, 0x7d, 0x73, 0x55, 0xc9, 0x90, 0x39, 0x48, 0xf3, 0x2e, 0xe4, 0x20, 0xb2,
+		0x43, 0x55, 0xe7, 0x9f, 0x50, 0x14, 0x77, 0x20, 0x9c, 0xca, 0x9c, 0xaf, 0xe2, 0xfc, 0x13, 0x0a,
+		0x29, 0x94, 0x21, 0xc2, 0xc4, 0x90, 0x10, 0xc8, 0xac, 0x14, 0x4b, 0xd5, 0x15, 0x6d, 0x6d, 0x1d,
+		0x3f, 0x9a, 0xe2, 0x8a, 0x12, 0x70, 0xeb, 0xd4, 0xea, 0x7a, 0xb5, 0xb8, 0x51, 0xad, 0x28, 0x21,
+		0x6f, 0xdd, 0x7b, 0x37, 0x6b, 0x6a, 0xb5, 0xa2, 0x04, 0x0b, 0x4d, 0x58, 0x18, 0xb7, 0x43, 0x8e,
+		0xfd, 0x84, 0x3c, 0xb2, 0x10, 0x9c, 0x20, 0x0b, 0x8c, 0xd6, 0xb0, 0x2c, 0x14, 0x3e, 0x15, 0x84,
+		0xf9, 0x31, 0x56, 0xc2, 0xd8, 0x4e, 0x9e, 0x85, 0x08, 0x97, 0x65, 0xae, 0xa9, 0x1f, 0x1c, 0x6b,
+		0x6e, 0x30, 0xc9, 0x1e, 0xb1, 0x9d, 0x18, 0x9e, 0xd7, 0xde, 0x0c, 0x4d, 0xb0, 0x37, 0x91, 0xc4,
+		0x88, 0xc0, 0x7e, 0xfd, 0xc8, 0x6e, 0xce, 0x0d, 0x9e, 0xf3, 0xb3, 0x18, 0x3c, 0xac, 0xee, 0x60,
+		0xbb, 0x7a, 0x64, 0xcc, 0xae, 0xfe, 0x34, 0xcc, 0x8d, 0x10, 0x9a, 0x79, 0x77, 0xfd, 0x50, 0x00,
+		0x72, 0x93, 0x98, 0x33, 0x45, 0x25, 0x06, 0x7d, 0x2a, 0xf1, 0xe9, 0x61, 0x0e, 0xde, 0x33, 0x79,
+		0x11, 0x46, 0xd6, 0xfa, 0x63, 0x01, 0x38, 0x32, 0xde, 0xaf, 0x18, 0x3b, 0x86, 0xf7, 0x40, 0xb4,
+		0x4b, 0xed, 0x5d, 0x53, 0xda, 0xc9, 0xf7, 0x8f, 0xb1, 0xbe, 0xb0, 0x79, 0x78, 0xb1, 0x05, 0x16,
+		0xb9, 0x30, 0x3c, 0xd6, 0x93, 0x93, 0xbc, 0x9c, 0x91, 0x91, 0x7e, 0x5b, 0x10, 0x0e, 0x8f, 0x25,
+		0x3e, 0x76, 0xa0, 0x77, 0x03, 0xb4, 0x8d, 0xde, 0xc0, 0xe6, 0xb6, 0x30, 0xd7, 0xc4, 0x09, 0x56,
+		0xc3, 0x94, 0x17, 0x6a, 0xd9, 0x81, 0xed, 0xb4, 0xf3, 0x5d, 0x12, 0x78, 0x15, 0x03, 0x78, 0xca,
+		0x1d, 0x68, 0x98, 0x0d, 0xf4, 0xc4, 0x84, 0x99, 0x8e, 0x08, 0xe6, 0xa3, 0xa0, 0x34, 0x3b, 0x6d,
+		0x6a, 0xd8, 0x9a, 0x65, 0xf7, 0xa9, 0xde, 0x6d, 0x1b, 0x3b, 0x7c, 0xb7, 0x5d, 0x8e, 0x6c, 0xeb,
+		0x1d, 0x8b, 0xaa, 0x59, 0xde, 0xdc, 0x90, 0xad, 0x88, 0xc1, 0x04, 0xa8, 0xef, 0xc1, 0x88, 0xfa,
+		0x30, 0x78, 0xb3, 0x83, 0x51, 0xf8, 0xfe, 0x04, 0x24, 0x3d, 0x5e, 0x18, 0xb9, 0x07, 0x52, 0x2f,
+		0xeb, 0xd7, 0x75, 0x4d, 0x7a, 0xd6, 0x9c, 0x13, 0x49, 0xac, 0x5b, 0xe7, 0x55, 0xe4, 0x51, 0x58,
+		0x60, 0x20, 0xe6, 0xc0, 0xa6, 0x7d, 0xad, 0xd9, 0xd1, 0x2d, 0x8b, 0x31, 0x2d, 0xce, 0x40, 0x09,
+		0xb6, 0xad, 0x61, 0x53, 0x59, 0xb6, 0x90, 0x73, 0x30, 0xcf, 0x30, 0xba, 0x83, 0x8e, 0xdd, 0xee,
+		0x75, 0xa8, 0x86, 0xbe, 0xbe, 0x95, 0x03, 0xef, 0xc8, 0xe6, 0x10, 0x62, 0x55, 0x00, 0xe0, 0x88,
+		0x2c, 0x52, 0x81, 0xbb, 0x19, 0xda, 0x0e, 0x35, 0x68, 0x5f, 0xb7, 0xa9, 0x46, 0x3f, 0x30, 0xd0,
+		0x3b, 0x96, 0xa6, 0x1b, 0x2d, 0x6d, 0x57, 0xb7, 0x76, 0x73, 0x0b, 0x48, 0xa0, 0x14, 0xcc, 0x05,
+		0xd4, 0x63, 0x08, 0x78, 0x49, 0xc0, 0x55, 0x19, 0x58, 0xd1, 0x68, 0x5d, 0xd6, 0xad, 0x5d, 0xb2,
+		0x0c, 0x47, 0x18, 0x15, 0xcb, 0xee, 0xb7, 0x8d, 0x1d, 0xad, 0xb9, 0x4b, 0x9b, 0xd7, 0xb4, 0x81,
+		0xbd, 0xfd, 0x54, 0xee, 0xb8, 0xb7, 0x7f, 0x36, 0xc2, 0x06, 0x83, 0x29, 0x23, 0xc8, 0xa6, 0xbd,
+		0xfd, 0x14, 0x69, 0x40, 0x0a, 0x17, 0xa3, 0xdb, 0x7e, 0x85, 0x6a, 0xdb, 0x66, 0x9f, 0xed, 0xa1,
+		0x99, 0x31, 0xaa, 0xc9, 0xc3, 0xc1, 0xa5, 0x35, 0x81, 0xb0, 0x6a, 0xb6, 0xe8, 0x72, 0xa4, 0xb1,
+		0x5e, 0xad, 0x56, 0xd4, 0xa4, 0xa4, 0x72, 0xd1, 0xec, 0xa3, 0x40, 0xed, 0x98, 0x0e, 0x83, 0x93,
+		0x5c, 0xa0, 0x76, 0x4c, 0xc9, 0xde, 0x73, 0x30, 0xdf, 0x6c, 0xf2, 0x39, 0xb7, 0x9b, 0x9a, 0xf0,
+		0xc8, 0xad, 0x9c, 0xe2, 0x63, 0x56, 0xb3, 0x79, 0x89, 0x03, 0x08, 0x19, 0xb7, 0xc8, 0x05, 0x38,
+		0xec, 0x32, 0xcb, 0x8b, 0x38, 0x37, 0x32, 0xcb, 0x61, 0xd4, 0x73, 0x30, 0xdf, 0xdb, 0x1b, 0x45,
+		0x24, 0xbe, 0x1e, 0x7b, 0x7b, 0xc3, 0x68, 0xf7, 0xb1, 0x28, 0x4b, 0x9f, 0x36, 0x99, 0xa9, 0x77,
+		0xd4, 0x0b, 0xed, 0x69, 0x20, 0x4b, 0xa0, 0x34, 0x9b, 0x1a, 0x35, 0xf4, 0xad, 0x0e, 0xd5, 0xf4,
+		0x3e, 0x35, 0x74, 0x2b, 0x77, 0x92, 0x01, 0x87, 0xed, 0xfe, 0x80, 0xaa, 0x99, 0x66, 0xb3, 0xca,
+		0x1a, 0x8b, 0xac, 0x8d, 0x9c, 0x86, 0x39, 0x73, 0xeb, 0xe5, 0x26, 0x17, 0x2c, 0xad, 0xd7, 0xa7,
+		0xdb, 0xed, 0x9b, 0xb9, 0x77, 0x31, 0x2e, 0x65, 0xb1, 0x81, 0x89, 0xd5, 0x3a, 0xab, 0x26, 0x0f,
+		0x82, 0xd2, 0xb4, 0x76, 0xf5, 0x7e, 0x8f, 0x69, 0x56, 0xab, 0xa7, 0x37, 0x69, 0xee, 0x3e, 0x0e,
+		0xca, 0xeb, 0xeb, 0xb2, 0x1a, 0x05, 0xdb, 0xba, 0xd1, 0xde, 0xb6, 0x25, 0xc5, 0x07, 0xb8, 0x60,
+		0xb3, 0x3a, 0x41, 0xed, 0x14, 0x28, 0xbd, 0xdd, 0x9e, 0xbf, 0xe3, 0x53, 0x0c, 0x2c, 0xd3, 0xdb,
+		0xed, 0x79, 0xfb, 0xbd, 0x17, 0xd2, 0xbd, 0x5d, 0x6f, 0xa7, 0x0f, 0x72, 0xfb, 0xab, 0xb7, 0xeb,
+		0xe9, 0xf1, 0x09, 0x38, 0x82, 0x40, 0x5d, 0x6a, 0xeb, 0x2d, 0xdd, 0xd6, 0x3d, 0xd0, 0x0f, 0x33,
+		0xe8, 0x85, 0xde, 0x6e, 0x6f, 0x55, 0x34, 0xfa, 0xc6, 0xd9, 0x1f, 0x6c, 0xed, 0x39, 0xf2, 0xf1,
+		0x08, 0x1f, 0x27, 0xd6, 0x49, 0x09, 0xb9, 0x6d, 0xf7, 0xe3, 0xcb, 0xe6, 0x6c, 0x15, 0x96, 0x21,
+		0xe5, 0x95, 0x7b, 0x92, 0x00, 0x2e, 0xf9, 0x4a, 0x00, 0x8d, 0xa0, 0xf2, 0x5a, 0x05, 0xcd, 0x97,
+		0x97, 0xaa, 0x4a, 0x10, 0xcd, 0xa8, 0x95, 0xda, 0x46, 0x55, 0x53, 0x37, 0xeb, 0x1b, 0xb5, 0xd5,
+		0xaa, 0x12, 0xf2, 0x18, 0xf6, 0x57, 0xc2, 0xf1, 0xd3, 0xca, 0x43, 0x57, 0xc2, 0xf1, 0xfb, 0x95,
+		0x07, 0x0a, 0xef, 0x84, 0x20, 0xe3, 0x77, 0xc0, 0xc9, 0xbb, 0xe1, 0xa8, 0x8c, 0xb0, 0x59, 0xd4,
+		0xd6, 0x6e, 0xb4, 0xfb, 0xec, 0xb3, 0xec, 0xea, 0x7c, 0x8b, 0x74, 0xc4, 0x6f, 0x41, 0x40, 0x35,
+		0xa8, 0xfd, 0x7c, 0xbb, 0x8f, 0x1f, 0x5d, 0x57, 0xb7, 0xc9, 0x0a, 0x9c, 0x34, 0x4c, 0xcd, 0xb2,
+		0x75, 0xa3, 0xa5, 0xf7, 0x5b, 0x9a, 0x1b, 0xdb, 0xd4, 0xf4, 0x66, 0x93, 0x5a, 0x96, 0xc9, 0xb7,
+		0x43, 0x87, 0xca, 0x5d, 0x86, 0xd9, 0x10, 0xc0, 0xee, 0x3e, 0x51, 0x14, 0xa0, 0x43, 0xd2, 0x1f,
+		0x9a, 0x24, 0xfd, 0xc7, 0x21, 0xd1, 0xd5, 0x7b, 0x1a, 0x35, 0xec, 0xfe, 0x1e, 0xb3, 0xd2, 0xe3,
+		0x6a, 0xbc, 0xab, 0xf7, 0xaa, 0x58, 0x26, 0x57, 0xe1, 0x7e, 0x17, 0x54, 0xeb, 0xd0, 0x1d, 0xbd,
+		0xb9, 0xa7, 0x31, 0x93, 0x9c, 0x45, 0x83, 0xb4, 0xa6, 0x69, 0x6c, 0x77, 0xda, 0x4d, 0xdb, 0xca,
+		0x25, 0x1d, 0x4d, 0x57, 0x70, 0x31, 0x56, 0x18, 0xc2, 0x15, 0xcb, 0x34, 0x98, 0x25, 0x5e, 0x96,
+		0xd0, 0x3e, 0x01, 0x49, 0x7d, 0x55, 0x08, 0x88, 0x7f, 0x91, 0xc3, 0x4a, 0xe4, 0x4a, 0x38, 0x1e,
+		0x51, 0xa2, 0x57, 0xc2, 0xf1, 0xa8, 0x12, 0xbb, 0x12, 0x8e, 0xc7, 0x95, 0xc4, 0x95, 0x70, 0x3c,
+		0xa1, 0x40, 0xe1, 0x56, 0x1a, 0x52, 0x5e, 0xc7, 0x02, 0xfd, 0xb4, 0x26, 0xdb, 0x5a, 0x03, 0x4c,
+		0xf9, 0xde, 0xbb, 0xaf, 0x1b, 0xb2, 0x54, 0xc6, 0x3d, 0x77, 0x39, 0xca, 0xad, 0x78, 0x95, 0x63,
+		0xa2, 0xbd, 0x83, 0x9f, 0x13, 0xe5, 0x56, 0x53, 0x5c, 0x15, 0x25, 0x72, 0x09, 0xa2, 0x2f, 0x5b,
+		0x8c, 0x76, 0x94, 0xd1, 0x7e, 0xd7, 0xfe, 0xb4, 0xaf, 0x34, 0x18, 0xf1, 0xc4, 0x95, 0x86, 0x56,
+		0x5f, 0x53, 0x57, 0x8b, 0x2b, 0xaa, 0x40, 0x27, 0xc7, 0x20, 0xdc, 0xd1, 0x5f, 0xd9, 0xf3, 0xef,
+		0xce, 0xac, 0x8a, 0x2c, 0x41, 0x76, 0x60, 0x70, 0xaf, 0x1c, 0xd7, 0x18, 0xa1, 0xb2, 0x5e, 0xa8,
+		0x8c, 0xdb, 0xba, 0x82, 0xf0, 0x33, 0xca, 0xd5, 0x31, 0x08, 0x63, 0xf8, 0xd9, 0xbf, 0x87, 0xb2,
+		0x2a, 0x72, 0x0a, 0x52, 0x2d, 0xba, 0x35, 0xd8, 0xd1, 0xfa, 0xb4, 0xa5, 0x37, 0x6d, 0xff, 0xce,
+		0x91, 0x64, 0x4d, 0x2a, 0x6b, 0x21, 0xcf, 0x41, 0x02, 0xd7, 0xc8, 0x60, 0x6b, 0x3c, 0xc7, 0x58,
+		0xf0, 0xc8, 0xfe, 0x2c, 0x10, 0x4b, 0x2c, 0x91, 0x54, 0x17, 0x9f, 0x5c, 0x86, 0x98, 0xad, 0xf7,
+		0x77, 0xa8, 0x6d, 0xe5, 0xe6, 0x17, 0x43, 0xa7, 0x32, 0x67, 0x97, 0x66, 0x21, 0xb5, 0xc1, 0x50,
+		0x98, 0x4f, 0x2c, 0xd1, 0xc9, 0xf3, 0xa0, 0x88, 0xa0, 0xab, 0x26, 0x1c, 0x5a, 0x2b, 0xb7, 0xc0,
+		0x04, 0xf0, 0xe1, 0xfd, 0x49, 0x8a, 0x98, 0x6d, 0x85, 0x23, 0xa9, 0x59, 0xea, 0x2b, 0xfb, 0xbf,
+		0x8b, 0xc3, 0x07, 0xf9, 0x2e, 0x36, 0x21, 0x2b, 0xfe, 0xd6, 0xac, 0x41, 0xaf, 0x67, 0xf6, 0xed,
+		0xdc, 0x91, 0xc5, 0xc0, 0xf4, 0x01, 0x49, 0x62, 0x1c, 0x47, 0xcd, 0x6c, 0xfb, 0xca, 0x5f, 0xbe,
+		0xcf, 0x2d, 0xff, 0x12, 0x64, 0xfc, 0xcc, 0xf0, 0x86, 0xbc, 0x43, 0x33, 0x86, 0xbc, 0xd1, 0x01,
+		0x91, 0x2e, 0x19, 0x6e, 0x42, 0xbc, 0x90, 0xff, 0xbe, 0x20, 0x64, 0xfc, 0x13, 0x23, 0x97, 0x80,
+		0xc8, 0x15, 0x6b, 0x1b, 0x76, 0xdf, 0x6c, 0x0d, 0x9a, 0xb4, 0x95, 0x0b, 0x4c, 0xe9, 0x67, 0x4e,
+		0xe0, 0xd4, 0x1c, 0x14, 0x2f, 0x21, 0xcf, 0x57, 0x10, 0x9c, 0x91, 0x50, 0xc5, 0xfd, 0x3e, 0xce,
+		0xc0, 0xbc, 0x24, 0x80, 0xc4, 0x6e, 0xe8, 0x7d, 0x03, 0x8d, 0x61, 0x6e, 0x9e, 0x13, 0x4f, 0xd3,
+		0xf3, 0xbc, 0x85, 0x14, 0x41, 0x8a, 0x8b, 0xd6, 0xa7, 0x5d, 0x13, 0x23, 0x5b, 0xe1, 0x29, 0xdd,
+		0x66, 0x04, 0x82, 0xca, 0xe1, 0x0b, 0x67, 0x20, 0xc2, 0xd4, 0x0f, 0x01, 0x10, 0x0a, 0x48, 0x39,
+		0x44, 0xe2, 0x10, 0x2e, 0xaf, 0xa9, 0xb8, 0x11, 0x2a, 0x90, 0xe2, 0xb5, 0xda, 0x7a, 0xad, 0x5a,
+		0xae, 0x2a, 0xc1, 0xc2, 0x39, 0x88, 0x72, 0x9d, 0x82, 0x9b, 0xa4, 0xa3, 0x55, 0x94, 0x43, 0xa2,
+		0x28, 0x68, 0x04, 0x64, 0xeb, 0xe6, 0x6a, 0xa9, 0xaa, 0x2a, 0xc1, 0xc2, 0x26, 0x64, 0x87, 0xbe,
+		0x43, 0x72, 0x18, 0xe6, 0xd4, 0xea, 0x46, 0xb5, 0x8e, 0x61, 0x00, 0x6d, 0xb3, 0xfe, 0x5c, 0x7d,
+		0xed, 0x79, 0x8c, 0xa1, 0xf9, 0xaa, 0xe5, 0x8e, 0x1b, 0x20, 0x0b, 0xa0, 0xb8, 0xd5, 0x8d, 0xb5,
+		0x4d, 0x95, 0x8d, 0xe6, 0x3b, 0x82, 0xa0, 0x0c, 0x7f, 0x94, 0xe4, 0x28, 0xcc, 0x6f, 0x14, 0xd5,
+		0x4b, 0xd5, 0x0d, 0x8d, 0x87, 0x36, 0x1c, 0xd2, 0x0b, 0xa0, 0x78, 0x1b, 0x2e, 0xd6, 0x58, 0xe4,
+		0xe6, 0x24, 0x1c, 0xf7, 0xd6, 0x56, 0x5f, 0xd8, 0xa8, 0xd6, 0x1b, 0xac, 0xf3, 0x62, 0xfd, 0x12,
+		0x6e, 0xff, 0x43, 0xf4, 0x64, 0x30, 0x25, 0x84, 0x43, 0xf5, 0xd3, 0xab, 0xae, 0x54, 0x94, 0xf0,
+		0x70, 0xf5, 0x5a, 0xbd, 0xba, 0x76, 0x51, 0x89, 0x0c, 0xf7, 0xce, 0x02, 0x2c, 0x51, 0x92, 0x87,
+		0x23, 0xc3, 0xb5, 0x5a, 0xb5, 0xbe, 0xa1, 0xbe, 0xa8, 0xc4, 0x86, 0x3b, 0x6e, 0x54, 0xd5, 0xab,
+		0xb5, 0x72, 0x55, 0x89, 0x93, 0x23, 0x40, 0xfc, 0x23, 0xda, 0xb8, 0xbc, 0x56, 0x51, 0x12, 0xe3,
+		0x76, 0x2c, 0xa2, 0xcc, 0x17, 0x7e, 0x2e, 0x00, 0x29, 0x6f, 0xb0, 0xc3, 0xa7, 0x54, 0x02, 0x5f,
+		0x6d, 0x9b, 0x6d, 0xe1, 0x0f, 0x83, 0x90, 0xf4, 0x44, 0x3d, 0xd0, 0x5d, 0xd5, 0x3b, 0x1d, 0xf3,
+		0x86, 0xa6, 0x77, 0xda, 0xba, 0x25, 0xf6, 0x43, 0x60, 0x55, 0x45, 0xac, 0x99, 0x75, 0xff, 0x99,
+		0xdd, 0x74, 0x89, 0xde, 0xb6, 0xe9, 0x12, 0xfb, 0x2a, 0x34, 0x5d, 0x22, 0x4a, 0xb4, 0xf0, 0xc1,
+		0x20, 0x28, 0xc3, 0x71, 0x90, 0x21, 0xbe, 0x05, 0x26, 0xf1, 0xcd, 0x3b, 0xbf, 0xe0, 0x41, 0xe6,
+		0x37, 0xbc, 0xab, 0x87, 0x26, 0xee, 0xea, 0x5f, 0x11, 0xb9, 0xfa, 0xb7, 0x01, 0xc8, 0xf8, 0xe3,
+		0x2b, 0xbe, 0xa9, 0x15, 0x0e, 0x32, 0x35, 0x3f, 0xeb, 0xee, 0x99, 0xc4, 0xba, 0xaf, 0xc8, 0xbc,
+		0x7e, 0x20, 0x04, 0x69, 0x5f, 0x38, 0x66, 0xd6, 0xd1, 0x7d, 0x00, 0xe6, 0xda, 0x2d, 0xda, 0xed,
+		0x99, 0x36, 0x26, 0x03, 0x68, 0x1d, 0x7a, 0x9d, 0x76, 0x18, 0x1b, 0x32, 0x63, 0x0e, 0x3c, 0x7d,
+		0x3d, 0x2c, 0xd5, 0x5c, 0xbc, 0x15, 0x44, 0x5b, 0x9e, 0xaf, 0x55, 0xaa, 0xab, 0xeb, 0x6b, 0x1b,
+		0xd5, 0x7a, 0xf9, 0x45, 0xa9, 0x72, 0x55, 0xa5, 0x3d, 0x04, 0xe6, 0x63, 0xf8, 0xbd, 0x5f, 0x1d,
+		0x7e, 0xe0, 0x3a, 0x28, 0xc3, 0xb3, 0x41, 0xcd, 0x3b, 0x66, 0x3e, 0xca, 0x21, 0x32, 0x0f, 0xd9,
+		0xfa, 0x9a, 0xd6, 0xa8, 0x55, 0xaa, 0x5a, 0xf5, 0xe2, 0xc5, 0x6a, 0x79, 0xa3, 0xc1, 0x63, 0xff,
+		0x0e, 0xf4, 0x86, 0x12, 0xf4, 0xae, 0xcd, 0x0f, 0x86, 0x60, 0x7e, 0xcc, 0x48, 0x48, 0x51, 0x44,
+		0xed, 0x78, 0x20, 0xf1, 0x91, 0x59, 0x46, 0xbf, 0x84, 0x0e, 0xf7, 0xba, 0xde, 0xb7, 0x45, 0x90,
+		0xef, 0x41, 0x40, 0xf6, 0x1a, 0x36, 0xda, 0xe1, 0x7d, 0x71, 0xa6, 0xc2, 0x6d, 0x85, 0xac, 0x5b,
+		0xcf, 0x8f, 0x55, 0x1e, 0x06, 0xd2, 0x33, 0xad, 0xb6, 0xdd, 0xbe, 0x8e, 0xb9, 0x09, 0xf2, 0x00,
+		0x06, 0x6d, 0x85, 0xb0, 0xaa, 0xc8, 0x96, 0x9a, 0x61, 0x3b, 0xd0, 0x06, 0xdd, 0xd1, 0x87, 0xa0,
+		0xd1, 0x4f, 0x08, 0xa9, 0x8a, 0x6c, 0x71, 0xa0, 0xef, 0x81, 0x54, 0xcb, 0x1c, 0x60, 0xa0, 0x84,
+		0xc3, 0xa1, 0xee, 0x0c, 0xa8, 0x49, 0x5e, 0xe7, 0x80, 0x88, 0x48, 0x96, 0x7b, 0xf2, 0x93, 0x52,
+		0x93, 0xbc, 0x8e, 0x83, 0x3c, 0x00, 0x59, 0x7d, 0x67, 0xa7, 0x8f, 0xc4, 0x25, 0x21, 0x1e, 0x9b,
+		0xcb, 0x38, 0xd5, 0x0c, 0x30, 0x7f, 0x05, 0xe2, 0x92, 0x0f, 0xe8, 0xa8, 0x22, 0x27, 0xb4, 0x1e,
+		0x0f, 0x38, 0x07, 0xf1, 0x30, 0xc8, 0x90, 0x8d, 0xf7, 0x40, 0xaa, 0x6d, 0x69, 0x6e, 0x66, 0x42,
+		0x70, 0x31, 0x78, 0x2a, 0xae, 0x26, 0xdb, 0x96, 0x73, 0x50, 0x59, 0xf8, 0x35, 0x00, 0x70, 0x85,
+		0x8d, 0x7c, 0x77, 0x00, 0x32, 0x7c, 0x27, 0xe8, 0xf5, 0xa9, 0x45, 0x8d, 0xa6, 0xf4, 0xdf, 0x1e,
+		0xdc, 0x47, 0x44, 0xb9, 0xf1, 0xbc, 0x2e, 0x10, 0x4a, 0xcf, 0x7e, 0x38, 0x10, 0x78, 0x3d, 0x10,
+		0x7e, 0x3d, 0x10, 0xf8, 0xf1, 0x40, 0x9a, 0xc4, 0xab, 0x2f, 0xac, 0xaf, 0xd4, 0xca, 0xb5, 0x8d,
+		0xdc, 0xa7, 0x63, 0xac, 0x5c, 0x5b, 0x15, 0xe5, 0xb7, 0x62, 0xfe, 0xf6, 0xb7, 0x63, 0xbf, 0x14,
+		0x08, 0xc5, 0xdf, 0x8e, 0xa9, 0xe9, 0x6d, 0x2f, 0x3d, 0xd2, 0xf1, 0x26, 0x35, 0x04, 0x27, 0x79,
+		0x7c, 0xee, 0x68, 0xaa, 0x22, 0x95, 0xa1, 0xf4, 0x20, 0x1b, 0x48, 0x94, 0x0d, 0x24, 0x49, 0xa2,
+		0xe5, 0x95, 0xb5, 0x46, 0xb5, 0xc2, 0x86, 0x91, 0x20, 0xe1, 0xb5, 0xf5, 0x6a, 0x3d, 0xf7, 0x96,
+		0xec, 0xd2, 0xcd, 0x7f, 0x78, 0x3d, 0x00, 0x47, 0xe5, 0xc1, 0xa7, 0xd8, 0x14, 0xa9, 0xd1, 0x34,
+		0x5b, 0xd2, 0x0c, 0xcd, 0x9c, 0x7d, 0x6c, 0xbf, 0xce, 0x55, 0x81, 0xca, 0x58, 0x52, 0x15, 0x88,
+		0xa5, 0x47, 0x46, 0x58, 0x52, 0xac, 0x57, 0xc4, 0x58, 0x92, 0x24, 0xba, 0x5e, 0x2c, 0x3f, 0x57,
+		0xad, 0xb8, 0xa3, 0x39, 0xdc, 0x1f, 0x47, 0x85, 0x7c, 0x03, 0x64, 0x31, 0x00, 0x8a, 0xb2, 0xd1,
+		0x6e, 0xf1, 0x93, 0xe8, 0xf0, 0xa4, 0x23, 0x4c, 0x77, 0x44, 0x18, 0x11, 0xbd, 0xea, 0x60, 0x94,
+		0x1e, 0xf4, 0x0c, 0x25, 0x41, 0xc2, 0xf5, 0xb5, 0x7a, 0x55, 0x0e, 0x83, 0x9d, 0xda, 0xbe, 0xe8,
+		0x0e, 0x23, 0x33, 0xf0, 0xa1, 0x92, 0x6f, 0x00, 0x45, 0xc6, 0x71, 0x1c, 0x96, 0x44, 0x26, 0x9d,
+		0xc2, 0xba, 0x03, 0x10, 0xd1, 0x20, 0x87, 0x19, 0xf7, 0x7b, 0x46, 0xb0, 0x40, 0xb2, 0x2b, 0xd5,
+		0xfa, 0xa5, 0x8d, 0xcb, 0xda, 0xba, 0x5a, 0x65, 0x87, 0x69, 0xb9, 0x4f, 0xcb, 0xee, 0xb3, 0x5d,
+		0x3f, 0x22, 0xf9, 0xc6, 0x00, 0x24, 0xb9, 0xad, 0xc2, 0x83, 0x47, 0xdc, 0xfb, 0xbf, 0x7f, 0xbf,
+		0xbe, 0x99, 0xa9, 0xc2, 0xa0, 0x4b, 0x17, 0x58, 0xb7, 0x21, 0x29, 0x10, 0x47, 0x09, 0x59, 0xa9,
+		0x5e, 0x2a, 0x96, 0x5f, 0xd4, 0x4a, 0xd5, 0xc6, 0x06, 0x6a, 0xb2, 0x35, 0x95, 0xcb, 0x28, 0x90,
+		0x48, 0x71, 0x65, 0x65, 0xed, 0x79, 0x97, 0x11, 0xf0, 0xb2, 0x43, 0xa6, 0xf0, 0x75, 0x90, 0xf6,
+		0x89, 0x3b, 0x5a, 0xaf, 0xcc, 0xea, 0xc5, 0x19, 0x34, 0xaa, 0xf5, 0xb2, 0xd7, 0xda, 0x4e, 0x81,
+		0x23, 0xde, 0x4a, 0x00, 0x4b, 0x52, 0xf8, 0x95, 0x20, 0xaa, 0x51, 0x31, 0x00, 0xe7, 0x78, 0x2f,
+		0x54, 0x78, 0x12, 0xe2, 0x52, 0x7c, 0xd1, 0x86, 0x66, 0xa6, 0xf0, 0x90, 0x05, 0x1f, 0x07, 0x26,
+		0xbb, 0x4a, 0x00, 0xfd, 0x15, 0x2e, 0xd3, 0x4a, 0xb0, 0x70, 0x15, 0x0e, 0x8f, 0x15, 0x3d, 0x72,
+		0x2f, 0x9c, 0x94, 0x47, 0x8a, 0xdc, 0x3a, 0xd7, 0xaa, 0xf5, 0xf2, 0x5a, 0x05, 0xfd, 0x19, 0x97,
+		0x26, 0x80, 0x90, 0x41, 0x3e, 0x4a, 0x29, 0x9f, 0x4a, 0xb0, 0x50, 0x86, 0x8c, 0x5f, 0x80, 0xc8,
+		0x71, 0x38, 0xba, 0xb9, 0x71, 0xf1, 0x29, 0xed, 0x6a, 0x71, 0xa5, 0x56, 0x29, 0x0e, 0x79, 0x2e,
+		0x00, 0x42, 0x8a, 0x94, 0x20, 0x0e, 0x14, 0xa5, 0x4b, 0x09, 0x15, 0x1a, 0x90, 0x1d, 0x12, 0x02,
+		0x72, 0x17, 0xe4, 0x84, 0x13, 0x31, 0x6e, 0x3c, 0xf3, 0x30, 0x2c, 0x16, 0xdc, 0x9d, 0xaa, 0x54,
+		0x57, 0x6a, 0xab, 0xb5, 0x0d, 0x36, 0xb2, 0xcb, 0x00, 0xee, 0xea, 0xe2, 0x6e, 0x75, 0xa5, 0xb1,
+		0x56, 0xd7, 0x2e, 0xa2, 0x2f, 0xb6, 0xe1, 0x21, 0x95, 0x00, 0xbe, 0x9a, 0x4a, 0x00, 0x5d, 0x86,
+		0xd1, 0x25, 0x57, 0x82, 0xa7, 0xa3, 0xb8, 0x57, 0x7d, 0x26, 0x76, 0x3a, 0x1a, 0xff, 0x4c, 0x4c,
+		0xf9, 0x2c, 0xfe, 0xff, 0xd9, 0x98, 0xf2, 0x39, 0xfc, 0xff, 0x5b, 0xea, 0xca, 0xb7, 0xd6, 0x4f,
+		0x47, 0xe3, 0xdf, 0x5e, 0x57, 0x3e, 0x82, 0xff, 0x7f, 0xa4, 0xae, 0x7c, 0x77, 0xfd, 0x4a, 0x34,
+		0xfe, 0x56, 0x4c, 0x79, 0x3b, 0x56, 0xf8, 0x0f, 0x21, 0x20, 0xae, 0xac, 0x39, 0xe1, 0x8a, 0x17,
+		0x20, 0xee, 0xc4, 0x3f, 0x78, 0x2a, 0xe5, 0xbb, 0xf7, 0x11, 0x51, 0x89, 0xe6, 0xa9, 0x1a, 0x8a,
+		0x87, 0x38, 0xd4, 0xd0, 0xd9, 0xed, 0xb6, 0x8d, 0x76, 0x77, 0xd0, 0xd5, 0x64, 0x50, 0x60, 0xaa,
+		0xb3, 0x2b, 0x10, 0x44, 0x99, 0x91, 0xd0, 0x6f, 0xfa, 0x48, 0x44, 0xa6, 0x92, 0xe0, 0x08, 0xa2,
+		0x9c, 0x7f, 0x33, 0x00, 0xb9, 0x49, 0x83, 0xbd, 0xad, 0x78, 0x45, 0x1d, 0x16, 0xcc, 0xeb, 0xb4,
+		0xdf, 0x6f, 0xb7, 0xd8, 0x61, 0x83, 0x63, 0x1c, 0x85, 0xa7, 0x1b, 0x47, 0xf3, 0x1e, 0x44, 0x51,
+		0x6d, 0x91, 0x12, 0xee, 0x61, 0x37, 0x51, 0x7d, 0x4b, 0x4a, 0x91, 0xe9, 0x94, 0xd2, 0x0c, 0x45,
+		0xd2, 0x28, 0x7c, 0x2c, 0x08, 0x19, 0x7f, 0x96, 0x22, 0xa9, 0x40, 0xbc, 0x63, 0x8a, 0x0c, 0x20,
+		0xbe, 0xae, 0xa7, 0xa6, 0x24, 0x36, 0x2e, 0xad, 0x08, 0x78, 0xd5, 0xc1, 0xcc, 0xff, 0xab, 0x00,
+		0xc4, 0x65, 0x35, 0x39, 0x02, 0xe1, 0x9e, 0x6e, 0xef, 0x32, 0x72, 0x91, 0x52, 0x50, 0x09, 0xa8,
+		0xac, 0x8c, 0xf5, 0x56, 0x4f, 0xe7, 0xd9, 0x4f, 0xa2, 0x1e, 0xcb, 0x68, 0xef, 0x74, 0xa8, 0xde,
+		0x62, 0x07, 0x62, 0x66, 0xb7, 0x4b, 0x0d, 0xdb, 0x92, 0xf6, 0x8e, 0xa8, 0x2f, 0x8b, 0x6a, 0x4c,
+		0x96, 0xb5, 0xfb, 0x7a, 0xbb, 0xe3, 0x83, 0x0d, 0x33, 0x58, 0x45, 0x36, 0x38, 0xc0, 0xcb, 0x70,
+		0x4c, 0xd2, 0x6d, 0x51, 0x5b, 0x6f, 0xee, 0xd2, 0x96, 0x8b, 0x14, 0x65, 0x07, 0xdf, 0x47, 0x05,
+		0x40, 0x45, 0xb4, 0x4b, 0xdc, 0xc2, 0x27, 0x83, 0x30, 0x27, 0x8f, 0xf0, 0x5a, 0x0e, 0xb3, 0x56,
+		0x01, 0x74, 0xc3, 0x30, 0x6d, 0x2f, 0xbb, 0x46, 0x4d, 0xbc, 0x11, 0xbc, 0xa5, 0xa2, 0x83, 0xa4,
+		0x7a, 0x08, 0xe4, 0x3f, 0x17, 0x00, 0x70, 0x9b, 0x26, 0xf2, 0xed, 0x24, 0x24, 0x45, 0x0e, 0x2a,
+		0x4b, 0x64, 0xe6, 0xf1, 0x2f, 0xe0, 0x55, 0x78, 0xd8, 0x87, 0xa1, 0xb1, 0x2d, 0xba, 0xd3, 0x36,
+		0x44, 0x52, 0x11, 0x2f, 0xc8, 0xb3, 0xf9, 0xb0, 0x9b, 0x74, 0xa7, 0x42, 0xdc, 0xa2, 0x5d, 0xdd,
+		0xb0, 0xdb, 0x4d, 0xf1, 0x7d, 0x9c, 0x3f, 0xd0, 0xe0, 0x97, 0x1a, 0x02, 0x5b, 0x75, 0xe8, 0x14,
+		0x4e, 0x41, 0x5c, 0xd6, 0x3a, 0xba, 0xf1, 0x10, 0x89, 0x41, 0xa8, 0x51, 0xc5, 0xdd, 0x81, 0x29,
+		0xaa, 0x5a, 0xb1, 0xa1, 0x04, 0x4f, 0x7f, 0x2c, 0x08, 0x31, 0xf9, 0xc1, 0xce, 0x43, 0xb6, 0x5a,
+		0xa9, 0x0d, 0xa9, 0xd9, 0x79, 0xc8, 0xc8, 0x4a, 0xae, 0xd1, 0x94, 0x6f, 0x8a, 0x79, 0x2b, 0xd7,
+		0xd5, 0xb5, 0x8d, 0xb5, 0xb3, 0xca, 0xa7, 0x47, 0x2b, 0x1f, 0x57, 0xde, 0x8a, 0x91, 0x39, 0x48,
+		0xc9, 0xca, 0xb3, 0x8f, 0x9e, 0x7d, 0x5c, 0x79, 0x7b, 0xb8, 0xea, 0x09, 0xe5, 0x33, 0x2c, 0xf4,
+		0x22, 0xab, 0x1e, 0xd3, 0x36, 0x50, 0x63, 0xae, 0xd5, 0x57, 0x5e, 0x54, 0x02, 0xde, 0x86, 0xb3,
+		0x9e, 0x86, 0x20, 0xb9, 0x1b, 0x8e, 0xca, 0x86, 0x0b, 0x17, 0x2e, 0x5c, 0x78, 0xd2, 0xd3, 0x78,
+		0xeb, 0x3b, 0xa3, 0xc3, 0xcd, 0x4f, 0x79, 0x9a, 0x7f, 0x64, 0xb4, 0xf9, 0x82, 0xa7, 0xf9, 0x47,
+		0xbf, 0x33, 0x4a, 0xe6, 0x21, 0x29, 0x9b, 0x57, 0x8b, 0x2f, 0x28, 0x5f, 0xfc, 0xe2, 0x17, 0xbf,
+		0x18, 0x2b, 0x7d, 0x03, 0xcc, 0x37, 0xcd, 0xee, 0xf0, 0xd2, 0x94, 0x94, 0xa1, 0x0c, 0x01, 0xeb,
+		0x72, 0xe0, 0xa5, 0x47, 0x04, 0xd0, 0x8e, 0xd9, 0xd1, 0x8d, 0x9d, 0x25, 0xb3, 0xbf, 0xe3, 0x26,
+		0xcc, 0xa3, 0x69, 0x69, 0x79, 0xd2, 0xe6, 0x7b, 0x5b, 0xff, 0x23, 0x10, 0xf8, 0xf1, 0x60, 0xe8,
+		0xd2, 0x7a, 0xe9, 0x67, 0x83, 0xf9, 0x4b, 0x1c, 0x71, 0x5d, 0x2e, 0xbc, 0x4a, 0xb7, 0x3b, 0xb4,
+		0x89, 0xab, 0x03, 0x7f, 0xfe, 0x10, 0x2c, 0xec, 0x98, 0x3b, 0x26, 0xa3, 0x74, 0x06, 0xff, 0xe2,
+		0x83, 0x20, 0x09, 0xa7, 0x36, 0x3f, 0x35, 0x3d, 0x7f, 0xb9, 0x0e, 0xf3, 0x02, 0x58, 0x63, 0x96,
+		0x2e, 0x3f, 0xc4, 0x24, 0xfb, 0x26, 0xc2, 0xe4, 0x7e, 0xf1, 0xcf, 0x58, 0x2c, 0x41, 0x9d, 0x13,
+		0xa8, 0xd8, 0xc6, 0xcf, 0x39, 0x97, 0x55, 0x38, 0xec, 0xa3, 0xc7, 0xbd, 0x0c, 0xda, 0x9f, 0x42,
+		0xf1, 0x77, 0x04, 0xc5, 0x79, 0x0f, 0xc5, 0x86, 0x40, 0x5d, 0x2e, 0x43, 0xfa, 0x20, 0xb4, 0xfe,
+		0xb9, 0xa0, 0x95, 0xa2, 0x5e, 0x22, 0x97, 0x20, 0xcb, 0x88, 0x34, 0x07, 0x96, 0x6d, 0x76, 0x99,
+		0x0b, 0xb7, 0x3f, 0x99, 0x7f, 0xf1, 0x67, 0x5c, 0xbd, 0x65, 0x10, 0xad, 0xec, 0x60, 0x2d, 0x2f,
+		0x03, 0xb3, 0xd8, 0x31, 0xbb, 0x73, 0x0a, 0x85, 0xdf, 0x15, 0x03, 0x71, 0xe0, 0x97, 0xaf, 0xc2,
+		0x02, 0xfe, 0xcd, 0x3c, 0x2c, 0xef, 0x48, 0xa6, 0x67, 0xcd, 0xe4, 0xfe, 0xf0, 0x43, 0x5c, 0x83,
+		0xce, 0x3b, 0x04, 0x3c, 0x63, 0xf2, 0xac, 0xe2, 0x0e, 0xb5, 0x6d, 0xda, 0xb7, 0x34, 0xbd, 0x33,
+		0x6e, 0x78, 0x9e, 0xb4, 0x83, 0xdc, 0x0f, 0x7c, 0xde, 0xbf, 0x8a, 0x97, 0x38, 0x66, 0xb1, 0xd3,
+		0x59, 0xde, 0x84, 0xa3, 0x63, 0xa4, 0x62, 0x06, 0x9a, 0x3f, 0x28, 0x68, 0x2e, 0x8c, 0x48, 0x06,
+		0x92, 0x5d, 0x07, 0x59, 0xef, 0xac, 0xe5, 0x0c, 0x34, 0x7f, 0x48, 0xd0, 0x24, 0x02, 0x57, 0x2e,
+		0x29, 0x52, 0xbc, 0x02, 0x73, 0xd7, 0x69, 0x7f, 0xcb, 0xb4, 0x44, 0xaa, 0xc7, 0x0c, 0xe4, 0x7e,
+		0x58, 0x90, 0xcb, 0x0a, 0x44, 0x96, 0xfb, 0x81, 0xb4, 0x2e, 0x40, 0x7c, 0x5b, 0x6f, 0xd2, 0x19,
+		0x48, 0xdc, 0x12, 0x24, 0x62, 0x08, 0x8f, 0xa8, 0x45, 0x48, 0xed, 0x98, 0xc2, 0xc9, 0x9e, 0x8e,
+		0xfe, 0x23, 0x02, 0x3d, 0x29, 0x71, 0x04, 0x89, 0x9e, 0xd9, 0x1b, 0x74, 0xd0, 0x03, 0x9f, 0x4e,
+		0xe2, 0x47, 0x25, 0x09, 0x89, 0x23, 0x48, 0x1c, 0x80, 0xad, 0x3f, 0x26, 0x49, 0x58, 0x1e, 0x7e,
+		0x3e, 0x8b, 0x19, 0xa0, 0x9d, 0x3d, 0xd3, 0x98, 0x65, 0x10, 0x1f, 0x15, 0x14, 0x40, 0xa0, 0x20,
+		0x81, 0xa7, 0x21, 0x31, 0xeb, 0x42, 0xfc, 0xd4, 0xe7, 0xe5, 0xe7, 0x21, 0x57, 0xe0, 0x12, 0x64,
+		0xa5, 0x82, 0xc2, 0x53, 0x95, 0xe9, 0x24, 0x7e, 0x5a, 0x90, 0xc8, 0x78, 0xd0, 0xc4, 0x34, 0x6c,
+		0x6a, 0xd9, 0x3b, 0x74, 0x16, 0x22, 0x1f, 0x93, 0xd3, 0x10, 0x28, 0x82, 0x95, 0x5b, 0xd4, 0x68,
+		0xee, 0xce, 0x46, 0xe1, 0xe3, 0x92, 0x95, 0x12, 0x07, 0x49, 0x94, 0x21, 0xdd, 0xd5, 0xfb, 0xd6,
+		0xae, 0xde, 0x99, 0x69, 0x39, 0x7e, 0x46, 0xd0, 0x48, 0x39, 0x48, 0x82, 0x23, 0x03, 0xe3, 0x20,
+		0x64, 0x7e, 0x56, 0x72, 0x64, 0x60, 0xf8, 0x08, 0xad, 0xc3, 0x82, 0x65, 0x33, 0x1b, 0xf7, 0x20,
+		0xd4, 0xfe, 0x9e, 0xfc, 0xf4, 0x38, 0xee, 0xaa, 0x97, 0xe2, 0xd3, 0x90, 0xb0, 0xda, 0xaf, 0xcc,
+		0x44, 0xe6, 0xe7, 0xe4, 0x4a, 0x33, 0x04, 0x44, 0x7e, 0x11, 0x8e, 0x8d, 0xdd, 0x26, 0x66, 0x20,
+		0xf6, 0xf3, 0x82, 0xd8, 0x91, 0x31, 0x5b, 0x85, 0x50, 0x09, 0x07, 0x25, 0xf9, 0xf7, 0xa5, 0x4a,
+		0xa0, 0x43, 0xb4, 0xd6, 0x31, 0xec, 0x69, 0xe9, 0xdb, 0x07, 0xe3, 0xda, 0x3f, 0x90, 0x5c, 0xe3,
+		0xb8, 0x3e, 0xae, 0x6d, 0xc0, 0x11, 0x41, 0xf1, 0x60, 0xeb, 0xfa, 0x0b, 0x52, 0xb1, 0x72, 0xec,
+		0x4d, 0xff, 0xea, 0x7e, 0x2d, 0xe4, 0x1d, 0x76, 0xca, 0xf8, 0x9a, 0xa5, 0x61, 0x1a, 0xc9, 0x74,
+		0xca, 0xbf, 0x28, 0x28, 0x4b, 0x8d, 0xef, 0x04, 0xe8, 0xac, 0x55, 0xbd, 0x87, 0xc4, 0x5f, 0x80,
+		0x9c, 0x24, 0x3e, 0x30, 0xfa, 0xb4, 0x69, 0xee, 0x18, 0xed, 0x57, 0x68, 0x6b, 0x06, 0xd2, 0xbf,
+		0x34, 0xb4, 0x54, 0x9b, 0x1e, 0x74, 0xa4, 0x5c, 0x03, 0xc5, 0xb1, 0x55, 0xb4, 0x76, 0x97, 0x1d,
+		0x0c, 0xef, 0x4f, 0xf1, 0x13, 0x72, 0xa5, 0x1c, 0xbc, 0x1a, 0x43, 0x5b, 0xae, 0x02, 0x4f, 0x16,
+		0x9f, 0x55, 0x24, 0x7f, 0x59, 0x10, 0x4a, 0xbb, 0x58, 0x42, 0x71, 0x34, 0xcd, 0x6e, 0x4f, 0xef,
+		0xcf, 0xa2, 0xff, 0xfe, 0xa1, 0x54, 0x1c, 0x02, 0x45, 0x28, 0x0e, 0xb4, 0xe8, 0x70, 0xb7, 0x9f,
+		0x81, 0xc2, 0xaf, 0x48, 0xc5, 0x21, 0x71, 0x04, 0x09, 0x69, 0x30, 0xcc, 0x40, 0xe2, 0x1f, 0x49,
+		0x12, 0x12, 0x07, 0x49, 0xbc, 0xd7, 0xdd, 0x68, 0xfb, 0x74, 0xa7, 0x6d, 0xd9, 0xe2, 0x3a, 0xc7,
+		0xfe, 0xa4, 0x7e, 0xf5, 0xf3, 0x7e, 0x23, 0x4c, 0xf5, 0xa0, 0xa2, 0x26, 0x12, 0x41, 0x31, 0x16,
+		0xf4, 0x9d, 0x3e, 0xb0, 0x5f, 0x93, 0x9a, 0xc8, 0x83, 0x86, 0x63, 0xf3, 0x58, 0x88, 0xc8, 0xf6,
+		0x26, 0xba, 0x74, 0x33, 0x90, 0xfb, 0xf5, 0xa1, 0xc1, 0x35, 0x24, 0x2e, 0xd2, 0xf4, 0xd8, 0x3f,
+		0x03, 0xe3, 0x1a, 0xdd, 0x9b, 0x49, 0x3a, 0xff, 0xf1, 0x90, 0xfd, 0xb3, 0xc9, 0x31, 0xb9, 0x0e,
+		0xc9, 0x0e, 0xd9, 0x53, 0x64, 0xda, 0x5d, 0xaf, 0xdc, 0x07, 0xdf, 0x11, 0xf3, 0xf5, 0x9b, 0x53,
+		0xcb, 0x2b, 0xa0, 0x88, 0x1a, 0xd7, 0x80, 0x9d, 0x4a, 0xec, 0x43, 0xef, 0x38, 0x72, 0xee, 0xb3,
+		0x79, 0x96, 0x2f, 0x42, 0xda, 0x67, 0xf0, 0x4c, 0x27, 0xf5, 0x4d, 0x82, 0x54, 0xca, 0x6b, 0xef,
+		0x2c, 0x9f, 0x83, 0x30, 0x1a, 0x2f, 0xd3, 0xd1, 0xbf, 0x59, 0xa0, 0x33, 0xf0, 0xe5, 0x67, 0x20,
+		0x2e, 0x8d, 0x96, 0xe9, 0xa8, 0xdf, 0x22, 0x50, 0x1d, 0x14, 0x44, 0x97, 0x06, 0xcb, 0x74, 0xf4,
+		0x6f, 0x95, 0xe8, 0x12, 0x05, 0xd1, 0x67, 0x67, 0xe1, 0x6f, 0x7d, 0x7b, 0x98, 0xa3, 0x4b, 0x94,
+		0x65, 0x4c, 0x56, 0xe7, 0x96, 0xca, 0x74, 0xec, 0x6f, 0x13, 0x9d, 0x4b, 0x8c, 0xe5, 0x27, 0x21,
+		0x32, 0x23, 0xc3, 0xbf, 0x53, 0xa0, 0x72, 0xf8, 0xe5, 0x32, 0x24, 0x3d, 0xd6, 0xc9, 0x74, 0xf4,
+		0xef, 0x12, 0xe8, 0x5e, 0x2c, 0x1c, 0xba, 0xb0, 0x4e, 0xa6, 0x13, 0xf8, 0xff, 0xe4, 0xd0, 0x05,
+		0x06, 0xb2, 0x4d, 0x1a, 0x26, 0xd3, 0xb1, 0x3f, 0x22, 0xb9, 0x2e, 0x51, 0x96, 0x9f, 0x85, 0x84,
+		0xb3, 0xd9, 0x4c, 0xc7, 0xff, 0x6e, 0x81, 0xef, 0xe2, 0x20, 0x07, 0x06, 0xc6, 0x01, 0x48, 0xfc,
+		0xff, 0x92, 0x03, 0x1e, 0x2c, 0xfc, 0x8c, 0x86, 0x0d, 0x98, 0xe9, 0x94, 0xbe, 0x47, 0x7e, 0x46,
+		0x43, 0xf6, 0x0b, 0xae, 0x26, 0xd3, 0xf9, 0xd3, 0x49, 0x7c, 0xaf, 0x5c, 0x4d, 0x06, 0x8f, 0xc3,
+		0x18, 0xb6, 0x08, 0xa6, 0xd3, 0xf8, 0x7e, 0x39, 0x8c, 0x21, 0x83, 0x60, 0x79, 0x1d, 0xc8, 0xa8,
+		0x35, 0x30, 0x9d, 0xde, 0xeb, 0x82, 0xde, 0xdc, 0x88, 0x31, 0xb0, 0xfc, 0x3c, 0x1c, 0x19, 0x6f,
+		0x09, 0x4c, 0xa7, 0xfa, 0x03, 0xef, 0x0c, 0xf9, 0x6e, 0x5e, 0x43, 0x60, 0x79, 0x03, 0x16, 0xc6,
+		0x59, 0x01, 0xd3, 0xc9, 0xfe, 0xe0, 0x3b, 0x7e, 0xc5, 0xed, 0x35, 0x02, 0x96, 0x8b, 0x00, 0xee,
+		0x06, 0x3c, 0x9d, 0xd6, 0x0f, 0x0b, 0x5a, 0x1e, 0x24, 0xfc, 0x34, 0xc4, 0xfe, 0x3b, 0x1d, 0xff,
+		0x96, 0xfc, 0x34, 0x04, 0x06, 0x7e, 0x1a, 0x72, 0xeb, 0x9d, 0x8e, 0xfd, 0x23, 0xf2, 0xd3, 0x90,
+		0x28, 0x28, 0xd9, 0x9e, 0xdd, 0x6d, 0x3a, 0x85, 0x8f, 0x4a, 0xc9, 0xf6, 0x60, 0x2d, 0xd7, 0x61,
+		0x6e, 0x64, 0x43, 0x9c, 0x4e, 0xea, 0xc7, 0x05, 0x29, 0x65, 0x78, 0x3f, 0xf4, 0x6e, 0x5e, 0x62,
+		0x33, 0x9c, 0x4e, 0xed, 0x27, 0x86, 0x36, 0x2f, 0xb1, 0x17, 0x2e, 0x3f, 0x0d, 0x71, 0x63, 0xd0,
+		0xe9, 0xe0, 0xc7, 0x43, 0xf6, 0xbf, 0xce, 0x97, 0xfb, 0xcc, 0x17, 0x04, 0x77, 0x24, 0xc2, 0xf2,
+		0x39, 0x88, 0xd0, 0xee, 0x16, 0x6d, 0x4d, 0xc3, 0xfc, 0xec, 0x17, 0xa4, 0xc2, 0x44, 0xe8, 0xe5,
+		0x67, 0x01, 0x78, 0x68, 0x84, 0x25, 0xca, 0x4e, 0xc1, 0xfd, 0xdc, 0x17, 0xc4, 0xfd, 0x19, 0x17,
+		0xc5, 0x25, 0xc0, 0x6f, 0xe3, 0xec, 0x4f, 0xe0, 0xf3, 0x7e, 0x02, 0x6c, 0x45, 0x2e, 0x40, 0x0c,
+		0x8f, 0xdd, 0x6c, 0x7d, 0x67, 0x1a, 0xf6, 0x9f, 0x0b, 0x6c, 0x09, 0x8f, 0x0c, 0xeb, 0x9a, 0x7d,
+		0x6a, 0xeb, 0x3b, 0xd6, 0x34, 0xdc, 0xff, 0x2a, 0x70, 0x1d, 0x04, 0x44, 0x6e, 0xea, 0x96, 0x3d,
+		0xcb, 0xbc, 0xff, 0x42, 0x22, 0x4b, 0x04, 0x1c, 0x34, 0xfe, 0x7d, 0x8d, 0xee, 0x4d, 0xc3, 0xfd,
+		0x4b, 0x39, 0x68, 0x01, 0xbf, 0xfc, 0x0c, 0x24, 0xf0, 0x4f, 0x7e, 0x29, 0x6e, 0x0a, 0xf2, 0x5f,
+		0x09, 0x64, 0x17, 0x03, 0x7b, 0xb6, 0xec, 0x96, 0xdd, 0x9e, 0xce, 0xec, 0xbf, 0x16, 0x2b, 0x2d,
+		0xe1, 0x97, 0x8b, 0x90, 0xb4, 0xec, 0x56, 0x6b, 0x20, 0xec, 0xd3, 0x29, 0xe8, 0xff, 0xed, 0x0b,
+		0x4e, 0xc8, 0xc2, 0xc1, 0xc1, 0xd5, 0xbe, 0x71, 0xcd, 0xee, 0x99, 0x2c, 0x63, 0x63, 0x1a, 0x85,
+		0x77, 0x04, 0x05, 0x0f, 0xca, 0x72, 0x19, 0x52, 0x38, 0x17, 0x79, 0xf0, 0x3d, 0x8d, 0xc4, 0xdf,
+		0x08, 0x06, 0xf8, 0x90, 0x4a, 0xef, 0xff, 0xdd, 0x37, 0x4f, 0x04, 0x3e, 0xf9, 0xe6, 0x89, 0xc0,
+		0x7f, 0x7e, 0xf3, 0x44, 0xe0, 0x23, 0x9f, 0x3a, 0x71, 0xe8, 0x93, 0x9f, 0x3a, 0x71, 0xe8, 0x8f,
+		0x3f, 0x75, 0xe2, 0xd0, 0xf8, 0x28, 0x31, 0x5c, 0x32, 0x2f, 0x99, 0x3c, 0x3e, 0xfc, 0xd2, 0x7d,
+		0x3b, 0x6d, 0x7b, 0x77, 0xb0, 0xb5, 0xd4, 0x34, 0xbb, 0x67, 0x9a, 0xa6, 0xd5, 0x35, 0xad, 0x33,
+		0xfe, 0xb8, 0x2e, 0xfb, 0x0b, 0xfe, 0x36, 0x00, 0xc7, 0x38, 0x19, 0x37, 0x9c, 0xab, 0x1b, 0x7b,
+		0x93, 0x9e, 0x59, 0x39, 0x0f, 0xa1, 0xa2, 0xb1, 0x47, 0x8e, 0x71, 0x05, 0xa7, 0x0d, 0xfa, 0x1d,
+		0x71, 0x33, 0x2b, 0x86, 0xe5, 0xcd, 0x7e, 0xc7, 0x9f, 0xab, 0x9b, 0x12, 0xb9, 0xba, 0xa5, 0xef,
+		0x0a, 0x1c, 0x6c, 0x26, 0xf1, 0xa2, 0xb1, 0xc7, 0x26, 0xb2, 0x1e, 0x78, 0xe9, 0xe1, 0xa9, 0x71,
+		0xee, 0x6b, 0x86, 0x79, 0xc3, 0xc0, 0x61, 0xf7, 0xb6, 0x64, 0x8c, 0xfb, 0xc4, 0x70, 0x8c, 0xfb,
+		0x79, 0xda, 0xe9, 0x3c, 0x87, 0x70, 0x78, 0x14, 0x6d, 0x6d, 0x45, 0xf9, 0x25, 0x60, 0xf8, 0x9e,
+		0x20, 0x9c, 0x18, 0x09, 0x67, 0x0b, 0x21, 0x98, 0xc4, 0x84, 0x65, 0x88, 0x57, 0xa4, 0x6c, 0xe5,
+		0xf0, 0x91, 0x93, 0xa6, 0x69, 0xb4, 0x78, 0xd2, 0x65, 0x48, 0x95, 0x45, 0x64, 0x84, 0xa1, 0x1b,
+		0xa6, 0x25, 0xee, 0x36, 0xf2, 0x42, 0xe9, 0x87, 0x0e, 0xc8, 0x88, 0xb4, 0xec, 0x49, 0x72, 0xe3,
+		0xb1, 0x19, 0xb9, 0x21, 0x27, 0xe1, 0x8b, 0xfc, 0xcf, 0xca, 0x95, 0xef, 0x0f, 0xc2, 0xc9, 0x61,
+		0xae, 0xe0, 0x97, 0x65, 0xd9, 0x7a, 0xb7, 0x37, 0x89, 0x2d, 0x4f, 0x43, 0x62, 0x43, 0xc2, 0x1c,
+		0x98, 0x2f, 0xb7, 0x0e, 0xc8, 0x97, 0x8c, 0xd3, 0x95, 0x64, 0xcc, 0xd9, 0x19, 0x19, 0xe3, 0xcc,
+		0xe3, 0xb6, 0x38, 0xf3, 0x43, 0x09, 0x38, 0xc6, 0x3f, 0x27, 0x8d, 0x7f, 0x4a, 0xbc, 0x20, 0x78,
+		0x92, 0xf2, 0x36, 0x4d, 0x3f, 0x27, 0x29, 0x3c, 0x07, 0xf3, 0x35, 0xd4, 0x16, 0xe8, 0x05, 0xb9,
+		0x27, 0x3c, 0x63, 0xaf, 0x7f, 0x2e, 0xfa, 0x0c, 0x7e, 0x71, 0x12, 0xe8, 0xad, 0x2a, 0x7c, 0x30,
+		0x00, 0x4a, 0xa3, 0xa9, 0x77, 0xf4, 0xfe, 0x97, 0x4a, 0x8a, 0x3c, 0x09, 0xc0, 0x33, 0x85, 0x9c,
+		0x87, 0x5b, 0xf0, 0xdc, 0xdb, 0x3b, 0xb9, 0x25, 0xde, 0x13, 0xbb, 0x30, 0x91, 0x60, 0xb0, 0xf8,
+		0xe7, 0xe9, 0x17, 0x00, 0xdc, 0x06, 0xcc, 0xac, 0x68, 0x94, 0x8b, 0x2b, 0x45, 0x55, 0xa6, 0x7c,
+		0x34, 0xd6, 0xab, 0x65, 0xfe, 0x8e, 0xc2, 0x21, 0x4c, 0x5e, 0xf0, 0x36, 0x3a, 0x49, 0xe6, 0x87,
+		0x61, 0xce, 0x5b, 0xcf, 0x2f, 0xb5, 0x07, 0x97, 0x2f, 0x63, 0xa4, 0x00, 0xb3, 0x15, 0x35, 0xbd,
+		0xd5, 0xa2, 0x2d, 0xad, 0x6d, 0x90, 0x29, 0x17, 0x58, 0x73, 0xbf, 0xf7, 0xef, 0x22, 0x6c, 0x6a,
+		0x69, 0x8e, 0x58, 0x44, 0xbc, 0x9a, 0x81, 0x36, 0x67, 0xbb, 0xdb, 0xeb, 0x50, 0x76, 0xda, 0xab,
+		0xb5, 0x25, 0xff, 0xa7, 0x9b, 0x33, 0x48, 0x0f, 0x4f, 0x8e, 0xe7, 0x5d, 0x74, 0x67, 0xf5, 0x96,
+		0x9f, 0x73, 0x73, 0x89, 0x9c, 0x01, 0x4e, 0xa5, 0xf8, 0xfb, 0x62, 0x84, 0x32, 0x9a, 0x21, 0x87,
+		0xb8, 0x02, 0x73, 0x78, 0x17, 0xac, 0xe7, 0x1b, 0xdf, 0x94, 0x1d, 0x44, 0xce, 0x56, 0x11, 0x98,
+		0xee, 0xd0, 0x9e, 0x84, 0xa8, 0xc5, 0x16, 0x65, 0x1a, 0x09, 0x39, 0x1c, 0x01, 0x8e, 0xe1, 0x2f,
+		0x2e, 0x06, 0xce, 0x8c, 0xa6, 0x10, 0xf8, 0x97, 0x82, 0x40, 0x8a, 0xa1, 0xc9, 0xd9, 0x18, 0x30,
+		0xc7, 0x5f, 0x2d, 0xa1, 0x9e, 0xd9, 0xec, 0x1f, 0x45, 0xf9, 0x27, 0x9f, 0x78, 0x94, 0x9d, 0xb0,
+		0xdf, 0xe3, 0x17, 0xba, 0x31, 0x1f, 0x8b, 0xaa, 0x08, 0xda, 0xee, 0x7c, 0x29, 0x64, 0x64, 0x7f,
+		0x62, 0xde, 0xfb, 0x77, 0xf6, 0x1b, 0xa2, 0xb3, 0x13, 0xe3, 0x24, 0xdc, 0xd3, 0x53, 0x5a, 0x50,
+		0xe5, 0x0d, 0xcb, 0x25, 0x48, 0xe3, 0xa1, 0xbc, 0xcb, 0x9c, 0xfd, 0x7b, 0xf9, 0xcd, 0x4f, 0x3c,
+		0xca, 0x3f, 0x34, 0x44, 0x12, 0xac, 0x29, 0x55, 0x27, 0x69, 0xbd, 0x97, 0x1e, 0x1a, 0xdd, 0xbf,
+		0xf9, 0x7f, 0x8f, 0x30, 0xea, 0x4f, 0x7b, 0x87, 0xea, 0x68, 0xa7, 0x3f, 0x09, 0xc1, 0x1c, 0xde,
+		0xa7, 0x36, 0xcf, 0xb0, 0x7f, 0x85, 0x56, 0x8a, 0xb0, 0xc2, 0x0c, 0xc7, 0xb6, 0xe7, 0xb9, 0xb2,
+		0x98, 0x2e, 0xb7, 0x7f, 0xf5, 0x1d, 0x3f, 0x1d, 0x71, 0x15, 0xca, 0xf2, 0xea, 0x68, 0x1e, 0xdd,
+		0x74, 0x1a, 0x7f, 0x2d, 0x69, 0x0c, 0xa7, 0xc5, 0x2d, 0xbf, 0x1b, 0xcf, 0x42, 0x05, 0x99, 0x69,
+		0xb6, 0x9b, 0x24, 0xe2, 0x60, 0xa0, 0xe5, 0xc6, 0x85, 0x76, 0x16, 0x3b, 0xfd, 0x1d, 0x89, 0xcf,
+		0x75, 0x18, 0x66, 0xa1, 0x2e, 0x5f, 0x82, 0x4c, 0xcb, 0x34, 0x6c, 0xcd, 0xec, 0xb6, 0x6d, 0xda,
+		0xed, 0xd9, 0x53, 0x2d, 0xdf, 0xbf, 0xe1, 0x44, 0xe2, 0x6a, 0x1a, 0xf1, 0xd6, 0x24, 0xda, 0x6d,
+		0x2d, 0xae, 0xd5, 0xba, 0x26, 0x76, 0x2b, 0xfb, 0x26, 0x5f, 0x44, 0x67, 0x71, 0xff, 0x22, 0x04,
+		0x27, 0x04, 0xf0, 0x96, 0x6e, 0xd1, 0x33, 0xd7, 0x1f, 0xdb, 0xa2, 0xb6, 0xfe, 0xd8, 0x99, 0xa6,
+		0xd9, 0x96, 0xa6, 0xca, 0x3c, 0x6f, 0x5f, 0xc2, 0xf6, 0x25, 0xd1, 0x9e, 0x1f, 0x7b, 0x9e, 0x9f,
+		0x9f, 0xbc, 0x8b, 0xe5, 0x47, 0x45, 0xa8, 0xf0, 0x12, 0x84, 0xcb, 0x66, 0x9b, 0xdd, 0xc4, 0x6a,
+		0x51, 0xc3, 0xec, 0x8a, 0xfd, 0x84, 0x17, 0xc8, 0x79, 0x88, 0xea, 0x5d, 0x73, 0x60, 0xd8, 0x7c,
+		0x2f, 0x29, 0x9d, 0xf8, 0xdd, 0x37, 0x4e, 0x1e, 0xfa, 0x93, 0x37, 0x4e, 0x86, 0x6a, 0x86, 0xfd,
+		0x47, 0xbf, 0xfc, 0x08, 0x08, 0xea, 0x35, 0xc3, 0xfe, 0xf8, 0x5b, 0xbf, 0x70, 0x3a, 0xa0, 0x0a,
+		0xe8, 0xe5, 0xf0, 0xdb, 0x3f, 0x76, 0x32, 0x50, 0x78, 0x01, 0x62, 0x15, 0xda, 0xdc, 0x87, 0xfc,
+		0x63, 0x43, 0xe4, 0x8f, 0x49, 0xf2, 0x15, 0xda, 0xf4, 0x90, 0xaf, 0xd0, 0xe6, 0x10, 0xe5, 0x27,
+		0x21, 0x5e, 0x33, 0x6c, 0xfe, 0xa4, 0xc2, 0x43, 0x10, 0x6a, 0x1b, 0xfc, 0x7e, 0xae, 0x87, 0xc2,
+		0xc8, 0x00, 0x55, 0x84, 0x42, 0xc4, 0x0a, 0x6d, 0x3a, 0x88, 0x2d, 0xda, 0xcc, 0x05, 0xa6, 0x75,
+		0x8d, 0x50, 0xa5, 0xca, 0x1f, 0xff, 0x97, 0x13, 0x87, 0x5e, 0x7d, 0xf3, 0xc4, 0xa1, 0x89, 0x4b,
+		0x5f, 0x98, 0xbe, 0xf4, 0xce, 0x8a, 0xff, 0x74, 0x18, 0xee, 0x66, 0x2f, 0xed, 0xf4, 0xbb, 0x6d,
+		0xc3, 0x3e, 0xd3, 0xec, 0xef, 0xf5, 0x6c, 0x13, 0xbf, 0x5f, 0x73, 0x5b, 0x2c, 0xf8, 0x9c, 0xdb,
+		0xbc, 0xc4, 0x9b, 0xc7, 0x2f, 0x77, 0x61, 0x1b, 0x22, 0xeb, 0x88, 0x87, 0x2c, 0xb6, 0x4d, 0x5b,
+		0xef, 0x08, 0xb3, 0x8c, 0x17, 0xb0, 0x96, 0xbf, 0xce, 0x13, 0xe4, 0xb5, 0x6d, 0xf9, 0x30, 0x4f,
+		0x87, 0xea, 0xdb, 0xfc, 0x91, 0x83, 0x10, 0xb3, 0xe7, 0xe3, 0x58, 0xc1, 0xde, 0x33, 0x58, 0x80,
+		0x88, 0x3e, 0xe0, 0x39, 0x58, 0x21, 0x34, 0xf4, 0x59, 0xa1, 0xf0, 0x1c, 0xc4, 0x44, 0x82, 0x01,
+		0x26, 0x21, 0x5d, 0xa3, 0x7b, 0xac, 0x9f, 0x94, 0x8a, 0x7f, 0x92, 0x25, 0x88, 0xb0, 0xc1, 0x8b,
+		0x1b, 0x27, 0xb9, 0xa5, 0x91, 0xd1, 0x2f, 0xb1, 0x41, 0xaa, 0x1c, 0xac, 0x70, 0x05, 0xe2, 0x15,
+		0x13, 0xa5, 0xd0, 0x4f, 0x2d, 0xc1, 0xa9, 0xb1, 0x31, 0xf7, 0x06, 0xb6, 0xbc, 0x15, 0xc8, 0x0a,
+		0x78, 0xc7, 0x96, 0x3f, 0x7a, 0x21, 0xf2, 0xc8, 0x44, 0xa9, 0x50, 0x86, 0x18, 0xa3, 0xbd, 0xd6,
+		0x73, 0x5e, 0x92, 0x0a, 0x78, 0x5e, 0x92, 0x12, 0xe4, 0x83, 0xee, 0x60, 0x09, 0x84, 0xf1, 0x46,
+		0xbc, 0x98, 0x37, 0xfb, 0xbb, 0xf0, 0x1e, 0x88, 0x0b, 0x22, 0x16, 0x39, 0x0b, 0x21, 0xb3, 0x27,
+		0x13, 0x22, 0xf3, 0x93, 0xa6, 0xb2, 0xd6, 0x2b, 0x85, 0x51, 0x66, 0x54, 0x04, 0x2e, 0xd5, 0x27,
+		0x8a, 0xc5, 0x13, 0x3e, 0xb1, 0xe8, 0x52, 0x7b, 0x6b, 0xdb, 0x76, 0xff, 0xe0, 0xcb, 0x39, 0x22,
+		0x0a, 0x8e, 0xa0, 0xdc, 0x0a, 0xc2, 0x09, 0x4f, 0xeb, 0x75, 0xda, 0xc7, 0x08, 0x1b, 0x97, 0x26,
+		0x21, 0x29, 0xc4, 0x33, 0x40, 0xd1, 0x3e, 0x41, 0x54, 0x9e, 0x81, 0x50, 0xb1, 0xd7, 0xc3, 0x77,
+		0x9f, 0x58, 0xb9, 0x69, 0x72, 0x59, 0x09, 0xab, 0x4e, 0x19, 0xdb, 0x2c, 0x73, 0xdb, 0xbe, 0xa1,
+		0xf7, 0x9d, 0x37, 0xa1, 0x64, 0xb9, 0x70, 0x01, 0x12, 0x65, 0xd3, 0xb0, 0xa8, 0x61, 0x0d, 0x98,
+		0xb1, 0xbf, 0xd5, 0x31, 0x9b, 0xd7, 0x04, 0x05, 0x5e, 0x40, 0x66, 0xeb, 0xbd, 0x1e, 0xc3, 0x0c,
+		0xab, 0xf8, 0x27, 0xff, 0x5e, 0x4b, 0x6b, 0x13, 0xd9, 0x73, 0xee, 0x60, 0xec, 0x11, 0x13, 0x74,
+		0xf8, 0xf3, 0x3f, 0x03, 0x70, 0xd7, 0xe8, 0x87, 0x74, 0x8d, 0xee, 0x59, 0x07, 0xfd, 0x8e, 0x5e,
+		0x80, 0xc4, 0x3a, 0x7b, 0x99, 0xf3, 0x39, 0xba, 0x47, 0xf2, 0x98, 0x1b, 0x7a, 0xf6, 0xdc, 0xb9,
+		0xc7, 0x2e, 0x70, 0x29, 0xbf, 0x7c, 0x48, 0x95, 0x15, 0xe4, 0x04, 0x24, 0x2c, 0xda, 0xec, 0x9d,
+		0x3d, 0x77, 0xfe, 0xda, 0x63, 0x5c, 0xac, 0x2e, 0x1f, 0x52, 0xdd, 0xaa, 0xe5, 0x38, 0xce, 0xf8,
+		0xed, 0x8f, 0x9e, 0x0c, 0x94, 0x22, 0x10, 0xb2, 0x06, 0xdd, 0x2f, 0x9b, 0x6c, 0x7c, 0x5f, 0x04,
+		0x16, 0x3d, 0xad, 0x7c, 0x73, 0x11, 0x19, 0xf7, 0xce, 0x7b, 0xaa, 0x8a, 0x67, 0xfe, 0x0c, 0x62,
+		0xc2, 0xae, 0xb1, 0x2f, 0x17, 0x0b, 0xbf, 0x14, 0x80, 0xd4, 0x55, 0x49, 0x19, 0x6f, 0x5f, 0x3c,
+		0x0d, 0xe0, 0xf4, 0x24, 0x3f, 0x95, 0xe3, 0x4b, 0xc3, 0x7d, 0x2d, 0x39, 0x38, 0xaa, 0x07, 0x1c,
+		0xaf, 0x15, 0xf5, 0xfa, 0x66, 0xcf, 0xb4, 0xc4, 0xfb, 0x40, 0x53, 0x50, 0x1d, 0x60, 0xbc, 0xeb,
+		0xc2, 0xb4, 0x9a, 0x76, 0xdd, 0xb4, 0x31, 0x77, 0xa6, 0x67, 0xde, 0x10, 0xaf, 0xae, 0x85, 0x54,
+		0x85, 0xb5, 0x5c, 0x65, 0x0d, 0xeb, 0x58, 0x8f, 0x83, 0x4e, 0x38, 0x54, 0xd0, 0x6f, 0xd5, 0x5b,
+		0xad, 0x3e, 0xb5, 0x2c, 0xa1, 0xb8, 0x64, 0x11, 0x1f, 0x25, 0xea, 0x0d, 0xb6, 0x34, 0xa9, 0x25,
+		0xf0, 0x59, 0xa7, 0x31, 0xdf, 0xbc, 0x94, 0x0d, 0xf1, 0xd5, 0x47, 0x7b, 0x83, 0x2d, 0x94, 0x94,
+		0x7b, 0x20, 0x35, 0x66, 0x30, 0xc9, 0xeb, 0xee, 0x38, 0xd8, 0x63, 0xb0, 0x62, 0x06, 0x5a, 0xaf,
+		0xdf, 0x36, 0xfb, 0x6d, 0x7b, 0x8f, 0x65, 0x70, 0x86, 0x54, 0x45, 0x36, 0xac, 0x8b, 0xfa, 0xc2,
+		0x35, 0xc8, 0x36, 0x98, 0x17, 0xe2, 0x8e, 0xfc, 0x9c, 0x3b, 0xbe, 0xc0, 0xf4, 0xf1, 0x4d, 0x1c,
+		0x59, 0x70, 0x64, 0x64, 0xa5, 0xd5, 0x89, 0x92, 0xf9, 0xf8, 0xc1, 0x24, 0xd3, 0xbf, 0xbb, 0x7d,
+		0xee, 0x18, 0xdc, 0x35, 0xdc, 0xe8, 0x53, 0x59, 0xb3, 0x0a, 0xe5, 0xb4, 0x50, 0x45, 0x7e, 0xff,
+		0x4d, 0x34, 0x3f, 0x45, 0x75, 0xe6, 0xa7, 0x7e, 0x3e, 0x85, 0x0b, 0x90, 0xc6, 0xfb, 0x49, 0x0d,
+		0x6a, 0x5f, 0xa6, 0x7a, 0x8b, 0xf6, 0xfd, 0xbb, 0x6c, 0x5a, 0xee, 0xb2, 0x04, 0xc2, 0x6c, 0x2b,
+		0xe5, 0xbb, 0x0c, 0xfb, 0xbb, 0xb0, 0x0b, 0x61, 0x44, 0x75, 0x77, 0x60, 0x81, 0xc1, 0x0a, 0x58,
+		0xbb, 0xb5, 0x67, 0x8b, 0x3b, 0x9a, 0x29, 0x95, 0x17, 0xc8, 0x13, 0x72, 0x1f, 0x0d, 0xed, 0xbf,
+		0x8f, 0x0a, 0x21, 0x14, 0xbb, 0x69, 0x07, 0x62, 0x25, 0x54, 0xbf, 0xb5, 0x8a, 0x33, 0x90, 0x80,
+		0x3b, 0x10, 0xb2, 0x0a, 0xd9, 0x9e, 0xde, 0xb7, 0xd9, 0x8b, 0x26, 0xbb, 0x6c, 0x16, 0x42, 0xce,
+		0x4f, 0x8e, 0x7e, 0x75, 0xbe, 0xc9, 0x8a, 0x5e, 0xd2, 0x3d, 0x6f, 0x65, 0xe1, 0xd3, 0x61, 0x88,
+		0x0a, 0x66, 0x3c, 0x03, 0x31, 0xc1, 0x56, 0x21, 0x99, 0x77, 0x2f, 0x8d, 0x6e, 0x46, 0x4b, 0xce,
+		0xa6, 0x21, 0xe8, 0x49, 0x1c, 0x72, 0x3f, 0xc4, 0x9b, 0xbb, 0x7a, 0xdb, 0xd0, 0xda, 0x2d, 0x61,
+		0x00, 0x26, 0xdf, 0x7c, 0xe3, 0x64, 0xac, 0x8c, 0x75, 0xb5, 0x8a, 0x1a, 0x63, 0x8d, 0xb5, 0x16,
+		0xee, 0xfc, 0xbb, 0xb4, 0xbd, 0xb3, 0x6b, 0x8b, 0xaf, 0x4b, 0x94, 0xf0, 0x15, 0x68, 0x14, 0x08,
+		0x91, 0x7d, 0x9f, 0x1f, 0xb1, 0xe5, 0x9d, 0x48, 0x52, 0x29, 0x8e, 0x1d, 0x7f, 0xe4, 0x3f, 0x9d,
+		0x0c, 0xa8, 0x0c, 0x83, 0x94, 0x21, 0xdd, 0xd1, 0x2d, 0x5b, 0x63, 0xbb, 0x16, 0x76, 0xcf, 0xd3,
+		0xee, 0x8f, 0x8d, 0x32, 0x44, 0x30, 0x56, 0x0c, 0x3d, 0x89, 0x58, 0xbc, 0xaa, 0x85, 0xaf, 0xf9,
+		0x30, 0x22, 0x98, 0x7e, 0xde, 0xb6, 0xb9, 0x2d, 0x15, 0x65, 0x7c, 0xcf, 0x60, 0x7d, 0x99, 0x55,
+		0x33, 0x8b, 0xea, 0x38, 0x24, 0xd8, 0x03, 0x3d, 0x0c, 0x84, 0xdf, 0xa7, 0x8b, 0x63, 0x05, 0x6b,
+		0x7c, 0x00, 0xb2, 0xae, 0x6e, 0xe4, 0x20, 0x71, 0x4e, 0xc5, 0xad, 0x66, 0x80, 0x8f, 0xc2, 0x82,
+		0x41, 0x6f, 0xda, 0xda, 0x30, 0x74, 0x82, 0x41, 0x13, 0x6c, 0xbb, 0xea, 0xc7, 0xb8, 0x0f, 0x32,
+		0x4d, 0xc9, 0x7c, 0x0e, 0x0b, 0x0c, 0x36, 0xed, 0xd4, 0x32, 0xb0, 0x63, 0x10, 0xd7, 0x7b, 0x3d,
+		0x0e, 0x90, 0x14, 0xba, 0xb1, 0xd7, 0x63, 0x4d, 0xa7, 0x61, 0x8e, 0xcd, 0xb1, 0x4f, 0x2d, 0xbc,
+		0xd7, 0xc1, 0x61, 0x52, 0x0c, 0x26, 0x8b, 0x0d, 0x2a, 0xaf, 0x67, 0xb0, 0xf7, 0x42, 0x9a, 0x5e,
+		0xc7, 0xcb, 0x8c, 0x4d, 0xca, 0xe1, 0xd2, 0x0c, 0x2e, 0x25, 0x2b, 0x19, 0xd0, 0x83, 0xe0, 0xe8,
+		0x3c, 0x4d, 0xea, 0xe3, 0x0c, 0xa7, 0x27, 0xeb, 0x8b, 0xbc, 0xba, 0x90, 0x83, 0x70, 0x45, 0xb7,
+		0x75, 0x34, 0x2a, 0xec, 0x9b, 0x7c, 0x93, 0x49, 0xa9, 0xf8, 0x67, 0xe1, 0xed, 0x20, 0x84, 0xaf,
+		0x9a, 0x36, 0x25, 0x8f, 0x7b, 0x0c, 0xbe, 0xcc, 0x38, 0x79, 0x6e, 0xb4, 0x77, 0x0c, 0xda, 0x5a,
+		0xb5, 0x76, 0x3c, 0x8f, 0x62, 0xba, 0xe2, 0x14, 0xf4, 0x89, 0xd3, 0x02, 0x44, 0xfa, 0xe6, 0xc0,
+		0x68, 0xc9, 0x8c, 0x7b, 0x56, 0x20, 0x55, 0x88, 0x3b, 0x52, 0x12, 0x9e, 0x26, 0x25, 0x59, 0x94,
+		0x12, 0x94, 0x61, 0x51, 0xa1, 0xc6, 0xb6, 0x84, 0xb0, 0x94, 0x20, 0xe1, 0x28, 0xaf, 0x5c, 0xe4,
+		0x00, 0x02, 0xeb, 0xa2, 0xe1, 0x46, 0xe2, 0xac, 0xbd, 0xc3, 0x3c, 0x2e, 0x71, 0x8a, 0xd3, 0x20,
+		0xb8, 0xe7, 0x13, 0x2b, 0xf1, 0x40, 0x67, 0x8c, 0xcd, 0xcb, 0x15, 0x2b, 0xfe, 0x48, 0xe7, 0x5d,
+		0x98, 0x98, 0xb7, 0x63, 0xb0, 0xdb, 0x24, 0x42, 0xf2, 0xdc, 0x8a, 0xc2, 0x6f, 0x05, 0x20, 0xca,
+		0x25, 0xd9, 0xc3, 0xb7, 0xc0, 0x78, 0xbe, 0x05, 0x27, 0xf1, 0x2d, 0x74, 0xfb, 0x7c, 0x2b, 0x02,
+		0x38, 0x83, 0xb1, 0xc4, 0xbb, 0x89, 0x63, 0xac, 0x05, 0x3e, 0xc4, 0x46, 0x7b, 0x47, 0x7c, 0xa8,
+		0x1e, 0xa4, 0xc2, 0x7f, 0x0c, 0x40, 0xc2, 0x69, 0x27, 0x45, 0x48, 0xcb, 0x71, 0x69, 0xdb, 0x1d,
+		0x7d, 0x47, 0xc8, 0xce, 0xdd, 0x13, 0x07, 0x77, 0xb1, 0xa3, 0xef, 0xa8, 0x49, 0x31, 0x1e, 0x2c,
+		0x8c, 0x5f, 0x87, 0xe0, 0x84, 0x75, 0xf0, 0x2d, 0x7c, 0xe8, 0xf6, 0x16, 0xde, 0xb7, 0x44, 0xe1,
+		0xe1, 0x25, 0xfa, 0x44, 0x90, 0x39, 0x2f, 0x3d, 0xd3, 0xd2, 0x3b, 0x5f, 0x89, 0x2f, 0xe2, 0x38,
+		0x24, 0x7a, 0x66, 0x47, 0xe3, 0x2d, 0xfc, 0x26, 0x4a, 0xbc, 0x67, 0x76, 0xd4, 0x91, 0x65, 0x8f,
+		0xdc, 0xa1, 0xcf, 0x25, 0x7a, 0x07, 0xb8, 0x16, 0x1b, 0xe6, 0x5a, 0x1f, 0x52, 0x9c, 0x15, 0x62,
+		0x2f, 0x7b, 0x14, 0x79, 0x80, 0x7f, 0xe5, 0x02, 0xa3, 0x7b, 0x2f, 0x1f, 0x36, 0x87, 0x54, 0xa3,
+		0xbb, 0x0e, 0x06, 0x57, 0xfd, 0xb9, 0xe0, 0x24, 0x0c, 0x2e, 0x76, 0xaa, 0x80, 0x2b, 0x7c, 0x5f,
+		0x00, 0x60, 0x05, 0x39, 0xcb, 0xe6, 0x8b, 0xbb, 0x90, 0xc5, 0x86, 0xa0, 0xf9, 0x7a, 0x3e, 0x31,
+		0x69, 0xd1, 0x44, 0xff, 0x29, 0xcb, 0x3b, 0xee, 0x32, 0xa4, 0x5d, 0x61, 0xb4, 0xa8, 0x1c, 0xcc,
+		0x89, 0x7d, 0x2c, 0x6a, 0xbc, 0x44, 0x96, 0xba, 0xee, 0x29, 0x15, 0xfe, 0x69, 0x00, 0x12, 0x6c,
+		0x4c, 0xf8, 0x5c, 0x9c, 0x6f, 0x0d, 0x03, 0xb7, 0xbf, 0x86, 0x77, 0x03, 0x70, 0x32, 0x98, 0xa6,
+		0x20, 0x24, 0x2b, 0xc1, 0x6a, 0x30, 0xf9, 0x00, 0x63, 0x4b, 0x62, 0xda, 0xa1, 0xfd, 0x19, 0x2e,
+		0x2d, 0x6e, 0xc1, 0xf6, 0xa3, 0x10, 0x63, 0x77, 0xac, 0x6f, 0x5a, 0xc2, 0x88, 0xc6, 0xc7, 0x45,
+		0x37, 0x6e, 0x5a, 0x85, 0x97, 0x21, 0xb6, 0x71, 0x93, 0xc7, 0x42, 0x8e, 0x43, 0xa2, 0x6f, 0x9a,
+		0x62, 0x4f, 0xe6, 0xb6, 0x50, 0x1c, 0x2b, 0xd8, 0x16, 0x24, 0xfd, 0xff, 0xa0, 0xeb, 0xff, 0xbb,
+		0x01, 0x8c, 0xd0, 0x4c, 0x01, 0x8c, 0xd3, 0xff, 0x3e, 0x00, 0x49, 0x8f, 0x7e, 0x20, 0x8f, 0xc1,
+		0xe1, 0xd2, 0xca, 0x5a, 0xf9, 0x39, 0xad, 0x56, 0xd1, 0x2e, 0xae, 0x14, 0x3d, 0x37, 0x44, 0xf3,
+		0x47, 0x5e, 0xbb, 0xb5, 0x48, 0x3c, 0xb0, 0x9b, 0x06, 0x3b, 0xae, 0x22, 0x67, 0x60, 0xc1, 0x8f,
+		0x52, 0x2c, 0x35, 0xf0, 0x45, 0x82, 0x40, 0xfe, 0xf0, 0x6b, 0xb7, 0x16, 0xe7, 0x3c, 0x18, 0xc5,
+		0x2d, 0x8b, 0x1a, 0xf6, 0x28, 0x42, 0x79, 0x6d, 0x75, 0x15, 0x2f, 0xe8, 0x8e, 0x20, 0x08, 0x85,
+		0xfd, 0x20, 0xcc, 0xf9, 0x11, 0xea, 0xb5, 0x15, 0x25, 0x94, 0x27, 0xaf, 0xdd, 0x5a, 0xcc, 0x78,
+		0xa0, 0xeb, 0xed, 0x4e, 0x3e, 0xfe, 0xe1, 0x9f, 0x38, 0x71, 0xe8, 0xe3, 0x3f, 0x79, 0x22, 0x80,
+		0x33, 0x4b, 0xfb, 0x74, 0x04, 0x79, 0x18, 0x8e, 0x36, 0x6a, 0x97, 0xea, 0xd5, 0x8a, 0xb6, 0xda,
+		0xb8, 0x34, 0x74, 0xc7, 0x37, 0x9f, 0x7d, 0xed, 0xd6, 0x62, 0x52, 0x4c, 0x69, 0x12, 0xf4, 0xba,
+		0x5a, 0xbd, 0xba, 0xb6, 0x51, 0x55, 0x02, 0x1c, 0x7a, 0xbd, 0x4f, 0xaf, 0x9b, 0x36, 0xff, 0x35,
+		0x8a, 0x47, 0xe1, 0xd8, 0x18, 0x68, 0x67, 0x62, 0x73, 0xaf, 0xdd, 0x5a, 0x4c, 0xaf, 0xf7, 0x29,
+		0xff, 0x7e, 0x18, 0xc6, 0x12, 0xe4, 0x46, 0x31, 0xd6, 0xd6, 0xd7, 0x1a, 0xc5, 0x15, 0x65, 0x31,
+		0xaf, 0xbc, 0x76, 0x6b, 0x31, 0x25, 0x95, 0x21, 0xc2, 0xbb, 0x33, 0xfb, 0x72, 0x79, 0x3b, 0xdf,
+		0x12, 0x83, 0xbb, 0x87, 0x1b, 0xcf, 0xf4, 0xf4, 0xbe, 0xde, 0x3d, 0xa8, 0xbb, 0x33, 0xe5, 0xbc,
+		0xba, 0xf0, 0x6a, 0x10, 0xb2, 0x8e, 0x31, 0xbd, 0xce, 0x7a, 0x20, 0x8f, 0x7b, 0xe3, 0x30, 0xc9,
+		0x89, 0xdb, 0x18, 0x87, 0x96, 0x61, 0x1a, 0x8c, 0xc3, 0x0b, 0xa3, 0x4c, 0xa8, 0x8b, 0xc5, 0x51,
+		0xbc, 0xaa, 0x80, 0x10, 0xa8, 0x0e, 0x06, 0x79, 0x16, 0x12, 0x8e, 0xf2, 0x70, 0x9e, 0xf1, 0x9d,
+		0xac, 0x6d, 0x04, 0xbe, 0x8b, 0x83, 0x2f, 0xeb, 0x4a, 0xb7, 0x21, 0x3c, 0xc9, 0x11, 0xb9, 0xca,
+		0x01, 0x04, 0xb2, 0x84, 0x2f, 0xd4, 0xc4, 0x67, 0x27, 0x66, 0xcf, 0x1e, 0x3f, 0xbc, 0xa9, 0x71,
+		0x4f, 0x8a, 0x5b, 0x25, 0xf1, 0xae, 0x7e, 0xb3, 0x84, 0x65, 0x54, 0x14, 0xd8, 0xb8, 0x23, 0x5e,
+		0x21, 0x0a, 0xa9, 0xd1, 0xae, 0x7e, 0xf3, 0x92, 0x6e, 0x5d, 0x09, 0xc7, 0x43, 0x4a, 0xb8, 0xf0,
+		0x33, 0x01, 0xc8, 0xf8, 0xe7, 0x48, 0x1e, 0x02, 0x82, 0x18, 0x78, 0xdc, 0x81, 0x2a, 0x86, 0x31,
+		0x4b, 0xd2, 0xc5, 0xcb, 0xc8, 0xc5, 0x1d, 0x5a, 0x1f, 0x74, 0xd9, 0x00, 0x2c, 0x82, 0x67, 0x23,
+		0x02, 0x58, 0xae, 0x93, 0x60, 0xe6, 0xb1, 0xd1, 0x5f, 0x69, 0x10, 0x00, 0x7c, 0xa3, 0x7a, 0x1d,
+		0x37, 0xaa, 0x0c, 0xa7, 0x27, 0x5b, 0xfc, 0x53, 0x09, 0xf9, 0xa7, 0x52, 0x78, 0x16, 0xb2, 0x43,
+		0xfc, 0x24, 0x05, 0x48, 0x8b, 0xa8, 0x00, 0x3b, 0xcb, 0xe5, 0xf6, 0x71, 0x42, 0x4d, 0x72, 0xef,
+		0x9f, 0x9d, 0x6d, 0x2f, 0xc7, 0x7f, 0x15, 0x43, 0x51, 0x18, 0x30, 0x7f, 0x08, 0xd2, 0x3e, 0x8e,
+		0xca, 0x48, 0x5d, 0xc0, 0x8d, 0xd4, 0xb9, 0xc0, 0x2f, 0x41, 0x0a, 0x15, 0x25, 0x6d, 0x09, 0xd8,
+		0xfb, 0x21, 0xcb, 0x15, 0xf9, 0x30, 0xaf, 0xb9, 0x25, 0xb5, 0x2a, 0x19, 0x5e, 0x80, 0xb4, 0x0b,
+		0xe7, 0xb2, 0x3d, 0x29, 0xa1, 0x2e, 0xe9, 0x56, 0xe9, 0xbd, 0x1f, 0x7f, 0xf3, 0x44, 0xe0, 0xcb,
+		0xf3, 0x21, 0xfe, 0xe1, 0x7b, 0xe1, 0xb8, 0xa7, 0x51, 0xdf, 0x6a, 0xb6, 0x7d, 0x51, 0x87, 0xac,
+		0xdb, 0xb8, 0x84, 0x8d, 0xd3, 0xa2, 0x07, 0xfb, 0xc6, 0x30, 0xf6, 0x0f, 0x98, 0xe5, 0xf7, 0xd7,
+		0x08, 0xd3, 0x03, 0x1b, 0xe3, 0x63, 0x94, 0x5f, 0x8c, 0x43, 0x4c, 0xa5, 0x1f, 0x18, 0x50, 0x0b,
+		0xaf, 0xaf, 0x87, 0x69, 0x73, 0xd7, 0x1c, 0x17, 0x12, 0xc2, 0xc9, 0x2d, 0x09, 0xb8, 0x6a, 0x73,
+		0xd7, 0xbc, 0x7c, 0x48, 0x65, 0xb0, 0xe4, 0x1c, 0x44, 0xb6, 0x3b, 0x03, 0x11, 0xa7, 0x18, 0x52,
+		0x16, 0x5e, 0xa4, 0x8b, 0x08, 0x74, 0xf9, 0x90, 0xca, 0xa1, 0xb1, 0x2b, 0xf6, 0x1b, 0x39, 0xa1,
+		0xfd, 0xbb, 0xc2, 0x8b, 0xc5, 0xd8, 0x15, 0xc2, 0x92, 0x12, 0x40, 0xdb, 0x68, 0xdb, 0x1a, 0xf3,
+		0xe1, 0x73, 0x91, 0x51, 0x2d, 0xe1, 0xc7, 0x6c, 0xdb, 0xcc, 0xeb, 0xc7, 0x48, 0x6a, 0x5b, 0x16,
+		0x70, 0xb8, 0x1f, 0x18, 0xd0, 0xfe, 0x5e, 0x2e, 0xba, 0xff, 0x70, 0xdf, 0x8b, 0x40, 0x38, 0x5c,
+		0x06, 0x4d, 0xaa, 0x90, 0x64, 0x97, 0xa5, 0xf9, 0xf7, 0x2b, 0x1e, 0xf9, 0x2a, 0x4c, 0x42, 0x2e,
+		0x21, 0x28, 0xfb, 0xa4, 0x2f, 0x1f, 0x52, 0x61, 0xcb, 0x29, 0xa1, 0x92, 0xe4, 0x4f, 0x39, 0xdb,
+		0x37, 0x73, 0xf1, 0x51, 0x35, 0xe5, 0xa5, 0xc1, 0xde, 0x73, 0xde, 0xb8, 0x89, 0x51, 0xe2, 0x26,
+		0xff, 0x13, 0xe7, 0xdf, 0xa2, 0x9d, 0x36, 0xbe, 0x94, 0x6d, 0xdf, 0xcc, 0x25, 0xf6, 0x9f, 0x7f,
+		0x85, 0x43, 0x32, 0x0a, 0x89, 0x96, 0x2c, 0xa0, 0xa2, 0xa5, 0x46, 0x4b, 0x4c, 0x03, 0x46, 0xf5,
+		0xb4, 0x6f, 0x9d, 0x8d, 0x96, 0x9c, 0x44, 0x9c, 0x8a, 0xbf, 0xc9, 0x53, 0x8e, 0x85, 0x9a, 0x1c,
+		0x35, 0x0a, 0x7d, 0x13, 0xe0, 0xb1, 0x8a, 0x43, 0xd2, 0x52, 0x25, 0x75, 0xc8, 0x74, 0xda, 0x96,
+		0xad, 0x59, 0x86, 0xde, 0xb3, 0x76, 0x4d, 0x5b, 0x3e, 0xf3, 0x7a, 0xdf, 0x24, 0x0a, 0x2b, 0x6d,
+		0xcb, 0x6e, 0x48, 0xe0, 0xcb, 0x87, 0xd4, 0x74, 0xc7, 0x5b, 0x81, 0xf4, 0xcc, 0xed, 0x6d, 0xda,
+		0x77, 0x08, 0xe6, 0xd2, 0xfb, 0xd3, 0x5b, 0x43, 0x68, 0x89, 0x8f, 0xf4, 0x4c, 0x6f, 0x05, 0xf9,
+		0x5a, 0x98, 0xef, 0x98, 0x7a, 0xcb, 0x21, 0xa7, 0x35, 0x77, 0x07, 0xc6, 0x35, 0x16, 0x49, 0xc0,
+		0x97, 0xfd, 0x27, 0x0d, 0xd2, 0xd4, 0x5b, 0x92, 0x44, 0x19, 0x11, 0x2e, 0x1f, 0x52, 0xe7, 0x3a,
+		0xc3, 0x95, 0xe4, 0x7d, 0xb0, 0xa0, 0xf7, 0x7a, 0x9d, 0xbd, 0x61, 0xea, 0x59, 0x46, 0xfd, 0xf4,
+		0x24, 0xea, 0x45, 0xc4, 0x19, 0x26, 0x4f, 0xf4, 0x91, 0x5a, 0xb2, 0x81, 0x31, 0x10, 0xca, 0x6e,
+		0x0d, 0xf5, 0x84, 0xa9, 0xc2, 0xde, 0x50, 0xc5, 0x1f, 0xd2, 0x9a, 0x40, 0x7b, 0x9d, 0xc3, 0x4b,
+		0xcb, 0xe6, 0xf2, 0x21, 0x0c, 0x97, 0xf8, 0xaa, 0x38, 0x55, 0xb3, 0x49, 0xd9, 0xd3, 0xd2, 0x82,
+		0xea, 0xdc, 0x34, 0xaa, 0x0c, 0xde, 0x4f, 0xd5, 0x57, 0x55, 0x8a, 0x89, 0xac, 0x3f, 0xf1, 0xcb,
+		0x2a, 0x0f, 0x40, 0xd2, 0xa3, 0x58, 0x30, 0xa8, 0x2e, 0xce, 0xf8, 0x65, 0xb6, 0xa0, 0x28, 0x16,
+		0x32, 0x90, 0xf2, 0x2a, 0x93, 0xc2, 0x47, 0x02, 0x90, 0xf4, 0xe8, 0x09, 0xc4, 0xf4, 0x86, 0x0e,
+		0x13, 0x6e, 0x54, 0xf0, 0x5e, 0xb9, 0x8b, 0xc8, 0x76, 0x7e, 0x9a, 0x94, 0x62, 0x95, 0x62, 0x13,
+		0xc3, 0xe7, 0x13, 0x7a, 0x67, 0x7b, 0x0e, 0x48, 0x88, 0x81, 0x40, 0xef, 0x6c, 0x4f, 0x02, 0xdc,
+		0x03, 0x29, 0x9c, 0xa9, 0xe6, 0x35, 0x34, 0x12, 0x6a, 0x12, 0xeb, 0x04, 0x48, 0xe1, 0x0f, 0x82,
+		0xa0, 0x0c, 0x2b, 0x20, 0x27, 0xa6, 0x18, 0x38, 0x70, 0x4c, 0xf1, 0xd8, 0x70, 0x34, 0xd3, 0x0d,
+		0x60, 0xae, 0x80, 0xe2, 0xc6, 0xe1, 0xf8, 0x46, 0x30, 0xd9, 0x70, 0x1a, 0xb2, 0xf0, 0xd4, 0x6c,
+		0xd3, 0x5f, 0x41, 0x2e, 0xfa, 0xce, 0x5e, 0xe4, 0x8f, 0xc0, 0x0d, 0x2f, 0xb1, 0x63, 0x2f, 0x6c,
+		0xf6, 0x5a, 0xba, 0x4d, 0x65, 0x5c, 0xc4, 0xc5, 0xc4, 0x6d, 0x1d, 0xc3, 0x7e, 0x96, 0xad, 0xdb,
+		0x54, 0x6c, 0xeb, 0x11, 0x1e, 0x1e, 0xd4, 0x7b, 0x3d, 0xf6, 0x9b, 0x39, 0x7c, 0x5b, 0xbf, 0x0f,
+		0x32, 0xa8, 0x93, 0xdb, 0x7a, 0x47, 0x13, 0x51, 0x82, 0x28, 0xdf, 0xfd, 0x45, 0xed, 0x65, 0x56,
+		0x59, 0x68, 0x41, 0xca, 0xab, 0x8f, 0x1d, 0x37, 0x2b, 0xe0, 0x71, 0xb3, 0x88, 0x78, 0x0d, 0x83,
+		0xf3, 0x47, 0xbe, 0x20, 0x32, 0x3e, 0xba, 0xbb, 0xc0, 0x5c, 0xb2, 0xeb, 0x3c, 0xe0, 0x11, 0x57,
+		0x79, 0xa1, 0xf0, 0xa1, 0x20, 0xcc, 0x8d, 0x68, 0xee, 0xb1, 0x61, 0x6f, 0xd7, 0xbf, 0x0c, 0x1e,
+		0xc8, 0xbf, 0x7c, 0xce, 0x1f, 0xd6, 0xf5, 0xec, 0x7c, 0xc7, 0x47, 0x98, 0xcc, 0xf5, 0x26, 0x0a,
+		0xb4, 0x20, 0xe2, 0x89, 0xfc, 0x32, 0x31, 0xdf, 0x84, 0x85, 0xad, 0xbd, 0x57, 0x74, 0xc3, 0x6e,
+		0x1b, 0x54, 0x1b, 0x59, 0xb5, 0xd1, 0xad, 0x74, 0xb5, 0x6d, 0x6d, 0xd1, 0x5d, 0xfd, 0x7a, 0xdb,
+		0x94, 0xc3, 0x9a, 0x77, 0xf0, 0xdd, 0xe0, 0x6e, 0x41, 0x85, 0x8c, 0x7f, 0xeb, 0x21, 0x19, 0x08,
+		0xda, 0x37, 0xc5, 0xfc, 0x83, 0xf6, 0x4d, 0xf2, 0xa8, 0x88, 0x03, 0xf1, 0x57, 0xc8, 0x46, 0x3b,
+		0x12, 0x78, 0x6e, 0x10, 0xa8, 0x50, 0x00, 0x65, 0x78, 0x3b, 0x1a, 0xa6, 0x5a, 0x78, 0x10, 0xb2,
+		0x43, 0xfb, 0xcd, 0xa4, 0xa8, 0x60, 0x21, 0x0b, 0x69, 0x01, 0xca, 0xd9, 0x51, 0x38, 0x02, 0x0b,
+		0xe3, 0xf6, 0x8a, 0xc2, 0x2e, 0x2c, 0x8c, 0xd3, 0xf9, 0xe4, 0x1c, 0xc4, 0x9d, 0xcd, 0x62, 0x4c,
+		0x14, 0x82, 0xcd, 0x42, 0x02, 0xab, 0x0e, 0xa8, 0x2f, 0x98, 0x1d, 0xf4, 0x05, 0xb3, 0x0b, 0xef,
+		0x87, 0xdc, 0xa4, 0x8d, 0x60, 0x68, 0x1a, 0x61, 0x47, 0x0a, 0x8f, 0x40, 0x54, 0xbc, 0xe1, 0x15,
+		0x64, 0xc7, 0x37, 0xa2, 0x84, 0xd2, 0xc9, 0x37, 0x85, 0x10, 0xab, 0xe6, 0x85, 0x82, 0x06, 0xc7,
+		0x26, 0x6e, 0x06, 0x93, 0x0f, 0x82, 0x38, 0x21, 0x71, 0x10, 0xd4, 0x94, 0xc3, 0xb1, 0xd8, 0x5c,
+		0x65, 0xb2, 0x03, 0x2f, 0x15, 0x5e, 0x0f, 0xc1, 0x91, 0xf1, 0x5b, 0x02, 0x59, 0x84, 0x14, 0xda,
+		0xdd, 0xb6, 0xdf, 0x44, 0x87, 0xae, 0x7e, 0x73, 0x43, 0xd8, 0xe7, 0x22, 0x90, 0x1e, 0x74, 0x02,
+		0xe9, 0x64, 0x13, 0xe6, 0x3a, 0x66, 0x53, 0xef, 0x68, 0x1e, 0x89, 0x17, 0xc2, 0x7e, 0xef, 0x08,
+		0xb3, 0xab, 0xfc, 0xc7, 0xa1, 0x5a, 0x23, 0x42, 0x9f, 0x65, 0x34, 0x56, 0x1c, 0xc9, 0x27, 0x15,
+		0x48, 0x76, 0x5d, 0x41, 0x3e, 0x80, 0xb0, 0x7b, 0xd1, 0x3c, 0x4b, 0x12, 0x19, 0x7b, 0xec, 0x13,
+		0x3d, 0xb0, 0x8a, 0x9e, 0x74, 0x82, 0x12, 0x9b, 0x78, 0x82, 0x32, 0xee, 0xb8, 0x22, 0x3e, 0xfe,
+		0xb8, 0xe2, 0xc3, 0xde, 0xa5, 0xf1, 0x6d, 0xa2, 0xa3, 0x27, 0x18, 0xa4, 0x01, 0x0b, 0x02, 0xbf,
+		0xe5, 0xe3, 0x7d, 0x70, 0x56, 0x45, 0x43, 0x24, 0xfa, 0x64, 0xb6, 0x87, 0x6e, 0x8f, 0xed, 0x52,
+		0x97, 0x86, 0x3d, 0xba, 0xf4, 0xff, 0xb2, 0xa5, 0xf8, 0x37, 0x09, 0x88, 0xab, 0xd4, 0xea, 0xe1,
+		0xc6, 0x89, 0xa1, 0x64, 0x7a, 0xb3, 0x49, 0x7b, 0xb6, 0x7b, 0x4c, 0x39, 0xce, 0x19, 0xe0, 0xd0,
+		0x55, 0x09, 0x89, 0x96, 0xb8, 0x83, 0x86, 0x51, 0x75, 0xe6, 0x6c, 0x4d, 0xf6, 0x9b, 0x04, 0xba,
+		0xd7, 0xdb, 0x3a, 0x2f, 0xbd, 0xad, 0xd0, 0x44, 0xe3, 0x9b, 0x63, 0x0d, 0xb9, 0x5b, 0x8f, 0x0b,
+		0x77, 0x2b, 0x3c, 0xa5, 0x33, 0x9f, 0xbf, 0x55, 0xf6, 0xf9, 0x5b, 0xd1, 0x29, 0xd3, 0x9c, 0xe0,
+		0x70, 0x9d, 0x97, 0x0e, 0x57, 0x6c, 0xca, 0x88, 0x87, 0x3c, 0xae, 0x8b, 0x7e, 0x8f, 0x2b, 0x3e,
+		0x41, 0x81, 0x48, 0xec, 0x89, 0x2e, 0xd7, 0x33, 0x1e, 0x97, 0x2b, 0x31, 0xd1, 0xdf, 0xe1, 0x44,
+		0xc6, 0xf8, 0x5c, 0x65, 0x9f, 0xcf, 0x05, 0x53, 0x78, 0x30, 0xc1, 0xe9, 0xfa, 0x1a, 0xaf, 0xd3,
+		0x95, 0x9c, 0xe8, 0xb7, 0x89, 0xf5, 0x1e, 0xe7, 0x75, 0x5d, 0x70, 0xbc, 0xae, 0xd4, 0x44, 0xb7,
+		0x51, 0xcc, 0x61, 0xd8, 0xed, 0x5a, 0x1b, 0x71, 0xbb, 0xd2, 0xe2, 0x87, 0x34, 0x27, 0x91, 0x98,
+		0xe2, 0x77, 0xad, 0x8d, 0xf8, 0x5d, 0x99, 0x29, 0x04, 0xa7, 0x38, 0x5e, 0x5f, 0x37, 0xde, 0xf1,
+		0x9a, 0xec, 0x1a, 0x89, 0x61, 0xce, 0xe6, 0x79, 0x69, 0x13, 0x3c, 0x2f, 0xee, 0x1d, 0x3d, 0x34,
+		0x91, 0xfc, 0xcc, 0xae, 0xd7, 0xe6, 0x18, 0xd7, 0x8b, 0x3b, 0x49, 0xa7, 0x26, 0x12, 0x9f, 0xc1,
+		0xf7, 0xda, 0x1c, 0xe3, 0x7b, 0x91, 0xa9, 0x64, 0x0f, 0xe2, 0x7c, 0xe1, 0xb3, 0xe0, 0x0f, 0xc2,
+		0x9c, 0x44, 0x76, 0xf4, 0x14, 0xda, 0x0f, 0xb4, 0xdf, 0x37, 0xfb, 0x32, 0xb3, 0x96, 0x15, 0x0a,
+		0xa7, 0x20, 0xe5, 0x80, 0xee, 0xef, 0xa8, 0x31, 0x3b, 0xcd, 0xa3, 0x87, 0x0a, 0xbf, 0x1a, 0x80,
+		0x94, 0x57, 0xc5, 0xf8, 0x0c, 0xf9, 0x84, 0x30, 0xe4, 0x3d, 0xee, 0x5b, 0xd0, 0xef, 0xbe, 0xe1,
+		0xfb, 0xef, 0xbd, 0x11, 0xcf, 0x4c, 0xef, 0x39, 0x9e, 0x99, 0x4c, 0x29, 0xe0, 0x4e, 0x9e, 0xd8,
+		0x56, 0xf8, 0x49, 0x4e, 0xd6, 0x49, 0xaf, 0xe0, 0x3e, 0x05, 0x79, 0x04, 0xe6, 0x3d, 0xb0, 0x8e,
+		0x5d, 0xc7, 0xdd, 0x14, 0xc5, 0x81, 0x2e, 0x0a, 0x03, 0xef, 0x9f, 0x05, 0x60, 0x6e, 0x44, 0xc5,
+		0x8d, 0xf5, 0xbe, 0x02, 0x77, 0xc8, 0xfb, 0x0a, 0xde, 0xb6, 0xf7, 0xe5, 0xb5, 0x53, 0x43, 0x7e,
+		0x3b, 0xf5, 0xbf, 0x07, 0x20, 0xed, 0xd3, 0xb4, 0xb8, 0x04, 0x4d, 0xb3, 0x45, 0x85, 0xe5, 0xc8,
+		0xfe, 0x46, 0xa3, 0xa2, 0x63, 0xca, 0x1f, 0x9c, 0xc0, 0x3f, 0x11, 0xca, 0xd9, 0x38, 0x12, 0x62,
+		0x5f, 0x70, 0x8c, 0xce, 0x88, 0x37, 0xff, 0x57, 0x24, 0xc5, 0x46, 0xdd, 0xa4, 0x58, 0xe7, 0x76,
+		0x5f, 0xcc, 0x73, 0xbb, 0x8f, 0x3c, 0x05, 0x09, 0x16, 0x11, 0xd5, 0x30, 0x21, 0x36, 0x3e, 0x6a,
+		0x9b, 0xf8, 0x13, 0x62, 0x2d, 0x96, 0xaa, 0xc7, 0xfe, 0xf2, 0x58, 0x0c, 0x09, 0x9f, 0xc5, 0x70,
+		0x17, 0x24, 0x70, 0xf4, 0xfc, 0x67, 0xaa, 0x40, 0x5c, 0x0d, 0x95, 0x15, 0x85, 0xf7, 0x01, 0x19,
+		0xdd, 0x24, 0xc8, 0x65, 0x88, 0xd2, 0xeb, 0xec, 0x81, 0x47, 0x9e, 0x68, 0x78, 0x64, 0xd4, 0x34,
+		0xc5, 0xe6, 0x52, 0x0e, 0x99, 0xfc, 0xd9, 0x37, 0x4e, 0x2a, 0x1c, 0xfa, 0x61, 0x27, 0xcf, 0x5f,
+		0x15, 0xf8, 0x85, 0x3f, 0x0d, 0x42, 0x56, 0x76, 0x20, 0x3d, 0xa7, 0x71, 0xbc, 0x1d, 0x77, 0x44,
+		0x38, 0x1b, 0xbf, 0x4f, 0x00, 0xec, 0xe8, 0x96, 0x76, 0x43, 0x37, 0x6c, 0xf1, 0xd3, 0xb5, 0x21,
+		0xd5, 0x53, 0x83, 0x09, 0xb6, 0x58, 0x1a, 0x58, 0xe2, 0xc7, 0x6b, 0x43, 0xaa, 0x53, 0xf6, 0xcc,
+		0x33, 0xf6, 0xa5, 0xcd, 0xd3, 0xcf, 0xe5, 0xf8, 0x10, 0x97, 0x3d, 0xce, 0x45, 0xc2, 0xeb, 0x5c,
+		0xf0, 0xc4, 0x60, 0x91, 0x9f, 0x08, 0x7c, 0x6c, 0xb2, 0x8c, 0x51, 0x99, 0x2e, 0xed, 0xf6, 0x4c,
+		0xb3, 0xa3, 0x71, 0x75, 0xc3, 0x7f, 0x56, 0x2e, 0x25, 0x2a, 0xab, 0x4c, 0xeb, 0x7c, 0x73, 0x10,
+		0xe6, 0x46, 0xb6, 0xd7, 0xbf, 0x7b, 0x0c, 0x66, 0x3f, 0x74, 0x32, 0x6c, 0x22, 0x90, 0x86, 0x37,
+		0x6b, 0x64, 0xc0, 0xd4, 0x82, 0x14, 0xe8, 0x59, 0xf5, 0x87, 0x72, 0xdd, 0x5f, 0x6d, 0x91, 0x17,
+		0xe1, 0xe8, 0x90, 0x6e, 0x73, 0x48, 0x07, 0x67, 0x55, 0x71, 0x87, 0xfd, 0x2a, 0x4e, 0x92, 0x76,
+		0x99, 0x15, 0xfa, 0x12, 0xbf, 0xba, 0x1a, 0x64, 0x24, 0x37, 0x84, 0xa7, 0x32, 0x6e, 0xf9, 0xd9,
+		0xcf, 0x98, 0xda, 0x18, 0x40, 0xf3, 0x85, 0x83, 0x52, 0xbc, 0x52, 0x04, 0x99, 0xd6, 0xe1, 0xb0,
+		0x24, 0xe5, 0xb3, 0x7c, 0xc8, 0x93, 0x90, 0x70, 0x8d, 0x26, 0xce, 0xd5, 0x7d, 0xc2, 0x05, 0x2e,
+		0x6c, 0xe1, 0x37, 0x02, 0x70, 0x78, 0xac, 0xed, 0x43, 0xaa, 0x10, 0xe5, 0x69, 0x6f, 0x22, 0x99,
+		0xe6, 0x91, 0xd9, 0x6c, 0xa6, 0x25, 0x9e, 0x13, 0xa7, 0x0a, 0xe4, 0xc2, 0xfb, 0x20, 0xca, 0x6b,
+		0x48, 0x12, 0x62, 0xbe, 0xf7, 0xa7, 0x8b, 0xe5, 0x72, 0x75, 0x5d, 0x3e, 0xa8, 0x5a, 0x62, 0x2f,
+		0x3c, 0x63, 0xb5, 0x5a, 0xbd, 0x52, 0x2d, 0x6f, 0x28, 0x21, 0xfc, 0xed, 0x5e, 0xfe, 0xb7, 0x78,
+		0x2b, 0x5a, 0x09, 0x7b, 0xaa, 0x1a, 0xd5, 0x7a, 0xa5, 0xaa, 0x2a, 0x91, 0xc2, 0x63, 0x70, 0x4c,
+		0x8e, 0x63, 0x34, 0xac, 0xe1, 0x44, 0x17, 0x02, 0x9e, 0xe8, 0x42, 0xe1, 0xf5, 0x20, 0xe4, 0x27,
+		0x9b, 0x4e, 0xe4, 0xca, 0xd0, 0xc4, 0xcf, 0x1e, 0xc0, 0xee, 0x1a, 0x9a, 0x3d, 0x06, 0x0f, 0xfb,
+		0x74, 0x9b, 0xda, 0xcd, 0x5d, 0x6e, 0xca, 0xf1, 0x2d, 0x33, 0xad, 0xa6, 0x45, 0x2d, 0x43, 0xb2,
+		0x38, 0xd8, 0xcb, 0xb4, 0x69, 0x6b, 0x5c, 0x17, 0x71, 0xa1, 0x4b, 0xa8, 0x69, 0x5e, 0xdb, 0xe0,
+		0x95, 0x85, 0xf7, 0x1f, 0x88, 0x97, 0x09, 0x88, 0xa8, 0x55, 0xfc, 0x71, 0x1e, 0xf6, 0x03, 0xc5,
+		0xec, 0x4f, 0xad, 0x51, 0x2f, 0xae, 0x37, 0x2e, 0xaf, 0x21, 0x2f, 0xe7, 0x21, 0x2b, 0x79, 0x29,
+		0x2b, 0x23, 0x85, 0x87, 0xe0, 0xe8, 0x04, 0xbb, 0x6f, 0x4c, 0x1e, 0xe2, 0x47, 0x03, 0x5e, 0x68,
+		0xbf, 0xcf, 0xbf, 0x06, 0x51, 0xcb, 0xd6, 0xed, 0x81, 0x25, 0x98, 0xf8, 0xe4, 0xac, 0x86, 0xe0,
+		0x92, 0xfc, 0xa3, 0xc1, 0xd0, 0x55, 0x41, 0xa6, 0x70, 0x0e, 0x32, 0xfe, 0x96, 0xc9, 0x3c, 0x70,
+		0x85, 0x28, 0x58, 0x78, 0x11, 0xc0, 0x13, 0x8f, 0x74, 0x32, 0xba, 0x02, 0xde, 0x8c, 0xae, 0x73,
+		0x10, 0xc1, 0x8c, 0x0c, 0x69, 0xce, 0x8c, 0x7e, 0x38, 0x98, 0x6c, 0xe9, 0x09, 0x3e, 0x70, 0xe8,
+		0x42, 0x1b, 0xc8, 0x68, 0x4c, 0x68, 0x42, 0x17, 0xcf, 0xf8, 0xbb, 0xb8, 0x67, 0x62, 0x74, 0x69,
+		0x7c, 0x57, 0xaf, 0x40, 0x84, 0x69, 0x9b, 0xb1, 0x57, 0x7c, 0xbe, 0x1e, 0x40, 0xb7, 0xed, 0x7e,
+		0x7b, 0x6b, 0xe0, 0x76, 0x70, 0x72, 0xbc, 0xb6, 0x2a, 0x4a, 0xb8, 0xd2, 0x5d, 0x42, 0x6d, 0x2d,
+		0xb8, 0xa8, 0x1e, 0xd5, 0xe5, 0x21, 0x58, 0xa8, 0x43, 0xc6, 0x8f, 0x3b, 0xfe, 0xca, 0xd2, 0xe8,
+		0x0f, 0x99, 0xb9, 0xc6, 0x97, 0x78, 0xb0, 0x84, 0x15, 0x0a, 0xaf, 0x05, 0x20, 0xbe, 0x71, 0x53,
+		0xc8, 0xf1, 0x3e, 0x49, 0x95, 0xee, 0xbd, 0x2d, 0x27, 0x58, 0xc8, 0xe3, 0xb1, 0x21, 0x27, 0xca,
+		0xfb, 0x35, 0xce, 0x97, 0x1a, 0x9e, 0xd5, 0xdb, 0x95, 0xd1, 0x6e, 0xa1, 0x9d, 0x9e, 0x9e, 0xed,
+		0x8e, 0x04, 0x06, 0xe3, 0x3d, 0xf7, 0x1b, 0x78, 0xa1, 0xd0, 0xf2, 0xa4, 0x25, 0xf0, 0x6d, 0xc3,
+		0x7b, 0x99, 0x22, 0x70, 0xe0, 0xcb, 0x14, 0x4e, 0x2f, 0x41, 0x6f, 0x2f, 0xd7, 0x21, 0x2e, 0x85,
+		0x82, 0xbc, 0xc7, 0x9b, 0x7b, 0x22, 0xcf, 0x68, 0x26, 0x6e, 0x9e, 0x82, 0xbc, 0x8b, 0x82, 0xce,
+		0x87, 0x48, 0xb9, 0x73, 0xfd, 0x0a, 0xf1, 0x1b, 0x55, 0x59, 0xde, 0xb0, 0x22, 0x9d, 0x8a, 0xc2,
+		0x4f, 0x05, 0x40, 0x19, 0x96, 0xca, 0xaf, 0xe4, 0x00, 0x50, 0x29, 0xa2, 0xf4, 0x7b, 0x7e, 0x16,
+		0x85, 0xaf, 0x7c, 0x1a, 0x6b, 0xdd, 0x1f, 0x46, 0xf9, 0x50, 0x10, 0x92, 0x9e, 0x98, 0x1e, 0x79,
+		0xc2, 0x97, 0x02, 0xba, 0xb8, 0x5f, 0xfc, 0xcf, 0x93, 0x03, 0xea, 0x9b, 0x58, 0xf0, 0xe0, 0x13,
+		0xbb, 0xf3, 0x49, 0xfa, 0xe3, 0x6f, 0xfb, 0x44, 0x26, 0xdc, 0xf6, 0xf9, 0xc6, 0x00, 0xc4, 0x9d,
+		0xad, 0xfb, 0xa0, 0xd1, 0xfc, 0x23, 0x10, 0x15, 0xbb, 0x13, 0x0f, 0xe7, 0x8b, 0xd2, 0xd8, 0x58,
+		0x68, 0x1e, 0xe2, 0xf2, 0x17, 0x77, 0x85, 0x23, 0xea, 0x94, 0x4f, 0x5f, 0x80, 0xa4, 0xe7, 0x60,
+		0x05, 0xf5, 0x44, 0xbd, 0xfa, 0xbc, 0x72, 0x28, 0x1f, 0x7b, 0xed, 0xd6, 0x62, 0xa8, 0x4e, 0x6f,
+		0xe0, 0x17, 0xa6, 0x56, 0xcb, 0x97, 0xab, 0xe5, 0xe7, 0x94, 0x40, 0x3e, 0xf9, 0xda, 0xad, 0xc5,
+		0x98, 0x4a, 0x59, 0xf8, 0xea, 0xf4, 0x73, 0x90, 0x1d, 0x5a, 0x18, 0xbf, 0x7e, 0x27, 0x90, 0xa9,
+		0x6c, 0xe2, 0x4f, 0x72, 0x14, 0x37, 0xaa, 0x1a, 0x4f, 0xa7, 0xc3, 0xe7, 0xcd, 0x57, 0x6a, 0x97,
+		0x2e, 0x6f, 0x68, 0xe5, 0x95, 0x5a, 0xb5, 0xbe, 0xa1, 0x15, 0x37, 0x36, 0x8a, 0xe5, 0xe7, 0x94,
+		0xe0, 0xd9, 0x2f, 0x00, 0x64, 0x8b, 0xa5, 0x72, 0x0d, 0xf7, 0xe7, 0xb6, 0x78, 0xc1, 0xbf, 0x0c,
+		0x61, 0x16, 0x0a, 0xd8, 0x37, 0x55, 0x24, 0xbf, 0x7f, 0x6c, 0x93, 0x5c, 0x84, 0x08, 0x8b, 0x12,
+		0x90, 0xfd, 0x73, 0x47, 0xf2, 0x53, 0x82, 0x9d, 0x38, 0x18, 0xf6, 0x39, 0xed, 0x9b, 0x4c, 0x92,
+		0xdf, 0x3f, 0xf6, 0x49, 0x54, 0x48, 0xb8, 0x5e, 0xc6, 0xf4, 0xe4, 0x8a, 0xfc, 0x0c, 0xda, 0x91,
+		0xac, 0x40, 0x4c, 0x3a, 0x86, 0xd3, 0xd2, 0x3d, 0xf2, 0x53, 0x83, 0x93, 0xc8, 0x2e, 0xee, 0xc0,
+		0xef, 0x9f, 0xbb, 0x92, 0x9f, 0x12, 0x69, 0x25, 0x35, 0x27, 0x09, 0x7f, 0x4a, 0x0a, 0x47, 0x7e,
+		0x5a, 0xb0, 0x11, 0x99, 0xe6, 0x86, 0x46, 0xa6, 0x67, 0xe4, 0xe4, 0x67, 0x08, 0x22, 0x93, 0x4d,
+		0x00, 0x8f, 0xbb, 0x3e, 0x43, 0xaa, 0x4d, 0x7e, 0x96, 0xe0, 0x30, 0x59, 0x83, 0xb8, 0xe3, 0x3d,
+		0x4d, 0x4d, 0x7c, 0xc9, 0x4f, 0x8f, 0xd2, 0x92, 0xf7, 0x41, 0xda, 0xef, 0x35, 0xcc, 0x96, 0xce,
+		0x92, 0x9f, 0x31, 0xfc, 0x8a, 0xf4, 0xfd, 0x2e, 0xc4, 0x6c, 0xe9, 0x2d, 0xf9, 0x19, 0xa3, 0xb1,
+		0xe4, 0x65, 0x98, 0x1b, 0x35, 0xf1, 0x67, 0xcf, 0x76, 0xc9, 0x1f, 0x20, 0x3e, 0x4b, 0xba, 0x40,
+		0xc6, 0xb8, 0x06, 0x07, 0x48, 0x7e, 0xc9, 0x1f, 0x24, 0x5c, 0x4b, 0x5a, 0x90, 0x1d, 0xb6, 0xb7,
+		0x67, 0x4d, 0x86, 0xc9, 0xcf, 0x1c, 0xba, 0xe5, 0xbd, 0xf8, 0xed, 0xf4, 0x59, 0x93, 0x63, 0xf2,
+		0x33, 0x47, 0x72, 0x4b, 0xc5, 0x89, 0xf9, 0x8d, 0x0f, 0xec, 0x9b, 0xdf, 0xe8, 0x66, 0x2c, 0x3a,
+		0x39, 0x8d, 0xff, 0xeb, 0x19, 0x78, 0x97, 0x78, 0x4c, 0xc0, 0xb2, 0xf5, 0x6b, 0x6d, 0x63, 0xc7,
+		0x79, 0x1d, 0x42, 0x94, 0x45, 0x72, 0xe3, 0x11, 0x0e, 0xb5, 0x24, 0x6b, 0xa7, 0xbc, 0x11, 0x31,
+		0xf1, 0x65, 0xb0, 0x69, 0x49, 0xc8, 0xd3, 0x53, 0x17, 0xf7, 0x79, 0x7f, 0x62, 0xca, 0x2b, 0x17,
+		0x63, 0xde, 0xa7, 0x98, 0x92, 0x84, 0xb9, 0x5f, 0xbe, 0x67, 0xe1, 0x7b, 0x02, 0x90, 0xb9, 0xdc,
+		0xb6, 0x6c, 0xb3, 0xdf, 0x6e, 0xea, 0x1d, 0xb6, 0x63, 0x3c, 0x3d, 0xeb, 0x25, 0x8f, 0x52, 0x02,
+		0x8d, 0x11, 0xf1, 0xa8, 0x05, 0x47, 0x21, 0x15, 0x88, 0x5e, 0xd7, 0x3b, 0xfc, 0x8a, 0x85, 0xf7,
+		0x09, 0x9b, 0x61, 0x9e, 0x7b, 0xac, 0x24, 0x2f, 0x15, 0x8e, 0x5b, 0xf8, 0x39, 0x96, 0xc4, 0xdd,
+		0xed, 0xb6, 0x2d, 0x34, 0xde, 0x54, 0x16, 0x6a, 0x59, 0x87, 0x70, 0x5f, 0xb7, 0x85, 0x53, 0x53,
+		0x7a, 0xb7, 0x78, 0x8a, 0xe2, 0xfe, 0xe9, 0x0f, 0x4a, 0x2c, 0x8d, 0xbe, 0x56, 0xc1, 0x28, 0x91,
+		0xe7, 0x01, 0x93, 0x87, 0x35, 0x46, 0x35, 0x78, 0x07, 0xa8, 0x62, 0x26, 0x35, 0x8e, 0x15, 0xbf,
+		0x20, 0x24, 0xdc, 0xdc, 0xd5, 0x8d, 0x1d, 0xca, 0xe9, 0x87, 0xee, 0x00, 0xfd, 0x74, 0x57, 0xbf,
+		0x59, 0x66, 0x34, 0xb1, 0x97, 0xe5, 0xf8, 0xeb, 0x3f, 0x76, 0xf2, 0x10, 0xcb, 0x45, 0xfe, 0x9d,
+		0x00, 0x80, 0xcb, 0x2e, 0xa2, 0x63, 0x2c, 0x5f, 0x96, 0x58, 0xf7, 0x32, 0x96, 0xff, 0xc0, 0xa4,
+		0xd5, 0x18, 0x62, 0x76, 0x29, 0x8d, 0x03, 0xfd, 0xe4, 0x1b, 0x27, 0x03, 0x7c, 0x5d, 0xb2, 0xcd,
+		0xa1, 0xc5, 0xb8, 0x02, 0x49, 0x1e, 0x42, 0xd3, 0x98, 0xdd, 0x1a, 0x9c, 0x6a, 0xb7, 0xa6, 0xa5,
+		0xdd, 0xca, 0x09, 0x02, 0xc7, 0xc6, 0x76, 0xcf, 0x3c, 0xde, 0x08, 0xc2, 0xd1, 0x75, 0x6a, 0xc8,
+		0x1f, 0x3e, 0xe2, 0x1d, 0xf2, 0x19, 0x93, 0xea, 0xb8, 0xfb, 0x64, 0x5c, 0x16, 0x72, 0x7f, 0xf4,
+		0xcb, 0x8f, 0x2c, 0x88, 0x89, 0x89, 0xd3, 0x6d, 0xfe, 0xb6, 0xf3, 0x98, 0x9b, 0x66, 0x52, 0x8a,
+		0x82, 0x77, 0x4c, 0x8a, 0xea, 0x90, 0xd6, 0x0d, 0xc3, 0x1c, 0x18, 0x4d, 0xc1, 0x8c, 0xd0, 0x41,
+		0x99, 0x91, 0x92, 0xf8, 0x08, 0x41, 0xd6, 0x21, 0x43, 0xb7, 0xb7, 0x69, 0x93, 0xfd, 0x58, 0xe5,
+		0x8c, 0x5e, 0xc1, 0x10, 0xc1, 0xb4, 0x43, 0x60, 0x88, 0xc1, 0xbf, 0x1e, 0x80, 0xf9, 0x0d, 0xf3,
+		0x1a, 0xc5, 0x87, 0x62, 0x1b, 0xbb, 0x7a, 0x9f, 0xaa, 0xb4, 0x69, 0xf6, 0x5b, 0xe8, 0x32, 0x8b,
+		0x6b, 0x4c, 0x61, 0x35, 0xd8, 0x6e, 0xe1, 0x4d, 0x1f, 0xf3, 0x86, 0x21, 0x7c, 0xcc, 0xfd, 0x18,
+		0xcc, 0xc1, 0xd0, 0x09, 0xeb, 0x9a, 0xad, 0x41, 0x87, 0x6a, 0x7a, 0xb3, 0xc9, 0xde, 0xaa, 0x09,
+		0x89, 0xb7, 0xc7, 0x58, 0x6d, 0x91, 0x57, 0x92, 0xf3, 0x5e, 0xf7, 0x29, 0x3c, 0x85, 0xb4, 0x0b,
+		0x2a, 0xde, 0xb3, 0xf9, 0x60, 0x08, 0x08, 0x86, 0x65, 0xd7, 0x99, 0x1f, 0xac, 0xca, 0x1f, 0x8f,
+		0xba, 0x43, 0x82, 0x71, 0x15, 0xb2, 0x26, 0xff, 0xc5, 0x64, 0x4b, 0xeb, 0x0d, 0xb6, 0xdc, 0x87,
+		0x0e, 0x16, 0x46, 0xf8, 0x5e, 0x34, 0xf6, 0x4a, 0xb9, 0xdf, 0x77, 0x49, 0xbb, 0x0e, 0x3b, 0x8e,
+		0x2b, 0x6d, 0x76, 0x5a, 0x62, 0x98, 0x18, 0xf4, 0xb8, 0x0a, 0x59, 0x83, 0xde, 0xf0, 0xd1, 0x0d,
+		0xdd, 0x1e, 0x5d, 0x83, 0xde, 0xf0, 0xd0, 0x75, 0xbd, 0xb7, 0xb0, 0xcf, 0x95, 0x54, 0x01, 0x3f,
+		0xd6, 0x5e, 0x87, 0x22, 0x73, 0xb8, 0xfc, 0x44, 0x0e, 0x2a, 0x3f, 0x19, 0x97, 0x02, 0x17, 0xa0,
+		0x0f, 0x73, 0x01, 0x3a, 0x84, 0xbf, 0xbb, 0x9d, 0xac, 0x78, 0x9e, 0xca, 0xc3, 0x23, 0x53, 0xd3,
+		0x68, 0x5f, 0xa3, 0x7d, 0xe7, 0xc8, 0x94, 0x17, 0xd1, 0x03, 0xe4, 0xbf, 0xd9, 0x6a, 0xef, 0xc9,
+		0x47, 0x52, 0x64, 0x19, 0xb1, 0x6e, 0xd0, 0x2d, 0xab, 0x2d, 0xf5, 0xa2, 0x2a, 0x8b, 0x98, 0xbf,
+		0x62, 0xd1, 0xe6, 0x00, 0x4f, 0x53, 0x90, 0x65, 0xb6, 0xde, 0xe4, 0xf3, 0x4b, 0xa8, 0x59, 0x59,
+		0x5f, 0xe6, 0xd5, 0x48, 0xa4, 0x45, 0x6d, 0xbd, 0xdd, 0xe1, 0x19, 0x99, 0x09, 0x55, 0x16, 0xbd,
+		0xf2, 0x1e, 0xf7, 0x46, 0x6e, 0xca, 0xa0, 0x98, 0x3d, 0xda, 0x3f, 0x90, 0xa0, 0x64, 0x25, 0x86,
+		0x94, 0x93, 0x17, 0x7d, 0x07, 0xa5, 0x5f, 0x8a, 0xa0, 0x78, 0x4e, 0x4d, 0x9d, 0x25, 0x7d, 0x59,
+		0x6f, 0x77, 0xe4, 0x0f, 0x7e, 0xab, 0xa2, 0x44, 0x96, 0x9d, 0x28, 0x29, 0xff, 0x79, 0xc0, 0xc2,
+		0x24, 0x2d, 0x5e, 0x32, 0x8d, 0x96, 0x3f, 0x20, 0x4a, 0x36, 0x20, 0x6a, 0xe3, 0x07, 0x2f, 0x98,
+		0x74, 0x20, 0x8d, 0x37, 0xfa, 0x3c, 0x94, 0xa0, 0x45, 0x76, 0x40, 0x69, 0xd1, 0x0e, 0xdd, 0x61,
+		0xac, 0xb4, 0x50, 0x91, 0xf0, 0x3b, 0xd6, 0x5f, 0xaa, 0x46, 0xcd, 0x3a, 0x54, 0x99, 0x76, 0x42,
+		0x75, 0xed, 0x7b, 0xac, 0x31, 0x26, 0x92, 0x66, 0x26, 0xcc, 0xdf, 0x23, 0x99, 0x5e, 0xab, 0xc2,
+		0x4b, 0x02, 0x25, 0x6c, 0x60, 0x6c, 0x99, 0x6c, 0x93, 0x91, 0x87, 0x28, 0x71, 0x7e, 0xb0, 0xee,
+		0xd4, 0x8b, 0x83, 0xf5, 0x75, 0xc8, 0xb8, 0xa0, 0xec, 0x4b, 0x4a, 0x1c, 0x58, 0x13, 0x3b, 0x04,
+		0x98, 0x6e, 0x5f, 0x05, 0x70, 0x77, 0x52, 0x27, 0xf9, 0x66, 0xea, 0x9e, 0xec, 0x9d, 0x8c, 0x87,
+		0x00, 0xe9, 0xc0, 0x7c, 0xb7, 0x6d, 0x68, 0x16, 0xed, 0x6c, 0x6b, 0x82, 0x73, 0x48, 0x37, 0x79,
+		0x07, 0x56, 0x7a, 0xae, 0xdb, 0x36, 0x1a, 0xb4, 0xb3, 0x5d, 0x71, 0xc8, 0x92, 0x26, 0xe0, 0x75,
+		0x2c, 0x6f, 0x47, 0xa9, 0x3b, 0xd0, 0x11, 0x1a, 0x35, 0x9e, 0x4e, 0x72, 0x10, 0xeb, 0xf5, 0xcd,
+		0x2d, 0xd3, 0x30, 0x59, 0x56, 0x4f, 0x5c, 0x95, 0x45, 0xf2, 0x6e, 0x38, 0xee, 0xae, 0x86, 0x69,
+		0x68, 0xbb, 0xa8, 0xae, 0xfb, 0x74, 0x5b, 0xe3, 0x1b, 0x4e, 0x86, 0xad, 0xe1, 0x51, 0x07, 0x64,
+		0xcd, 0xb8, 0x6c, 0x76, 0x5a, 0x2a, 0xdd, 0x2e, 0x63, 0x33, 0x1e, 0x9c, 0xb9, 0xd8, 0xed, 0x96,
+		0x95, 0xcb, 0x2e, 0x86, 0x30, 0x61, 0xde, 0xa9, 0xac, 0xb5, 0xac, 0xe5, 0x14, 0xea, 0xb9, 0xd7,
+		0xa5, 0xae, 0x5b, 0x67, 0xcf, 0xf9, 0x88, 0xef, 0x9e, 0x5a, 0xb8, 0x7b, 0xe9, 0xb2, 0xc0, 0x2f,
+		0x92, 0xed, 0xb7, 0x7b, 0x39, 0xa0, 0x5c, 0x1d, 0xbd, 0xfa, 0xa7, 0x8b, 0x81, 0xc2, 0x4f, 0x06,
+		0x20, 0x5a, 0xb9, 0xba, 0xae, 0xb7, 0xfb, 0xb8, 0x6b, 0xb9, 0x5f, 0xd0, 0xcc, 0xbb, 0x96, 0x83,
+		0x22, 0xea, 0xc7, 0x6f, 0x7e, 0xc1, 0x83, 0x6e, 0x7e, 0x43, 0x13, 0xbf, 0x02, 0x31, 0x3e, 0x4a,
+		0x8b, 0x3c, 0x0b, 0x91, 0x1e, 0xfe, 0x91, 0x0b, 0xf8, 0xde, 0x88, 0x1c, 0xfd, 0xf2, 0x18, 0xbc,
+		0x57, 0x4e, 0x39, 0x5e, 0xe1, 0x6f, 0x03, 0x00, 0x95, 0xab, 0x57, 0x37, 0xfa, 0x6d, 0xdc, 0x50,
+		0xee, 0xd4, 0xb4, 0x57, 0xe0, 0xb0, 0x3b, 0x6d, 0xab, 0xdf, 0x9c, 0x79, 0xea, 0xf3, 0x0e, 0x5a,
+		0xa3, 0xdf, 0x1c, 0x4b, 0xad, 0x65, 0xd9, 0x0e, 0xb5, 0xd0, 0xcc, 0xd4, 0x2a, 0x96, 0x3d, 0x9e,
+		0x97, 0x2f, 0x40, 0xd2, 0x9d, 0xbe, 0x45, 0x6a, 0x10, 0xb7, 0xc5, 0xdf, 0x82, 0xa5, 0x85, 0xc9,
+		0x2c, 0x95, 0x68, 0x5e, 0xb6, 0x3a, 0xe8, 0x85, 0xff, 0x8d, 0x9c, 0x75, 0x3f, 0x9c, 0xaf, 0x2a,
+		0x81, 0xc2, 0x6d, 0x47, 0x6c, 0x0b, 0x77, 0xc2, 0xf1, 0x11, 0xb4, 0x86, 0x58, 0xfb, 0xe1, 0x20,
+		0xfe, 0x10, 0xbd, 0xf8, 0x7c, 0xbf, 0x6a, 0x39, 0xb1, 0x09, 0x31, 0x6a, 0xd8, 0xfd, 0x36, 0x95,
+		0x29, 0x02, 0x8f, 0x4e, 0x5a, 0xf0, 0x31, 0x73, 0xa9, 0x1a, 0x76, 0x7f, 0xcf, 0xbb, 0xfc, 0x92,
+		0xd6, 0x10, 0x2b, 0x7e, 0x3b, 0x04, 0xb9, 0x49, 0xe8, 0xf8, 0xc8, 0x49, 0xb3, 0x4f, 0x59, 0x85,
+		0xe6, 0x3b, 0x46, 0xcb, 0xc8, 0xea, 0xcb, 0x13, 0x4d, 0xc7, 0xe0, 0x97, 0x68, 0x3a, 0x12, 0x0a,
+		0x59, 0x79, 0x2f, 0x66, 0x4b, 0xef, 0xe8, 0x78, 0x99, 0x3a, 0x74, 0x07, 0x76, 0x0d, 0x79, 0xd9,
+		0xa6, 0xc4, 0x69, 0x92, 0xab, 0x10, 0x93, 0xe4, 0xc3, 0x77, 0x80, 0xbc, 0x24, 0x86, 0x37, 0xa4,
+		0xbc, 0xdb, 0x06, 0x33, 0xa2, 0xc2, 0x6a, 0xd2, 0xa9, 0xab, 0xb5, 0xa6, 0xed, 0x4b, 0xd1, 0x7d,
+		0xf7, 0x25, 0x8f, 0xad, 0xfa, 0x9b, 0x21, 0xcc, 0x0b, 0x6a, 0xfd, 0x1d, 0x5c, 0xbc, 0xaf, 0x05,
+		0xe0, 0x1f, 0x38, 0x2a, 0xdf, 0x5c, 0xf8, 0x0e, 0x28, 0x8c, 0x04, 0xa7, 0x57, 0xb1, 0xec, 0xaf,
+		0xe4, 0x0a, 0xfe, 0xeb, 0x20, 0xa4, 0xbc, 0x2b, 0xf8, 0x77, 0x60, 0xb7, 0x23, 0x75, 0x57, 0xbd,
+		0xf1, 0x1b, 0x2c, 0x0f, 0x4e, 0x52, 0x6f, 0x23, 0xb2, 0x3d, 0x83, 0x5e, 0xfb, 0x8b, 0x18, 0x44,
+		0x45, 0x4a, 0xe9, 0xda, 0x88, 0x31, 0x1e, 0x98, 0xf6, 0x8e, 0x40, 0x5a, 0xbe, 0x23, 0x30, 0xd6,
+		0x16, 0xbf, 0x8f, 0x9b, 0xb3, 0xbe, 0x3c, 0x55, 0x3c, 0xd6, 0x44, 0x83, 0xd4, 0xbd, 0x55, 0x81,
+		0xa9, 0xba, 0x08, 0xe6, 0xea, 0x70, 0x84, 0xc1, 0x0b, 0x43, 0x55, 0x5e, 0x43, 0x1e, 0x01, 0xb2,
+		0xeb, 0x04, 0x50, 0x35, 0x97, 0x19, 0x08, 0x37, 0xe7, 0xb6, 0x48, 0x70, 0x7c, 0xf0, 0xc5, 0x34,
+		0x5a, 0x1a, 0x7f, 0xe9, 0x57, 0x3c, 0xbf, 0x8c, 0x35, 0x15, 0xac, 0x20, 0xff, 0x0f, 0x37, 0xe9,
+		0x87, 0xe2, 0x77, 0xc2, 0xb9, 0x5a, 0x39, 0xd8, 0x47, 0xf1, 0xd7, 0x6f, 0x9c, 0xcc, 0xef, 0xe9,
+		0xdd, 0xce, 0x72, 0x61, 0x0c, 0xc9, 0x02, 0x33, 0xf1, 0xfd, 0x71, 0x3f, 0xf2, 0x7e, 0xcc, 0x94,
+		0x73, 0xc0, 0x44, 0xfc, 0xb2, 0x45, 0x3b, 0xba, 0xbc, 0xed, 0x30, 0x3b, 0xb7, 0x0f, 0x37, 0x87,
+		0x22, 0x78, 0x15, 0x24, 0x43, 0x7a, 0x5e, 0xe9, 0x63, 0x8c, 0xd8, 0xd6, 0x9b, 0x18, 0x0e, 0x8a,
+		0xdf, 0x81, 0xcf, 0xde, 0x95, 0x50, 0xf4, 0x84, 0x2f, 0x32, 0xc2, 0xe4, 0x06, 0x1c, 0xdb, 0xe9,
+		0x98, 0x5b, 0x78, 0x7f, 0xab, 0xfd, 0x81, 0x41, 0xbb, 0xa5, 0x09, 0xc1, 0xd4, 0x9a, 0x7a, 0x2f,
+		0x97, 0xb8, 0x03, 0xbd, 0x1e, 0xe1, 0xe4, 0x57, 0x18, 0xf5, 0x06, 0x27, 0x5e, 0xd6, 0x7b, 0xe4,
+		0xff, 0x85, 0xbb, 0xdc, 0xa9, 0x8e, 0xe9, 0x1b, 0xee, 0x40, 0xdf, 0xc7, 0x9c, 0x1e, 0x46, 0xba,
+		0xaf, 0x83, 0x82, 0xef, 0x5e, 0xf4, 0x45, 0x9c, 0x4c, 0xdb, 0xa6, 0x54, 0x5c, 0xd5, 0x38, 0xb6,
+		0x34, 0xe6, 0xa1, 0xec, 0x25, 0x7c, 0x82, 0xda, 0xfb, 0x49, 0x66, 0xae, 0xb9, 0x41, 0xb6, 0x8b,
+		0x94, 0x92, 0x65, 0xc8, 0xb3, 0xa0, 0xb6, 0x08, 0x64, 0x69, 0x5e, 0xe2, 0xfc, 0xe6, 0x7b, 0x5a,
+		0x3d, 0x82, 0x11, 0xea, 0x91, 0x10, 0x9d, 0xb5, 0x7c, 0x4a, 0xea, 0xc8, 0xd7, 0xde, 0xfa, 0x85,
+		0xd3, 0xc7, 0x3d, 0xf3, 0xba, 0xe9, 0x9c, 0xd8, 0xf0, 0xcf, 0xbc, 0xf0, 0xf3, 0x01, 0x20, 0xae,
+		0x01, 0xe3, 0xdc, 0x4f, 0x5a, 0x65, 0xb7, 0x56, 0x44, 0xad, 0x73, 0x41, 0x69, 0x62, 0x18, 0x40,
+		0x42, 0xfa, 0x1c, 0x67, 0x8f, 0x62, 0x7e, 0x8f, 0x6b, 0x2e, 0x04, 0x0f, 0xc0, 0x12, 0x89, 0xe4,
+		0xe8, 0xfc, 0x43, 0x85, 0x37, 0x02, 0x98, 0x59, 0x38, 0xa4, 0xd9, 0x9c, 0x61, 0x37, 0x81, 0xf4,
+		0x3d, 0x8d, 0x4c, 0x3b, 0xc8, 0x9c, 0x9f, 0xdb, 0x53, 0x94, 0x73, 0xfd, 0xe1, 0xd6, 0x2f, 0x97,
+		0xed, 0x23, 0xa2, 0xae, 0xbf, 0x17, 0xc0, 0xab, 0xa7, 0xad, 0xd1, 0x25, 0x69, 0x40, 0xca, 0x3b,
+		0x16, 0x31, 0xab, 0x77, 0xcd, 0x32, 0x2b, 0xef, 0x84, 0x7c, 0x44, 0x70, 0x2e, 0x52, 0x83, 0xf2,
+		0xf3, 0xa3, 0xc7, 0x66, 0xe6, 0x92, 0x73, 0x36, 0x3e, 0x6e, 0x5b, 0x09, 0xb3, 0xc5, 0xfa, 0xae,
+		0x20, 0x84, 0xd7, 0x4d, 0xb3, 0x43, 0xbe, 0x31, 0x00, 0x73, 0x86, 0x69, 0x33, 0x05, 0x44, 0x5b,
+		0x9a, 0x88, 0x90, 0xf1, 0x9d, 0xf9, 0xea, 0xc1, 0xb8, 0xf7, 0xd9, 0x37, 0x4e, 0x8e, 0x92, 0x1a,
+		0xf7, 0xec, 0x7b, 0xd6, 0x30, 0xed, 0x12, 0x03, 0x62, 0x11, 0x78, 0x8b, 0xdc, 0x80, 0xb4, 0xbf,
+		0x7f, 0xbe, 0x9d, 0xab, 0x07, 0xee, 0x3f, 0x3d, 0xb5, 0xef, 0xd4, 0x96, 0xa7, 0x63, 0xfe, 0xf8,
+		0xf2, 0x5f, 0xe2, 0xe2, 0xbe, 0x08, 0xca, 0xd5, 0xe1, 0x6c, 0xe9, 0x2a, 0xc4, 0x0e, 0x9a, 0x78,
+		0xed, 0xe5, 0xb8, 0xc0, 0x3d, 0xfd, 0x2b, 0x01, 0x00, 0x37, 0x1e, 0x89, 0x2f, 0x5e, 0x95, 0xd6,
+		0xea, 0x15, 0xad, 0xb1, 0x51, 0xdc, 0xd8, 0x6c, 0xf8, 0x7f, 0x0c, 0x45, 0xbe, 0x8f, 0x65, 0xf5,
+		0x68, 0xb3, 0xbd, 0xdd, 0xa6, 0x2d, 0x72, 0x3f, 0x2c, 0xf8, 0xa1, 0xb1, 0x54, 0xad, 0x28, 0x81,
+		0x7c, 0xea, 0xb5, 0x5b, 0x8b, 0x71, 0xee, 0xea, 0x50, 0x7c, 0x5d, 0xf4, 0xf0, 0x28, 0x1c, 0xfe,
+		0x90, 0x4a, 0x30, 0x9f, 0x7e, 0xed, 0xd6, 0x62, 0xc2, 0xf1, 0x89, 0x48, 0x01, 0x88, 0x17, 0x52,
+		0xd0, 0x0b, 0xe5, 0xe1, 0xb5, 0x5b, 0x8b, 0x51, 0xbe, 0x2c, 0xf9, 0x30, 0xbe, 0x82, 0x75, 0xfa,
+		0xeb, 0x01, 0x6a, 0xc6, 0x76, 0x5f, 0x6f, 0x32, 0x81, 0xcc, 0xc3, 0x91, 0x5a, 0xfd, 0xa2, 0x5a,
+		0x2c, 0x6f, 0xd4, 0xd6, 0xea, 0xfe, 0x61, 0x0f, 0xb5, 0x55, 0xd6, 0x36, 0x4b, 0x2b, 0x55, 0x0d,
+		0xdf, 0xdd, 0xe2, 0x59, 0x47, 0xbe, 0xb6, 0xe7, 0xeb, 0x1b, 0xb5, 0xd5, 0xaa, 0x12, 0x2c, 0x5d,
+		0x9c, 0x78, 0xee, 0xfd, 0xf0, 0xbe, 0x0b, 0xee, 0x6a, 0x4a, 0xdf, 0xe1, 0xf7, 0xff, 0x19, 0x00,
+		0xf7, 0x2b, 0x30, 0xe6, 0x31, 0xb0, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2155,6 +2505,72 @@ func (this *Commission) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PendingCommissionChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingCommissionChange)
+	if !ok {
+		that2, ok := that.(PendingCommissionChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if !this.AnnounceTime.Equal(that1.AnnounceTime) {
+		return false
+	}
+	if !this.EffectiveTime.Equal(that1.EffectiveTime) {
+		return false
+	}
+	return true
+}
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (this *Description) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2306,6 +2722,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if this.CommissionChangeDelay != that1.CommissionChangeDelay {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if this.MaxConsPubKeyRotations != that1.MaxConsPubKeyRotations {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2503,7 +2937,7 @@ func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Description) Marshal() (dAtA []byte, err error) {
+func (m *PendingCommissionChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2513,46 +2947,218 @@ func (m *Description) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Description) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingCommissionChange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x2a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x22
+	i -= n4
+	i = encodeVarintStaking(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AnnounceTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AnnounceTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x1a
+	i -= n5
+	i = encodeVarintStaking(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsPubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStaking(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Description) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Description) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
 		copy(dAtA[i:], m.Moniker)
 		i = encodeVarintStaking(dAtA, i, uint64(len(m.Moniker)))
 		i--
@@ -2582,20 +3188,20 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.UnbondingIds) > 0 {
-		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
-		var j4 int
+		dAtA10 := make([]byte, len(m.UnbondingIds)*10)
+		var j9 int
 		for _, num := range m.UnbondingIds {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j9++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintStaking(dAtA, i, uint64(j4))
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintStaking(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0x7a
 	}
@@ -2644,12 +3250,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
-	if err7 != nil {
-		return 0, err7
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n7
-	i = encodeVarintStaking(dAtA, i, uint64(n7))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -3059,12 +3665,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err10 != nil {
-		return 0, err10
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3125,12 +3731,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err11 != nil {
-		return 0, err11
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n11
-	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i -= n16
+	i = encodeVarintStaking(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3219,6 +3825,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxConsPubKeyRotations != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxConsPubKeyRotations))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeDelay):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3251,12 +3910,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n19
+	i = encodeVarintStaking(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3531,6 +4190,75 @@ func (m *Commission) Size() (n int) {
 	return n
 }
 
+func (m *PendingCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AnnounceTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func (m *Description) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3836,6 +4564,19 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeDelay)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxConsPubKeyRotations != 0 {
+		n += 1 + sovStaking(uint64(m.MaxConsPubKeyRotations))
+	}
 	return n
 }
 
@@ -4171,70 +4912,601 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnounceTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AnnounceTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsPubKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Commission) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4261,13 +5533,35 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4294,7 +5588,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6642,6 +7936,193 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsPubKeyRotations", wireType)
+			}
+			m.MaxConsPubKeyRotations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsPubKeyRotations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts holding tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord returns an initialized tokenize share record, whose
+// module account name is derived from its id.
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     valAddr.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record,
+// made of the validator operator address and the record id.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return strings.ToLower(r.Validator) + "/" + strconv.FormatUint(r.Id, 10)
+}
+
+// GetOwnerAddr returns the address of the record owner.
+func (r TokenizeShareRecord) GetOwnerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.Owner)
+}
+
+// GetValidatorAddr returns the operator address of the validator of the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetValidatorAddr() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(r.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate performs basic sanity validation checks of a tokenize share record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return err
+	}
+
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return err
+	}
+
+	if expected := fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id); r.ModuleAccount != expected {
+		return fmt.Errorf("invalid tokenize share record module account: %s, expected %s", r.ModuleAccount, expected)
+	}
+
+	return nil
+}